@@ -614,6 +614,21 @@ func roleIDSeqRestoreFunc(
 	return txn.KV().Put(ctx, deps.codec.SequenceKey(keys.RoleIDSequenceID), max.Oid+1)
 }
 
+// largeObjectOIDSeqRestoreFunc advances system.large_object_oid_seq past the
+// OIDs of the restored large objects, so that the OIDs allocated for new large
+// objects don't collide with them.
+func largeObjectOIDSeqRestoreFunc(
+	ctx context.Context, _ customRestoreFuncDeps, txn isql.Txn, _, _ string,
+) error {
+	_, err := txn.ExecEx(
+		ctx, "large-object-oid-seq-custom-restore", txn.KV(),
+		sessiondata.NodeUserSessionDataOverride,
+		`SELECT setval('system.large_object_oid_seq', greatest(max(loid)::INT8, 16384))
+FROM system.large_objects`,
+	)
+	return err
+}
+
 // systemTableBackupConfiguration is a map from every systemTable present in the
 // cluster to a configuration struct which specifies how it should be treated by
 // backup. Every system table should have a specification defined here, enforced
@@ -882,6 +897,26 @@ var systemTableBackupConfiguration = map[string]systemBackupConfiguration{
 	systemschema.ClusterMetricsTable.GetName(): {
 		shouldIncludeInClusterBackup: optOutOfClusterBackup,
 	},
+	systemschema.LargeObjectsTable.GetName(): {
+		shouldIncludeInClusterBackup: optInToClusterBackup, // No desc ID columns.
+	},
+	systemschema.LargeObjectPagesTable.GetName(): {
+		shouldIncludeInClusterBackup: optInToClusterBackup, // No desc ID columns.
+	},
+	systemschema.LargeObjectOIDSequence.GetName(): {
+		shouldIncludeInClusterBackup: optInToClusterBackup,
+		customRestoreFunc:            largeObjectOIDSeqRestoreFunc,
+		restoreInOrder:               1, // Restore after system.large_objects.
+	},
+	systemschema.UserLoginFailuresTable.GetName(): {
+		shouldIncludeInClusterBackup: optOutOfClusterBackup,
+	},
+	systemschema.PasswordHistoryTable.GetName(): {
+		shouldIncludeInClusterBackup: optInToClusterBackup, // No desc ID columns.
+	},
+	systemschema.AuditLogCheckpointsTable.GetName(): {
+		shouldIncludeInClusterBackup: optOutOfClusterBackup,
+	},
 }
 
 func rekeySystemTable(
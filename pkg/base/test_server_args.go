@@ -98,6 +98,10 @@ type TestServerArgs struct {
 	// the server.Config struct.
 	ExternalIODir string
 
+	// AuditChainKeyFile is used to initialize the same-named field on
+	// the server.Config struct.
+	AuditChainKeyFile string
+
 	// Fields copied to the server.Config.
 	Insecure                    bool
 	UseDRPC                     bool
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/changefeedbase"
 	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/sql"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
@@ -52,6 +53,51 @@ func checkPrivilegesForDescriptor(
 	return hasSelect, hasChangefeed, nil
 }
 
+// checkNoMaskedColumns returns a pgcode.InsufficientPrivilege error if the
+// given table has a column whose masking policy applies to the given user.
+// Changefeeds without a SELECT query emit the stored column values, so they
+// would bypass the masking policy; CDC queries are planned like any other
+// query and have the masking functions applied, but they are rejected at
+// planning time if a primary key column is masked, since the key holds the
+// stored values. memberOf is used to look up the roles of the user, and is
+// only called if a policy names a role other than the user and public.
+func checkNoMaskedColumns(
+	ctx context.Context,
+	table catalog.TableDescriptor,
+	user username.SQLUsername,
+	memberOf func(context.Context, username.SQLUsername) (map[username.SQLUsername]bool, error),
+) error {
+	var roles map[username.SQLUsername]bool
+	for _, col := range table.PublicColumns() {
+		mp := col.ColumnDesc().MaskingPolicy
+		if mp == nil {
+			continue
+		}
+		for _, r := range mp.RoleNames {
+			role := username.MakeSQLUsernameFromPreNormalizedString(r)
+			applies := role.IsPublicRole() || role == user
+			if !applies {
+				if roles == nil {
+					var err error
+					if roles, err = memberOf(ctx, user); err != nil {
+						return err
+					}
+				}
+				_, applies = roles[role]
+			}
+			if applies {
+				return errors.WithHint(
+					pgerror.Newf(pgcode.InsufficientPrivilege,
+						"column %q of table %q is masked for user %s",
+						col.GetName(), table.GetName(), user),
+					"use a changefeed with a SELECT query to emit the masked values",
+				)
+			}
+		}
+	}
+	return nil
+}
+
 // authorizeUserToCreateChangefeed performs changefeed creation authorization checks, returning a
 // pgcode.InsufficientPrivilege error if the check fails.
 //
@@ -699,6 +699,16 @@ func typeToSchema(typ *types.T) (*SchemaField, error) {
 				return tree.ParseDLTree(x.(string))
 			},
 		)
+	case types.XMLFamily:
+		setNullable(
+			SchemaTypeString,
+			func(d tree.Datum, _ interface{}) (interface{}, error) {
+				return d.(*tree.DXML).Contents, nil
+			},
+			func(x interface{}) (tree.Datum, error) {
+				return tree.NewDXML(x.(string)), nil
+			},
+		)
 	// case types.PGVectorFamily:
 	//
 	// We could have easily supported PGVector type via stringification, but it
@@ -209,6 +209,18 @@ func (c *prevCol) IsInaccessible() bool {
 	return false
 }
 
+func (c *prevCol) IsEncrypted() bool {
+	return false
+}
+
+func (c *prevCol) HasMissingValue() bool {
+	return false
+}
+
+func (c *prevCol) GetMissingValue() []byte {
+	return nil
+}
+
 func (c *prevCol) IsExpressionIndexColumn() bool {
 	return false
 }
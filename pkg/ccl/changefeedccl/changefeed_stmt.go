@@ -784,6 +784,11 @@ func createChangefeedJobRecord(
 				for _, warning := range changefeedvalidators.WarningsForTable(table, tolerances) {
 					p.BufferClientNotice(ctx, pgnotice.Newf("%s", warning))
 				}
+				if changefeedStmt.Select == nil {
+					if err := checkNoMaskedColumns(ctx, table, p.User(), p.MemberOfWithAdminOption); err != nil {
+						return nil, changefeedbase.Targets{}, err
+					}
+				}
 
 				hasSelect, hasChangefeed, err := checkPrivilegesForDescriptor(ctx, p, desc)
 				if err != nil {
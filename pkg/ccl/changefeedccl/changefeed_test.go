@@ -712,6 +712,64 @@ func TestRLSBlocking(t *testing.T) {
 	cdcTest(t, testFn, withAllowChangefeedErr("expects terminal error"))
 }
 
+func TestMaskingPolicyBlocking(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	testFn := func(t *testing.T, s TestServer, f cdctest.TestFeedFactory) {
+		sqlDB := sqlutils.MakeSQLRunner(s.DB)
+		sqlDB.Exec(t, `CREATE TABLE masked (a INT PRIMARY KEY, b STRING)`)
+		sqlDB.Exec(t, `INSERT INTO masked VALUES (0, 'initial')`)
+		sqlDB.Exec(t, `CREATE FUNCTION mask_str(s STRING) RETURNS STRING IMMUTABLE LANGUAGE SQL AS $$ SELECT 'xxx' $$`)
+		const setPolicy = `ALTER TABLE masked ALTER COLUMN b SET MASKING POLICY mask_str FOR ROLES (root)`
+		const dropPolicy = `ALTER TABLE masked ALTER COLUMN b DROP MASKING POLICY`
+
+		// Ensure that a changefeed without a SELECT query fails once a column
+		// becomes masked.
+		tf := feed(t, f, `CREATE CHANGEFEED FOR masked`)
+		defer closeFeed(t, tf)
+		assertPayloads(t, tf, []string{
+			`masked: [0]->{"after": {"a": 0, "b": "initial"}}`,
+		})
+		sqlDB.Exec(t, setPolicy)
+		sqlDB.Exec(t, `INSERT INTO masked VALUES (1, 'second')`)
+		_, err := readNextMessages(context.Background(), tf, 1)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `column "b" of table "masked" is masked for user root`)
+
+		// The cdc_prev tuple holds the stored values of all columns, so it cannot
+		// be used while a column is masked.
+		expErrSubstr := `cdc_prev cannot be used because table "masked" has columns masked for user root`
+		expectErrCreatingFeed(t, f, `CREATE CHANGEFEED AS SELECT a, cdc_prev FROM masked`, expErrSubstr)
+
+		// Ensure that a CDC query using cdc_prev fails once a column becomes
+		// masked.
+		sqlDB.Exec(t, dropPolicy)
+		prevFeed := feed(t, f, `CREATE CHANGEFEED AS SELECT a, cdc_prev FROM masked`)
+		defer closeFeed(t, prevFeed)
+		assertPayloads(t, prevFeed, []string{
+			`masked: [0]->{"a": 0, "cdc_prev": null}`,
+			`masked: [1]->{"a": 1, "cdc_prev": null}`,
+		})
+		sqlDB.Exec(t, setPolicy)
+		sqlDB.Exec(t, `UPDATE masked SET b = 'updated' WHERE a = 0`)
+		_, err = readNextMessages(context.Background(), prevFeed, 1)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expErrSubstr)
+
+		// The key of a CDC query holds the stored primary key values, so the
+		// query cannot be used while a primary key column is masked, even if
+		// the column is not projected.
+		sqlDB.Exec(t, dropPolicy)
+		sqlDB.Exec(t, `CREATE FUNCTION mask_int(i INT) RETURNS INT IMMUTABLE LANGUAGE SQL AS $$ SELECT 0 $$`)
+		sqlDB.Exec(t, `ALTER TABLE masked ALTER COLUMN a SET MASKING POLICY mask_int FOR ROLES (root)`)
+		expectErrCreatingFeed(t, f, `CREATE CHANGEFEED AS SELECT b FROM masked`,
+			`primary key column "a" of table "masked" is masked for user root`)
+	}
+
+	cdcTest(t, testFn, withAllowChangefeedErr("expects terminal error"))
+}
+
 func TestToJSONAsChangefeed(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 	defer log.Scope(t).Close(t)
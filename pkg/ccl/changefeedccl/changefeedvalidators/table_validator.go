@@ -48,6 +48,13 @@ func validateTable(
 	if tableDesc.IsSequence() {
 		return errors.Errorf(`CHANGEFEED cannot target sequences: %s`, tableDesc.GetName())
 	}
+	for _, col := range tableDesc.PublicColumns() {
+		// The event decoder has no access to the column keys.
+		if col.IsEncrypted() {
+			return errors.Errorf(`CHANGEFEED cannot target table %s with encrypted column %s`,
+				tableDesc.GetName(), col.GetName())
+		}
+	}
 	if tableDesc.Offline() {
 		if allowOfflineDescriptor {
 			return nil
@@ -17,9 +17,11 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/changefeedbase"
 	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/kvevent"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/sql"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
 	"github.com/cockroachdb/cockroach/pkg/sql/execinfra"
 	"github.com/cockroachdb/cockroach/pkg/sql/execinfrapb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
@@ -72,6 +74,13 @@ type kvEventToRowConsumer struct {
 	metrics *sliMetrics
 	sv      *settings.Values
 
+	// execCfg and user are used to check that no column of the target tables
+	// becomes masked for the changefeed's user. maskCheckedVersion holds, for
+	// each table, the descriptor version that was last checked.
+	execCfg            *sql.ExecutorConfig
+	user               username.SQLUsername
+	maskCheckedVersion map[descpb.ID]descpb.DescriptorVersion
+
 	// This pacer is used to incorporate event consumption to elastic CPU
 	// control. This helps ensure that event encoding/decoding does not throttle
 	// foreground SQL traffic.
@@ -278,6 +287,9 @@ func newKVEventToRowConsumer(
 		metrics:              metrics,
 		pacer:                pacer,
 		sv:                   cfg.SV(),
+		execCfg:              cfg,
+		user:                 spec.User(),
+		maskCheckedVersion:   make(map[descpb.ID]descpb.DescriptorVersion),
 	}, nil
 }
 
@@ -376,6 +388,12 @@ func (c *kvEventToRowConsumer) ConsumeEvent(ctx context.Context, ev kvevent.Even
 		return nil
 	}
 
+	if c.evaluator == nil {
+		if err := c.checkNoMaskedColumns(ctx, updatedRow.EventDescriptor); err != nil {
+			return err
+		}
+	}
+
 	// Get prev value, if necessary.
 	prevRow, prevStatus, err := func() (cdcevent.Row, cdcevent.DecodeStatus, error) {
 		if !c.details.Opts.GetFilters().WithDiff {
@@ -416,6 +434,32 @@ func (c *kvEventToRowConsumer) ConsumeEvent(ctx context.Context, ev kvevent.Even
 	return c.encodeAndEmit(ctx, updatedRow, prevRow, schemaTimestamp, ev.DetachAlloc())
 }
 
+// checkNoMaskedColumns returns a terminal error if a column of the event's
+// table is masked for the user of the changefeed. This is checked when the
+// changefeed is created, but masking policies may be added while it runs.
+// The check is only performed once per table descriptor version.
+func (c *kvEventToRowConsumer) checkNoMaskedColumns(
+	ctx context.Context, ed *cdcevent.EventDescriptor,
+) error {
+	if v, ok := c.maskCheckedVersion[ed.TableID]; ok && v == ed.Version {
+		return nil
+	}
+	memberOf := func(
+		ctx context.Context, member username.SQLUsername,
+	) (roles map[username.SQLUsername]bool, err error) {
+		err = c.execCfg.InternalDB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+			roles, err = sql.MemberOfWithAdminOption(ctx, c.execCfg, txn, member)
+			return err
+		})
+		return roles, err
+	}
+	if err := checkNoMaskedColumns(ctx, ed.TableDescriptor(), c.user, memberOf); err != nil {
+		return changefeedbase.WithTerminalError(err)
+	}
+	c.maskCheckedVersion[ed.TableID] = ed.Version
+	return nil
+}
+
 func (c *kvEventToRowConsumer) encodeAndEmit(
 	ctx context.Context,
 	updatedRow cdcevent.Row,
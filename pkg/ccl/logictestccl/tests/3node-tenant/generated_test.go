@@ -141,6 +141,13 @@ func TestTenantLogic_alter_column_type(
 	runLogicTest(t, "alter_column_type")
 }
 
+func TestTenantLogic_alter_column_type_general(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "alter_column_type_general")
+}
+
 func TestTenantLogic_alter_database_convert_to_schema(
 	t *testing.T,
 ) {
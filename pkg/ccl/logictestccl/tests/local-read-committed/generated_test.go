@@ -335,6 +335,13 @@ func TestReadCommittedLogic_bpchar(
 	runLogicTest(t, "bpchar")
 }
 
+func TestReadCommittedLogic_brin_index(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "brin_index")
+}
+
 func TestReadCommittedLogic_buffered_writes(
 	t *testing.T,
 ) {
@@ -608,6 +615,13 @@ func TestReadCommittedLogic_database(
 	runLogicTest(t, "database")
 }
 
+func TestReadCommittedLogic_database_collation(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "database_collation")
+}
+
 func TestReadCommittedLogic_datetime(
 	t *testing.T,
 ) {
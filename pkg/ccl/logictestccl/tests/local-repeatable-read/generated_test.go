@@ -308,6 +308,13 @@ func TestRepeatableReadLogic_bpchar(
 	runLogicTest(t, "bpchar")
 }
 
+func TestRepeatableReadLogic_brin_index(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "brin_index")
+}
+
 func TestRepeatableReadLogic_buffered_writes(
 	t *testing.T,
 ) {
@@ -567,6 +574,13 @@ func TestRepeatableReadLogic_database(
 	runLogicTest(t, "database")
 }
 
+func TestRepeatableReadLogic_database_collation(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "database_collation")
+}
+
 func TestRepeatableReadLogic_datetime(
 	t *testing.T,
 ) {
@@ -173,6 +173,13 @@ func TestCCLLogic_partitioning(
 	runCCLLogicTest(t, "partitioning")
 }
 
+func TestCCLLogic_partitioning_all_by_alter(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runCCLLogicTest(t, "partitioning_all_by_alter")
+}
+
 func TestCCLLogic_partitioning_all_by_nothing(
 	t *testing.T,
 ) {
@@ -194,6 +201,13 @@ func TestCCLLogic_partitioning_enum(
 	runCCLLogicTest(t, "partitioning_enum")
 }
 
+func TestCCLLogic_partitioning_hash(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runCCLLogicTest(t, "partitioning_hash")
+}
+
 func TestCCLLogic_partitioning_implicit(
 	t *testing.T,
 ) {
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/cockroach/pkg/ccl/utilccl"
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/config/zonepb"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/sql"
@@ -17,6 +18,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
@@ -182,12 +184,7 @@ func createPartitioningImpl(
 				"declared partition columns (%s) do not match first %d columns in index being partitioned (%s)",
 				partitioningString(), n, strings.Join(newIdxColumnNames[:n], ", "))
 		}
-		switch col.GetType().Family() {
-		case types.ArrayFamily:
-			return partDesc, unimplemented.NewWithIssuef(91766,
-				"partitioning by array column (%s) not supported", col.GetName())
-
-		case types.PGVectorFamily:
+		if col.GetType().Family() == types.PGVectorFamily {
 			// Can't partition by a column that does not have linear ordering.
 			return partDesc, pgerror.Newf(pgcode.FeatureNotSupported,
 				"partitioning by vector column (%s) not supported", col.GetName())
@@ -208,6 +205,10 @@ func createPartitioningImpl(
 			p.Values = append(p.Values, encodedTuple)
 		}
 		if l.Subpartition != nil {
+			if l.Subpartition.IsHash() {
+				return partDesc, pgerror.Newf(pgcode.FeatureNotSupported,
+					"PARTITION %s: cannot subpartition by HASH", p.Name)
+			}
 			newColOffset := colOffset + int(partDesc.NumColumns)
 			if numImplicitColumns > 0 {
 				return catpb.PartitioningDescriptor{}, unimplemented.New(
@@ -339,6 +340,20 @@ func createPartitioning(
 		return nil, newPartitioning, err
 	}
 
+	// PARTITION BY HASH is implemented as a LIST partitioning on a hidden
+	// computed column, which is never one of the explicitly indexed columns.
+	var hashColNames []string
+	if partBy.IsHash() {
+		hashColNames, partBy, err = desugarHashPartitioning(ctx, st, columnLookupFn, partBy, allowedNewColumnNames)
+		if err != nil {
+			return nil, newPartitioning, err
+		}
+		// The hidden partition column is virtual, so it needs no backfill and
+		// may be added along with the partitioning.
+		allowedNewColumnNames = append(allowedNewColumnNames[:len(allowedNewColumnNames):len(allowedNewColumnNames)], partBy.Fields[0])
+		allowImplicitPartitioning = true
+	}
+
 	// Truncate existing implicitly partitioned column names.
 	newIdxColumnNames := oldKeyColumnNames[oldNumImplicitColumns:]
 
@@ -394,9 +409,47 @@ func createPartitioning(
 	if err != nil {
 		return nil, catpb.PartitioningDescriptor{}, err
 	}
+	newPartitioning.HashColumnNames = hashColNames
 	return newImplicitCols, newPartitioning, err
 }
 
+// desugarHashPartitioning validates a PARTITION BY HASH clause, and returns the
+// names of the hashed columns along with the LIST partitioning on the hidden
+// partition column which implements it. The hidden column must already have
+// been added to the table by the caller, though it need not be public yet.
+func desugarHashPartitioning(
+	ctx context.Context,
+	st *cluster.Settings,
+	columnLookupFn func(tree.Name) (catalog.Column, error),
+	partBy *tree.PartitionBy,
+	allowedNewColumnNames []tree.Name,
+) (hashColNames []string, listPartBy *tree.PartitionBy, _ error) {
+	if !st.Version.IsActive(ctx, clusterversion.V26_2_HashPartitioning) {
+		return nil, nil, pgerror.New(pgcode.FeatureNotSupported,
+			"PARTITION BY HASH is not supported until the upgrade to v26.2 is finalized")
+	}
+	if err := tabledesc.ValidateHashPartitionCount(partBy.HashPartitions); err != nil {
+		return nil, nil, err
+	}
+	hashColNames, hashColName := tabledesc.HashPartitionColumnNames(partBy)
+	for _, colName := range hashColNames {
+		if _, err := findColumnByNameOnTable(
+			columnLookupFn, tree.Name(colName), allowedNewColumnNames,
+		); err != nil {
+			return nil, nil, err
+		}
+	}
+	if _, err := columnLookupFn(tree.Name(hashColName)); err != nil {
+		return nil, nil, errors.WithHint(
+			pgerror.Newf(pgcode.FeatureNotSupported,
+				"hash partitioning column %q does not exist", hashColName),
+			"PARTITION BY HASH can only be used when creating a table, or with the "+
+				"declarative schema changer in CREATE INDEX and ALTER TABLE ... PARTITION BY",
+		)
+	}
+	return hashColNames, tabledesc.HashPartitionByToList(partBy), nil
+}
+
 func init() {
 	sql.CreatePartitioningCCL = createPartitioning
 	scdeps.CreatePartitioningCCL = createPartitioning
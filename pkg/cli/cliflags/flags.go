@@ -1304,6 +1304,22 @@ The value "disabled" will disable all local file I/O.
 `,
 	}
 
+	AuditChainKeyFile = FlagInfo{
+		Name: "audit-chain-key-file",
+		Description: `
+Path to a file containing the secret key used to chain the events logged to the
+SENSITIVE_ACCESS log channel with HMACs, so that they can be checked for
+tampering with cockroach debug verify-audit-log. Surrounding whitespace is
+ignored. The file is read again periodically, and a new chain is started when
+the key changes. The chain is disabled if the flag is not specified.
+<PRE>
+
+</PRE>
+The key is not accessible through SQL. All the nodes should use the same key,
+and the file should only be readable by the user running the node.
+`,
+	}
+
 	URL = FlagInfo{
 		Name:   "url",
 		EnvVar: "COCKROACH_URL",
@@ -1361,6 +1361,7 @@ var debugCmds = []*cobra.Command{
 	debugEnvCmd,
 	debugZipCmd,
 	debugMergeLogsCmd,
+	debugVerifyAuditLogCmd,
 	debugListFilesCmd,
 	debugResetQuorumCmd,
 	debugSendKVBatchCmd,
@@ -1479,6 +1480,12 @@ func init() {
 	f.DurationVar(&jobCleanupInfoRowOpts.Age, "age", jobCleanupInfoRowOpts.Age,
 		"minimum age of job_info rows to delete; rows younger than this will not be deleted")
 
+	f = debugVerifyAuditLogCmd.Flags()
+	f.StringVar(&debugVerifyAuditLogOpts.keyFile, "key-file", "",
+		"file containing the key passed to the nodes with --audit-chain-key-file")
+	f.BoolVar(&debugVerifyAuditLogOpts.skipCheckpoints, "skip-checkpoints", false,
+		"do not check the chains against the checkpoints stored in the cluster")
+
 	f = debugSyncBenchCmd.Flags()
 	f.IntVarP(&syncBenchOpts.Concurrency, "concurrency", "c", syncBenchOpts.Concurrency,
 		"number of concurrent writers")
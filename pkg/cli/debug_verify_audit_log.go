@@ -0,0 +1,154 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package cli
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cockroachdb/cockroach/pkg/cli/clierrorplus"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/log/auditchain"
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+)
+
+const debugVerifyAuditLogAppName = catconstants.InternalAppNamePrefix + " cockroach debug verify-audit-log"
+
+var debugVerifyAuditLogCmd = &cobra.Command{
+	Use:   "verify-audit-log <log file>... --key-file=<file> --url=<cluster connection string>",
+	Short: "check the audit log hash chain for missing or modified events",
+	Long: `
+Checks the integrity of the hash chains of the events logged to the
+SENSITIVE_ACCESS channel, when the nodes are started with --audit-chain-key-file.
+The key file must contain the same key as the file of the nodes; surrounding
+whitespace is ignored.
+
+All the log files that contain events of the channel must be provided, in the
+crdb-v2 format and without redaction. The chains are also checked against the
+checkpoints stored in system.audit_log_checkpoints, which are read from the
+cluster unless --skip-checkpoints is specified. A chain whose events were
+removed after its last checkpoint cannot be detected as truncated.
+
+The command fails if any event is missing or was modified.
+`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: clierrorplus.MaybeDecorateError(runDebugVerifyAuditLog),
+}
+
+var debugVerifyAuditLogOpts = struct {
+	keyFile         string
+	skipCheckpoints bool
+}{}
+
+func runDebugVerifyAuditLog(_ *cobra.Command, args []string) (resErr error) {
+	ctx := context.Background()
+	if debugVerifyAuditLogOpts.keyFile == "" {
+		return errors.New("--key-file is required")
+	}
+	key, err := auditchain.ReadKeyFile(debugVerifyAuditLogOpts.keyFile)
+	if err != nil {
+		return err
+	}
+
+	v := auditchain.NewVerifier(key)
+	for _, path := range args {
+		if err := addAuditLogFile(v, path); err != nil {
+			return errors.Wrapf(err, "reading %s", path)
+		}
+	}
+
+	if !debugVerifyAuditLogOpts.skipCheckpoints {
+		sqlConn, err := makeSQLClient(ctx, debugVerifyAuditLogAppName, useSystemDb)
+		if err != nil {
+			return errors.Wrap(err, "could not establish connection to cluster")
+		}
+		defer func() { resErr = errors.CombineErrors(resErr, sqlConn.Close()) }()
+		rows, err := sqlConn.Query(ctx,
+			`SELECT chain_id::STRING, sequence, hmac, signature FROM system.audit_log_checkpoints`)
+		if err != nil {
+			return errors.Wrap(err, "reading the audit log checkpoints")
+		}
+		vals := make([]driver.Value, 4)
+		for {
+			if err := rows.Next(vals); err == io.EOF {
+				break
+			} else if err != nil {
+				_ = rows.Close()
+				return err
+			}
+			chainID, ok1 := vals[0].(string)
+			seq, ok2 := vals[1].(int64)
+			mac, ok3 := vals[2].([]byte)
+			sig, ok4 := vals[3].([]byte)
+			if !ok1 || !ok2 || !ok3 || !ok4 {
+				_ = rows.Close()
+				return errors.Newf("unexpected checkpoint row %v", vals)
+			}
+			v.AddCheckpoint(auditchain.Checkpoint{
+				ChainID:   chainID,
+				Sequence:  uint64(seq),
+				HMAC:      append([]byte(nil), mac...),
+				Signature: append([]byte(nil), sig...),
+			})
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+	}
+
+	problems := v.Verify()
+	fmt.Printf("checked %d events in %d chains\n", v.NumEvents(), v.NumChains())
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if len(problems) > 0 {
+		return errors.Newf("found %d problems in the audit log", len(problems))
+	}
+	return nil
+}
+
+// addAuditLogFile adds the chained events of the given log file to the
+// verifier.
+func addAuditLogFile(v *auditchain.Verifier, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, format, err := log.ReadFormatFromLogFile(f)
+	if err != nil {
+		return err
+	}
+	// The other formats do not preserve the events as they were logged.
+	if log.FormatParsers[format] != "v2" {
+		return errors.Newf("unsupported log format %q, only crdb-v2 log files can be verified", format)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	d, err := log.NewEntryDecoderWithFormat(f, log.WithMarkedSensitiveData, format)
+	if err != nil {
+		return err
+	}
+	for {
+		var e logpb.Entry
+		if err := d.Decode(&e); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if _, err := v.AddLogEntry(e); err != nil {
+			return err
+		}
+	}
+}
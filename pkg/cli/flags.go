@@ -614,6 +614,7 @@ func init() {
 		cliflagcfg.VarFlag(f, &startCtx.diskTempStorageSizeValue, cliflags.SQLTempStorage)
 		cliflagcfg.StringFlag(f, &startCtx.tempDir, cliflags.TempDir)
 		cliflagcfg.StringFlag(f, &startCtx.externalIODir, cliflags.ExternalIODir)
+		cliflagcfg.StringFlag(f, &serverCfg.AuditChainKeyFile, cliflags.AuditChainKeyFile)
 
 		if backgroundFlagDefined {
 			cliflagcfg.BoolFlag(f, &startBackground, cliflags.Background)
@@ -718,6 +719,7 @@ func init() {
 	clientCmds := []*cobra.Command{
 		debugJobTraceFromClusterCmd,
 		debugJobCleanupInfoRows,
+		debugVerifyAuditLogCmd,
 		debugGossipValuesCmd,
 		debugTimeSeriesDumpCmd,
 		debugZipCmd,
@@ -852,6 +854,7 @@ func init() {
 		demoCmd,
 		debugJobTraceFromClusterCmd,
 		debugJobCleanupInfoRows,
+		debugVerifyAuditLogCmd,
 		doctorExamineClusterCmd,
 		doctorExamineFallbackClusterCmd,
 		doctorRecreateClusterCmd,
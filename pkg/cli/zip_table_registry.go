@@ -1253,6 +1253,9 @@ var zipInternalTablesPerNode = DebugZipTableRegistry{
 // debug.zip:
 //   - system.comments: avoid downloading noise from SQL schema.
 //   - system.join_tokens: avoid downloading secret join keys.
+//   - system.large_object_pages: avoid downloading user data stored through
+//     the large object functions.
+//   - system.password_history: avoid downloading password hashes.
 //   - system.span_count, system.span_stats_buckets, system.span_stats_samples,
 //     system.span_stats_tenant_boundaries, system.span_stats_unique_keys: these
 //     power Key Visualizer and unlikely to be helpful in any investigation.
@@ -1276,6 +1279,8 @@ var disabledSystemTables = map[string]struct{}{
 	"system.cluster_metrics":                {},
 	"system.comments":                       {},
 	"system.join_tokens":                    {},
+	"system.large_object_pages":             {},
+	"system.password_history":               {},
 	"system.span_count":                     {},
 	"system.span_stats_buckets":             {},
 	"system.span_stats_samples":             {},
@@ -1294,6 +1299,14 @@ var disabledSystemTables = map[string]struct{}{
 }
 
 var zipSystemTables = DebugZipTableRegistry{
+	"system.audit_log_checkpoints": {
+		nonSensitiveCols: NonSensitiveColumns{
+			"chain_id",
+			"sequence",
+			"sql_instance_id",
+			"created",
+		},
+	},
 	"system.database_role_settings": {
 		nonSensitiveCols: NonSensitiveColumns{
 			"database_id",
@@ -1384,6 +1397,13 @@ var zipSystemTables = DebugZipTableRegistry{
 	"system.job_message": {
 		nonSensitiveCols: NonSensitiveColumns{"job_id", "written", "kind", "message"},
 	},
+	"system.large_objects": {
+		nonSensitiveCols: NonSensitiveColumns{
+			"loid",
+			"owner_id",
+			"created",
+		},
+	},
 	"system.lease": {
 		nonSensitiveCols: NonSensitiveColumns{
 			"desc_id",
@@ -1882,6 +1902,14 @@ limit 5000;`,
 			"username",
 		},
 	},
+	"system.user_login_failures": {
+		nonSensitiveCols: NonSensitiveColumns{
+			"user_id",
+			"failed_attempts",
+			"last_failure",
+			"locked_until",
+		},
+	},
 	"system.zones": {
 		customQueryRedacted: `SELECT "id" FROM system.zones;`,
 		customQueryUnredacted: `SELECT 
@@ -0,0 +1,115 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package filekms implements a KMS backed by a key file on the local disk of
+// each node. It is a stand-in for a real KMS in tests and local deployments,
+// and offers none of the guarantees of a managed key service: anyone who can
+// read the file can unwrap every key wrapped with it.
+//
+// URIs have the form file-kms:///absolute/path/to/key. The master key is the
+// SHA-256 digest of the file contents, so any file with enough entropy may be
+// used.
+package filekms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/cockroach/pkg/cloud"
+	"github.com/cockroachdb/errors"
+)
+
+// Scheme is the URI scheme of the file-backed KMS.
+const Scheme = "file-kms"
+
+type fileKMS struct {
+	path string
+	aead cipher.AEAD
+}
+
+var _ cloud.KMS = &fileKMS{}
+
+func init() {
+	cloud.RegisterKMSFromURIFactory(MakeFileKMS, Scheme)
+}
+
+// MakeFileKMS is the factory method which returns a file-backed KMS.
+func MakeFileKMS(_ context.Context, uri string, env cloud.KMSEnv) (cloud.KMS, error) {
+	// The key file is read with the privileges of the node, which makes it an
+	// implicit credential.
+	if env.KMSConfig().DisableImplicitCredentials {
+		return nil, errors.New(
+			"implicit credentials disallowed for file-kms due to --external-io-disable-implicit-credentials flag")
+	}
+	kmsURI, err := url.ParseRequestURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	if kmsURI.Host != "" {
+		return nil, errors.Newf("file-kms URI must not have a host, got %q", kmsURI.Host)
+	}
+	if len(kmsURI.Query()) > 0 {
+		return nil, errors.New("file-kms URI does not accept query parameters")
+	}
+	path := filepath.Clean(kmsURI.Path)
+	if !filepath.IsAbs(path) {
+		return nil, errors.Newf("file-kms key path %q must be absolute", kmsURI.Path)
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, cloud.KMSInaccessible(errors.Wrap(err, "reading file-kms key"))
+	}
+	if len(contents) == 0 {
+		return nil, errors.Newf("file-kms key file %q is empty", path)
+	}
+	masterKey := sha256.Sum256(contents)
+	block, err := aes.NewCipher(masterKey[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &fileKMS{path: path, aead: aead}, nil
+}
+
+// MasterKeyID implements the KMS interface.
+func (k *fileKMS) MasterKeyID() string {
+	return k.path
+}
+
+// Encrypt implements the KMS interface.
+func (k *fileKMS) Encrypt(_ context.Context, data []byte) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize(), k.aead.NonceSize()+len(data)+k.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return k.aead.Seal(nonce, nonce, data, nil /* additionalData */), nil
+}
+
+// Decrypt implements the KMS interface.
+func (k *fileKMS) Decrypt(_ context.Context, data []byte) ([]byte, error) {
+	if len(data) < k.aead.NonceSize() {
+		return nil, errors.New("file-kms ciphertext is too short")
+	}
+	nonce, sealed := data[:k.aead.NonceSize()], data[k.aead.NonceSize():]
+	plaintext, err := k.aead.Open(nil /* dst */, nonce, sealed, nil /* additionalData */)
+	if err != nil {
+		return nil, errors.Wrap(err, "file-kms decryption failed")
+	}
+	return plaintext, nil
+}
+
+// Close implements the KMS interface.
+func (k *fileKMS) Close() error {
+	return nil
+}
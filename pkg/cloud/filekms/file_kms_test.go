@@ -0,0 +1,71 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package filekms
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/cloud"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileKMS(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "master.key")
+	require.NoError(t, os.WriteFile(keyPath, []byte("not a very secret key"), 0600))
+	uri := "file-kms://" + keyPath
+
+	env := &cloud.TestKMSEnv{
+		Settings:         cluster.MakeTestingClusterSettings(),
+		ExternalIOConfig: &base.ExternalIODirConfig{},
+		Username:         username.RootUserName(),
+	}
+	cloud.KMSEncryptDecrypt(t, uri, env)
+
+	t.Run("wrong key", func(t *testing.T) {
+		ctx := context.Background()
+		kms, err := cloud.KMSFromURI(ctx, uri, env)
+		require.NoError(t, err)
+		ciphertext, err := kms.Encrypt(ctx, []byte("hello world"))
+		require.NoError(t, err)
+
+		otherPath := filepath.Join(dir, "other.key")
+		require.NoError(t, os.WriteFile(otherPath, []byte("another key"), 0600))
+		other, err := cloud.KMSFromURI(ctx, "file-kms://"+otherPath, env)
+		require.NoError(t, err)
+		_, err = other.Decrypt(ctx, ciphertext)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid uris", func(t *testing.T) {
+		ctx := context.Background()
+		for _, tc := range []struct {
+			uri string
+			err string
+		}{
+			{"file-kms://host" + keyPath, "must not have a host"},
+			{uri + "?AUTH=implicit", "does not accept query parameters"},
+			{"file-kms://" + filepath.Join(dir, "missing.key"), "reading file-kms key"},
+		} {
+			_, err := cloud.KMSFromURI(ctx, tc.uri, env)
+			require.ErrorContains(t, err, tc.err)
+		}
+
+		noImplicit := *env
+		noImplicit.ExternalIOConfig = &base.ExternalIODirConfig{DisableImplicitCredentials: true}
+		_, err := cloud.KMSFromURI(ctx, uri, &noImplicit)
+		require.ErrorContains(t, err, "implicit credentials disallowed")
+	})
+}
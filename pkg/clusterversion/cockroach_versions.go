@@ -223,6 +223,85 @@ const (
 	// This table stores cluster metrics with labels, types, and values.
 	V26_2_AddSystemClusterMetricsTable
 
+	// V26_2_AddSystemLargeObjectTables adds the system.large_objects and
+	// system.large_object_pages tables, which back the large object functions.
+	V26_2_AddSystemLargeObjectTables
+
+	// V26_2_XMLType is the version at which the xml type can be used in
+	// columns and other persisted values.
+	V26_2_XMLType
+
+	// V26_2_AddSystemLoginPolicyTables adds the system.user_login_failures and
+	// system.password_history tables, which back account lockout and password
+	// history policies.
+	V26_2_AddSystemLoginPolicyTables
+
+	// V26_2_ColumnPrivileges is the version at which privileges can be granted
+	// on table columns.
+	V26_2_ColumnPrivileges
+
+	// V26_2_ColumnMaskingPolicies is the version at which masking policies can
+	// be set on table columns.
+	V26_2_ColumnMaskingPolicies
+
+	// V26_2_ColumnEncryption is the version at which table columns can be
+	// encrypted with KMS-managed keys.
+	V26_2_ColumnEncryption
+
+	// V26_2_AddSystemAuditLogCheckpointsTable adds the
+	// system.audit_log_checkpoints table, which stores the signed checkpoints
+	// of the audit log hash chains.
+	V26_2_AddSystemAuditLogCheckpointsTable
+
+	// V26_2_SequenceCycle is the version at which sequences and identity
+	// columns can be created with the CYCLE option.
+	V26_2_SequenceCycle
+
+	// V26_2_AddColumnMissingValue is the version at which NOT NULL columns with
+	// a constant default can be added without backfilling existing rows.
+	V26_2_AddColumnMissingValue
+
+	// V26_2_AlterColumnTypeGeneral is the version at which ALTER COLUMN TYPE
+	// can rewrite columns that are indexed, constrained or referenced by views
+	// and routines.
+	V26_2_AlterColumnTypeGeneral
+
+	// V26_2_HashPartitioning is the version at which tables and indexes can be
+	// partitioned with PARTITION BY HASH.
+	V26_2_HashPartitioning
+
+	// V26_2_BrinIndexes is the version at which BRIN indexes can be created.
+	V26_2_BrinIndexes
+
+	// V26_2_DatabaseDefaultCollation is the version at which databases can be
+	// created with a default collation.
+	V26_2_DatabaseDefaultCollation
+
+	// V26_2_RepartitionAllBy is the version at which the partitioning of
+	// PARTITION ALL BY tables can be changed.
+	V26_2_RepartitionAllBy
+
+	// V26_2_BuiltinTriggerFunctions is the version at which triggers can call
+	// builtin trigger functions such as tsvector_update_trigger.
+	V26_2_BuiltinTriggerFunctions
+
+	// V26_2_DeclarativeCreateAndAlterStatements is the version at which CREATE
+	// TABLE, CREATE VIEW, CREATE TYPE, ALTER TYPE and ALTER DATABASE run in the
+	// declarative schema changer by default.
+	V26_2_DeclarativeCreateAndAlterStatements
+
+	// V26_2_TextSearchObjects is the version at which text search configurations
+	// and dictionaries can be created and altered.
+	V26_2_TextSearchObjects
+
+	// V26_2_UserDefinedCollations is the version at which collations can be
+	// created.
+	V26_2_UserDefinedCollations
+
+	// V26_2_UserDefinedCastsAndOperators is the version at which casts and
+	// operators can be created.
+	V26_2_UserDefinedCastsAndOperators
+
 	// *************************************************
 	// Step (1) Add new versions above this comment.
 	// Do not add new versions to a patch release.
@@ -279,6 +358,44 @@ var versionTable = [numKeys]roachpb.Version{
 
 	V26_2_AddSystemClusterMetricsTable: {Major: 26, Minor: 1, Internal: 6},
 
+	V26_2_AddSystemLargeObjectTables: {Major: 26, Minor: 1, Internal: 8},
+
+	V26_2_XMLType: {Major: 26, Minor: 1, Internal: 10},
+
+	V26_2_AddSystemLoginPolicyTables: {Major: 26, Minor: 1, Internal: 12},
+
+	V26_2_ColumnPrivileges: {Major: 26, Minor: 1, Internal: 14},
+
+	V26_2_ColumnMaskingPolicies: {Major: 26, Minor: 1, Internal: 16},
+
+	V26_2_ColumnEncryption: {Major: 26, Minor: 1, Internal: 18},
+
+	V26_2_AddSystemAuditLogCheckpointsTable: {Major: 26, Minor: 1, Internal: 20},
+
+	V26_2_SequenceCycle: {Major: 26, Minor: 1, Internal: 22},
+
+	V26_2_AddColumnMissingValue: {Major: 26, Minor: 1, Internal: 24},
+
+	V26_2_AlterColumnTypeGeneral: {Major: 26, Minor: 1, Internal: 26},
+
+	V26_2_HashPartitioning: {Major: 26, Minor: 1, Internal: 28},
+
+	V26_2_BrinIndexes: {Major: 26, Minor: 1, Internal: 30},
+
+	V26_2_DatabaseDefaultCollation: {Major: 26, Minor: 1, Internal: 32},
+
+	V26_2_RepartitionAllBy: {Major: 26, Minor: 1, Internal: 34},
+
+	V26_2_BuiltinTriggerFunctions: {Major: 26, Minor: 1, Internal: 36},
+
+	V26_2_DeclarativeCreateAndAlterStatements: {Major: 26, Minor: 1, Internal: 38},
+
+	V26_2_TextSearchObjects: {Major: 26, Minor: 1, Internal: 40},
+
+	V26_2_UserDefinedCollations: {Major: 26, Minor: 1, Internal: 42},
+
+	V26_2_UserDefinedCastsAndOperators: {Major: 26, Minor: 1, Internal: 44},
+
 	// *************************************************
 	// Step (2): Add new versions above this comment.
 	// Do not add new versions to a patch release.
@@ -0,0 +1,370 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package geomfn
+
+import (
+	"math"
+
+	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/cockroachdb/cockroach/pkg/geo/geopb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/twpayne/go-geom"
+)
+
+// NoCluster is the cluster number assigned to geometries which do not belong
+// to any cluster.
+const NoCluster = -1
+
+// maxKMeansIterations bounds the number of refinement rounds performed by
+// ClusterKMeans.
+const maxKMeansIterations = 1000
+
+// clusterUnionFind is a disjoint-set forest used to group geometries into
+// clusters.
+type clusterUnionFind []int
+
+func makeClusterUnionFind(n int) clusterUnionFind {
+	uf := make(clusterUnionFind, n)
+	for i := range uf {
+		uf[i] = i
+	}
+	return uf
+}
+
+func (uf clusterUnionFind) find(i int) int {
+	for uf[i] != i {
+		uf[i] = uf[uf[i]]
+		i = uf[i]
+	}
+	return i
+}
+
+func (uf clusterUnionFind) union(i, j int) {
+	ri, rj := uf.find(i), uf.find(j)
+	// Always keep the smaller index as the root so that cluster numbering
+	// follows the input order.
+	if ri < rj {
+		uf[rj] = ri
+	} else if rj < ri {
+		uf[ri] = rj
+	}
+}
+
+// numberClusters converts the cluster representative of each input into a
+// 0-indexed cluster number, assigned in order of first appearance. Inputs
+// whose representative is NoCluster keep that value.
+func numberClusters(reps []int) []int {
+	numbers := make(map[int]int)
+	ret := make([]int, len(reps))
+	for i, rep := range reps {
+		if rep == NoCluster {
+			ret[i] = NoCluster
+			continue
+		}
+		n, ok := numbers[rep]
+		if !ok {
+			n = len(numbers)
+			numbers[rep] = n
+		}
+		ret[i] = n
+	}
+	return ret
+}
+
+// ClusterDBSCAN assigns each geometry a cluster number using the DBSCAN
+// algorithm. A geometry is a core geometry if at least minPoints geometries
+// (including itself) are within eps of it. Core geometries within eps of each
+// other share a cluster, and other geometries within eps of a core geometry
+// join the cluster of the first such core geometry. The remaining geometries,
+// along with any that are nil or empty, are assigned NoCluster.
+func ClusterDBSCAN(geoms []*geo.Geometry, eps float64, minPoints int) ([]int, error) {
+	if eps < 0 {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "eps must be greater than or equal to zero")
+	}
+	if minPoints < 0 {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "minpoints must be greater than or equal to zero")
+	}
+	n := len(geoms)
+	neighbors := make([][]int, n)
+	for i := range geoms {
+		if geoms[i] == nil || geoms[i].Empty() {
+			continue
+		}
+		neighbors[i] = append(neighbors[i], i)
+		for j := i + 1; j < n; j++ {
+			if geoms[j] == nil || geoms[j].Empty() {
+				continue
+			}
+			within, err := DWithin(*geoms[i], *geoms[j], eps, geo.FnInclusive)
+			if err != nil {
+				return nil, err
+			}
+			if within {
+				neighbors[i] = append(neighbors[i], j)
+				neighbors[j] = append(neighbors[j], i)
+			}
+		}
+	}
+
+	isCore := func(i int) bool {
+		return len(neighbors[i]) > 0 && len(neighbors[i]) >= minPoints
+	}
+	uf := makeClusterUnionFind(n)
+	for i := range geoms {
+		if !isCore(i) {
+			continue
+		}
+		for _, j := range neighbors[i] {
+			if isCore(j) {
+				uf.union(i, j)
+			}
+		}
+	}
+
+	reps := make([]int, n)
+	for i := range geoms {
+		reps[i] = NoCluster
+		if isCore(i) {
+			reps[i] = uf.find(i)
+			continue
+		}
+		// Border geometries join the cluster of the first core neighbor.
+		firstCore := NoCluster
+		for _, j := range neighbors[i] {
+			if isCore(j) && (firstCore == NoCluster || j < firstCore) {
+				firstCore = j
+			}
+		}
+		if firstCore != NoCluster {
+			reps[i] = uf.find(firstCore)
+		}
+	}
+	return numberClusters(reps), nil
+}
+
+// ClusterKMeans assigns each geometry to one of k clusters using the k-means
+// algorithm on the centroids of the geometries. If maxRadius is positive,
+// the number of clusters is increased until no geometry is further than
+// maxRadius from the center of its cluster. Geometries which are nil or empty
+// are assigned NoCluster.
+func ClusterKMeans(geoms []*geo.Geometry, k int, maxRadius float64) ([]int, error) {
+	if k <= 0 {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "number of clusters must be greater than zero")
+	}
+	var srid geopb.SRID
+	var idxs []int
+	var points []geom.Coord
+	for i, g := range geoms {
+		if g == nil || g.Empty() {
+			continue
+		}
+		if len(idxs) == 0 {
+			srid = g.SRID()
+		} else if g.SRID() != srid {
+			return nil, geo.NewMismatchingSRIDsError(geoms[idxs[0]].SpatialObject(), g.SpatialObject())
+		}
+		c, err := clusterCentroid(*g)
+		if err != nil {
+			return nil, err
+		}
+		idxs = append(idxs, i)
+		points = append(points, c)
+	}
+
+	reps := make([]int, len(geoms))
+	for i := range reps {
+		reps[i] = NoCluster
+	}
+	if len(points) == 0 {
+		return reps, nil
+	}
+	if k > len(points) {
+		k = len(points)
+	}
+	var assignment []int
+	for {
+		var radius float64
+		assignment, radius = kMeans(points, k)
+		if maxRadius <= 0 || radius <= maxRadius || k == len(points) {
+			break
+		}
+		k++
+	}
+	for i, idx := range idxs {
+		reps[idx] = assignment[i]
+	}
+	return numberClusters(reps), nil
+}
+
+// clusterCentroid returns the 2D centroid of the given non-empty geometry.
+func clusterCentroid(g geo.Geometry) (geom.Coord, error) {
+	if g.ShapeType2D() != geopb.ShapeType_Point {
+		var err error
+		if g, err = Centroid(g); err != nil {
+			return nil, err
+		}
+	}
+	t, err := g.AsGeomT()
+	if err != nil {
+		return nil, err
+	}
+	c := t.FlatCoords()
+	return geom.Coord{c[0], c[1]}, nil
+}
+
+// kMeans partitions the given points into k clusters. It returns the cluster
+// of each point along with the largest distance between a point and the
+// center of its cluster. The initial centers are chosen deterministically:
+// the first is the lowest point, and each subsequent one is the point
+// furthest from the centers chosen so far.
+func kMeans(points []geom.Coord, k int) (assignment []int, radius float64) {
+	centers := make([]geom.Coord, 0, k)
+	first := 0
+	for i, p := range points {
+		if p[1] < points[first][1] || (p[1] == points[first][1] && p[0] < points[first][0]) {
+			first = i
+		}
+	}
+	centers = append(centers, points[first])
+	minDists := make([]float64, len(points))
+	for i, p := range points {
+		minDists[i] = coordDistanceSquared(p, centers[0])
+	}
+	for len(centers) < k {
+		furthest := 0
+		for i := range points {
+			if minDists[i] > minDists[furthest] {
+				furthest = i
+			}
+		}
+		centers = append(centers, points[furthest])
+		for i, p := range points {
+			minDists[i] = math.Min(minDists[i], coordDistanceSquared(p, points[furthest]))
+		}
+	}
+
+	assignment = make([]int, len(points))
+	for i := range assignment {
+		assignment[i] = -1
+	}
+	for iter := 0; iter < maxKMeansIterations; iter++ {
+		changed := false
+		for i, p := range points {
+			best := 0
+			bestDist := coordDistanceSquared(p, centers[0])
+			for c := 1; c < len(centers); c++ {
+				if d := coordDistanceSquared(p, centers[c]); d < bestDist {
+					best, bestDist = c, d
+				}
+			}
+			if assignment[i] != best {
+				assignment[i] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		sums := make([]geom.Coord, len(centers))
+		counts := make([]int, len(centers))
+		for i, p := range points {
+			c := assignment[i]
+			if sums[c] == nil {
+				sums[c] = geom.Coord{0, 0}
+			}
+			sums[c][0] += p[0]
+			sums[c][1] += p[1]
+			counts[c]++
+		}
+		for c := range centers {
+			// Centers which lost all of their points keep their position.
+			if counts[c] > 0 {
+				centers[c] = geom.Coord{sums[c][0] / float64(counts[c]), sums[c][1] / float64(counts[c])}
+			}
+		}
+	}
+
+	for i, p := range points {
+		radius = math.Max(radius, math.Sqrt(coordDistanceSquared(p, centers[assignment[i]])))
+	}
+	return assignment, radius
+}
+
+func coordDistanceSquared(a, b geom.Coord) float64 {
+	dx, dy := a[0]-b[0], a[1]-b[1]
+	return dx*dx + dy*dy
+}
+
+// ClusterIntersecting groups the given geometries into GeometryCollections of
+// geometries which are connected through intersections.
+func ClusterIntersecting(geoms []geo.Geometry) ([]geo.Geometry, error) {
+	return clusterConnected(geoms, Intersects)
+}
+
+// ClusterWithin groups the given geometries into GeometryCollections of
+// geometries which are connected through chains of geometries at most
+// distance apart.
+func ClusterWithin(geoms []geo.Geometry, distance float64) ([]geo.Geometry, error) {
+	if distance < 0 {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "tolerance must be greater than or equal to zero")
+	}
+	return clusterConnected(geoms, func(a, b geo.Geometry) (bool, error) {
+		return DWithin(a, b, distance, geo.FnInclusive)
+	})
+}
+
+// clusterConnected groups the given geometries into the connected components
+// of the graph whose edges are the pairs of geometries for which connected
+// returns true. Each component is returned as a GeometryCollection, in order
+// of the first appearance of one of its members.
+func clusterConnected(
+	geoms []geo.Geometry, connected func(a, b geo.Geometry) (bool, error),
+) ([]geo.Geometry, error) {
+	uf := makeClusterUnionFind(len(geoms))
+	for i := range geoms {
+		for j := i + 1; j < len(geoms); j++ {
+			if uf.find(i) == uf.find(j) {
+				continue
+			}
+			ok, err := connected(geoms[i], geoms[j])
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				uf.union(i, j)
+			}
+		}
+	}
+
+	reps := make([]int, len(geoms))
+	for i := range geoms {
+		reps[i] = uf.find(i)
+	}
+	clusters := numberClusters(reps)
+	var collections []*geom.GeometryCollection
+	for i, g := range geoms {
+		c := clusters[i]
+		if c == len(collections) {
+			collections = append(collections, geom.NewGeometryCollection().SetSRID(int(g.SRID())))
+		}
+		t, err := g.AsGeomT()
+		if err != nil {
+			return nil, err
+		}
+		if err := collections[c].Push(t); err != nil {
+			return nil, err
+		}
+	}
+	ret := make([]geo.Geometry, len(collections))
+	for i, gc := range collections {
+		var err error
+		if ret[i], err = geo.MakeGeometryFromGeomT(gc); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
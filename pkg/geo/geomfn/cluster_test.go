@@ -0,0 +1,143 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package geomfn
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/stretchr/testify/require"
+)
+
+func parseClusterInputs(ewkts []string) []*geo.Geometry {
+	ret := make([]*geo.Geometry, len(ewkts))
+	for i, ewkt := range ewkts {
+		if ewkt == "" {
+			continue
+		}
+		g := geo.MustParseGeometry(ewkt)
+		ret[i] = &g
+	}
+	return ret
+}
+
+func TestClusterDBSCAN(t *testing.T) {
+	inputs := []string{
+		"POINT(0 0)",
+		"POINT(0 1)",
+		"POINT(1 0)",
+		"POINT(10 10)",
+		"POINT(10 11)",
+		"POINT(50 50)",
+		"",
+		"POINT EMPTY",
+		"POINT(0 2)",
+	}
+	testCases := []struct {
+		eps       float64
+		minPoints int
+		expected  []int
+	}{
+		{eps: 1, minPoints: 1, expected: []int{0, 0, 0, 1, 1, 2, NoCluster, NoCluster, 0}},
+		{eps: 1, minPoints: 2, expected: []int{0, 0, 0, 1, 1, NoCluster, NoCluster, NoCluster, 0}},
+		// Only (0 0) and (0 1) have three neighbors; (1 0) and (0 2) are border
+		// geometries.
+		{eps: 1, minPoints: 3, expected: []int{0, 0, 0, NoCluster, NoCluster, NoCluster, NoCluster, NoCluster, 0}},
+		{eps: 100, minPoints: 10, expected: []int{NoCluster, NoCluster, NoCluster, NoCluster, NoCluster, NoCluster, NoCluster, NoCluster, NoCluster}},
+	}
+	for _, tc := range testCases {
+		clusters, err := ClusterDBSCAN(parseClusterInputs(inputs), tc.eps, tc.minPoints)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, clusters)
+	}
+
+	_, err := ClusterDBSCAN(parseClusterInputs(inputs), -1, 1)
+	require.EqualError(t, err, "eps must be greater than or equal to zero")
+	_, err = ClusterDBSCAN(
+		parseClusterInputs([]string{"SRID=4004;POINT(1.0 1.0)", "SRID=4326;LINESTRING(1.0 1.0, 2.0 2.0)"}), 1, 1,
+	)
+	requireMismatchingSRIDError(t, err)
+}
+
+func TestClusterKMeans(t *testing.T) {
+	inputs := []string{
+		"POINT(0 0)",
+		"POINT(10 10)",
+		"POINT(0 1)",
+		"",
+		"POINT(10 11)",
+		"LINESTRING(20 0, 22 0)",
+		"POINT(21 1)",
+	}
+	testCases := []struct {
+		k         int
+		maxRadius float64
+		expected  []int
+	}{
+		{k: 1, expected: []int{0, 0, 0, NoCluster, 0, 0, 0}},
+		{k: 3, expected: []int{0, 1, 0, NoCluster, 1, 2, 2}},
+		// There are only six geometries, so at most six clusters are created.
+		{k: 10, expected: []int{0, 1, 2, NoCluster, 3, 4, 5}},
+		// The number of clusters grows until every cluster fits the radius.
+		{k: 1, maxRadius: 2, expected: []int{0, 1, 0, NoCluster, 1, 2, 2}},
+	}
+	for _, tc := range testCases {
+		clusters, err := ClusterKMeans(parseClusterInputs(inputs), tc.k, tc.maxRadius)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, clusters)
+	}
+
+	_, err := ClusterKMeans(parseClusterInputs(inputs), 0, 0)
+	require.EqualError(t, err, "number of clusters must be greater than zero")
+}
+
+func TestClusterWithin(t *testing.T) {
+	geoms := []geo.Geometry{
+		geo.MustParseGeometry("POINT(0 0)"),
+		geo.MustParseGeometry("POINT(10 0)"),
+		geo.MustParseGeometry("LINESTRING(1 0, 2 0)"),
+		geo.MustParseGeometry("POINT(11 0)"),
+		geo.MustParseGeometry("POINT(3 0)"),
+	}
+	clusters, err := ClusterWithin(geoms, 1)
+	require.NoError(t, err)
+	require.Len(t, clusters, 2)
+	requireGeomEqual(
+		t,
+		geo.MustParseGeometry("GEOMETRYCOLLECTION(POINT(0 0), LINESTRING(1 0, 2 0), POINT(3 0))"),
+		clusters[0],
+	)
+	requireGeomEqual(
+		t,
+		geo.MustParseGeometry("GEOMETRYCOLLECTION(POINT(10 0), POINT(11 0))"),
+		clusters[1],
+	)
+
+	clusters, err = ClusterWithin(geoms, 0.5)
+	require.NoError(t, err)
+	require.Len(t, clusters, 5)
+
+	_, err = ClusterWithin(geoms, -1)
+	require.EqualError(t, err, "tolerance must be greater than or equal to zero")
+}
+
+func TestClusterIntersecting(t *testing.T) {
+	geoms := []geo.Geometry{
+		geo.MustParseGeometry("LINESTRING(0 0, 1 1)"),
+		geo.MustParseGeometry("POINT(5 5)"),
+		geo.MustParseGeometry("LINESTRING(1 1, 2 2)"),
+		geo.MustParseGeometry("LINESTRING(4 0, 0 4)"),
+	}
+	clusters, err := ClusterIntersecting(geoms)
+	require.NoError(t, err)
+	require.Len(t, clusters, 2)
+	requireGeomEqual(
+		t,
+		geo.MustParseGeometry("GEOMETRYCOLLECTION(LINESTRING(0 0, 1 1), LINESTRING(1 1, 2 2), LINESTRING(4 0, 0 4))"),
+		clusters[0],
+	)
+	requireGeomEqual(t, geo.MustParseGeometry("GEOMETRYCOLLECTION(POINT(5 5))"), clusters[1])
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package geomfn
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/cockroachdb/cockroach/pkg/geo/geopb"
+	"github.com/cockroachdb/errors"
+	"github.com/twpayne/go-geom"
+)
+
+// DumpItem is a component of a geometry together with its 1-indexed path
+// inside the geometry it was extracted from.
+type DumpItem struct {
+	Path     []int
+	Geometry geo.Geometry
+}
+
+// Dump returns the non-collection components of the given geometry. As in
+// PostGIS, a geometry which is not a collection is returned as-is with an
+// empty path, and empty collections have no components.
+func Dump(g geo.Geometry) ([]DumpItem, error) {
+	t, err := g.AsGeomT()
+	if err != nil {
+		return nil, err
+	}
+	var items []DumpItem
+	if err := dumpGeomT(t, nil /* path */, g.SRID(), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func dumpGeomT(t geom.T, path []int, srid geopb.SRID, items *[]DumpItem) error {
+	switch t := t.(type) {
+	case *geom.MultiPoint:
+		for i := 0; i < t.NumPoints(); i++ {
+			if err := appendDumpItem(t.Point(i), appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.MultiLineString:
+		for i := 0; i < t.NumLineStrings(); i++ {
+			if err := appendDumpItem(t.LineString(i), appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.MultiPolygon:
+		for i := 0; i < t.NumPolygons(); i++ {
+			if err := appendDumpItem(t.Polygon(i), appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.GeometryCollection:
+		for i, sub := range t.Geoms() {
+			if err := dumpGeomT(sub, appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.Point, *geom.LineString, *geom.Polygon:
+		return appendDumpItem(t, path, srid, items)
+	default:
+		return errors.AssertionFailedf("unknown geometry type: %T", t)
+	}
+	return nil
+}
+
+// DumpPoints returns every vertex of the given geometry as a Point, along with
+// its path. Following PostGIS, the last element of the path is the 1-indexed
+// position of the vertex in its LineString or ring, and is preceded by the
+// ring number for Polygons and by the component number for collections.
+func DumpPoints(g geo.Geometry) ([]DumpItem, error) {
+	t, err := g.AsGeomT()
+	if err != nil {
+		return nil, err
+	}
+	var items []DumpItem
+	if err := dumpPointsGeomT(t, nil /* path */, g.SRID(), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func dumpPointsGeomT(t geom.T, path []int, srid geopb.SRID, items *[]DumpItem) error {
+	switch t := t.(type) {
+	case *geom.Point:
+		if t.Empty() {
+			return nil
+		}
+		return appendDumpItem(t, appendPath(path, 0), srid, items)
+	case *geom.LineString:
+		return dumpPointsFlat(t.Layout(), t.FlatCoords(), path, srid, items)
+	case *geom.Polygon:
+		for i := 0; i < t.NumLinearRings(); i++ {
+			ring := t.LinearRing(i)
+			if err := dumpPointsFlat(ring.Layout(), ring.FlatCoords(), appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.MultiPoint:
+		for i := 0; i < t.NumPoints(); i++ {
+			if err := dumpPointsGeomT(t.Point(i), appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.MultiLineString:
+		for i := 0; i < t.NumLineStrings(); i++ {
+			if err := dumpPointsGeomT(t.LineString(i), appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.MultiPolygon:
+		for i := 0; i < t.NumPolygons(); i++ {
+			if err := dumpPointsGeomT(t.Polygon(i), appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	case *geom.GeometryCollection:
+		for i, sub := range t.Geoms() {
+			if err := dumpPointsGeomT(sub, appendPath(path, i), srid, items); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.AssertionFailedf("unknown geometry type: %T", t)
+	}
+	return nil
+}
+
+func dumpPointsFlat(
+	layout geom.Layout, flatCoords []float64, path []int, srid geopb.SRID, items *[]DumpItem,
+) error {
+	stride := layout.Stride()
+	for i := 0; i < len(flatCoords); i += stride {
+		point := geom.NewPointFlat(layout, flatCoords[i:i+stride])
+		if err := appendDumpItem(point, appendPath(path, i/stride), srid, items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendPath returns a copy of path with the 1-indexed form of idx appended.
+func appendPath(path []int, idx int) []int {
+	ret := make([]int, len(path), len(path)+1)
+	copy(ret, path)
+	return append(ret, idx+1)
+}
+
+func appendDumpItem(t geom.T, path []int, srid geopb.SRID, items *[]DumpItem) error {
+	geo.AdjustGeomTSRID(t, srid)
+	g, err := geo.MakeGeometryFromGeomT(t)
+	if err != nil {
+		return err
+	}
+	*items = append(*items, DumpItem{Path: path, Geometry: g})
+	return nil
+}
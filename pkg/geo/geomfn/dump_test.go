@@ -0,0 +1,125 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package geomfn
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/stretchr/testify/require"
+)
+
+type dumpTestItem struct {
+	path []int
+	ewkt string
+}
+
+func requireDumpItemsEqual(t *testing.T, expected []dumpTestItem, got []DumpItem) {
+	require.Len(t, got, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i].path, got[i].Path)
+		requireGeomEqual(t, geo.MustParseGeometry(expected[i].ewkt), got[i].Geometry)
+	}
+}
+
+func TestDump(t *testing.T) {
+	testCases := []struct {
+		ewkt     string
+		expected []dumpTestItem
+	}{
+		{
+			ewkt:     "SRID=4326;POINT(1 2)",
+			expected: []dumpTestItem{{path: nil, ewkt: "SRID=4326;POINT(1 2)"}},
+		},
+		{
+			ewkt:     "GEOMETRYCOLLECTION EMPTY",
+			expected: nil,
+		},
+		{
+			ewkt: "SRID=4326;MULTIPOINT((1 2), (3 4))",
+			expected: []dumpTestItem{
+				{path: []int{1}, ewkt: "SRID=4326;POINT(1 2)"},
+				{path: []int{2}, ewkt: "SRID=4326;POINT(3 4)"},
+			},
+		},
+		{
+			ewkt: "MULTIPOLYGON(((0 0, 1 0, 1 1, 0 0)), ((5 5, 6 5, 6 6, 5 5)))",
+			expected: []dumpTestItem{
+				{path: []int{1}, ewkt: "POLYGON((0 0, 1 0, 1 1, 0 0))"},
+				{path: []int{2}, ewkt: "POLYGON((5 5, 6 5, 6 6, 5 5))"},
+			},
+		},
+		{
+			ewkt: "GEOMETRYCOLLECTION(POINT(1 2), MULTILINESTRING((0 0, 1 1), (2 2, 3 3)), GEOMETRYCOLLECTION(POINT(4 5)))",
+			expected: []dumpTestItem{
+				{path: []int{1}, ewkt: "POINT(1 2)"},
+				{path: []int{2, 1}, ewkt: "LINESTRING(0 0, 1 1)"},
+				{path: []int{2, 2}, ewkt: "LINESTRING(2 2, 3 3)"},
+				{path: []int{3, 1}, ewkt: "POINT(4 5)"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ewkt, func(t *testing.T) {
+			items, err := Dump(geo.MustParseGeometry(tc.ewkt))
+			require.NoError(t, err)
+			requireDumpItemsEqual(t, tc.expected, items)
+		})
+	}
+}
+
+func TestDumpPoints(t *testing.T) {
+	testCases := []struct {
+		ewkt     string
+		expected []dumpTestItem
+	}{
+		{
+			ewkt:     "SRID=4326;POINT(1 2)",
+			expected: []dumpTestItem{{path: []int{1}, ewkt: "SRID=4326;POINT(1 2)"}},
+		},
+		{
+			ewkt:     "POINT EMPTY",
+			expected: nil,
+		},
+		{
+			ewkt: "LINESTRING Z (0 0 1, 1 1 2)",
+			expected: []dumpTestItem{
+				{path: []int{1}, ewkt: "POINT Z (0 0 1)"},
+				{path: []int{2}, ewkt: "POINT Z (1 1 2)"},
+			},
+		},
+		{
+			ewkt: "GEOMETRYCOLLECTION(POINT(0 1), POLYGON((0 0, 4 0, 4 4, 0 0), (1 1, 2 1, 2 2, 1 1)))",
+			expected: []dumpTestItem{
+				{path: []int{1, 1}, ewkt: "POINT(0 1)"},
+				{path: []int{2, 1, 1}, ewkt: "POINT(0 0)"},
+				{path: []int{2, 1, 2}, ewkt: "POINT(4 0)"},
+				{path: []int{2, 1, 3}, ewkt: "POINT(4 4)"},
+				{path: []int{2, 1, 4}, ewkt: "POINT(0 0)"},
+				{path: []int{2, 2, 1}, ewkt: "POINT(1 1)"},
+				{path: []int{2, 2, 2}, ewkt: "POINT(2 1)"},
+				{path: []int{2, 2, 3}, ewkt: "POINT(2 2)"},
+				{path: []int{2, 2, 4}, ewkt: "POINT(1 1)"},
+			},
+		},
+		{
+			ewkt: "MULTIPOINT((1 2), (3 4))",
+			expected: []dumpTestItem{
+				{path: []int{1, 1}, ewkt: "POINT(1 2)"},
+				{path: []int{2, 1}, ewkt: "POINT(3 4)"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ewkt, func(t *testing.T) {
+			items, err := DumpPoints(geo.MustParseGeometry(tc.ewkt))
+			require.NoError(t, err)
+			requireDumpItemsEqual(t, tc.expected, items)
+		})
+	}
+}
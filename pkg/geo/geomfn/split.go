@@ -0,0 +1,380 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package geomfn
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/cockroachdb/cockroach/pkg/geo/geopb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/errors"
+	"github.com/twpayne/go-geom"
+)
+
+// splitTolerance is the largest distance between a splitting point and a
+// LineString for the point to be considered to lie on the LineString.
+const splitTolerance = 1e-9
+
+// Split returns a GeometryCollection of the parts that result from splitting
+// the input geometry by the blade geometry. LineStrings can be split by
+// points, lines or the boundaries of polygons, while Polygons can only be
+// split by lines. Collections are split component by component.
+func Split(g geo.Geometry, blade geo.Geometry) (geo.Geometry, error) {
+	if g.SRID() != blade.SRID() {
+		return geo.Geometry{}, geo.NewMismatchingSRIDsError(g.SpatialObject(), blade.SpatialObject())
+	}
+	t, err := g.AsGeomT()
+	if err != nil {
+		return geo.Geometry{}, err
+	}
+	bladeT, err := blade.AsGeomT()
+	if err != nil {
+		return geo.Geometry{}, err
+	}
+	gc := geom.NewGeometryCollection().SetSRID(int(g.SRID()))
+	if err := splitGeomT(t, blade, bladeT, gc); err != nil {
+		return geo.Geometry{}, err
+	}
+	return geo.MakeGeometryFromGeomT(gc)
+}
+
+// splitGeomT splits t by the blade, appending the parts to gc.
+func splitGeomT(t geom.T, blade geo.Geometry, bladeT geom.T, gc *geom.GeometryCollection) error {
+	switch t := t.(type) {
+	case *geom.LineString:
+		return splitLineString(t, blade, bladeT, gc)
+	case *geom.Polygon:
+		return splitPolygon(t, blade, bladeT, gc)
+	case *geom.MultiLineString:
+		for i := 0; i < t.NumLineStrings(); i++ {
+			if err := splitLineString(t.LineString(i), blade, bladeT, gc); err != nil {
+				return err
+			}
+		}
+	case *geom.MultiPolygon:
+		for i := 0; i < t.NumPolygons(); i++ {
+			if err := splitPolygon(t.Polygon(i), blade, bladeT, gc); err != nil {
+				return err
+			}
+		}
+	case *geom.GeometryCollection:
+		for _, sub := range t.Geoms() {
+			if err := splitGeomT(sub, blade, bladeT, gc); err != nil {
+				return err
+			}
+		}
+	default:
+		return pgerror.Newf(
+			pgcode.InvalidParameterValue, "splitting a %s is unsupported", geomTTypeName(t),
+		)
+	}
+	return nil
+}
+
+func splitLineString(
+	line *geom.LineString, blade geo.Geometry, bladeT geom.T, gc *geom.GeometryCollection,
+) error {
+	if line.Empty() {
+		return nil
+	}
+	var points []geom.Coord
+	var err error
+	switch bladeT.(type) {
+	case *geom.Point, *geom.MultiPoint:
+		points = collectCoords(bladeT)
+	case *geom.LineString, *geom.MultiLineString:
+		points, err = lineIntersectionPoints(line, blade)
+	case *geom.Polygon, *geom.MultiPolygon:
+		// Polygons split lines along their boundary.
+		var boundary geo.Geometry
+		if boundary, err = Boundary(blade); err == nil {
+			points, err = lineIntersectionPoints(line, boundary)
+		}
+	default:
+		return pgerror.Newf(
+			pgcode.InvalidParameterValue,
+			"splitting a LineString by a %s is unsupported", geomTTypeName(bladeT),
+		)
+	}
+	if err != nil {
+		return err
+	}
+	for _, part := range splitLineStringByPoints(line, points) {
+		if err := gc.Push(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lineIntersectionPoints returns the points at which the given LineString
+// intersects the linear blade. It returns an error if they share a line.
+func lineIntersectionPoints(line *geom.LineString, blade geo.Geometry) ([]geom.Coord, error) {
+	lineGeom, err := geo.MakeGeometryFromGeomT(
+		geom.NewLineStringFlat(line.Layout(), line.FlatCoords()).SetSRID(int(blade.SRID())),
+	)
+	if err != nil {
+		return nil, err
+	}
+	intersection, err := Intersection(lineGeom, blade)
+	if err != nil {
+		return nil, err
+	}
+	t, err := intersection.AsGeomT()
+	if err != nil {
+		return nil, err
+	}
+	if hasNonEmptyNonPoint(t) {
+		return nil, pgerror.Newf(
+			pgcode.InvalidParameterValue, "splitter line has linear intersection with input",
+		)
+	}
+	return collectCoords(t), nil
+}
+
+// lineCut is a location on a LineString, given as the index of a segment and
+// the fraction of the way along that segment.
+type lineCut struct {
+	seg      int
+	fraction float64
+}
+
+// splitLineStringByPoints splits the LineString at each of the given points
+// that lie on it. Points which do not lie on the LineString, or which lie on
+// one of its endpoints, are ignored.
+func splitLineStringByPoints(line *geom.LineString, points []geom.Coord) []*geom.LineString {
+	numSegs := line.NumCoords() - 1
+	var cuts []lineCut
+	for _, p := range points {
+		bestSeg, bestFraction, bestDist := -1, 0.0, math.Inf(1)
+		for i := 0; i < numSegs; i++ {
+			fraction, dist := projectOntoSegment(p, line.Coord(i), line.Coord(i+1))
+			if dist < bestDist {
+				bestSeg, bestFraction, bestDist = i, fraction, dist
+			}
+		}
+		if bestSeg == -1 || bestDist > splitTolerance {
+			continue
+		}
+		if bestFraction == 1 && bestSeg < numSegs-1 {
+			bestSeg, bestFraction = bestSeg+1, 0
+		}
+		if (bestSeg == 0 && bestFraction == 0) || (bestSeg == numSegs-1 && bestFraction == 1) {
+			continue
+		}
+		cuts = append(cuts, lineCut{seg: bestSeg, fraction: bestFraction})
+	}
+	if len(cuts) == 0 {
+		return []*geom.LineString{line}
+	}
+	sort.Slice(cuts, func(i, j int) bool {
+		if cuts[i].seg != cuts[j].seg {
+			return cuts[i].seg < cuts[j].seg
+		}
+		return cuts[i].fraction < cuts[j].fraction
+	})
+
+	layout := line.Layout()
+	stride := layout.Stride()
+	var parts []*geom.LineString
+	cur := append([]float64(nil), line.Coord(0)...)
+	finishPart := func(at []float64) {
+		if !coordsEqual(cur[len(cur)-stride:], at) {
+			cur = append(cur, at...)
+		}
+		if len(cur) >= 2*stride {
+			parts = append(parts, geom.NewLineStringFlat(layout, cur))
+		}
+		cur = append([]float64(nil), at...)
+	}
+	cutIdx := 0
+	for i := 0; i < numSegs; i++ {
+		start, end := line.Coord(i), line.Coord(i+1)
+		for ; cutIdx < len(cuts) && cuts[cutIdx].seg == i; cutIdx++ {
+			finishPart(interpolateCoord(start, end, cuts[cutIdx].fraction))
+		}
+		if !coordsEqual(cur[len(cur)-stride:], end) {
+			cur = append(cur, end...)
+		}
+	}
+	if len(cur) >= 2*stride {
+		parts = append(parts, geom.NewLineStringFlat(layout, cur))
+	}
+	return parts
+}
+
+// projectOntoSegment returns how far along the segment from a to b the
+// closest point to p lies, as a fraction in [0, 1], along with the distance
+// from p to that point.
+func projectOntoSegment(p, a, b geom.Coord) (fraction, distance float64) {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	lenSq := dx*dx + dy*dy
+	if lenSq > 0 {
+		fraction = ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / lenSq
+		fraction = math.Max(0, math.Min(1, fraction))
+	}
+	cx, cy := a[0]+fraction*dx, a[1]+fraction*dy
+	return fraction, math.Hypot(p[0]-cx, p[1]-cy)
+}
+
+// interpolateCoord returns the coordinate the given fraction of the way from
+// a to b, interpolating every ordinate.
+func interpolateCoord(a, b geom.Coord, fraction float64) []float64 {
+	switch fraction {
+	case 0:
+		return append([]float64(nil), a...)
+	case 1:
+		return append([]float64(nil), b...)
+	}
+	ret := make([]float64, len(a))
+	for i := range a {
+		ret[i] = a[i] + fraction*(b[i]-a[i])
+	}
+	return ret
+}
+
+func coordsEqual(a, b []float64) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitPolygon(
+	polygon *geom.Polygon, blade geo.Geometry, bladeT geom.T, gc *geom.GeometryCollection,
+) error {
+	switch bladeT.(type) {
+	case *geom.LineString, *geom.MultiLineString:
+	default:
+		return pgerror.Newf(
+			pgcode.InvalidParameterValue,
+			"splitting a Polygon by a %s is unsupported", geomTTypeName(bladeT),
+		)
+	}
+	if polygon.Empty() {
+		return nil
+	}
+	polygonGeom, err := geo.MakeGeometryFromGeomT(
+		geom.NewPolygonFlat(polygon.Layout(), polygon.FlatCoords(), polygon.Ends()).SetSRID(int(blade.SRID())),
+	)
+	if err != nil {
+		return err
+	}
+	if bladeT.Empty() {
+		return gc.Push(polygon)
+	}
+
+	// Node the boundary of the polygon together with the blade, and form
+	// polygons from the resulting linework. The pieces of the input are the
+	// polygons whose interior lies inside the input polygon; this discards
+	// both the polygons formed by the holes of the input and those formed
+	// only by the blade.
+	boundary, err := Boundary(polygonGeom)
+	if err != nil {
+		return err
+	}
+	noded, err := Union(boundary, blade)
+	if err != nil {
+		return err
+	}
+	polygonized, err := Polygonize([]geo.Geometry{noded})
+	if err != nil {
+		return err
+	}
+	polygonizedT, err := polygonized.AsGeomT()
+	if err != nil {
+		return err
+	}
+	pieces, ok := polygonizedT.(*geom.GeometryCollection)
+	if !ok {
+		return errors.AssertionFailedf("unexpected polygonize result type: %T", polygonizedT)
+	}
+	for _, piece := range pieces.Geoms() {
+		geo.AdjustGeomTSRID(piece, blade.SRID())
+		pieceGeom, err := geo.MakeGeometryFromGeomT(piece)
+		if err != nil {
+			return err
+		}
+		pos, err := PointOnSurface(pieceGeom)
+		if err != nil {
+			return err
+		}
+		inside, err := Intersects(polygonGeom, pos)
+		if err != nil {
+			return err
+		}
+		if inside {
+			if err := gc.Push(piece); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectCoords returns all coordinates of the given geometry.
+func collectCoords(t geom.T) []geom.Coord {
+	if gc, ok := t.(*geom.GeometryCollection); ok {
+		var ret []geom.Coord
+		for _, sub := range gc.Geoms() {
+			ret = append(ret, collectCoords(sub)...)
+		}
+		return ret
+	}
+	if t.Empty() {
+		return nil
+	}
+	stride := t.Layout().Stride()
+	flatCoords := t.FlatCoords()
+	ret := make([]geom.Coord, 0, len(flatCoords)/stride)
+	for i := 0; i < len(flatCoords); i += stride {
+		ret = append(ret, geom.Coord(flatCoords[i:i+stride]))
+	}
+	return ret
+}
+
+// hasNonEmptyNonPoint returns whether the given geometry has a non-empty
+// component which is not a point.
+func hasNonEmptyNonPoint(t geom.T) bool {
+	switch t := t.(type) {
+	case *geom.Point, *geom.MultiPoint:
+		return false
+	case *geom.GeometryCollection:
+		for _, sub := range t.Geoms() {
+			if hasNonEmptyNonPoint(sub) {
+				return true
+			}
+		}
+		return false
+	default:
+		return !t.Empty()
+	}
+}
+
+// geomTTypeName returns the name of the shape type of the given geometry.
+func geomTTypeName(t geom.T) string {
+	switch t.(type) {
+	case *geom.Point:
+		return geopb.ShapeType_Point.String()
+	case *geom.LineString:
+		return geopb.ShapeType_LineString.String()
+	case *geom.Polygon:
+		return geopb.ShapeType_Polygon.String()
+	case *geom.MultiPoint:
+		return geopb.ShapeType_MultiPoint.String()
+	case *geom.MultiLineString:
+		return geopb.ShapeType_MultiLineString.String()
+	case *geom.MultiPolygon:
+		return geopb.ShapeType_MultiPolygon.String()
+	default:
+		return geopb.ShapeType_GeometryCollection.String()
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package geomfn
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestSplit(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		input    string
+		blade    string
+		expected string
+	}{
+		{
+			desc:     "LineString by MultiPoint",
+			input:    "LINESTRING(0 0, 10 0)",
+			blade:    "MULTIPOINT((5 0), (2 0), (20 0), (0 0))",
+			expected: "GEOMETRYCOLLECTION(LINESTRING(0 0, 2 0), LINESTRING(2 0, 5 0), LINESTRING(5 0, 10 0))",
+		},
+		{
+			desc:     "LineString by Point on a vertex",
+			input:    "SRID=4326;LINESTRING(0 0, 5 5, 10 0)",
+			blade:    "SRID=4326;POINT(5 5)",
+			expected: "SRID=4326;GEOMETRYCOLLECTION(LINESTRING(0 0, 5 5), LINESTRING(5 5, 10 0))",
+		},
+		{
+			desc:     "LineString by Point not on the line",
+			input:    "LINESTRING(0 0, 10 0)",
+			blade:    "POINT(5 5)",
+			expected: "GEOMETRYCOLLECTION(LINESTRING(0 0, 10 0))",
+		},
+		{
+			desc:     "LineString by LineString",
+			input:    "LINESTRING(0 0, 10 0)",
+			blade:    "LINESTRING(5 -5, 5 5)",
+			expected: "GEOMETRYCOLLECTION(LINESTRING(0 0, 5 0), LINESTRING(5 0, 10 0))",
+		},
+		{
+			desc:     "MultiLineString by Point",
+			input:    "MULTILINESTRING((0 0, 10 0), (0 5, 10 5))",
+			blade:    "POINT(5 0)",
+			expected: "GEOMETRYCOLLECTION(LINESTRING(0 0, 5 0), LINESTRING(5 0, 10 0), LINESTRING(0 5, 10 5))",
+		},
+		{
+			desc:     "empty LineString",
+			input:    "LINESTRING EMPTY",
+			blade:    "POINT(5 0)",
+			expected: "GEOMETRYCOLLECTION EMPTY",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g, err := Split(geo.MustParseGeometry(tc.input), geo.MustParseGeometry(tc.blade))
+			require.NoError(t, err)
+			requireGeomEqual(t, geo.MustParseGeometry(tc.expected), g)
+		})
+	}
+
+	t.Run("Polygon by LineString", func(t *testing.T) {
+		g, err := Split(
+			geo.MustParseGeometry("POLYGON((0 0, 10 0, 10 10, 0 10, 0 0), (6 6, 8 6, 8 8, 6 8, 6 6))"),
+			geo.MustParseGeometry("LINESTRING(5 -1, 5 11)"),
+		)
+		require.NoError(t, err)
+		gT, err := g.AsGeomT()
+		require.NoError(t, err)
+		gc, ok := gT.(*geom.GeometryCollection)
+		require.True(t, ok)
+		require.Equal(t, 2, gc.NumGeoms())
+		area, err := Area(g)
+		require.NoError(t, err)
+		require.Equal(t, float64(96), area)
+	})
+
+	errorTestCases := []struct {
+		input       string
+		blade       string
+		expectedErr string
+	}{
+		{
+			input:       "LINESTRING(0 0, 10 0)",
+			blade:       "LINESTRING(2 0, 4 0)",
+			expectedErr: "splitter line has linear intersection with input",
+		},
+		{
+			input:       "POLYGON((0 0, 10 0, 10 10, 0 10, 0 0))",
+			blade:       "POINT(5 5)",
+			expectedErr: "splitting a Polygon by a Point is unsupported",
+		},
+		{
+			input:       "POINT(0 0)",
+			blade:       "LINESTRING(2 0, 4 0)",
+			expectedErr: "splitting a Point is unsupported",
+		},
+	}
+	for _, tc := range errorTestCases {
+		t.Run(tc.expectedErr, func(t *testing.T) {
+			_, err := Split(geo.MustParseGeometry(tc.input), geo.MustParseGeometry(tc.blade))
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+
+	t.Run("errors if SRIDs mismatch", func(t *testing.T) {
+		_, err := Split(mismatchingSRIDGeometryA, mismatchingSRIDGeometryB)
+		requireMismatchingSRIDError(t, err)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/geo/geos"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/twpayne/go-geom"
 )
 
 // Boundary returns the boundary of a given Geometry.
@@ -209,6 +210,58 @@ func MinimumRotatedRectangle(g geo.Geometry) (geo.Geometry, error) {
 	return gm, nil
 }
 
+// Polygonize returns a GeometryCollection of the polygons formed by the
+// linework of the given geometries.
+func Polygonize(geoms []geo.Geometry) (geo.Geometry, error) {
+	gc := geom.NewGeometryCollection()
+	for i, g := range geoms {
+		if i == 0 {
+			gc.SetSRID(int(g.SRID()))
+		} else if g.SRID() != geoms[0].SRID() {
+			return geo.Geometry{}, geo.NewMismatchingSRIDsError(geoms[0].SpatialObject(), g.SpatialObject())
+		}
+		t, err := g.AsGeomT()
+		if err != nil {
+			return geo.Geometry{}, err
+		}
+		if err := gc.Push(t); err != nil {
+			return geo.Geometry{}, err
+		}
+	}
+	if gc.Empty() {
+		return geo.MakeGeometryFromGeomT(gc)
+	}
+	collected, err := geo.MakeGeometryFromGeomT(gc)
+	if err != nil {
+		return geo.Geometry{}, err
+	}
+	polygonsEWKB, err := geos.Polygonize(collected.EWKB())
+	if err != nil {
+		return geo.Geometry{}, err
+	}
+	return geo.ParseGeometryFromEWKB(polygonsEWKB)
+}
+
+// ConcaveHull returns a possibly concave geometry which encloses all
+// geometries within the input. A targetPercent of 1 produces the convex hull,
+// while smaller values produce increasingly concave results. Holes are only
+// produced if allowHoles is set.
+func ConcaveHull(g geo.Geometry, targetPercent float64, allowHoles bool) (geo.Geometry, error) {
+	if math.IsNaN(targetPercent) || targetPercent < 0 || targetPercent > 1 {
+		return geo.Geometry{}, pgerror.Newf(
+			pgcode.InvalidParameterValue, "target_percent must be between 0 and 1",
+		)
+	}
+	if g.Empty() {
+		return g, nil
+	}
+	hullEWKB, err := geos.ConcaveHull(g.EWKB(), targetPercent, allowHoles)
+	if err != nil {
+		return geo.Geometry{}, err
+	}
+	return geo.ParseGeometryFromEWKB(hullEWKB)
+}
+
 // BoundingBoxHasInfiniteCoordinates checks if the bounding box of a Geometry
 // has an infinite coordinate.
 func BoundingBoxHasInfiniteCoordinates(g geo.Geometry) bool {
@@ -170,6 +170,10 @@ typedef char (*CR_GEOS_EqualsExact_r)(CR_GEOS_Handle, CR_GEOS_Geometry,
 typedef CR_GEOS_Geometry (*CR_GEOS_MinimumRotatedRectangle_r)(CR_GEOS_Handle, CR_GEOS_Geometry);
 
 typedef CR_GEOS_Geometry (*CR_GEOS_Snap_r)(CR_GEOS_Handle, CR_GEOS_Geometry, CR_GEOS_Geometry, double);
+typedef CR_GEOS_Geometry (*CR_GEOS_Polygonize_r)(CR_GEOS_Handle, const CR_GEOS_Geometry[],
+                                                 unsigned int);
+typedef CR_GEOS_Geometry (*CR_GEOS_ConcaveHull_r)(CR_GEOS_Handle, CR_GEOS_Geometry, double,
+                                                  unsigned int);
 typedef const char* (*CR_GEOS_Version_r)();
 
 std::string ToString(CR_GEOS_Slice slice) { return std::string(slice.data, slice.len); }
@@ -283,6 +287,11 @@ struct CR_GEOS {
 
   CR_GEOS_Snap_r GEOSSnap_r;
 
+  CR_GEOS_Polygonize_r GEOSPolygonize_r;
+  // GEOSConcaveHull_r is only available in GEOS 3.11 and later, so it may be
+  // nullptr when an older library is loaded.
+  CR_GEOS_ConcaveHull_r GEOSConcaveHull_r;
+
   CR_GEOS_Version_r GEOSversion;
 
   CR_GEOS(dlhandle geoscHandle, dlhandle geosHandle)
@@ -388,6 +397,8 @@ struct CR_GEOS {
     INIT(GEOSClipByRect_r);
     INIT(GEOSNode_r);
     INIT(GEOSSnap_r);
+    INIT(GEOSPolygonize_r);
+    INIT(GEOSConcaveHull_r);
     INIT(GEOSversion);
     return nullptr;
 
@@ -1556,3 +1567,47 @@ CR_GEOS_Status CR_GEOS_Snap(CR_GEOS* lib, CR_GEOS_Slice input, CR_GEOS_Slice tar
   lib->GEOS_finish_r(handle);
   return toGEOSString(error.data(), error.length());
 }
+
+CR_GEOS_Status CR_GEOS_Polygonize(CR_GEOS* lib, CR_GEOS_Slice a, CR_GEOS_String* ret) {
+  std::string error;
+  auto handle = initHandleWithErrorBuffer(lib, &error);
+  auto gGeom = CR_GEOS_GeometryFromSlice(lib, handle, a);
+  *ret = {.data = NULL, .len = 0};
+  if (gGeom != nullptr) {
+    // The polygonizer extracts the linework from every component of the
+    // input, so a single collection can be passed in place of an array.
+    const CR_GEOS_Geometry geoms[] = {gGeom};
+    auto r = lib->GEOSPolygonize_r(handle, geoms, 1);
+    if (r != NULL) {
+      auto srid = lib->GEOSGetSRID_r(handle, gGeom);
+      CR_GEOS_writeGeomToEWKB(lib, handle, r, ret, srid);
+      lib->GEOSGeom_destroy_r(handle, r);
+    }
+    lib->GEOSGeom_destroy_r(handle, gGeom);
+  }
+  lib->GEOS_finish_r(handle);
+  return toGEOSString(error.data(), error.length());
+}
+
+CR_GEOS_Status CR_GEOS_ConcaveHull(CR_GEOS* lib, CR_GEOS_Slice a, double ratio, char allowHoles,
+                                   CR_GEOS_String* ret) {
+  *ret = {.data = NULL, .len = 0};
+  if (lib->GEOSConcaveHull_r == nullptr) {
+    std::string error = "concave hull requires GEOS 3.11 or later";
+    return toGEOSString(error.data(), error.length());
+  }
+  std::string error;
+  auto handle = initHandleWithErrorBuffer(lib, &error);
+  auto gGeom = CR_GEOS_GeometryFromSlice(lib, handle, a);
+  if (gGeom != nullptr) {
+    auto r = lib->GEOSConcaveHull_r(handle, gGeom, ratio, allowHoles);
+    if (r != NULL) {
+      auto srid = lib->GEOSGetSRID_r(handle, gGeom);
+      CR_GEOS_writeGeomToEWKB(lib, handle, r, ret, srid);
+      lib->GEOSGeom_destroy_r(handle, r);
+    }
+    lib->GEOSGeom_destroy_r(handle, gGeom);
+  }
+  lib->GEOS_finish_r(handle);
+  return toGEOSString(error.data(), error.length());
+}
@@ -1123,3 +1123,38 @@ func Snap(input, target geopb.EWKB, tolerance float64) (geopb.EWKB, error) {
 	}
 	return cStringToSafeGoBytes(cEWKB), nil
 }
+
+// Polygonize returns a GeometryCollection of the polygons formed from the
+// linework of the given EWKB.
+func Polygonize(a geopb.EWKB) (geopb.EWKB, error) {
+	g, err := ensureInitInternal()
+	if err != nil {
+		return nil, err
+	}
+	var cEWKB C.CR_GEOS_String
+	if err := statusToError(C.CR_GEOS_Polygonize(g, goToCSlice(a), &cEWKB)); err != nil {
+		return nil, err
+	}
+	return cStringToSafeGoBytes(cEWKB), nil
+}
+
+// ConcaveHull returns a possibly concave geometry enclosing the given EWKB.
+// A ratio of 1 produces the convex hull, while smaller ratios produce more
+// concave results.
+func ConcaveHull(a geopb.EWKB, ratio float64, allowHoles bool) (geopb.EWKB, error) {
+	g, err := ensureInitInternal()
+	if err != nil {
+		return nil, err
+	}
+	var cEWKB C.CR_GEOS_String
+	flag := 0
+	if allowHoles {
+		flag = 1
+	}
+	if err := statusToError(
+		C.CR_GEOS_ConcaveHull(g, goToCSlice(a), C.double(ratio), C.char(flag), &cEWKB),
+	); err != nil {
+		return nil, err
+	}
+	return cStringToSafeGoBytes(cEWKB), nil
+}
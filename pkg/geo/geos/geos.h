@@ -190,6 +190,11 @@ CR_GEOS_Status CR_GEOS_VoronoiDiagram(CR_GEOS* lib, CR_GEOS_Slice g, CR_GEOS_Sli
 
 CR_GEOS_Status CR_GEOS_Snap(CR_GEOS* lib, CR_GEOS_Slice input, CR_GEOS_Slice target, double tolerance, CR_GEOS_String* ret);
 
+CR_GEOS_Status CR_GEOS_Polygonize(CR_GEOS* lib, CR_GEOS_Slice a, CR_GEOS_String* ret);
+
+CR_GEOS_Status CR_GEOS_ConcaveHull(CR_GEOS* lib, CR_GEOS_Slice a, double ratio, char allowHoles,
+                                   CR_GEOS_String* ret);
+
 #ifdef __cplusplus
 }  // extern "C"
 #endif
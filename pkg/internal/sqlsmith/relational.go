@@ -1810,7 +1810,8 @@ func (s *Smither) isOrderable(typ *types.T) bool {
 	if typ.Family() == types.ArrayFamily {
 		typ = typ.ArrayContents()
 	}
-	if typ.Family() == types.RefCursorFamily || typ.Family() == types.JsonpathFamily {
+	if typ.Family() == types.RefCursorFamily || typ.Family() == types.JsonpathFamily ||
+		typ.Family() == types.XMLFamily {
 		// These types don't define an ordering function in PG.
 		return false
 	}
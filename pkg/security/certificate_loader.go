@@ -222,6 +222,7 @@ type CertificateLoader struct {
 	certsDir             string
 	skipPermissionChecks bool
 	certificates         []*CertInfo
+	crls                 []*CRLInfo
 }
 
 // Certificates returns the loaded certificates.
@@ -229,6 +230,11 @@ func (cl *CertificateLoader) Certificates() []*CertInfo {
 	return cl.certificates
 }
 
+// CRLs returns the loaded certificate revocation lists.
+func (cl *CertificateLoader) CRLs() []*CRLInfo {
+	return cl.crls
+}
+
 // NewCertificateLoader creates a new instance of the certificate loader.
 func NewCertificateLoader(certsDir string) *CertificateLoader {
 	return &CertificateLoader{
@@ -266,8 +272,8 @@ func (cl *CertificateLoader) TestDisablePermissionChecks() {
 }
 
 // Load examines all .crt files in the certs directory, determines their
-// usage, and looks for their keys.
-// It populates the certificates field.
+// usage, and looks for their keys. It also reads all .crl files.
+// It populates the certificates and crls fields.
 func (cl *CertificateLoader) Load() error {
 	fileInfos, err := securityassets.GetLoader().ReadDir(cl.certsDir)
 	if err != nil {
@@ -298,6 +304,11 @@ func (cl *CertificateLoader) Load() error {
 			continue
 		}
 
+		if certnames.IsCRLFilename(filename) {
+			cl.crls = append(cl.crls, cl.loadCRL(filename))
+			continue
+		}
+
 		if !certnames.IsCertificateFilename(filename) {
 			if log.V(3) {
 				log.Dev.Infof(context.Background(), "skipping non-certificate file %s", filename)
@@ -338,6 +349,27 @@ func (cl *CertificateLoader) Load() error {
 	return nil
 }
 
+// loadCRL reads and parses the given CRL file.
+// Errors are persisted for better visibility later.
+func (cl *CertificateLoader) loadCRL(filename string) *CRLInfo {
+	fullPath := filepath.Join(cl.certsDir, filename)
+	ci := &CRLInfo{Filename: filename}
+	contents, err := securityassets.GetLoader().ReadFile(fullPath)
+	if err != nil {
+		log.Dev.Warningf(context.Background(), "could not read CRL file %s: %v", fullPath, err)
+		ci.Error = errors.Wrapf(err, "could not read CRL file %s", fullPath)
+		return ci
+	}
+	ci.FileContents = contents
+	if err := parseCRL(ci); err != nil {
+		log.Dev.Warningf(context.Background(), "could not parse CRL %s: %v", fullPath, err)
+		ci.Error = err
+	} else if log.V(3) {
+		log.Dev.Infof(context.Background(), "found CRL %s", ci.Filename)
+	}
+	return ci
+}
+
 // findKey takes a CertInfo and looks for the corresponding key file.
 // If found, sets the 'keyFilename' and returns nil, returns error otherwise.
 // Does not load CA keys.
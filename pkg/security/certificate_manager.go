@@ -46,6 +46,8 @@ import (
 //   - client.<user>.crt  client certificate for 'user'. Verified using 'ca.crt', or 'ca-client.crt'.
 //   - client.node.crt    client certificate for the 'node' user. If it does not exist,
 //     fall back on 'node.crt'.
+//   - *.crl              certificate revocation lists. Peer certificates listed in a CRL
+//     signed by their issuer are rejected during TLS verification.
 type CertificateManager struct {
 	tenantIdentifier uint64
 	timeSource       timeutil.TimeSource
@@ -78,6 +80,9 @@ type CertificateManager struct {
 	// Certs only used with multi-tenancy.
 	tenantCACert, tenantCert, tenantSigningCert *CertInfo
 
+	// Certificate revocation lists found in the certs directory.
+	crls crlSet
+
 	// TLS configs. Initialized lazily. Wiped on every successful Load().
 	// Server-side config.
 	serverConfig *tls.Config
@@ -365,6 +370,18 @@ func (cm *CertificateManager) LoadCertificates() error {
 		}
 	}
 
+	// Refuse to load a CRL that cannot be parsed, rather than silently
+	// accepting the certificates it might revoke.
+	for _, ci := range cl.CRLs() {
+		if ci.Error != nil {
+			return makeErrorf(ci.Error, "problem with CRL %s", ci.Filename)
+		}
+		if next := ci.RevocationList.NextUpdate; !next.IsZero() && next.Before(timeutil.Now()) {
+			log.Ops.Warningf(context.Background(), "CRL %s is past its next update time %s", ci.Filename, next)
+		}
+	}
+	crls := makeCRLSet(cl.CRLs())
+
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 	if cm.initialized {
@@ -429,6 +446,8 @@ func (cm *CertificateManager) LoadCertificates() error {
 	cm.tenantCert = tenantCert
 	cm.tenantSigningCert = tenantSigningCert
 
+	cm.crls = crls
+
 	return nil
 }
 
@@ -509,6 +528,7 @@ func (cm *CertificateManager) getEmbeddedServerTLSConfig(
 	if err != nil {
 		return nil, err
 	}
+	cm.addRevocationCheck(cfg)
 
 	cm.serverConfig = cfg
 	return cfg, nil
@@ -543,6 +563,7 @@ func (cm *CertificateManager) GetNodeClientTLSConfig() (*tls.Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	cm.addRevocationCheck(cfg)
 
 	// Cache the config.
 	cm.clientConfig = cfg
@@ -586,6 +607,7 @@ func (cm *CertificateManager) getEmbeddedUIServerTLSConfig(
 	if err != nil {
 		return nil, err
 	}
+	cm.addRevocationCheck(cfg)
 
 	cm.uiServerConfig = cfg
 	return cfg, nil
@@ -752,6 +774,7 @@ func (cm *CertificateManager) GetTenantTLSConfig() (*tls.Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	cm.addRevocationCheck(cfg)
 
 	cm.tenantConfig = cfg
 	return cfg, nil
@@ -816,6 +839,7 @@ func (cm *CertificateManager) GetClientTLSConfig(user username.SQLUsername) (*tl
 	if err != nil {
 		return nil, err
 	}
+	cm.addRevocationCheck(cfg)
 
 	return cfg, nil
 }
@@ -846,6 +870,7 @@ func (cm *CertificateManager) GetUIClientTLSConfig() (*tls.Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	cm.addRevocationCheck(cfg)
 
 	return cfg, nil
 }
@@ -32,6 +32,9 @@ type Metrics struct {
 	// The top-level aggregated value for this metric is not meaningful
 	// (it sums up all the minimum expirations of all users).
 	ClientExpiration *aggmetric.AggGauge
+	// CRLExpiration is the earliest "Next Update" date across the
+	// certificate revocation lists in the certs directory.
+	CRLExpiration *metric.Gauge
 
 	// Below are TTL metrics which mirror the above expiration metrics.
 	// Instead of returning the unix time in seconds however, they
@@ -45,6 +48,7 @@ type Metrics struct {
 	NodeTTL       *metric.Gauge
 	NodeClientTTL *metric.Gauge
 	ClientTTL     *aggmetric.AggGauge
+	CRLTTL        *metric.Gauge
 }
 
 var _ metric.Struct = (*Metrics)(nil)
@@ -144,6 +148,18 @@ var (
 		LabeledName:  "security.certificate.expiration",
 		StaticLabels: metric.MakeLabelPairs(metric.LabelCertificateType, "client-tenant"),
 	}
+	metaCRLExpiration = metric.Metadata{
+		Name: "security.certificate.expiration.crl",
+		Help: "Earliest next update time across the certificate revocation lists in the " +
+			"certs directory. 0 means no CRL.",
+		Measurement:  "Certificate Expiration",
+		Unit:         metric.Unit_TIMESTAMP_SEC,
+		Visibility:   metric.Metadata_ESSENTIAL,
+		Category:     metric.Metadata_EXPIRATIONS,
+		HowToUse:     "Alert when this approaches the current time, so that a new CRL is published before revoked certificates can go unnoticed.",
+		LabeledName:  "security.certificate.expiration",
+		StaticLabels: metric.MakeLabelPairs(metric.LabelCertificateType, "crl"),
+	}
 
 	metaCATTL = metric.Metadata{
 		Name:         "security.certificate.ttl.ca",
@@ -217,6 +233,14 @@ var (
 		LabeledName:  "security.certificate.ttl",
 		StaticLabels: metric.MakeLabelPairs(metric.LabelCertificateType, "client-tenant"),
 	}
+	metaCRLTTL = metric.Metadata{
+		Name:         "security.certificate.ttl.crl",
+		Help:         "Seconds till the earliest next update time across the certificate revocation lists. 0 means past due or no CRL.",
+		Measurement:  "Certificate TTL",
+		Unit:         metric.Unit_TIMESTAMP_SEC,
+		LabeledName:  "security.certificate.ttl",
+		StaticLabels: metric.MakeLabelPairs(metric.LabelCertificateType, "crl"),
+	}
 )
 
 // certClosure defines a way to expose a certificate to the below metric types.
@@ -254,6 +278,33 @@ func ttlGauge(
 	})
 }
 
+// crlExpirationGauge exposes the earliest "Next Update" date of the CRLs
+// loaded by the certificate manager.
+func crlExpirationGauge(metadata metric.Metadata, cm *CertificateManager) *metric.Gauge {
+	return metric.NewFunctionalGauge(metadata, func() int64 {
+		if next := cm.crlNextUpdate(); !next.IsZero() {
+			return next.Unix()
+		}
+		return 0
+	})
+}
+
+func crlTTLGauge(
+	metadata metric.Metadata, cm *CertificateManager, ts timeutil.TimeSource,
+) *metric.Gauge {
+	return metric.NewFunctionalGauge(metadata, func() int64 {
+		next := cm.crlNextUpdate()
+		if next.IsZero() {
+			return 0
+		}
+		sec := next.Sub(ts.Now()).Seconds()
+		if sec < 0 {
+			return 0
+		}
+		return int64(sec)
+	})
+}
+
 var defaultTimeSource = timeutil.DefaultTimeSource{}
 
 // createMetricsLocked makes metrics using the certificate values on the manager.
@@ -276,6 +327,7 @@ func createMetricsLocked(cm *CertificateManager) *Metrics {
 		ClientCAExpiration:   expirationGauge(metaClientCAExpiration, cm.ClientCACert),
 		NodeExpiration:       expirationGauge(metaNodeExpiration, cm.NodeCert),
 		NodeClientExpiration: expirationGauge(metaNodeClientExpiration, func() *CertInfo { return cm.nodeClientCert }),
+		CRLExpiration:        crlExpirationGauge(metaCRLExpiration, cm),
 
 		CATTL:         ttlGauge(metaCATTL, cm.CACert, ts),
 		TenantTTL:     ttlGauge(metaTenantTTL, func() *CertInfo { return cm.tenantCert }, ts),
@@ -286,5 +338,6 @@ func createMetricsLocked(cm *CertificateManager) *Metrics {
 		ClientCATTL:   ttlGauge(metaClientCATTL, cm.ClientCACert, ts),
 		NodeTTL:       ttlGauge(metaNodeTTL, cm.NodeCert, ts),
 		NodeClientTTL: ttlGauge(metaNodeClientTTL, func() *CertInfo { return cm.nodeClientCert }, ts),
+		CRLTTL:        crlTTLGauge(metaCRLTTL, cm, ts),
 	}
 }
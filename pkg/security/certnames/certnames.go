@@ -14,6 +14,7 @@ import (
 const (
 	certExtension = `.crt`
 	keyExtension  = `.key`
+	crlExtension  = `.crl`
 )
 
 // IsCertificateFilename returns true if the file name looks like a certificate file.
@@ -21,6 +22,12 @@ func IsCertificateFilename(filename string) bool {
 	return strings.HasSuffix(filename, certExtension)
 }
 
+// IsCRLFilename returns true if the file name looks like a certificate
+// revocation list.
+func IsCRLFilename(filename string) bool {
+	return strings.HasSuffix(filename, crlExtension)
+}
+
 // KeyForCert returns the expected key file name for the given cert file name.
 // The caller is responsible for calling IsCertFile beforehand.
 func KeyForCert(certFile string) string {
@@ -0,0 +1,176 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// crlPEMBlockType is the PEM block type of certificate revocation lists.
+const crlPEMBlockType = "X509 CRL"
+
+// CRLInfo describes a certificate revocation list found in the certs
+// directory. Each .crl file holds a single CRL, either PEM or DER
+// encoded.
+type CRLInfo struct {
+	// Filename is the name of the CRL file, relative to the certs directory.
+	Filename string
+
+	// FileContents is the raw contents of the CRL file.
+	FileContents []byte
+
+	// RevocationList is the parsed CRL. Nil if Error is set.
+	RevocationList *x509.RevocationList
+
+	// Error is any error encountered while reading or parsing the file.
+	Error error
+
+	// revoked is the set of serial numbers listed in the CRL, in their
+	// decimal representation.
+	revoked map[string]struct{}
+}
+
+// parseCRL attempts to parse the file contents into a revocation list.
+func parseCRL(ci *CRLInfo) error {
+	if len(ci.FileContents) == 0 {
+		return errors.Errorf("empty CRL file: %s", ci.Filename)
+	}
+
+	der := ci.FileContents
+	if block, _ := pem.Decode(ci.FileContents); block != nil {
+		if block.Type != crlPEMBlockType {
+			return errors.Errorf("unexpected PEM block type %q in CRL file %s", block.Type, ci.Filename)
+		}
+		der = block.Bytes
+	}
+
+	crl, err := x509.ParseRevocationList(der)
+	if err != nil {
+		return makeErrorf(err, "failed to parse CRL file %s", ci.Filename)
+	}
+
+	ci.RevocationList = crl
+	ci.revoked = make(map[string]struct{}, len(crl.RevokedCertificateEntries))
+	for _, entry := range crl.RevokedCertificateEntries {
+		ci.revoked[entry.SerialNumber.String()] = struct{}{}
+	}
+	return nil
+}
+
+// crlSet indexes the loaded CRLs by the raw subject of their issuer.
+type crlSet map[string][]*CRLInfo
+
+// makeCRLSet builds a crlSet from successfully parsed CRLs.
+func makeCRLSet(crls []*CRLInfo) crlSet {
+	if len(crls) == 0 {
+		return nil
+	}
+	s := make(crlSet, len(crls))
+	for _, ci := range crls {
+		if ci.Error != nil {
+			continue
+		}
+		issuer := string(ci.RevocationList.RawIssuer)
+		s[issuer] = append(s[issuer], ci)
+	}
+	return s
+}
+
+// nextUpdate returns the earliest "Next Update" date across all CRLs in
+// the set, or the zero time if there are none.
+func (s crlSet) nextUpdate() time.Time {
+	var earliest time.Time
+	for _, crls := range s {
+		for _, ci := range crls {
+			next := ci.RevocationList.NextUpdate
+			if !next.IsZero() && (earliest.IsZero() || next.Before(earliest)) {
+				earliest = next
+			}
+		}
+	}
+	return earliest
+}
+
+// checkRevocation returns an error if a certificate in the verified
+// chains is listed in a CRL signed by the certificate's issuer.
+//
+// CRLs are used even after their "Next Update" date: a stale CRL still
+// lists certificates that were revoked, and rejecting all connections
+// because a new CRL was not published in time would turn a missed
+// publication into an outage. Stale CRLs are surfaced via the
+// security.certificate.expiration.crl metric instead.
+func (s crlSet) checkRevocation(verifiedChains [][]*x509.Certificate) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chain := range verifiedChains {
+		// Ignore the last cert in the chain; it's the root and it cannot
+		// be revoked by a CRL it signs itself.
+		for i := 0; i < len(chain)-1; i++ {
+			cert, issuer := chain[i], chain[i+1]
+			serial := cert.SerialNumber.String()
+			for _, ci := range s[string(issuer.RawSubject)] {
+				if _, ok := ci.revoked[serial]; !ok {
+					continue
+				}
+				// The signature is only checked upon a match, to keep
+				// handshakes cheap. A CRL that was not signed by this issuer
+				// (e.g. one from a different CA with the same subject) does
+				// not apply.
+				if err := ci.RevocationList.CheckSignatureFrom(issuer); err != nil {
+					continue
+				}
+				return errors.Newf("certificate %v (serial %s) is revoked by CRL %s",
+					cert.Subject, serial, ci.Filename)
+			}
+		}
+	}
+	return nil
+}
+
+// verifyNotRevoked is a tls.Config.VerifyPeerCertificate callback that
+// rejects peer certificates revoked by one of the CRLs in the certs
+// directory. The CRLs are looked up at handshake time, so that a reload
+// takes effect for connections established with existing tls.Config
+// objects too.
+func (cm *CertificateManager) verifyNotRevoked(
+	_ [][]byte, verifiedChains [][]*x509.Certificate,
+) error {
+	cm.mu.RLock()
+	crls := cm.crls
+	cm.mu.RUnlock()
+	return crls.checkRevocation(verifiedChains)
+}
+
+// addRevocationCheck makes cfg reject peer certificates that are revoked
+// by one of the CRLs in the certs directory. The CRL check runs before
+// the OCSP check installed by newBaseTLSConfig, since it does not
+// require network access.
+func (cm *CertificateManager) addRevocationCheck(cfg *tls.Config) {
+	next := cfg.VerifyPeerCertificate
+	cfg.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		if err := cm.verifyNotRevoked(rawCerts, verifiedChains); err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		return next(rawCerts, verifiedChains)
+	}
+}
+
+// crlNextUpdate returns the earliest "Next Update" date of the loaded
+// CRLs, or the zero time if there are none.
+func (cm *CertificateManager) crlNextUpdate() time.Time {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.crls.nextUpdate()
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package security_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/security/certnames"
+	"github.com/cockroachdb/cockroach/pkg/security/securityassets"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestCert(t *testing.T, path string) *x509.Certificate {
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	blocks, err := security.PEMToCertificates(contents)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(blocks[0].Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+// TestCRL verifies that peer certificates listed in a CRL from the certs
+// directory are rejected by the TLS configs of the certificate manager,
+// and that CRLs are picked up on reload.
+func TestCRL(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// Do not mock cert access for this test.
+	securityassets.ResetLoader()
+	defer ResetTest()
+	certsDir := t.TempDir()
+	caKeyPath := filepath.Join(certsDir, certnames.EmbeddedCAKey)
+
+	require.NoError(t, security.CreateCAPair(
+		certsDir, caKeyPath, testKeySize, 96*time.Hour, true, true))
+	require.NoError(t, security.CreateNodePair(
+		certsDir, caKeyPath, testKeySize, 48*time.Hour, true, []string{"127.0.0.1"}))
+	for _, user := range []username.SQLUsername{username.RootUserName(), username.TestUserName()} {
+		require.NoError(t, security.CreateClientPair(
+			certsDir, caKeyPath, testKeySize, 48*time.Hour, true, user,
+			[]roachpb.TenantID{roachpb.SystemTenantID}, nil /* tenantNames */, false))
+	}
+
+	caCert := readTestCert(t, filepath.Join(certsDir, certnames.CACertFilename()))
+	rootCert := readTestCert(t, filepath.Join(certsDir, certnames.ClientCertFilename(username.RootUserName())))
+	testCert := readTestCert(t, filepath.Join(certsDir, certnames.ClientCertFilename(username.TestUserName())))
+	caKeyPEM, err := os.ReadFile(caKeyPath)
+	require.NoError(t, err)
+	caKey, err := security.PEMToPrivateKey(caKeyPEM)
+	require.NoError(t, err)
+
+	cm, err := security.NewCertificateManager(certsDir, security.CommandTLSSettings{})
+	require.NoError(t, err)
+	require.Zero(t, cm.Metrics().CRLExpiration.Value())
+
+	verify := func(cert *x509.Certificate) error {
+		cfg, err := cm.GetServerTLSConfig()
+		require.NoError(t, err)
+		cfg, err = cfg.GetConfigForClient(nil)
+		require.NoError(t, err)
+		return cfg.VerifyPeerCertificate(nil, [][]*x509.Certificate{{cert, caCert}})
+	}
+	require.NoError(t, verify(testCert))
+
+	// Revoke testuser's certificate.
+	nextUpdate := timeutil.Now().Add(24 * time.Hour).Truncate(time.Second)
+	crlDER, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: timeutil.Now().Add(-time.Minute),
+		NextUpdate: nextUpdate,
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{SerialNumber: testCert.SerialNumber, RevocationTime: timeutil.Now()},
+		},
+	}, caCert, caKey.(crypto.Signer))
+	require.NoError(t, err)
+	crlPath := filepath.Join(certsDir, "ca.crl")
+	require.NoError(t, os.WriteFile(crlPath,
+		pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlDER}), 0600))
+
+	// The CRL is only used after a reload.
+	require.NoError(t, verify(testCert))
+	require.NoError(t, cm.LoadCertificates())
+	require.ErrorContains(t, verify(testCert), "is revoked by CRL ca.crl")
+	require.NoError(t, verify(rootCert))
+	require.Equal(t, nextUpdate.Unix(), cm.Metrics().CRLExpiration.Value())
+	require.Positive(t, cm.Metrics().CRLTTL.Value())
+
+	// Client configs check the server's certificate too.
+	clientCfg, err := cm.GetClientTLSConfig(username.RootUserName())
+	require.NoError(t, err)
+	require.Error(t, clientCfg.VerifyPeerCertificate(nil, [][]*x509.Certificate{{testCert, caCert}}))
+
+	// A CRL that cannot be parsed prevents the reload.
+	require.NoError(t, os.WriteFile(crlPath, []byte("garbage"), 0600))
+	require.ErrorContains(t, cm.LoadCertificates(), "problem with CRL ca.crl")
+	require.ErrorContains(t, verify(testCert), "is revoked")
+
+	// Removing the CRL lifts the revocation.
+	require.NoError(t, os.Remove(crlPath))
+	require.NoError(t, cm.LoadCertificates())
+	require.NoError(t, verify(testCert))
+	require.Zero(t, cm.Metrics().CRLExpiration.Value())
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package security
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/errors"
+)
+
+// LockoutThreshold is the cluster setting that configures the number
+// of consecutive failed login attempts after which a SQL user is
+// locked out.
+var LockoutThreshold = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"server.user_login.lockout.failed_attempts_threshold",
+	"the number of consecutive failed password login attempts after which a user "+
+		"is prevented from logging in for server.user_login.lockout.duration "+
+		"(0 = lockout disabled). The root user is never locked out.",
+	0,
+	settings.NonNegativeInt,
+	settings.WithPublic)
+
+// LockoutDuration is the cluster setting that configures for how long
+// a SQL user is locked out after reaching LockoutThreshold.
+var LockoutDuration = settings.RegisterDurationSetting(
+	settings.ApplicationLevel,
+	"server.user_login.lockout.duration",
+	"the amount of time during which a user is prevented from logging in after "+
+		"reaching server.user_login.lockout.failed_attempts_threshold",
+	15*time.Minute,
+	settings.PositiveDuration,
+	settings.WithPublic)
+
+// PasswordHistoryCount is the cluster setting that configures how
+// many previous passwords of a user cannot be reused.
+var PasswordHistoryCount = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"server.user_login.password_history.count",
+	"the number of most recent passwords of a user that cannot be reused "+
+		"when changing their password in cleartext via SQL (0 = no history check)",
+	0,
+	settings.IntInRange(0, 100),
+	settings.WithPublic)
+
+const passwordComplexityDescSuffix = " required in passwords set in cleartext via SQL. " +
+	"This setting only applies when adding new users or altering an existing user's password; " +
+	"it will not affect existing logins."
+
+// MinPasswordUppercase is the cluster setting that configures the
+// minimum number of uppercase letters in SQL passwords.
+var MinPasswordUppercase = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"server.user_login.password_complexity.min_uppercase",
+	"the minimum number of uppercase letters"+passwordComplexityDescSuffix,
+	0,
+	settings.NonNegativeInt,
+	settings.WithPublic)
+
+// MinPasswordLowercase is the cluster setting that configures the
+// minimum number of lowercase letters in SQL passwords.
+var MinPasswordLowercase = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"server.user_login.password_complexity.min_lowercase",
+	"the minimum number of lowercase letters"+passwordComplexityDescSuffix,
+	0,
+	settings.NonNegativeInt,
+	settings.WithPublic)
+
+// MinPasswordDigits is the cluster setting that configures the
+// minimum number of digits in SQL passwords.
+var MinPasswordDigits = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"server.user_login.password_complexity.min_digits",
+	"the minimum number of digits"+passwordComplexityDescSuffix,
+	0,
+	settings.NonNegativeInt,
+	settings.WithPublic)
+
+// MinPasswordSpecial is the cluster setting that configures the
+// minimum number of special (neither letter nor digit) characters in
+// SQL passwords.
+var MinPasswordSpecial = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"server.user_login.password_complexity.min_special",
+	"the minimum number of special characters (neither letters nor digits)"+passwordComplexityDescSuffix,
+	0,
+	settings.NonNegativeInt,
+	settings.WithPublic)
+
+// ErrPasswordTooSimple indicates that a client provided a password
+// that does not satisfy the configured character-class rules.
+var ErrPasswordTooSimple = errors.New("password does not satisfy complexity requirements")
+
+// ErrPasswordReused indicates that a client provided a password that
+// is among the user's most recent passwords.
+var ErrPasswordReused = errors.New("password was used recently")
+
+// CheckPasswordComplexity verifies that the given cleartext password
+// satisfies the character-class rules configured via the
+// server.user_login.password_complexity settings. The returned error,
+// if any, carries a hint that lists the unmet requirements.
+func CheckPasswordComplexity(sv *settings.Values, passwordStr string) error {
+	var upper, lower, digits, special int64
+	for _, r := range passwordStr {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsDigit(r):
+			digits++
+		case !unicode.IsLetter(r):
+			special++
+		}
+	}
+	var missing []string
+	for _, c := range []struct {
+		setting *settings.IntSetting
+		count   int64
+		what    string
+	}{
+		{MinPasswordUppercase, upper, "uppercase letters"},
+		{MinPasswordLowercase, lower, "lowercase letters"},
+		{MinPasswordDigits, digits, "digits"},
+		{MinPasswordSpecial, special, "special characters"},
+	} {
+		if minCount := c.setting.Get(sv); c.count < minCount {
+			missing = append(missing, fmt.Sprintf("%d %s", minCount, c.what))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return errors.WithHintf(ErrPasswordTooSimple,
+		"Passwords must contain at least %s.", strings.Join(missing, ", "))
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package security_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPasswordComplexity(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	ctx := context.Background()
+	st := cluster.MakeTestingClusterSettings()
+	sv := &st.SV
+
+	// With the default settings, any password is accepted.
+	require.NoError(t, security.CheckPasswordComplexity(sv, "a"))
+
+	security.MinPasswordUppercase.Override(ctx, sv, 1)
+	security.MinPasswordLowercase.Override(ctx, sv, 2)
+	security.MinPasswordDigits.Override(ctx, sv, 1)
+	security.MinPasswordSpecial.Override(ctx, sv, 1)
+
+	for _, tc := range []struct {
+		password string
+		hint     string
+	}{
+		{password: "Ab1!c"},
+		{password: "Éé9 ü"},
+		{password: "ab1!c", hint: "at least 1 uppercase letters"},
+		{password: "AB1!C", hint: "at least 2 lowercase letters"},
+		{password: "Abc!", hint: "at least 1 digits"},
+		{password: "Abc1", hint: "at least 1 special characters"},
+		{password: "", hint: "at least 1 uppercase letters, 2 lowercase letters, 1 digits, 1 special characters"},
+	} {
+		t.Run(tc.password, func(t *testing.T) {
+			err := security.CheckPasswordComplexity(sv, tc.password)
+			if tc.hint == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, errors.Is(err, security.ErrPasswordTooSimple))
+			require.Contains(t, errors.FlattenHints(err), tc.hint)
+		})
+	}
+}
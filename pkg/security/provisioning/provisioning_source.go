@@ -51,7 +51,7 @@ func ParseProvisioningSource(sourceStr string) (*Source, error) {
 }
 
 func parseAuthMethod(sourceStr string) (authMethod string, idp string, err error) {
-	supportedProvisioningMethods := []string{supportedAuthMethodLDAP, supportedAuthMethodJWT, supportedAuthMethodOIDC}
+	supportedProvisioningMethods := []string{supportedAuthMethodLDAP, supportedAuthMethodJWT, supportedAuthMethodOIDC, supportedAuthMethodSCIM}
 	for _, method := range supportedProvisioningMethods {
 		prefix := method + ":"
 		if strings.HasPrefix(sourceStr, prefix) {
@@ -76,6 +76,14 @@ func parseIDP(idp string) (u *url.URL, err error) {
 	return
 }
 
+// SCIMSource returns the provisioning source of the roles created by the SCIM
+// provisioning API for the resource type at the given path. Like "oidc", "scim"
+// is not an authentication method: the roles still log in with the methods
+// configured in the HBA configuration.
+func SCIMSource(resourcePath string) string {
+	return supportedAuthMethodSCIM + ":" + resourcePath
+}
+
 func (source *Source) Size() int {
 	return len(source.authMethod) + len(source.idp.String())
 }
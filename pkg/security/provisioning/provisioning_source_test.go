@@ -55,7 +55,7 @@ func TestParseProvisioningSource(t *testing.T) {
 		},
 	}
 
-	for _, method := range []string{"ldap", "jwt_token", "oidc"} {
+	for _, method := range []string{"ldap", "jwt_token", "oidc", "scim"} {
 		t.Run(method, func(t *testing.T) {
 			for _, tt := range sharedTests {
 				t.Run(tt.name, func(t *testing.T) {
@@ -168,7 +168,7 @@ func TestValidateSource(t *testing.T) {
 		},
 	}
 
-	for _, method := range []string{"ldap", "jwt_token", "oidc"} {
+	for _, method := range []string{"ldap", "jwt_token", "oidc", "scim"} {
 		t.Run(method, func(t *testing.T) {
 			for _, tt := range sharedTests {
 				t.Run(tt.name, func(t *testing.T) {
@@ -192,6 +192,11 @@ func TestValidateSource(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("scim/valid_resource_path_source", func(t *testing.T) {
+		err := ValidateSource(SCIMSource("/scim/v2/Users"))
+		require.NoError(t, err)
+	})
+
 	t.Run("ldap/https_source_is_valid_by_current_implementation", func(t *testing.T) {
 		err := ValidateSource("ldap:https://accounts.google.com")
 		require.NoError(t, err)
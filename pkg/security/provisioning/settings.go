@@ -29,6 +29,7 @@ const (
 	supportedAuthMethodOIDC             = "oidc"
 	testSupportedAuthMethodCertPassword = "cert-password"
 	supportedAuthMethodJWT              = "jwt_token"
+	supportedAuthMethodSCIM             = "scim"
 	baseProvisioningSettingName         = "security.provisioning."
 	ldapProvisioningEnableSettingName   = baseProvisioningSettingName + "ldap.enabled"
 	jwtProvisioningEnableSettingName    = baseProvisioningSettingName + "jwt.enabled"
@@ -9,6 +9,9 @@ const (
 	// APIV2Path is the prefix for the RESTful v2 API.
 	APIV2Path = "/api/v2/"
 
+	// SCIMV2Path is the prefix for the SCIM 2.0 provisioning API.
+	SCIMV2Path = "/scim/v2/"
+
 	// AdminPrefix is the prefix for RESTful endpoints used to provide an
 	// administrative interface to the cockroach cluster.
 	AdminPrefix = "/_admin/v1/"
@@ -201,11 +201,23 @@ func (s *authenticationServer) userLogin(
 		return nil, errWebAuthenticationFailure
 	}
 
+	// Refuse the login if the user was locked out after too many failed
+	// attempts.
+	execCfg := s.sqlServer.ExecutorConfig()
+	failedAttempts, lockedUntil, err := sql.GetUserLockout(ctx, execCfg, username)
+	if err != nil {
+		return nil, srverrors.APIInternalError(ctx, err)
+	}
+	if !lockedUntil.IsZero() {
+		log.Ops.Warningf(ctx, "refusing DB Console login for user %s: locked out until %s", username, lockedUntil)
+		return nil, errWebAuthenticationFailure
+	}
+
 	ldapAuthSuccess := false
 	var ldapUserDN *ldap.DN
 	originIP := s.lookupIncomingRequestOriginIP(ctx)
 	hbaConf, identMap := s.sqlServer.PGServer().GetAuthenticationConfiguration()
-	authMethod, hbaEntry, err := s.lookupAuthenticationMethodUsingRules(hba.ConnHostSSL, hbaConf, username, originIP)
+	authMethod, hbaEntry, err := s.lookupAuthenticationMethodUsingRules(ctx, hba.ConnHostSSL, hbaConf, username, originIP)
 	if err != nil {
 		if log.V(1) {
 			log.Dev.Infof(ctx, "invalid retrieval of HBA entry: error: %v", err)
@@ -214,7 +226,6 @@ func (s *authenticationServer) userLogin(
 		if log.V(1) {
 			log.Dev.Infof(ctx, "retrieved LDAP HBA entry successfully: authMethod: %s, hbaEntry: %v", authMethod.String(), hbaEntry)
 		}
-		execCfg := s.sqlServer.ExecutorConfig()
 		ldapManager.Do(func() {
 			if ldapManager.m == nil {
 				ldapManager.m = pgwire.ConfigureLDAPAuth(ctx, execCfg.AmbientCtx, execCfg.Settings, execCfg.NodeInfo.LogicalClusterID())
@@ -253,9 +264,17 @@ func (s *authenticationServer) userLogin(
 			)
 		}
 		if !verified {
+			if _, err := sql.RecordFailedLogin(ctx, execCfg, username); err != nil {
+				log.Dev.Warningf(ctx, "recording the failed login for user %s failed: %+v", username, err)
+			}
 			return nil, errWebAuthenticationFailure
 		}
 	}
+	if failedAttempts > 0 {
+		if err := sql.ResetFailedLogins(ctx, execCfg, username); err != nil {
+			log.Dev.Warningf(ctx, "resetting the failed logins for user %s failed: %+v", username, err)
+		}
+	}
 
 	// If LDAP authentication was successful and the HBA entry includes a
 	// group list filter, perform role authorization.
@@ -264,8 +283,6 @@ func (s *authenticationServer) userLogin(
 			log.Dev.Infof(ctx, "LDAP authentication succeeded; attempting authorization for user %s", username)
 		}
 
-		execCfg := s.sqlServer.ExecutorConfig()
-
 		if detailedErrors, authError := pgwire.AuthorizeLDAPHelper(
 			ctx, nil, ldapManager.m, execCfg, ldapUserDN, username, hbaEntry, identMap,
 		); authError != nil {
@@ -727,13 +744,18 @@ func (s *authenticationServer) lookupIncomingRequestOriginIP(ctx context.Context
 }
 
 func (s *authenticationServer) lookupAuthenticationMethodUsingRules(
-	connType hba.ConnType, auth *hba.Conf, user secuser.SQLUsername, originIP net.IP,
+	ctx context.Context,
+	connType hba.ConnType,
+	auth *hba.Conf,
+	user secuser.SQLUsername,
+	originIP net.IP,
 ) (authMethod rulebasedscanner.String, entry *hba.Entry, err error) {
+	client := &hba.ClientAddr{IP: originIP, Resolver: net.DefaultResolver}
 	// Look up the method.
 	for i := range auth.Entries {
 		entry = &auth.Entries[i]
 		var connMatch bool
-		connMatch, err = entry.ConnMatches(connType, originIP)
+		connMatch, err = entry.ConnMatches(ctx, connType, client)
 		if err != nil {
 			// TODO(souravcrl): Determine if an error should be reported
 			// upon unknown address formats.
@@ -748,6 +770,11 @@ func (s *authenticationServer) lookupAuthenticationMethodUsingRules(
 			// The user does not match.
 			continue
 		}
+		if entry.Database != nil {
+			// DB Console logins are not made to a database, so they only
+			// match rules which apply to all databases.
+			continue
+		}
 
 		return entry.Method, entry, nil
 	}
@@ -291,6 +291,11 @@ type BaseConfig struct {
 	// operations that can specify node-local I/O paths (such as BACKUP, RESTORE
 	// or IMPORT) can access files.
 	ExternalIODir string
+
+	// AuditChainKeyFile is the local file path of the key used to chain the
+	// events logged on the SENSITIVE_ACCESS channel. The chain is disabled if
+	// it is empty.
+	AuditChainKeyFile string
 }
 
 // MakeBaseConfig returns a BaseConfig with default values.
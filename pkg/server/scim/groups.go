@@ -0,0 +1,215 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/gorilla/mux"
+)
+
+func (s *Server) listGroups(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var code int
+	var resp interface{}
+	err := s.db.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+		snap, err := loadSnapshot(ctx, txn)
+		if err != nil {
+			return err
+		}
+		code, resp, err = snap.list(r, groupsSource, "displayName", func(r *role) interface{} {
+			return snap.group(r)
+		})
+		return err
+	})
+	return code, resp, err
+}
+
+func (s *Server) getGroup(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var resp group
+	err := s.db.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+		snap, err := loadSnapshot(ctx, txn)
+		if err != nil {
+			return err
+		}
+		g, err := snap.lookup(mux.Vars(r)["id"], groupsSource)
+		if err != nil {
+			return err
+		}
+		resp = snap.group(g)
+		return nil
+	})
+	return http.StatusOK, resp, err
+}
+
+func (s *Server) createGroup(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req group
+	if err := decodeRequest(r, &req); err != nil {
+		return 0, nil, err
+	}
+	name, err := parseName("displayName", req.DisplayName)
+	if err != nil {
+		return 0, nil, err
+	}
+	snap, err := s.mutate(ctx, "Group", "create", func(ctx context.Context, m *mutation) error {
+		m.event.RoleName = name.Normalized()
+		members, err := m.snap.resolve(req.Members)
+		if err != nil {
+			return err
+		}
+		if err := m.createRole(ctx, name, groupsSource, false /* login */); err != nil {
+			return err
+		}
+		// The new role is not part of the snapshot yet.
+		return m.updateMembers(ctx, &role{name: name}, members, nil /* remove */)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, snap.group(snap.byName[name]), nil
+}
+
+func (s *Server) replaceGroup(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req group
+	if err := decodeRequest(r, &req); err != nil {
+		return 0, nil, err
+	}
+	return s.updateGroup(ctx, r, "replace", func(ctx context.Context, m *mutation, g *role) error {
+		if err := checkImmutableName(g, "displayName", req.DisplayName); err != nil {
+			return err
+		}
+		members, err := m.snap.resolve(req.Members)
+		if err != nil {
+			return err
+		}
+		return m.setMembers(ctx, g, members)
+	})
+}
+
+// memberFilterRE matches the paths that select a member of a group by ID, for
+// example `members[value eq "123"]`.
+var memberFilterRE = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+"([^"]*)"\s*\]$`)
+
+func (s *Server) patchGroup(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req patchRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return 0, nil, err
+	}
+	return s.updateGroup(ctx, r, "patch", func(ctx context.Context, m *mutation, g *role) error {
+		for _, op := range req.Operations {
+			opName := strings.ToLower(op.Op)
+			if opName != "add" && opName != "remove" && opName != "replace" {
+				return newError(http.StatusBadRequest, "invalidSyntax", "invalid operation %q", op.Op)
+			}
+			// The value is either the value of the attribute at the path, or
+			// an object with the attributes to modify.
+			attrs := map[string]json.RawMessage{}
+			switch {
+			case op.Path == "":
+				if err := json.Unmarshal(op.Value, &attrs); err != nil {
+					return newError(http.StatusBadRequest, "invalidValue", "invalid operation value: %v", err)
+				}
+			case memberFilterRE.MatchString(op.Path):
+				if opName != "remove" {
+					return newError(http.StatusBadRequest, "invalidPath", "invalid path %q", op.Path)
+				}
+				id := memberFilterRE.FindStringSubmatch(op.Path)[1]
+				members, err := m.snap.resolve([]reference{{Value: id}})
+				if err != nil {
+					return err
+				}
+				if err := m.updateMembers(ctx, g, nil /* add */, members); err != nil {
+					return err
+				}
+				continue
+			default:
+				attrs[op.Path] = op.Value
+			}
+			for attr, value := range attrs {
+				switch {
+				case strings.EqualFold(attr, "displayName"):
+					var displayName string
+					if err := json.Unmarshal(value, &displayName); err != nil {
+						return newError(http.StatusBadRequest, "invalidValue", "invalid displayName %s", value)
+					}
+					if err := checkImmutableName(g, "displayName", displayName); err != nil {
+						return err
+					}
+				case strings.EqualFold(attr, "members"):
+					var refs []reference
+					noValue := len(value) == 0 || string(value) == "null"
+					if !noValue {
+						if err := json.Unmarshal(value, &refs); err != nil {
+							return newError(http.StatusBadRequest, "invalidValue", "invalid members %s", value)
+						}
+					}
+					members, err := m.snap.resolve(refs)
+					if err != nil {
+						return err
+					}
+					switch {
+					case opName == "add":
+						err = m.updateMembers(ctx, g, members, nil /* remove */)
+					case opName == "remove" && noValue:
+						// Removing the members attribute removes all the members.
+						err = m.setMembers(ctx, g, nil)
+					case opName == "remove":
+						err = m.updateMembers(ctx, g, nil /* add */, members)
+					default:
+						err = m.setMembers(ctx, g, members)
+					}
+					if err != nil {
+						return err
+					}
+				default:
+					return newError(http.StatusBadRequest, "invalidPath", "unsupported attribute %q", attr)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// updateGroup applies fn to the group of the request, and returns the updated
+// group.
+func (s *Server) updateGroup(
+	ctx context.Context,
+	r *http.Request,
+	operation string,
+	fn func(context.Context, *mutation, *role) error,
+) (int, interface{}, error) {
+	id := mux.Vars(r)["id"]
+	snap, err := s.mutate(ctx, "Group", operation, func(ctx context.Context, m *mutation) error {
+		g, err := m.snap.lookup(id, groupsSource)
+		if err != nil {
+			return err
+		}
+		m.event.RoleName = g.name.Normalized()
+		return fn(ctx, m, g)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, snap.group(snap.byID[id]), nil
+}
+
+func (s *Server) deleteGroup(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	if _, err := s.mutate(ctx, "Group", "delete", func(ctx context.Context, m *mutation) error {
+		g, err := m.snap.lookup(mux.Vars(r)["id"], groupsSource)
+		if err != nil {
+			return err
+		}
+		m.event.RoleName = g.name.Normalized()
+		return m.dropRole(ctx, g)
+	}); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
@@ -0,0 +1,327 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+)
+
+// The SCIM schema URNs.
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	patchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// maxResults is the maximum number of resources returned by a list request.
+const maxResults = 1000
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+// reference refers to another resource, for example a member of a group.
+type reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type user struct {
+	Schemas  []string    `json:"schemas"`
+	ID       string      `json:"id,omitempty"`
+	UserName string      `json:"userName"`
+	Active   *bool       `json:"active,omitempty"`
+	Groups   []reference `json:"groups,omitempty"`
+	Meta     *meta       `json:"meta,omitempty"`
+}
+
+type group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []reference `json:"members"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+type patchRequest struct {
+	Schemas    []string  `json:"schemas"`
+	Operations []patchOp `json:"Operations"`
+}
+
+type patchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// role is a role created through the API.
+type role struct {
+	name username.SQLUsername
+	// id is the SCIM ID of the resource, which is the ID of the role.
+	id     string
+	source string
+	login  bool
+}
+
+// snapshot contains the roles created through the API and their memberships.
+type snapshot struct {
+	// roles is ordered by ID.
+	roles  []*role
+	byID   map[string]*role
+	byName map[username.SQLUsername]*role
+	// members and groups map the groups to their members, and the members to
+	// their groups.
+	members map[*role][]*role
+	groups  map[*role][]*role
+}
+
+const rolesQuery = `
+SELECT u.username, u.user_id::INT8, p.value,
+       NOT EXISTS(
+         SELECT 1 FROM system.role_options AS o WHERE o.username = u.username AND o.option = 'NOLOGIN'
+       )
+  FROM system.users AS u
+  JOIN system.role_options AS p ON p.username = u.username AND p.option = 'PROVISIONSRC'
+ WHERE p.value IN ($1, $2)
+ ORDER BY u.user_id`
+
+const membershipsQuery = `
+SELECT m.role, m.member
+  FROM system.role_members AS m
+  JOIN system.role_options AS p ON p.username = m.role AND p.option = 'PROVISIONSRC'
+ WHERE p.value = $1`
+
+// loadSnapshot reads the roles created through the API.
+func loadSnapshot(ctx context.Context, txn isql.Txn) (*snapshot, error) {
+	rows, err := txn.QueryBufferedEx(ctx, "scim-roles", txn.KV(),
+		sessiondata.NodeUserSessionDataOverride, rolesQuery, usersSource, groupsSource)
+	if err != nil {
+		return nil, err
+	}
+	s := &snapshot{
+		byID:    make(map[string]*role, len(rows)),
+		byName:  make(map[username.SQLUsername]*role, len(rows)),
+		members: make(map[*role][]*role),
+		groups:  make(map[*role][]*role),
+	}
+	for _, row := range rows {
+		r := &role{
+			name:   username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[0]))),
+			id:     strconv.FormatInt(int64(tree.MustBeDInt(row[1])), 10),
+			source: string(tree.MustBeDString(row[2])),
+			login:  bool(tree.MustBeDBool(row[3])),
+		}
+		s.roles = append(s.roles, r)
+		s.byID[r.id] = r
+		s.byName[r.name] = r
+	}
+
+	rows, err = txn.QueryBufferedEx(ctx, "scim-memberships", txn.KV(),
+		sessiondata.NodeUserSessionDataOverride, membershipsQuery, groupsSource)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		g := s.byName[username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[0])))]
+		m := s.byName[username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[1])))]
+		// Only the memberships between roles created through the API are
+		// exposed.
+		if g == nil || m == nil {
+			continue
+		}
+		s.members[g] = append(s.members[g], m)
+		s.groups[m] = append(s.groups[m], g)
+	}
+	return s, nil
+}
+
+// lookup returns the role of the resource with the given ID and source.
+func (s *snapshot) lookup(id, source string) (*role, error) {
+	if r, ok := s.byID[id]; ok && r.source == source {
+		return r, nil
+	}
+	return nil, newError(http.StatusNotFound, "", "resource %s not found", id)
+}
+
+// resolve returns the roles of the given references, which may be users or
+// groups.
+func (s *snapshot) resolve(refs []reference) ([]*role, error) {
+	res := make([]*role, 0, len(refs))
+	for _, ref := range refs {
+		r, ok := s.byID[ref.Value]
+		if !ok {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "member %q not found", ref.Value)
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func (s *snapshot) user(r *role) user {
+	u := user{
+		Schemas:  []string{userSchema},
+		ID:       r.id,
+		UserName: r.name.Normalized(),
+		Active:   &r.login,
+		Meta:     &meta{ResourceType: "User", Location: usersPath + "/" + r.id},
+	}
+	for _, g := range s.groups[r] {
+		u.Groups = append(u.Groups, reference{Value: g.id, Display: g.name.Normalized()})
+	}
+	return u
+}
+
+func (s *snapshot) group(r *role) group {
+	g := group{
+		Schemas:     []string{groupSchema},
+		ID:          r.id,
+		DisplayName: r.name.Normalized(),
+		Members:     []reference{},
+		Meta:        &meta{ResourceType: "Group", Location: groupsPath + "/" + r.id},
+	}
+	for _, m := range s.members[r] {
+		g.Members = append(g.Members, reference{Value: m.id, Display: m.name.Normalized()})
+	}
+	return g
+}
+
+// list returns the list response for the roles of the given source, whose
+// names match the filter of the request, if any. The filter attribute is
+// the given name attribute.
+func (s *snapshot) list(
+	r *http.Request, source, nameAttr string, render func(*role) interface{},
+) (int, interface{}, error) {
+	q := r.URL.Query()
+	var name *username.SQLUsername
+	if filter := q.Get("filter"); filter != "" {
+		n, err := parseNameFilter(filter, nameAttr)
+		if err != nil {
+			return 0, nil, err
+		}
+		name = &n
+	}
+	var matches []*role
+	for _, r := range s.roles {
+		if r.source == source && (name == nil || r.name == *name) {
+			matches = append(matches, r)
+		}
+	}
+
+	startIndex, count := 1, maxResults
+	if v := q.Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, nil, newError(http.StatusBadRequest, "invalidValue", "invalid startIndex %q", v)
+		}
+		// Per RFC 7644, a startIndex lower than 1 is interpreted as 1.
+		startIndex = max(i, 1)
+	}
+	if v := q.Get("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, nil, newError(http.StatusBadRequest, "invalidValue", "invalid count %q", v)
+		}
+		count = min(max(i, 0), maxResults)
+	}
+	resp := listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(matches),
+		StartIndex:   startIndex,
+		Resources:    []interface{}{},
+	}
+	for i := startIndex - 1; i < len(matches) && len(resp.Resources) < count; i++ {
+		resp.Resources = append(resp.Resources, render(matches[i]))
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	return http.StatusOK, resp, nil
+}
+
+var filterRE = regexp.MustCompile(`^\s*(\w+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// parseNameFilter parses a filter of the form `<nameAttr> eq "<value>"`,
+// which is the only kind of filter supported by the API, and returns the
+// SQL username it refers to.
+func parseNameFilter(filter, nameAttr string) (username.SQLUsername, error) {
+	m := filterRE.FindStringSubmatch(filter)
+	if m == nil || !strings.EqualFold(m[1], nameAttr) {
+		return username.SQLUsername{}, newError(http.StatusBadRequest, "invalidFilter",
+			"unsupported filter %q: only %s eq \"...\" is supported", filter, nameAttr)
+	}
+	var value string
+	if err := json.Unmarshal([]byte(m[2]), &value); err != nil {
+		return username.SQLUsername{}, newError(http.StatusBadRequest, "invalidFilter", "invalid filter %q", filter)
+	}
+	return username.MakeSQLUsernameFromUserInput(value, username.PurposeValidation)
+}
+
+// parseName returns the SQL username of the given userName or displayName.
+func parseName(attr, value string) (username.SQLUsername, error) {
+	name, err := username.MakeSQLUsernameFromUserInput(value, username.PurposeCreation)
+	if err != nil {
+		return username.SQLUsername{}, newError(http.StatusBadRequest, "invalidValue",
+			"invalid %s %q: %v", attr, value, err)
+	}
+	if name.IsReserved() {
+		return username.SQLUsername{}, newError(http.StatusBadRequest, "invalidValue",
+			"%s %q is reserved", attr, value)
+	}
+	return name, nil
+}
+
+// checkImmutableName returns an error if the given userName or displayName
+// does not match the name of the role: roles cannot be renamed.
+func checkImmutableName(r *role, attr, value string) error {
+	name, err := username.MakeSQLUsernameFromUserInput(value, username.PurposeValidation)
+	if err != nil || name != r.name {
+		return newError(http.StatusBadRequest, "mutability", "%s cannot be changed", attr)
+	}
+	return nil
+}
+
+// parseBool parses a boolean value of a PATCH operation. Some clients send
+// booleans as strings.
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, newError(http.StatusBadRequest, "invalidValue", "invalid boolean value %s", raw)
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/lexbase"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/cockroach/pkg/util/log/severity"
+)
+
+// mutation modifies the roles in a transaction.
+type mutation struct {
+	txn isql.Txn
+	// snap is the snapshot of the roles at the start of the transaction.
+	snap *snapshot
+	// event records the modifications.
+	event eventpb.ScimProvisioning
+}
+
+// mutate runs fn in a transaction and returns the resulting snapshot of the
+// roles. The modifications are recorded in the event log once committed.
+func (s *Server) mutate(
+	ctx context.Context, resourceType, operation string, fn func(context.Context, *mutation) error,
+) (*snapshot, error) {
+	var m *mutation
+	var res *snapshot
+	if err := s.db.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+		snap, err := loadSnapshot(ctx, txn)
+		if err != nil {
+			return err
+		}
+		m = &mutation{txn: txn, snap: snap}
+		m.event.ResourceType = resourceType
+		m.event.Operation = operation
+		if err := fn(ctx, m); err != nil {
+			return err
+		}
+		res, err = loadSnapshot(ctx, txn)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	log.StructuredEvent(ctx, severity.INFO, &m.event)
+	return res, nil
+}
+
+func (m *mutation) exec(ctx context.Context, stmt string) error {
+	_, err := m.txn.ExecEx(ctx, "scim-provisioning", m.txn.KV(), sessiondata.NodeUserSessionDataOverride, stmt)
+	return err
+}
+
+// createRole creates a role with the given provisioning source. Users are
+// created with LOGIN, unless they are inactive, and groups with NOLOGIN.
+func (m *mutation) createRole(
+	ctx context.Context, name username.SQLUsername, source string, login bool,
+) error {
+	loginOption := "NOLOGIN"
+	if login {
+		loginOption = "LOGIN"
+	}
+	m.event.Options = append(m.event.Options, "PROVISIONSRC", loginOption)
+	return m.exec(ctx, fmt.Sprintf("CREATE ROLE %s WITH PROVISIONSRC %s %s",
+		name.SQLIdentifier(), lexbase.EscapeSQLString(source), loginOption))
+}
+
+// setLogin activates or deactivates a user.
+func (m *mutation) setLogin(ctx context.Context, r *role, login bool) error {
+	if r.login == login {
+		return nil
+	}
+	loginOption := "NOLOGIN"
+	if login {
+		loginOption = "LOGIN"
+	}
+	if err := m.exec(ctx, fmt.Sprintf("ALTER ROLE %s WITH %s", r.name.SQLIdentifier(), loginOption)); err != nil {
+		return err
+	}
+	r.login = login
+	m.event.Options = append(m.event.Options, loginOption)
+	return nil
+}
+
+func (m *mutation) dropRole(ctx context.Context, r *role) error {
+	return m.exec(ctx, fmt.Sprintf("DROP ROLE %s", r.name.SQLIdentifier()))
+}
+
+// grant adds the given roles to the members of the group.
+func (m *mutation) grant(ctx context.Context, g *role, members []*role) error {
+	if len(members) == 0 {
+		return nil
+	}
+	names := make([]string, len(members))
+	for i, r := range members {
+		names[i] = r.name.SQLIdentifier()
+		m.event.AddedMembers = append(m.event.AddedMembers, r.name.Normalized())
+	}
+	return m.exec(ctx, fmt.Sprintf("GRANT %s TO %s", g.name.SQLIdentifier(), strings.Join(names, ", ")))
+}
+
+// revoke removes the given roles from the members of the group.
+func (m *mutation) revoke(ctx context.Context, g *role, members []*role) error {
+	if len(members) == 0 {
+		return nil
+	}
+	names := make([]string, len(members))
+	for i, r := range members {
+		names[i] = r.name.SQLIdentifier()
+		m.event.RemovedMembers = append(m.event.RemovedMembers, r.name.Normalized())
+	}
+	return m.exec(ctx, fmt.Sprintf("REVOKE %s FROM %s", g.name.SQLIdentifier(), strings.Join(names, ", ")))
+}
+
+// updateMembers adds and removes members of the group. The members that are
+// already in the desired state are skipped.
+func (m *mutation) updateMembers(ctx context.Context, g *role, add, remove []*role) error {
+	current := make(map[*role]bool)
+	for _, r := range m.snap.members[g] {
+		current[r] = true
+	}
+	var toAdd, toRemove []*role
+	for _, r := range add {
+		if !current[r] {
+			current[r] = true
+			toAdd = append(toAdd, r)
+		}
+	}
+	for _, r := range remove {
+		if current[r] {
+			delete(current, r)
+			toRemove = append(toRemove, r)
+		}
+	}
+	if err := m.grant(ctx, g, toAdd); err != nil {
+		return err
+	}
+	if err := m.revoke(ctx, g, toRemove); err != nil {
+		return err
+	}
+	members := m.snap.members[g][:0:0]
+	for _, r := range append(m.snap.members[g], toAdd...) {
+		if current[r] {
+			members = append(members, r)
+		}
+	}
+	m.snap.members[g] = members
+	return nil
+}
+
+// setMembers replaces the members of the group.
+func (m *mutation) setMembers(ctx context.Context, g *role, members []*role) error {
+	keep := make(map[*role]bool, len(members))
+	for _, r := range members {
+		keep[r] = true
+	}
+	var remove []*role
+	for _, r := range m.snap.members[g] {
+		if !keep[r] {
+			remove = append(remove, r)
+		}
+	}
+	return m.updateMembers(ctx, g, members, remove)
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package scim implements a SCIM 2.0 (RFC 7643, RFC 7644) provisioning API,
+// which lets an identity provider manage SQL users, groups and group
+// memberships.
+//
+// SCIM users are SQL users and SCIM groups are SQL roles; group memberships
+// are role memberships. The roles created through the API are marked with the
+// PROVISIONSRC role option, and the API only ever exposes and modifies the
+// roles that it created: the other roles of the cluster, including root and
+// admin, are out of its reach. Deactivating a user sets NOLOGIN on its role.
+//
+// The API is served under apiconstants.SCIMV2Path. It is disabled unless the
+// server.scim.bearer_token cluster setting is set, and the SCIM clients must
+// present that token in the Authorization header of every request.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/security/provisioning"
+	"github.com/cockroachdb/cockroach/pkg/server/apiconstants"
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/mux"
+)
+
+var bearerToken = settings.RegisterStringSetting(
+	settings.ApplicationLevel,
+	"server.scim.bearer_token",
+	"bearer token that SCIM clients must present to use the SCIM 2.0 provisioning API "+
+		"under "+apiconstants.SCIMV2Path+" (empty = API disabled)",
+	"",
+	settings.Sensitive,
+	settings.WithPublic,
+)
+
+const (
+	usersPath  = apiconstants.SCIMV2Path + "Users"
+	groupsPath = apiconstants.SCIMV2Path + "Groups"
+)
+
+// The provisioning sources of the roles created through the API.
+var (
+	usersSource  = provisioning.SCIMSource(usersPath)
+	groupsSource = provisioning.SCIMSource(groupsPath)
+)
+
+// maxRequestSize is the maximum size of the body of a request.
+const maxRequestSize = 1 << 20
+
+// Server serves the SCIM 2.0 API.
+type Server struct {
+	db  descs.DB
+	st  *cluster.Settings
+	mux *mux.Router
+}
+
+var _ http.Handler = &Server{}
+
+// NewServer returns a new SCIM server, which reads and modifies the roles
+// through the given database.
+func NewServer(db descs.DB, st *cluster.Settings) *Server {
+	s := &Server{db: db, st: st, mux: mux.NewRouter()}
+	routes := []struct {
+		method  string
+		path    string
+		handler handlerFunc
+	}{
+		{http.MethodGet, apiconstants.SCIMV2Path + "ServiceProviderConfig", s.serviceProviderConfig},
+		{http.MethodGet, usersPath, s.listUsers},
+		{http.MethodPost, usersPath, s.createUser},
+		{http.MethodGet, usersPath + "/{id}", s.getUser},
+		{http.MethodPut, usersPath + "/{id}", s.replaceUser},
+		{http.MethodPatch, usersPath + "/{id}", s.patchUser},
+		{http.MethodDelete, usersPath + "/{id}", s.deleteUser},
+		{http.MethodGet, groupsPath, s.listGroups},
+		{http.MethodPost, groupsPath, s.createGroup},
+		{http.MethodGet, groupsPath + "/{id}", s.getGroup},
+		{http.MethodPut, groupsPath + "/{id}", s.replaceGroup},
+		{http.MethodPatch, groupsPath + "/{id}", s.patchGroup},
+		{http.MethodDelete, groupsPath + "/{id}", s.deleteGroup},
+	}
+	for _, route := range routes {
+		s.mux.Handle(route.path, route.handler).Methods(route.method)
+	}
+	s.mux.NotFoundHandler = handlerFunc(func(context.Context, *http.Request) (int, interface{}, error) {
+		return 0, nil, newError(http.StatusNotFound, "", "resource not found")
+	})
+	s.mux.MethodNotAllowedHandler = handlerFunc(func(context.Context, *http.Request) (int, interface{}, error) {
+		return 0, nil, newError(http.StatusMethodNotAllowed, "", "method not allowed")
+	})
+	return s
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := bearerToken.Get(&s.st.SV)
+	if token == "" {
+		writeError(ctx, w, newError(http.StatusNotFound, "", "SCIM provisioning is not enabled"))
+		return
+	}
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) ||
+		subtle.ConstantTimeCompare([]byte(auth[len(prefix):]), []byte(token)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(ctx, w, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	s.mux.ServeHTTP(w, r)
+}
+
+// handlerFunc handles a request of the API. It returns the status code and
+// the resource of the response, or an error.
+type handlerFunc func(ctx context.Context, r *http.Request) (int, interface{}, error)
+
+// ServeHTTP implements the http.Handler interface.
+func (h handlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	code, resp, err := h(ctx, r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if resp == nil {
+		w.WriteHeader(code)
+		return
+	}
+	writeResponse(ctx, w, code, resp)
+}
+
+func writeResponse(ctx context.Context, w http.ResponseWriter, code int, resp interface{}) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		log.Dev.Errorf(ctx, "encoding SCIM response: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(code)
+	_, _ = w.Write(b)
+}
+
+// apiError is an error reported to the client with a SCIM error response.
+type apiError struct {
+	status int
+	// scimType is the SCIM detail error keyword, if any.
+	scimType string
+	detail   string
+}
+
+func newError(status int, scimType string, format string, args ...interface{}) error {
+	return &apiError{status: status, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func (e *apiError) Error() string {
+	return e.detail
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	var e *apiError
+	if !errors.As(err, &e) {
+		switch pgerror.GetPGCode(err) {
+		case pgcode.DuplicateObject:
+			e = &apiError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+		case pgcode.DependentObjectsStillExist:
+			e = &apiError{status: http.StatusConflict, detail: err.Error()}
+		case pgcode.InvalidParameterValue, pgcode.InvalidName, pgcode.ReservedName, pgcode.InvalidGrantOperation:
+			e = &apiError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+		default:
+			log.Dev.Errorf(ctx, "SCIM request failed: %v", err)
+			e = &apiError{status: http.StatusInternalServerError, detail: "internal error"}
+		}
+	}
+	writeResponse(ctx, w, e.status, errorResponse{
+		Schemas:  []string{errorSchema},
+		Status:   fmt.Sprint(e.status),
+		ScimType: e.scimType,
+		Detail:   e.detail,
+	})
+}
+
+// decodeRequest decodes the JSON body of the request into v.
+func decodeRequest(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func (s *Server) serviceProviderConfig(context.Context, *http.Request) (int, interface{}, error) {
+	type supported struct {
+		Supported bool `json:"supported"`
+	}
+	type filter struct {
+		Supported  bool `json:"supported"`
+		MaxResults int  `json:"maxResults"`
+	}
+	type bulk struct {
+		Supported      bool `json:"supported"`
+		MaxOperations  int  `json:"maxOperations"`
+		MaxPayloadSize int  `json:"maxPayloadSize"`
+	}
+	type authenticationScheme struct {
+		Type        string `json:"type"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	return http.StatusOK, struct {
+		Schemas               []string               `json:"schemas"`
+		Patch                 supported              `json:"patch"`
+		Bulk                  bulk                   `json:"bulk"`
+		Filter                filter                 `json:"filter"`
+		ChangePassword        supported              `json:"changePassword"`
+		Sort                  supported              `json:"sort"`
+		ETag                  supported              `json:"etag"`
+		AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+	}{
+		Schemas: []string{serviceProviderConfigSchema},
+		Patch:   supported{Supported: true},
+		Filter:  filter{Supported: true, MaxResults: maxResults},
+		AuthenticationSchemes: []authenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "Bearer token",
+			Description: "The token configured in the server.scim.bearer_token cluster setting.",
+		}},
+	}, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scim_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/testutils/serverutils"
+	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSCIM(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	s, sqlDB, _ := serverutils.StartServer(t, base.TestServerArgs{})
+	defer s.Stopper().Stop(ctx)
+	app := s.ApplicationLayer()
+	db := sqlutils.MakeSQLRunner(sqlDB)
+	client, err := app.GetUnauthenticatedHTTPClient()
+	require.NoError(t, err)
+
+	const token = "scim test token"
+	type response map[string]interface{}
+	do := func(method, path, token string, body interface{}) (int, response) {
+		var reqBody io.Reader
+		if body != nil {
+			b, err := json.Marshal(body)
+			require.NoError(t, err)
+			reqBody = bytes.NewReader(b)
+		}
+		u := app.AdminURL().URL.JoinPath(path)
+		if i := strings.IndexByte(path, '?'); i >= 0 {
+			u = app.AdminURL().URL.JoinPath(path[:i])
+			u.RawQuery = path[i+1:]
+		}
+		req, err := http.NewRequest(method, u.String(), reqBody)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/scim+json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var res response
+		if resp.StatusCode != http.StatusNoContent {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		}
+		return resp.StatusCode, res
+	}
+	filter := func(path, f string) string {
+		return path + "?" + url.Values{"filter": {f}}.Encode()
+	}
+
+	// The API is disabled by default.
+	code, _ := do(http.MethodGet, "/scim/v2/Users", token, nil)
+	require.Equal(t, http.StatusNotFound, code)
+
+	db.Exec(t, `SET CLUSTER SETTING server.scim.bearer_token = $1`, token)
+	code, _ = do(http.MethodGet, "/scim/v2/Users", "wrong token", nil)
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	// Create a user and a group.
+	code, alice := do(http.MethodPost, "/scim/v2/Users", token, response{
+		"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		"userName": "Alice",
+		"name":     response{"givenName": "Alice"},
+	})
+	require.Equal(t, http.StatusCreated, code)
+	require.Equal(t, "alice", alice["userName"])
+	require.Equal(t, true, alice["active"])
+	aliceID := alice["id"].(string)
+
+	code, res := do(http.MethodPost, "/scim/v2/Users", token, response{"userName": "alice"})
+	require.Equal(t, http.StatusConflict, code)
+	require.Equal(t, "uniqueness", res["scimType"])
+	code, _ = do(http.MethodPost, "/scim/v2/Users", token, response{"userName": "public"})
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, engineers := do(http.MethodPost, "/scim/v2/Groups", token, response{
+		"displayName": "engineers",
+		"members":     []response{{"value": aliceID}},
+	})
+	require.Equal(t, http.StatusCreated, code)
+	groupID := engineers["id"].(string)
+	db.CheckQueryResults(t, `SELECT member FROM system.role_members WHERE role = 'engineers'`,
+		[][]string{{"alice"}})
+
+	// Only the roles created through the API are exposed.
+	db.Exec(t, `CREATE USER bob`)
+	var bobID string
+	db.QueryRow(t, `SELECT user_id FROM system.users WHERE username = 'bob'`).Scan(&bobID)
+	code, _ = do(http.MethodGet, "/scim/v2/Users/"+bobID, token, nil)
+	require.Equal(t, http.StatusNotFound, code)
+	code, res = do(http.MethodGet, "/scim/v2/Users", token, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, float64(1), res["totalResults"])
+	code, res = do(http.MethodGet, filter("/scim/v2/Users", `userName eq "ALICE"`), token, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, float64(1), res["totalResults"])
+	code, res = do(http.MethodGet, filter("/scim/v2/Users", `userName eq "bob"`), token, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, float64(0), res["totalResults"])
+	code, res = do(http.MethodGet, "/scim/v2/Users/"+aliceID, token, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []interface{}{map[string]interface{}{"value": groupID, "display": "engineers"}}, res["groups"])
+
+	// Deactivate the user.
+	code, res = do(http.MethodPatch, "/scim/v2/Users/"+aliceID, token, response{
+		"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []response{{"op": "Replace", "value": response{"active": "False"}}},
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, false, res["active"])
+	db.CheckQueryResults(t,
+		`SELECT count(*) FROM system.role_options WHERE username = 'alice' AND option = 'NOLOGIN'`,
+		[][]string{{"1"}})
+	code, res = do(http.MethodPatch, "/scim/v2/Users/"+aliceID, token, response{
+		"Operations": []response{{"op": "replace", "path": "userName", "value": "carol"}},
+	})
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, "mutability", res["scimType"])
+
+	// Update the members of the group.
+	code, res = do(http.MethodPatch, "/scim/v2/Groups/"+groupID, token, response{
+		"Operations": []response{{"op": "remove", "path": `members[value eq "` + aliceID + `"]`}},
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, res["members"])
+	code, res = do(http.MethodPatch, "/scim/v2/Groups/"+groupID, token, response{
+		"Operations": []response{{"op": "add", "path": "members", "value": []response{{"value": aliceID}}}},
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, res["members"], 1)
+	code, _ = do(http.MethodPatch, "/scim/v2/Groups/"+groupID, token, response{
+		"Operations": []response{{"op": "add", "path": "members", "value": []response{{"value": bobID}}}},
+	})
+	require.Equal(t, http.StatusBadRequest, code)
+	code, res = do(http.MethodPut, "/scim/v2/Groups/"+groupID, token, response{
+		"displayName": "engineers",
+		"members":     []response{},
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, res["members"])
+	db.CheckQueryResults(t, `SELECT count(*) FROM system.role_members WHERE role = 'engineers'`,
+		[][]string{{"0"}})
+
+	// Delete the group and the user.
+	code, _ = do(http.MethodDelete, "/scim/v2/Groups/"+groupID, token, nil)
+	require.Equal(t, http.StatusNoContent, code)
+	code, _ = do(http.MethodDelete, "/scim/v2/Users/"+aliceID, token, nil)
+	require.Equal(t, http.StatusNoContent, code)
+	db.CheckQueryResults(t,
+		`SELECT username FROM system.users WHERE username IN ('alice', 'bob', 'engineers')`,
+		[][]string{{"bob"}})
+
+	// The modifications are recorded in the event log.
+	log.FlushFiles()
+	entries, err := log.FetchEntriesFromFiles(0, math.MaxInt64, 100,
+		regexp.MustCompile(`"EventType":"scim_provisioning"`), log.WithMarkedSensitiveData)
+	require.NoError(t, err)
+	// Create the user and the group, deactivate the user, update the group
+	// three times, then delete both.
+	require.Len(t, entries, 8)
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/gorilla/mux"
+)
+
+func (s *Server) listUsers(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var code int
+	var resp interface{}
+	err := s.db.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+		snap, err := loadSnapshot(ctx, txn)
+		if err != nil {
+			return err
+		}
+		code, resp, err = snap.list(r, usersSource, "userName", func(r *role) interface{} {
+			return snap.user(r)
+		})
+		return err
+	})
+	return code, resp, err
+}
+
+func (s *Server) getUser(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var resp user
+	err := s.db.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+		snap, err := loadSnapshot(ctx, txn)
+		if err != nil {
+			return err
+		}
+		u, err := snap.lookup(mux.Vars(r)["id"], usersSource)
+		if err != nil {
+			return err
+		}
+		resp = snap.user(u)
+		return nil
+	})
+	return http.StatusOK, resp, err
+}
+
+func (s *Server) createUser(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req user
+	if err := decodeRequest(r, &req); err != nil {
+		return 0, nil, err
+	}
+	name, err := parseName("userName", req.UserName)
+	if err != nil {
+		return 0, nil, err
+	}
+	active := req.Active == nil || *req.Active
+	snap, err := s.mutate(ctx, "User", "create", func(ctx context.Context, m *mutation) error {
+		m.event.RoleName = name.Normalized()
+		return m.createRole(ctx, name, usersSource, active)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, snap.user(snap.byName[name]), nil
+}
+
+func (s *Server) replaceUser(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req user
+	if err := decodeRequest(r, &req); err != nil {
+		return 0, nil, err
+	}
+	active := req.Active == nil || *req.Active
+	return s.updateUser(ctx, r, "replace", func(ctx context.Context, m *mutation, u *role) error {
+		if err := checkImmutableName(u, "userName", req.UserName); err != nil {
+			return err
+		}
+		return m.setLogin(ctx, u, active)
+	})
+}
+
+func (s *Server) patchUser(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req patchRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return 0, nil, err
+	}
+	return s.updateUser(ctx, r, "patch", func(ctx context.Context, m *mutation, u *role) error {
+		for _, op := range req.Operations {
+			switch strings.ToLower(op.Op) {
+			case "add", "replace":
+			case "remove":
+				// The attributes that can be removed are not stored.
+				continue
+			default:
+				return newError(http.StatusBadRequest, "invalidSyntax", "invalid operation %q", op.Op)
+			}
+			// The value is either the value of the attribute at the path, or
+			// an object with the attributes to modify.
+			attrs := map[string]json.RawMessage{}
+			if op.Path != "" {
+				attrs[op.Path] = op.Value
+			} else if err := json.Unmarshal(op.Value, &attrs); err != nil {
+				return newError(http.StatusBadRequest, "invalidValue", "invalid operation value: %v", err)
+			}
+			for attr, value := range attrs {
+				switch {
+				case strings.EqualFold(attr, "active"):
+					active, err := parseBool(value)
+					if err != nil {
+						return err
+					}
+					if err := m.setLogin(ctx, u, active); err != nil {
+						return err
+					}
+				case strings.EqualFold(attr, "userName"):
+					var userName string
+					if err := json.Unmarshal(value, &userName); err != nil {
+						return newError(http.StatusBadRequest, "invalidValue", "invalid userName %s", value)
+					}
+					if err := checkImmutableName(u, "userName", userName); err != nil {
+						return err
+					}
+				}
+				// The other attributes, such as the names and the emails of
+				// the user, are not stored.
+			}
+		}
+		return nil
+	})
+}
+
+// updateUser applies fn to the user of the request, and returns the updated
+// user.
+func (s *Server) updateUser(
+	ctx context.Context,
+	r *http.Request,
+	operation string,
+	fn func(context.Context, *mutation, *role) error,
+) (int, interface{}, error) {
+	id := mux.Vars(r)["id"]
+	snap, err := s.mutate(ctx, "User", operation, func(ctx context.Context, m *mutation) error {
+		u, err := m.snap.lookup(id, usersSource)
+		if err != nil {
+			return err
+		}
+		m.event.RoleName = u.name.Normalized()
+		return fn(ctx, m, u)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, snap.user(snap.byID[id]), nil
+}
+
+func (s *Server) deleteUser(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	if _, err := s.mutate(ctx, "User", "delete", func(ctx context.Context, m *mutation) error {
+		u, err := m.snap.lookup(mux.Vars(r)["id"], usersSource)
+		if err != nil {
+			return err
+		}
+		m.event.RoleName = u.name.Normalized()
+		return m.dropRole(ctx, u)
+	}); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
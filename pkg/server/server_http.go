@@ -23,6 +23,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/server/authserver"
 	"github.com/cockroachdb/cockroach/pkg/server/debug"
 	"github.com/cockroachdb/cockroach/pkg/server/privchecker"
+	"github.com/cockroachdb/cockroach/pkg/server/scim"
 	"github.com/cockroachdb/cockroach/pkg/server/serverpb"
 	"github.com/cockroachdb/cockroach/pkg/server/srverrors"
 	"github.com/cockroachdb/cockroach/pkg/server/status"
@@ -263,6 +264,10 @@ func (s *httpServer) setupRoutes(
 		s.mux.Handle(apiconstants.APIV2Path, apiServer)
 	}
 
+	// The SCIM provisioning API authenticates its clients with a bearer
+	// token of its own, instead of a SQL user session.
+	s.mux.Handle(apiconstants.SCIMV2Path, scim.NewServer(execCfg.InternalDB, execCfg.Settings))
+
 	// Register debugging endpoints.
 	handleDebugAuthenticated := handleDebugUnauthenticated
 	handleInspectzAuthenticated := handleInspectzUnauthenticated
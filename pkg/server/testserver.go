@@ -196,6 +196,7 @@ func makeTestConfigFromParams(params base.TestServerArgs) Config {
 	cfg.StartDiagnosticsReporting = params.StartDiagnosticsReporting
 	cfg.DisableSQLServer = params.DisableSQLServer
 	cfg.ExternalIODir = params.ExternalIODir
+	cfg.AuditChainKeyFile = params.AuditChainKeyFile
 	if params.TraceDir != "" {
 		if err := initTraceDir(params.TraceDir); err == nil {
 			cfg.InflightTraceDirName = params.TraceDir
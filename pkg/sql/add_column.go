@@ -6,6 +6,7 @@
 package sql
 
 import (
+	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
@@ -14,6 +15,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq/oid"
 )
@@ -32,6 +34,12 @@ func (p *planner) addColumnImpl(
 		d.Computed.Expr = schemaexpr.MaybeRewriteComputedColumn(d.Computed.Expr, params.SessionData())
 	}
 
+	dbDesc, err := p.Descriptors().ByIDWithLeased(p.txn).WithoutNonPublic().Get().Database(params.ctx, desc.GetParentID())
+	if err != nil {
+		return err
+	}
+	d = d.WithDatabaseCollation(dbDesc.GetDefaultCollation())
+
 	toType, err := tree.ResolveType(params.ctx, d.Type, params.p.semaCtx.GetTypeResolver())
 	if err != nil {
 		return err
@@ -114,6 +122,15 @@ func (p *planner) addColumnImpl(
 			return sqlerrors.NewNonNullViolationError(col.Name)
 		}
 	}
+	// Existing rows need not be backfilled if they can decode to a constant
+	// missing value instead.
+	col.MissingValue = schemaexpr.MakeMissingValue(
+		params.ctx, params.EvalContext(), col, cdd.DefaultExpr,
+		params.ExecCfg().Settings.Version.ActiveVersion(params.ctx),
+	)
+	if col.MissingValue != nil {
+		telemetry.Inc(sqltelemetry.SchemaNewColumnTypeQualificationCounter("missing_value"))
+	}
 	if isPublic, err := checkColumnDoesNotExist(n.tableDesc, d.Name); err != nil {
 		if isPublic && t.IfNotExists {
 			return nil
@@ -134,6 +151,23 @@ func (p *planner) addColumnImpl(
 		if err != nil {
 			return err
 		}
+	} else if d.IsEncrypted() {
+		// Encrypted columns get a family of their own; AllocateIDs will name it.
+		if err := n.tableDesc.AddColumnToFamilyMaybeCreate(
+			col.Name, "" /* family */, true /* create */, false, /* ifNotExists */
+		); err != nil {
+			return err
+		}
+	}
+	if d.IsEncrypted() {
+		if col.DefaultExpr != nil || !col.Nullable || col.IsComputed() {
+			return pgerror.Newf(pgcode.FeatureNotSupported,
+				"encrypted column %q must be added as a nullable column without a default or computed expression",
+				col.Name)
+		}
+		if err := params.p.initColumnEncryption(params.ctx, n.tableDesc.GetID(), d, col); err != nil {
+			return err
+		}
 	}
 
 	if d.IsComputed() {
@@ -145,6 +179,21 @@ func (p *planner) addColumnImpl(
 			return err
 		}
 		col.ComputeExpr = &serializedExpr
+		// The column backfiller reads encrypted values without decrypting them,
+		// so it cannot compute expressions over them.
+		if !col.Virtual {
+			refs, err := schemaexpr.ExtractColumnIDs(n.tableDesc, d.Computed.Expr)
+			if err != nil {
+				return err
+			}
+			for _, other := range n.tableDesc.PublicColumns() {
+				if other.IsEncrypted() && refs.Contains(other.GetID()) {
+					return pgerror.Newf(pgcode.FeatureNotSupported,
+						"stored computed column %q cannot reference encrypted column %q",
+						col.Name, other.GetName())
+				}
+			}
+		}
 	}
 
 	if !col.Virtual {
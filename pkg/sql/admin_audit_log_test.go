@@ -8,18 +8,24 @@ package sql
 import (
 	"context"
 	"math"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils/serverutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/log/auditchain"
 	"github.com/cockroachdb/cockroach/pkg/util/log/channel"
 	"github.com/cockroachdb/cockroach/pkg/util/log/logconfig"
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
 	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
 )
 
 func installSensitiveAccessLogFileSink(sc *log.TestLogScope, t *testing.T) func() {
@@ -309,3 +315,137 @@ COMMIT;
 		t.Fatal(errors.Newf("unexpected entries found"))
 	}
 }
+
+// TestAuditLogChain verifies that the events logged on the SENSITIVE_ACCESS
+// channel are chained with the key of the node-local key file, and that the
+// chain can be verified against its checkpoints.
+func TestAuditLogChain(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	sc := log.ScopeWithoutShowLogs(t)
+	defer sc.Close(t)
+
+	cleanup := installSensitiveAccessLogFileSink(sc, t)
+	defer cleanup()
+
+	const key = "audit chain test key"
+	keyFile := filepath.Join(t.TempDir(), "audit_chain.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(key+"\n"), 0600))
+
+	s, sqlDB, _ := serverutils.StartServer(t, base.TestServerArgs{AuditChainKeyFile: keyFile})
+	defer s.Stopper().Stop(context.Background())
+
+	db := sqlutils.MakeSQLRunner(sqlDB)
+	db.Exec(t, `SET CLUSTER SETTING sql.log.audit_chain.checkpoint_interval = '10ms'`)
+	// The key is not exposed through SQL.
+	db.CheckQueryResults(t,
+		`SELECT count(*) FROM [SHOW ALL CLUSTER SETTINGS] WHERE variable LIKE 'sql.log.audit_chain.%' AND variable != 'sql.log.audit_chain.checkpoint_interval'`,
+		[][]string{{"0"}})
+	db.Exec(t, `SET CLUSTER SETTING sql.log.admin_audit.enabled = true`)
+	for i := 0; i < 5; i++ {
+		db.Exec(t, `SELECT $1::INT`, i)
+	}
+	db.Exec(t, `SET CLUSTER SETTING sql.log.admin_audit.enabled = false`)
+
+	fetchEntries := func() []logpb.Entry {
+		log.FlushFiles()
+		entries, err := log.FetchEntriesFromFiles(0, math.MaxInt64, 10000,
+			regexp.MustCompile(`"AuditChainID"`), log.WithMarkedSensitiveData)
+		require.NoError(t, err)
+		return entries
+	}
+	fetchCheckpoints := func() []auditchain.Checkpoint {
+		var cps []auditchain.Checkpoint
+		rows := db.Query(t, `SELECT chain_id::STRING, sequence, hmac, signature FROM system.audit_log_checkpoints`)
+		defer rows.Close()
+		for rows.Next() {
+			var cp auditchain.Checkpoint
+			var seq int64
+			require.NoError(t, rows.Scan(&cp.ChainID, &seq, &cp.HMAC, &cp.Signature))
+			cp.Sequence = uint64(seq)
+			cps = append(cps, cp)
+		}
+		require.NoError(t, rows.Err())
+		return cps
+	}
+	verifyWithKey := func(key string, entries []logpb.Entry, cps []auditchain.Checkpoint) []string {
+		v := auditchain.NewVerifier([]byte(key))
+		for _, e := range entries {
+			_, err := v.AddLogEntry(e)
+			require.NoError(t, err)
+		}
+		for _, cp := range cps {
+			v.AddCheckpoint(cp)
+		}
+		var problems []string
+		for _, p := range v.Verify() {
+			problems = append(problems, p.String())
+		}
+		return problems
+	}
+	verify := func(entries []logpb.Entry, cps []auditchain.Checkpoint) []string {
+		return verifyWithKey(key, entries, cps)
+	}
+
+	// Wait for the head of the chain to be checkpointed.
+	var entries []logpb.Entry
+	var cps []auditchain.Checkpoint
+	testutils.SucceedsSoon(t, func() error {
+		entries, cps = fetchEntries(), fetchCheckpoints()
+		if len(entries) < 6 {
+			return errors.Newf("found %d chained entries", len(entries))
+		}
+		var head uint64
+		for _, cp := range cps {
+			if cp.Sequence > head {
+				head = cp.Sequence
+			}
+		}
+		if head < uint64(len(entries)) {
+			return errors.Newf("chain checkpointed at %d, expected %d", head, len(entries))
+		}
+		return nil
+	})
+	require.Empty(t, verify(entries, cps))
+
+	// A removed event is detected, even at the end of the chain.
+	var removed []logpb.Entry
+	for _, e := range entries {
+		if !strings.Contains(e.Message, `"AuditSequence":3,`) {
+			removed = append(removed, e)
+		}
+	}
+	require.Len(t, removed, len(entries)-1)
+	require.Regexp(t, `chain .*: event 3 is missing`, strings.Join(verify(removed, cps), "\n"))
+
+	// So is a modified event.
+	modified := append([]logpb.Entry(nil), entries...)
+	for i := range modified {
+		if strings.Contains(modified[i].Message, `"AuditSequence":2,`) {
+			modified[i].Message = strings.Replace(modified[i].Message, `"Timestamp":`, `"Timestamp":1`, 1)
+		}
+	}
+	require.Regexp(t, `chain .*: event 2 was modified`, strings.Join(verify(modified, cps), "\n"))
+
+	// A checkpoint written to the system table without the key is detected.
+	chainID := cps[0].ChainID
+	forged := auditchain.NewChain(chainID, []byte("guessed key")).Checkpoint()
+	db.Exec(t, `UPSERT INTO system.audit_log_checkpoints (chain_id, sequence, hmac, signature, sql_instance_id)
+VALUES ($1::UUID, 0, $2, $3, 1)`, chainID, []byte{}, forged.Signature)
+	require.Regexp(t, `chain .*: checkpoint at sequence number 0 has an invalid signature`,
+		strings.Join(verify(entries, fetchCheckpoints()), "\n"))
+
+	// Changing the key in the file starts a new chain, signed with the new key.
+	const newKey = "rotated audit chain test key"
+	require.NoError(t, os.WriteFile(keyFile, []byte(newKey), 0600))
+	testutils.SucceedsSoon(t, func() error {
+		for _, cp := range fetchCheckpoints() {
+			if cp.ChainID != chainID && cp.Sequence == 0 {
+				if problems := verifyWithKey(newKey, nil, []auditchain.Checkpoint{cp}); len(problems) > 0 {
+					return errors.Newf("%v", problems)
+				}
+				return nil
+			}
+		}
+		return errors.New("no new chain was started")
+	})
+}
@@ -73,7 +73,8 @@ func AlterColumnType(
 	}
 
 	typ, err = schemachange.ValidateAlterColumnTypeChecks(ctx, t,
-		params.EvalContext().Settings, typ, col.IsGeneratedAsIdentity(), col.IsVirtual())
+		params.EvalContext().Settings, params.p.semaCtx.GetTypeResolver(), typ,
+		col.IsGeneratedAsIdentity(), col.IsVirtual())
 	if err != nil {
 		return err
 	}
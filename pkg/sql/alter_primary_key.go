@@ -54,6 +54,10 @@ func (p *planner) AlterPrimaryKey(
 	alterPKNode tree.AlterTableAlterPrimaryKey,
 	alterPrimaryKeyLocalitySwap *alterPrimaryKeyLocalitySwap,
 ) error {
+	if err := checkNoEncryptedColumns(tableDesc, "change the primary key of"); err != nil {
+		return err
+	}
+
 	// Check if sql_safe_updates is enabled and the table has vector indexes
 	if len(tableDesc.VectorIndexes()) > 0 {
 		if p.EvalContext().SessionData().SafeUpdates {
@@ -237,6 +237,15 @@ func (n *alterRoleNode) startExec(params runParams) error {
 		return err
 	}
 	if hasPasswordOpt {
+		if hashedPassword != nil {
+			_, passwordStr, err := n.roleOptions.GetPassword()
+			if err != nil {
+				return err
+			}
+			if err := params.p.checkPasswordHistory(params.ctx, n.roleName, passwordStr, hashedPassword); err != nil {
+				return err
+			}
+		}
 		// Updating PASSWORD is a special case since PASSWORD lives in system.users
 		// while the rest of the role options lives in system.role_options.
 		rowAffected, err := params.p.InternalSQLTxn().ExecEx(
@@ -251,6 +260,11 @@ func (n *alterRoleNode) startExec(params runParams) error {
 		if err != nil {
 			return err
 		}
+		if hashedPassword != nil && rowAffected > 0 {
+			if err := params.p.recordPasswordHistory(params.ctx, n.roleName, hashedPassword); err != nil {
+				return err
+			}
+		}
 		if sessioninit.CacheEnabled.Get(&params.p.ExecCfg().Settings.SV) && rowAffected > 0 {
 			// Bump user table versions to force a refresh of AuthInfo cache.
 			if err := params.p.bumpUsersTableVersion(params.ctx); err != nil {
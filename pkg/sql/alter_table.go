@@ -679,6 +679,14 @@ func (n *alterTableNode) startExec(params runParams) error {
 					"cannot set explicit partitioning with PARTITION BY on hash sharded primary key",
 				)
 			}
+			if t.PartitionBy.IsHash() {
+				// Hash partitioning requires rewriting the primary index, which is
+				// only implemented by the declarative schema changer.
+				return pgerror.New(
+					pgcode.FeatureNotSupported,
+					"ALTER TABLE ... PARTITION BY HASH is only supported by the declarative schema changer",
+				)
+			}
 			oldPartitioning := n.tableDesc.GetPrimaryIndex().GetPartitioning().DeepCopy()
 			if oldPartitioning.NumImplicitColumns() > 0 {
 				return unimplemented.NewWithIssue(
@@ -983,6 +991,14 @@ func applyColumnMutation(
 ) error {
 	switch t := mut.(type) {
 	case *tree.AlterTableAlterColumnType:
+		if col.ColumnDesc().MaskingPolicy != nil {
+			return pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+				"cannot alter type of column %q because it has a masking policy", col.GetName())
+		}
+		if col.IsEncrypted() {
+			return pgerror.Newf(pgcode.FeatureNotSupported,
+				"cannot alter type of encrypted column %q", col.GetName())
+		}
 		return AlterColumnType(ctx, tableDesc, col, t, params, cmds, tn)
 
 	case *tree.AlterTableSetDefault:
@@ -1062,6 +1078,12 @@ func applyColumnMutation(
 		}
 		column.ColumnDesc().Hidden = !t.Visible
 
+	case *tree.AlterTableSetMaskingPolicy:
+		return params.p.setColumnMaskingPolicy(ctx, tableDesc, col, t)
+
+	case *tree.AlterTableRotateEncryptionKey:
+		return params.p.rotateColumnEncryptionKey(ctx, tableDesc, col, t)
+
 	case *tree.AlterTableSetNotNull:
 		if !col.IsNullable() {
 			return nil
@@ -1099,6 +1121,9 @@ func applyColumnMutation(
 			return pgerror.Newf(pgcode.Syntax,
 				`column "%s" of relation "%s" is an identity column`, col.GetName(), tn.ObjectName)
 		}
+		if col.HasMissingValue() {
+			return sqlerrors.NewDropNotNullOnColumnWithMissingValueError(col.GetName())
+		}
 		// See if there's already a mutation to add/drop a not null constraint.
 		for i := range tableDesc.Mutations {
 			if constraint := tableDesc.Mutations[i].GetConstraint(); constraint != nil &&
@@ -1301,6 +1326,9 @@ func applyColumnMutation(
 		optsNode = append(optsNode, tree.SequenceOption{Name: tree.SeqOptMaxValue, IntVal: &opts.MaxValue})
 		optsNode = append(optsNode, tree.SequenceOption{Name: tree.SeqOptIncrement, IntVal: &opts.Increment})
 		optsNode = append(optsNode, tree.SequenceOption{Name: tree.SeqOptStart, IntVal: &opts.Start})
+		if opts.Cycle {
+			optsNode = append(optsNode, tree.SequenceOption{Name: tree.SeqOptCycle})
+		}
 		if opts.Virtual {
 			optsNode = append(optsNode, tree.SequenceOption{Name: tree.SeqOptVirtual})
 		}
@@ -2633,7 +2661,7 @@ func (p *planner) checkSchemaChangeIsAllowed(
 			case *tree.AlterTableRenameColumn, *tree.AlterTableRenameConstraint,
 				*tree.AlterTableResetStorageParams, *tree.AlterTablePartitionByTable,
 				*tree.AlterTableSetOnUpdate, *tree.AlterTableDropNotNull,
-				*tree.AlterTableSetVisible, *tree.AlterTableDropStored,
+				*tree.AlterTableSetVisible, *tree.AlterTableSetMaskingPolicy, *tree.AlterTableDropStored,
 				*tree.AlterTableValidateConstraint, *tree.AlterTableInjectStats, *tree.AlterTablePushStats:
 			default:
 				preventedBySchemaLocked = true
@@ -0,0 +1,161 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package auditlogging
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/log/auditchain"
+	"github.com/cockroachdb/cockroach/pkg/util/stop"
+	"github.com/cockroachdb/cockroach/pkg/util/uuid"
+)
+
+var auditChainCheckpointInterval = settings.RegisterDurationSetting(
+	settings.ApplicationLevel,
+	"sql.log.audit_chain.checkpoint_interval",
+	"the interval at which the head of the audit log hash chain is checkpointed in system.audit_log_checkpoints",
+	time.Minute,
+	settings.PositiveDuration,
+	settings.WithPublic,
+)
+
+// StartAuditChain starts the task that maintains the hash chain of the events
+// logged on the SENSITIVE_ACCESS channel, authenticated with the key stored in
+// the given node-local file. The key is deliberately not configurable through
+// SQL, so that the users that can read the cluster settings or write to the
+// system tables cannot forge the chain. The file is read again at every
+// checkpoint, and a new chain is started every time the key changes. The head
+// of the chain is periodically checkpointed in system.audit_log_checkpoints,
+// so that a truncated chain can be detected.
+//
+// The log channels are shared by all the servers of the process, and so is the
+// chain: it is managed by the first server that configures it.
+func StartAuditChain(
+	ctx context.Context,
+	stopper *stop.Stopper,
+	db isql.DB,
+	st *cluster.Settings,
+	instanceID base.SQLInstanceID,
+	keyFile string,
+) {
+	if keyFile == "" {
+		return
+	}
+	m := &auditChainManager{db: db, st: st, instanceID: instanceID, keyFile: keyFile}
+	intervalChanged := make(chan struct{}, 1)
+	auditChainCheckpointInterval.SetOnChange(&st.SV, func(context.Context) {
+		select {
+		case intervalChanged <- struct{}{}:
+		default:
+		}
+	})
+	_ = stopper.RunAsyncTask(ctx, "audit-log-chain", func(ctx context.Context) {
+		ctx, cancel := stopper.WithCancelOnQuiesce(ctx)
+		defer cancel()
+		defer m.detach()
+
+		m.update(ctx)
+		timer := time.NewTimer(auditChainCheckpointInterval.Get(&st.SV))
+		defer timer.Stop()
+		for {
+			select {
+			case <-stopper.ShouldQuiesce():
+				return
+			case <-intervalChanged:
+				timer.Reset(auditChainCheckpointInterval.Get(&st.SV))
+			case <-timer.C:
+				m.update(ctx)
+				if m.chain != nil {
+					m.checkpoint(ctx, m.chain)
+				}
+				timer.Reset(auditChainCheckpointInterval.Get(&st.SV))
+			}
+		}
+	})
+}
+
+type auditChainManager struct {
+	db         isql.DB
+	st         *cluster.Settings
+	instanceID base.SQLInstanceID
+	keyFile    string
+
+	// key is the key of the current chain, if any.
+	key   string
+	chain *auditchain.Chain
+	// checkpointed is the sequence number of the last checkpoint of the
+	// current chain, or -1 if it was not checkpointed yet.
+	checkpointed int64
+}
+
+// update replaces the current chain if the key changed. The current chain is
+// kept if the key cannot be read.
+func (m *auditChainManager) update(ctx context.Context) {
+	keyBytes, err := auditchain.ReadKeyFile(m.keyFile)
+	if err != nil {
+		log.Dev.Warningf(ctx, "unable to update the audit log chain: %v", err)
+		return
+	}
+	key := string(keyBytes)
+	if key == m.key {
+		return
+	}
+	next := auditchain.NewChain(uuid.MakeV4().String(), keyBytes)
+	if !log.CompareAndSwapAuditChain(m.chain, next) {
+		log.Dev.Infof(ctx, "the audit log chain is managed by another server of this process")
+		return
+	}
+	if m.chain != nil {
+		// Record the final head of the previous chain.
+		m.checkpoint(ctx, m.chain)
+	}
+	m.key, m.chain, m.checkpointed = key, next, -1
+	log.Dev.Infof(ctx, "started audit log chain %s", next.ID())
+	// Record the start of the chain.
+	m.checkpoint(ctx, next)
+}
+
+// detach stops the stamping of the events with the current chain.
+func (m *auditChainManager) detach() {
+	if m.chain != nil {
+		log.CompareAndSwapAuditChain(m.chain, nil)
+	}
+}
+
+// checkpoint stores a checkpoint of the given chain, if it has new events
+// since the last one.
+func (m *auditChainManager) checkpoint(ctx context.Context, c *auditchain.Chain) {
+	cp := c.Checkpoint()
+	if c == m.chain && int64(cp.Sequence) <= m.checkpointed {
+		return
+	}
+	if !m.st.Version.IsActive(ctx, clusterversion.V26_2_AddSystemAuditLogCheckpointsTable) {
+		// The checkpoint is stored once the table exists.
+		return
+	}
+	mac := cp.HMAC
+	if mac == nil {
+		mac = []byte{}
+	}
+	if _, err := m.db.Executor().Exec(ctx, "audit-log-checkpoint", nil, /* txn */
+		`UPSERT INTO system.audit_log_checkpoints (chain_id, sequence, hmac, signature, sql_instance_id)
+VALUES ($1::UUID, $2, $3, $4, $5)`,
+		cp.ChainID, int64(cp.Sequence), mac, cp.Signature, int64(m.instanceID),
+	); err != nil {
+		log.Dev.Warningf(ctx, "unable to checkpoint audit log chain %s: %v", cp.ChainID, err)
+		return
+	}
+	if c == m.chain {
+		m.checkpointed = int64(cp.Sequence)
+	}
+}
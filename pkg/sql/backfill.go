@@ -1512,6 +1512,8 @@ func (sc *SchemaChanger) validateIndexes(ctx context.Context) error {
 		case idxtype.VECTOR:
 			// TODO(drewk): consider whether we can perform useful validation for
 			// vector indexes.
+		case idxtype.BRIN:
+			// BRIN indexes have no per-row entries to validate.
 		default:
 			return errors.AssertionFailedf("unknown index type %d", idx.GetType())
 		}
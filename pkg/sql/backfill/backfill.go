@@ -171,6 +171,9 @@ func (cb *ColumnBackfiller) init(
 	if err := rowenc.InitIndexFetchSpec(&spec, evalCtx.Codec, desc, desc.GetPrimaryIndex(), cb.fetcherCols); err != nil {
 		return err
 	}
+	// Encrypted columns are never updated by the backfill, and have no keys
+	// here; fetch their ciphertext as is.
+	rowenc.PassThroughEncryptedColumns(&spec)
 
 	cb.mon = mon
 	cb.rowMetrics = rowMetrics
@@ -936,6 +939,11 @@ type IndexBackfiller struct {
 	// backfilled.
 	indexesToEncode []catalog.Index
 
+	// brinIndexes are the BRIN indexes being added. They are not included in
+	// indexesToEncode since they are built from summaries of blocks of rows
+	// rather than from per-row entries.
+	brinIndexes []rowenc.BrinIndex
+
 	// sourceIndex the primary index that should be used to execute this
 	// backfill.
 	sourceIndex catalog.Index
@@ -1289,6 +1297,14 @@ func (ib *IndexBackfiller) initIndexes(
 			(allowListAsSet.Empty() || allowListAsSet.Contains(m.AsIndex().GetID())) {
 			idx := m.AsIndex()
 			ib.added = append(ib.added, idx)
+			if idx.GetType().IsSummary() {
+				bi, err := rowenc.MakeBrinIndex(evalCtx.Codec, desc, idx)
+				if err != nil {
+					return err
+				}
+				ib.brinIndexes = append(ib.brinIndexes, bi)
+				continue
+			}
 			keyPrefix := rowenc.MakeIndexKeyPrefix(evalCtx.Codec, desc.GetID(), idx.GetID())
 			ib.keyPrefixes = append(ib.keyPrefixes, keyPrefix)
 		}
@@ -1344,6 +1360,14 @@ func (ib *IndexBackfiller) init(
 	// being added. If there are partial indexes, allocate a new list that is
 	// reset in BuildIndexEntriesChunk for every row added.
 	ib.indexesToEncode = ib.added
+	if len(ib.brinIndexes) > 0 {
+		ib.indexesToEncode = make([]catalog.Index, 0, len(ib.added))
+		for _, idx := range ib.added {
+			if !idx.GetType().IsSummary() {
+				ib.indexesToEncode = append(ib.indexesToEncode, idx)
+			}
+		}
+	}
 	if len(ib.predicates) > 0 {
 		ib.indexesToEncode = make([]catalog.Index, 0, len(ib.added))
 		ib.keyPrefixes = make([][]byte, 0, len(ib.added))
@@ -1455,6 +1479,12 @@ func (ib *IndexBackfiller) BuildIndexEntriesChunk(
 	}
 	memUsedPerChunk += indexEntriesPerRowInitialBufferSize
 	buffer := make([]rowenc.IndexEntry, len(ib.added))
+	// Summarize the rows of the chunk into blocks for each BRIN index. Blocks
+	// never span chunks, which are disjoint ranges of the source index.
+	brinBuilders := make([]*rowenc.BrinBlockBuilder, len(ib.brinIndexes))
+	for i := range ib.brinIndexes {
+		brinBuilders[i] = ib.brinIndexes[i].NewBlockBuilder(rowenc.DefaultBrinRowsPerBlock)
+	}
 	evaluateExprs := func(cols []catalog.Column) error {
 		for i := range cols {
 			colID := cols[i].GetID()
@@ -1522,6 +1552,9 @@ func (ib *IndexBackfiller) BuildIndexEntriesChunk(
 			ib.indexesToEncode = ib.indexesToEncode[:0]
 			ib.keyPrefixes = ib.keyPrefixes[:0]
 			for _, idx := range ib.added {
+				if idx.GetType().IsSummary() {
+					continue
+				}
 				if !idx.IsPartial() {
 					// If the index is not a partial index, all rows should have
 					// an entry.
@@ -1598,6 +1631,18 @@ func (ib *IndexBackfiller) BuildIndexEntriesChunk(
 		if err != nil {
 			return nil, nil, memUsedPerChunk, err
 		}
+		for j := range brinBuilders {
+			row, vals, err := ib.brinIndexes[j].EncodeRow(ib.sourceIndex, ib.colIdxMap, ib.rowVals)
+			if err != nil {
+				return nil, nil, memUsedPerChunk, err
+			}
+			entry, full, err := brinBuilders[j].Add(ctx, ib.evalCtx, row, vals)
+			if err != nil {
+				return nil, nil, memUsedPerChunk, err
+			} else if full {
+				buffer = append(buffer, entry)
+			}
+		}
 
 		// The memory monitor has already accounted for cap(entries). If the number
 		// of index entries are going to cause the entries buffer to re-slice, then
@@ -1614,6 +1659,24 @@ func (ib *IndexBackfiller) BuildIndexEntriesChunk(
 		entries = append(entries, buffer...)
 	}
 
+	// Add the summaries of the last blocks of the chunk.
+	for _, bb := range brinBuilders {
+		entry, ok, err := bb.Finish()
+		if err != nil {
+			return nil, nil, memUsedPerChunk, err
+		} else if !ok {
+			continue
+		}
+		if cap(entries) == len(entries) {
+			resliceSize := sizeOfIndexEntry * int64(cap(entries))
+			if err := ib.GrowBoundAccount(ctx, resliceSize); err != nil {
+				return nil, nil, memUsedPerChunk, err
+			}
+			memUsedPerChunk += resliceSize
+		}
+		entries = append(entries, entry)
+	}
+
 	// We can release the memory which was allocated for `buffer` since all its
 	// contents have been copied to `entries`.
 	shrinkSize := sizeOfIndexEntry * int64(cap(buffer))
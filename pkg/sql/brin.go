@@ -0,0 +1,324 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/kv"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/constraint"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/errors"
+)
+
+// BRIN indexes summarize contiguous blocks of rows of the primary index with
+// the minimum and maximum values of the indexed columns. A scan of the primary
+// index with filters on these columns skips the blocks whose summaries cannot
+// satisfy the filters. See rowenc/brin.go for the layout of the index.
+
+// brinLockDurability returns the durability of the locks taken on the pending
+// entries of a BRIN index while they are summarized. Under isolation levels
+// that tolerate write skew, the locks protect the rows from changing before
+// the summaries are written and must be durable.
+func brinLockDurability(txn *kv.Txn) kvpb.KeyLockingDurabilityType {
+	if txn.IsoLevel().ToleratesWriteSkew() {
+		return kvpb.GuaranteedDurability
+	}
+	return kvpb.BestEffort
+}
+
+// brinBlockMayMatch returns whether a block whose values of a column are
+// summarized by min, max and hasNulls may contain a value satisfying the
+// given single-column constraint.
+func brinBlockMayMatch(
+	ctx context.Context,
+	cmpCtx tree.CompareContext,
+	c *constraint.Constraint,
+	min, max tree.Datum,
+	hasNulls bool,
+) (bool, error) {
+	lo, hi := min, max
+	if hasNulls {
+		lo = tree.DNull
+	}
+	descending := c.Columns.Get(0).Descending()
+	for i, n := 0, c.Spans.Count(); i < n; i++ {
+		sp := c.Spans.Get(i)
+		low, lowBoundary := sp.StartKey(), sp.StartBoundary()
+		high, highBoundary := sp.EndKey(), sp.EndBoundary()
+		if descending {
+			low, lowBoundary, high, highBoundary = high, highBoundary, low, lowBoundary
+		}
+		if !high.IsEmpty() {
+			cmp, err := high.Value(0).Compare(ctx, cmpCtx, lo)
+			if err != nil {
+				return false, err
+			}
+			if cmp < 0 || (cmp == 0 && highBoundary == constraint.ExcludeBoundary) {
+				// The span ends before the block.
+				continue
+			}
+		}
+		if !low.IsEmpty() {
+			cmp, err := low.Value(0).Compare(ctx, cmpCtx, hi)
+			if err != nil {
+				return false, err
+			}
+			if cmp > 0 || (cmp == 0 && lowBoundary == constraint.ExcludeBoundary) {
+				// The span starts after the block.
+				continue
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// pruneBrinBlocks removes from the spans of a primary index scan the blocks
+// summarized by the BRIN indexes of the table that cannot contain rows
+// satisfying the block filters. Rows with pending entries that may satisfy
+// the filters are never removed.
+func pruneBrinBlocks(
+	ctx context.Context,
+	p *planner,
+	table cat.Table,
+	desc catalog.TableDescriptor,
+	spans roachpb.Spans,
+	filters []exec.BlockFilter,
+) (roachpb.Spans, error) {
+	for _, sp := range spans {
+		if len(sp.EndKey) == 0 {
+			// Point lookups are never worth pruning.
+			return spans, nil
+		}
+	}
+	codec := p.ExecCfg().Codec
+	primaryPrefix := rowenc.MakeIndexKeyPrefix(codec, desc.GetID(), desc.GetPrimaryIndexID())
+	rowsSpan := func(start, end roachpb.Key) roachpb.Span {
+		return roachpb.Span{
+			Key:    append(slices.Clip(primaryPrefix), start...),
+			EndKey: append(slices.Clip(primaryPrefix), end...).PrefixEnd(),
+		}
+	}
+	var a tree.DatumAlloc
+	var skip roachpb.Spans
+	for _, index := range desc.PublicNonPrimaryIndexes() {
+		if !index.GetType().IsSummary() {
+			continue
+		}
+		bi, err := rowenc.MakeBrinIndex(codec, desc, index)
+		if err != nil {
+			return nil, err
+		}
+		// Find the filters on the columns of the index, keyed by the position
+		// of the column in the index.
+		var positions []int
+		var constraints []*constraint.Constraint
+		for _, f := range filters {
+			colID := descpb.ColumnID(table.Column(int(f.Column)).ColID())
+			if pos := slices.Index(bi.ColIDs, colID); pos >= 0 {
+				positions = append(positions, pos)
+				constraints = append(constraints, f.Constraint)
+			}
+		}
+		if len(positions) == 0 {
+			continue
+		}
+		mayMatch := func(min, max tree.Datums, hasNulls func(pos int) bool) (bool, error) {
+			for i, pos := range positions {
+				ok, err := brinBlockMayMatch(
+					ctx, p.EvalContext(), constraints[i], min[pos], max[pos], hasNulls(pos),
+				)
+				if err != nil || !ok {
+					return false, err
+				}
+			}
+			return true, nil
+		}
+		var indexSkip, keep roachpb.Spans
+		span := bi.BlocksSpan()
+		kvs, err := p.txn.Scan(ctx, span.Key, span.EndKey, 0 /* maxRows */)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range kvs {
+			b, err := bi.DecodeBlock(&a, entry.Key, entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			ok, err := mayMatch(b.Min, b.Max, func(pos int) bool { return b.HasNulls[pos] })
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				indexSkip = append(indexSkip, rowsSpan(b.Start, b.End))
+			}
+		}
+		if len(indexSkip) == 0 {
+			continue
+		}
+		span = bi.PendingSpan()
+		kvs, err = p.txn.Scan(ctx, span.Key, span.EndKey, 0 /* maxRows */)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range kvs {
+			row, vals, err := bi.DecodePending(&a, entry.Key, entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			ok, err := mayMatch(vals, vals, func(pos int) bool { return vals[pos] == tree.DNull })
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				keep = append(keep, rowsSpan(row, row))
+			}
+		}
+		indexSkip, _ = roachpb.MergeSpans(indexSkip)
+		keep, _ = roachpb.MergeSpans(keep)
+		skip = append(skip, roachpb.SubtractSpans(indexSkip, keep)...)
+	}
+	if len(skip) == 0 {
+		return spans, nil
+	}
+	skip, _ = roachpb.MergeSpans(skip)
+	return roachpb.SubtractSpans(spans, skip), nil
+}
+
+// BrinSummarize is part of the eval.Planner interface.
+func (p *planner) BrinSummarize(
+	ctx context.Context, tableID int64, indexName string, rowsPerBlock int64,
+) (int64, error) {
+	if rowsPerBlock == 0 {
+		rowsPerBlock = rowenc.DefaultBrinRowsPerBlock
+	} else if rowsPerBlock < 0 {
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue,
+			"rows per block must be positive, got %d", rowsPerBlock)
+	}
+	desc, err := p.Descriptors().ByIDWithLeased(p.Txn()).WithoutNonPublic().Get().Table(ctx, descpb.ID(tableID))
+	if err != nil {
+		return 0, err
+	}
+	if err := p.CheckPrivilege(ctx, desc, privilege.CREATE); err != nil {
+		return 0, err
+	}
+	index := catalog.FindPublicNonPrimaryIndex(desc, func(idx catalog.Index) bool {
+		return idx.GetName() == indexName
+	})
+	if index == nil {
+		return 0, pgerror.Newf(pgcode.UndefinedObject,
+			"index %q does not exist on table %q", indexName, desc.GetName())
+	}
+	if !index.GetType().IsSummary() {
+		return 0, pgerror.Newf(pgcode.WrongObjectType,
+			"index %q is not a BRIN index", indexName)
+	}
+	bi, err := rowenc.MakeBrinIndex(p.ExecCfg().Codec, desc, index)
+	if err != nil {
+		return 0, err
+	}
+	// Lock the pending entries that are folded into the summaries, so that the
+	// rows they belong to cannot change until the summaries are written. Rows
+	// written afterwards get new pending entries, which are left in place.
+	pendingSpan := bi.PendingSpan()
+	pending, err := p.txn.ScanForUpdate(
+		ctx, pendingSpan.Key, pendingSpan.EndKey, 0 /* maxRows */, brinLockDurability(p.txn),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Read the primary key and indexed columns of every row in primary key
+	// order.
+	var colMap catalog.TableColMap
+	var colNames []string
+	addCol := func(colID descpb.ColumnID) error {
+		if _, ok := colMap.Get(colID); ok {
+			return nil
+		}
+		col, err := catalog.MustFindColumnByID(desc, colID)
+		if err != nil {
+			return err
+		}
+		colMap.Set(colID, len(colNames))
+		colNames = append(colNames, tree.NameString(col.GetName()))
+		return nil
+	}
+	primaryIndex := desc.GetPrimaryIndex()
+	for i := 0; i < primaryIndex.NumKeyColumns(); i++ {
+		if err := addCol(primaryIndex.GetKeyColumnID(i)); err != nil {
+			return 0, err
+		}
+	}
+	for _, colID := range bi.ColIDs {
+		if err := addCol(colID); err != nil {
+			return 0, err
+		}
+	}
+	query := fmt.Sprintf(
+		"SELECT %s FROM [%d AS t]@[%d] ORDER BY PRIMARY KEY t",
+		strings.Join(colNames, ", "), desc.GetID(), primaryIndex.GetID(),
+	)
+	it, err := p.QueryIteratorEx(ctx, "brin-summarize", sessiondata.NodeUserSessionDataOverride, query)
+	if err != nil {
+		return 0, err
+	}
+	batch := p.txn.NewBatch()
+	blocksSpan := bi.BlocksSpan()
+	batch.DelRange(blocksSpan.Key, blocksSpan.EndKey, false /* returnKeys */)
+	for _, entry := range pending {
+		batch.Del(entry.Key)
+	}
+	var blocks int64
+	putBlock := func(entry rowenc.IndexEntry) {
+		batch.Put(entry.Key, &entry.Value)
+		blocks++
+	}
+	bb := bi.NewBlockBuilder(rowsPerBlock)
+	if err := func() (retErr error) {
+		defer func() { retErr = errors.CombineErrors(retErr, it.Close()) }()
+		var ok bool
+		for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+			row, vals, err := bi.EncodeRow(primaryIndex, colMap, it.Cur())
+			if err != nil {
+				return err
+			}
+			entry, full, err := bb.Add(ctx, p.EvalContext(), row, vals)
+			if err != nil {
+				return err
+			} else if full {
+				putBlock(entry)
+			}
+		}
+		return err
+	}(); err != nil {
+		return 0, err
+	}
+	if entry, ok, err := bb.Finish(); err != nil {
+		return 0, err
+	} else if ok {
+		putBlock(entry)
+	}
+	if err := p.txn.Run(ctx, batch); err != nil {
+		return 0, err
+	}
+	return blocks, nil
+}
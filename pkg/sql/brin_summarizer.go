@@ -0,0 +1,133 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/stop"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/logtags"
+)
+
+// brinAutoSummarizeRowsThreshold is the number of rows written to a table
+// with BRIN indexes after which the indexes are summarized in the background.
+var brinAutoSummarizeRowsThreshold = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"sql.brin.auto_summarize.rows_threshold",
+	"number of rows written to a table through a node after which the node "+
+		"summarizes the BRIN indexes of the table in the background; 0 disables "+
+		"automatic summarization",
+	8*rowenc.DefaultBrinRowsPerBlock,
+	settings.NonNegativeInt,
+)
+
+// BrinSummarizer summarizes the BRIN indexes of tables in the background once
+// enough rows have been written to them, so that their pending entries don't
+// accumulate until crdb_internal.brin_summarize is called. Like the automatic
+// statistics refresher, it is notified of the rows written by each mutation
+// statement executed on the node, and only counts the writes of its node.
+type BrinSummarizer struct {
+	st      *cluster.Settings
+	db      isql.DB
+	stopper *stop.Stopper
+
+	mu struct {
+		syncutil.Mutex
+		// rowsWritten is the number of rows written to each table since its
+		// BRIN indexes were last summarized by the node.
+		rowsWritten map[descpb.ID]int64
+		// running holds the tables whose BRIN indexes are being summarized.
+		running map[descpb.ID]struct{}
+	}
+}
+
+// NewBrinSummarizer creates a BrinSummarizer.
+func NewBrinSummarizer(st *cluster.Settings, db isql.DB, stopper *stop.Stopper) *BrinSummarizer {
+	s := &BrinSummarizer{st: st, db: db, stopper: stopper}
+	s.mu.rowsWritten = make(map[descpb.ID]int64)
+	s.mu.running = make(map[descpb.ID]struct{})
+	return s
+}
+
+// NotifyMutation is called when a mutation statement writes rowsAffected rows
+// to the given table. It starts summarizing the BRIN indexes of the table in
+// the background if enough rows were written since they were last summarized.
+// It is a no-op on a nil BrinSummarizer.
+func (s *BrinSummarizer) NotifyMutation(
+	ctx context.Context, table catalog.TableDescriptor, rowsAffected int,
+) {
+	if s == nil || rowsAffected <= 0 {
+		return
+	}
+	var indexNames []string
+	for _, index := range table.PublicNonPrimaryIndexes() {
+		if index.GetType().IsSummary() {
+			indexNames = append(indexNames, index.GetName())
+		}
+	}
+	if len(indexNames) == 0 {
+		return
+	}
+	threshold := brinAutoSummarizeRowsThreshold.Get(&s.st.SV)
+	if threshold == 0 {
+		return
+	}
+	tableID := table.GetID()
+	if !func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.mu.rowsWritten[tableID] += int64(rowsAffected)
+		if _, ok := s.mu.running[tableID]; ok || s.mu.rowsWritten[tableID] < threshold {
+			return false
+		}
+		delete(s.mu.rowsWritten, tableID)
+		s.mu.running[tableID] = struct{}{}
+		return true
+	}() {
+		return
+	}
+	// The summaries are written by their own transactions, after the
+	// statement returns, so that writers never wait on them.
+	taskCtx := logtags.AddTags(context.Background(), logtags.FromContext(ctx))
+	if err := s.stopper.RunAsyncTask(taskCtx, "brin-auto-summarize", func(ctx context.Context) {
+		ctx, cancel := s.stopper.WithCancelOnQuiesce(ctx)
+		defer cancel()
+		defer func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.mu.running, tableID)
+		}()
+		for _, indexName := range indexNames {
+			if err := s.summarize(ctx, tableID, indexName); err != nil {
+				log.Dev.Warningf(ctx, "failed to summarize BRIN index %q of table %d: %v",
+					indexName, tableID, err)
+			}
+		}
+	}); err != nil {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.mu.running, tableID)
+	}
+}
+
+// summarize folds the pending entries of the given BRIN index into its block
+// summaries.
+func (s *BrinSummarizer) summarize(ctx context.Context, tableID descpb.ID, indexName string) error {
+	_, err := s.db.Executor().ExecEx(
+		ctx, "brin-auto-summarize", nil /* txn */, sessiondata.NodeUserSessionDataOverride,
+		`SELECT crdb_internal.brin_summarize($1::INT8::REGCLASS, $2)`, int64(tableID), indexName,
+	)
+	return err
+}
@@ -0,0 +1,512 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/funcdesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/cast"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+	"github.com/cockroachdb/cockroach/pkg/util/iterutil"
+	"github.com/cockroachdb/errors"
+)
+
+// User-defined casts and operators are evaluated by calling a user-defined
+// function, and are consulted during type checking only when no built-in
+// cast or operator applies. A cast is stored in the descriptor of the schema
+// that contains its function, since casts are not scoped to a schema; an
+// operator is stored in the descriptor of the schema it is created in. Both
+// are only resolved from the schemas on the search path, and as in Postgres,
+// only the owner of one of their types may create them.
+// Expressions that use them are rewritten into calls to the function, so
+// the only dependency to track is that of the cast or operator on its
+// function, which DROP FUNCTION checks with functionUsedByCastOrOperator.
+
+// checkCastsAndOperatorsSupported returns an error if user-defined casts and
+// operators cannot be stored in schema descriptors at the active cluster
+// version.
+func checkCastsAndOperatorsSupported(ctx context.Context, p *planner) error {
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_2_UserDefinedCastsAndOperators) {
+		return pgerror.New(pgcode.FeatureNotSupported,
+			"user-defined casts and operators are not supported until the upgrade to v26.2 is finalized")
+	}
+	return nil
+}
+
+type createCastNode struct {
+	zeroInputPlanNode
+	n *tree.CreateCast
+}
+
+// CreateCast creates a user-defined cast.
+func (p *planner) CreateCast(ctx context.Context, n *tree.CreateCast) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"CREATE CAST",
+	); err != nil {
+		return nil, err
+	}
+	if err := checkCastsAndOperatorsSupported(ctx, p); err != nil {
+		return nil, err
+	}
+	if n.Context != cast.ContextExplicit {
+		return nil, unimplemented.Newf("create cast "+n.Context.String(),
+			"%s casts are not supported", n.Context)
+	}
+	return &createCastNode{n: n}, nil
+}
+
+func (n *createCastNode) startExec(params runParams) error {
+	p := params.p
+	source, target, err := p.resolveCastTypes(params.ctx, n.n.SourceType, n.n.TargetType)
+	if err != nil {
+		return err
+	}
+	if source.Oid() == target.Oid() {
+		return pgerror.New(pgcode.InvalidObjectDefinition,
+			"source data type and target data type are the same")
+	}
+	if err := p.checkOwnsEitherType(params.ctx, source, target); err != nil {
+		return err
+	}
+	if _, ok := cast.LookupCast(source, target); ok {
+		return pgerror.Newf(pgcode.DuplicateObject,
+			"cast from type %s to type %s already exists", source.SQLString(), target.SQLString())
+	}
+	if _, found, err := p.lookupCast(params.ctx, source, target, false /* onSearchPath */); err != nil {
+		return err
+	} else if found {
+		return pgerror.Newf(pgcode.DuplicateObject,
+			"cast from type %s to type %s already exists", source.SQLString(), target.SQLString())
+	}
+
+	fnDesc, ol, err := p.resolveCastOrOperatorFunction(params.ctx, &n.n.Function)
+	if err != nil {
+		return err
+	}
+	if ol.Types.Length() != 1 || ol.Types.GetAt(0).Oid() != source.Oid() {
+		return pgerror.Newf(pgcode.InvalidObjectDefinition,
+			"cast function must take one argument of type %s", source.SQLString())
+	}
+	if ret := ol.FixedReturnType(); ret == nil || ret.Oid() != target.Oid() {
+		return pgerror.Newf(pgcode.InvalidObjectDefinition,
+			"return data type of cast function must match target data type %s", target.SQLString())
+	}
+
+	db, sc, err := p.schemaOfFunction(params.ctx, fnDesc)
+	if err != nil {
+		return err
+	}
+	mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "casts")
+	if err != nil {
+		return err
+	}
+	mutSc.AddCast(descpb.SchemaDescriptor_Cast{
+		SourceType: source,
+		TargetType: target,
+		FunctionID: fnDesc.GetID(),
+	})
+	return p.writeSchemaDescChange(params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()))
+}
+
+func (n *createCastNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *createCastNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *createCastNode) Close(ctx context.Context)           {}
+func (n *createCastNode) ReadingOwnWrites()                   {}
+
+type dropCastNode struct {
+	zeroInputPlanNode
+	n *tree.DropCast
+}
+
+// DropCast drops a user-defined cast.
+func (p *planner) DropCast(ctx context.Context, n *tree.DropCast) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"DROP CAST",
+	); err != nil {
+		return nil, err
+	}
+	return &dropCastNode{n: n}, nil
+}
+
+func (n *dropCastNode) startExec(params runParams) error {
+	p := params.p
+	source, target, err := p.resolveCastTypes(params.ctx, n.n.SourceType, n.n.TargetType)
+	if err != nil {
+		return err
+	}
+	funcOID, found, err := p.lookupCast(params.ctx, source, target, false /* onSearchPath */)
+	if err != nil {
+		return err
+	}
+	if !found {
+		if _, ok := cast.LookupCast(source, target); ok {
+			return pgerror.Newf(pgcode.DependentObjectsStillExist,
+				"cannot drop cast from %s to %s because it is required by the database system",
+				source.SQLString(), target.SQLString())
+		}
+		if !n.n.IfExists {
+			return pgerror.Newf(pgcode.UndefinedObject,
+				"cast from type %s to type %s does not exist", source.SQLString(), target.SQLString())
+		}
+		p.BufferClientNotice(params.ctx, pgnotice.Newf(
+			"cast from type %s to type %s does not exist, skipping", source.SQLString(), target.SQLString()))
+		return nil
+	}
+	if err := p.checkOwnsEitherType(params.ctx, source, target); err != nil {
+		return err
+	}
+	fnDesc, err := p.Descriptors().ByIDWithoutLeased(p.txn).Get().Function(
+		params.ctx, funcdesc.UserDefinedFunctionOIDToID(funcOID),
+	)
+	if err != nil {
+		return err
+	}
+	db, sc, err := p.schemaOfFunction(params.ctx, fnDesc)
+	if err != nil {
+		return err
+	}
+	mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "casts")
+	if err != nil {
+		return err
+	}
+	if !mutSc.RemoveCast(source.Oid(), target.Oid()) {
+		return errors.AssertionFailedf("cast from %s to %s not found in schema %q",
+			source.SQLString(), target.SQLString(), mutSc.GetName())
+	}
+	return p.writeSchemaDescChange(params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()))
+}
+
+func (n *dropCastNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *dropCastNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *dropCastNode) Close(ctx context.Context)           {}
+func (n *dropCastNode) ReadingOwnWrites()                   {}
+
+type createOperatorNode struct {
+	zeroInputPlanNode
+	n *tree.CreateOperator
+}
+
+// CreateOperator creates a user-defined operator.
+func (p *planner) CreateOperator(ctx context.Context, n *tree.CreateOperator) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"CREATE OPERATOR",
+	); err != nil {
+		return nil, err
+	}
+	if err := checkCastsAndOperatorsSupported(ctx, p); err != nil {
+		return nil, err
+	}
+	return &createOperatorNode{n: n}, nil
+}
+
+func (n *createOperatorNode) startExec(params runParams) error {
+	p := params.p
+	name := n.n.Operator.Symbol.String()
+	var leftRef, rightRef tree.ResolvableTypeReference
+	var fnName *tree.UnresolvedObjectName
+	for _, opt := range n.n.Options {
+		switch key := strings.ToLower(string(opt.Name)); key {
+		case "leftarg", "rightarg", "function", "procedure":
+			if opt.Value == nil {
+				return pgerror.Newf(pgcode.SyntaxError, "operator attribute %q requires a value", key)
+			}
+			switch key {
+			case "leftarg":
+				leftRef = opt.Value
+			case "rightarg":
+				rightRef = opt.Value
+			default:
+				un, ok := opt.Value.(*tree.UnresolvedObjectName)
+				if !ok {
+					return pgerror.Newf(pgcode.InvalidParameterValue,
+						"invalid function name for operator attribute %q", key)
+				}
+				fnName = un
+			}
+		case "commutator", "negator", "restrict", "join", "hashes", "merges":
+			return unimplemented.NewWithIssuef(65017, "operator attribute %q is not supported", key)
+		default:
+			return pgerror.Newf(pgcode.SyntaxError, "operator attribute %q not recognized", key)
+		}
+	}
+	if fnName == nil {
+		return pgerror.New(pgcode.InvalidFunctionDefinition, "operator function must be specified")
+	}
+	if leftRef == nil || rightRef == nil {
+		return unimplemented.NewWithIssue(65017, "only binary operators are supported")
+	}
+	left, right, err := p.resolveCastTypes(params.ctx, leftRef, rightRef)
+	if err != nil {
+		return err
+	}
+	if err := p.checkOwnsEitherType(params.ctx, left, right); err != nil {
+		return err
+	}
+
+	// Operators are created in the first schema of the search path, like other
+	// objects with unqualified names.
+	un, err := tree.NewUnresolvedObjectName(1, [3]string{name}, tree.NoAnnotation)
+	if err != nil {
+		return err
+	}
+	db, sc, _, err := p.ResolveTargetObject(params.ctx, un)
+	if err != nil {
+		return err
+	}
+	mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "operators")
+	if err != nil {
+		return err
+	}
+	if err := mutSc.ForEachOperator(func(op descpb.SchemaDescriptor_Operator) error {
+		if op.Name == name && op.LeftType.Oid() == left.Oid() && op.RightType.Oid() == right.Oid() {
+			return pgerror.Newf(pgcode.DuplicateFunction, "operator %s already exists",
+				formatOperator(name, left, right))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	fnDesc, ol, err := p.resolveCastOrOperatorFunction(params.ctx, &tree.RoutineObj{
+		FuncName: fnName.ToRoutineName(),
+		Params: tree.RoutineParams{
+			{Type: leftRef, Class: tree.RoutineParamIn},
+			{Type: rightRef, Class: tree.RoutineParamIn},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if ret := ol.FixedReturnType(); ret == nil || ret.Family() == types.VoidFamily {
+		return pgerror.New(pgcode.InvalidFunctionDefinition, "operator function must return a value")
+	}
+
+	mutSc.AddOperator(descpb.SchemaDescriptor_Operator{
+		Name:       name,
+		LeftType:   left,
+		RightType:  right,
+		FunctionID: fnDesc.GetID(),
+	})
+	return p.writeSchemaDescChange(params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()))
+}
+
+func (n *createOperatorNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *createOperatorNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *createOperatorNode) Close(ctx context.Context)           {}
+func (n *createOperatorNode) ReadingOwnWrites()                   {}
+
+type dropOperatorNode struct {
+	zeroInputPlanNode
+	n *tree.DropOperator
+}
+
+// DropOperator drops user-defined operators.
+func (p *planner) DropOperator(ctx context.Context, n *tree.DropOperator) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"DROP OPERATOR",
+	); err != nil {
+		return nil, err
+	}
+	return &dropOperatorNode{n: n}, nil
+}
+
+func (n *dropOperatorNode) startExec(params runParams) error {
+	p := params.p
+	for i := range n.n.Operators {
+		toDrop := &n.n.Operators[i]
+		name := toDrop.Operator.Symbol.String()
+		left, right, err := p.resolveCastTypes(params.ctx, toDrop.LeftType, toDrop.RightType)
+		if err != nil {
+			return err
+		}
+		un, err := tree.NewUnresolvedObjectName(1, [3]string{name}, tree.NoAnnotation)
+		if err != nil {
+			return err
+		}
+		db, sc, err := p.lookupObjectInSchema(params.ctx, un, false /* withLeased */, func(
+			sc catalog.SchemaDescriptor,
+		) bool {
+			return sc.ForEachOperator(func(op descpb.SchemaDescriptor_Operator) error {
+				if op.Name == name && op.LeftType.Oid() == left.Oid() && op.RightType.Oid() == right.Oid() {
+					return iterutil.StopIteration()
+				}
+				return nil
+			}) != nil
+		})
+		if err != nil {
+			return err
+		}
+		if sc == nil {
+			if !n.n.IfExists {
+				return pgerror.Newf(pgcode.UndefinedFunction, "operator does not exist: %s",
+					formatOperator(name, left, right))
+			}
+			p.BufferClientNotice(params.ctx, pgnotice.Newf("operator %s does not exist, skipping",
+				formatOperator(name, left, right)))
+			continue
+		}
+		mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "operators")
+		if err != nil {
+			return err
+		}
+		mutSc.RemoveOperator(name, left.Oid(), right.Oid())
+		if err := p.writeSchemaDescChange(
+			params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *dropOperatorNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *dropOperatorNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *dropOperatorNode) Close(ctx context.Context)           {}
+func (n *dropOperatorNode) ReadingOwnWrites()                   {}
+
+// formatOperator formats a binary operator and its operand types the way
+// Postgres does in error messages, e.g. "mytype + mytype".
+func formatOperator(name string, left, right *types.T) string {
+	return left.SQLString() + " " + name + " " + right.SQLString()
+}
+
+// resolveCastTypes resolves the pair of types of a cast or of the operands of
+// an operator.
+func (p *planner) resolveCastTypes(
+	ctx context.Context, first, second tree.ResolvableTypeReference,
+) (*types.T, *types.T, error) {
+	firstTyp, err := tree.ResolveType(ctx, first, p.semaCtx.GetTypeResolver())
+	if err != nil {
+		return nil, nil, err
+	}
+	secondTyp, err := tree.ResolveType(ctx, second, p.semaCtx.GetTypeResolver())
+	if err != nil {
+		return nil, nil, err
+	}
+	return firstTyp, secondTyp, nil
+}
+
+// checkOwnsEitherType checks that the current user owns at least one of the
+// types of a cast or of the operands of an operator. Built-in types are owned
+// by admins.
+func (p *planner) checkOwnsEitherType(ctx context.Context, first, second *types.T) error {
+	for _, typ := range []*types.T{first, second} {
+		var owns bool
+		var err error
+		if typ.UserDefined() {
+			var desc catalog.TypeDescriptor
+			desc, err = p.Descriptors().ByIDWithoutLeased(p.txn).Get().Type(
+				ctx, typedesc.UserDefinedTypeOIDToID(typ.Oid()),
+			)
+			if err != nil {
+				return err
+			}
+			owns, err = p.HasOwnership(ctx, desc)
+		} else {
+			owns, err = p.HasAdminRole(ctx)
+		}
+		if err != nil || owns {
+			return err
+		}
+	}
+	return pgerror.Newf(pgcode.InsufficientPrivilege,
+		"must be owner of type %s or type %s", first.SQLString(), second.SQLString())
+}
+
+// resolveCastOrOperatorFunction resolves the user-defined function of a cast
+// or an operator and checks that the current user may execute it.
+func (p *planner) resolveCastOrOperatorFunction(
+	ctx context.Context, fn *tree.RoutineObj,
+) (catalog.FunctionDescriptor, *tree.QualifiedOverload, error) {
+	ol, err := p.matchRoutine(ctx, fn, true /* required */, tree.UDFRoutine, false /* inDropContext */)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ol.Type != tree.UDFRoutine {
+		return nil, nil, pgerror.Newf(pgcode.WrongObjectType, "%s is not a function", fn.FuncName.String())
+	}
+	if ol.Class == tree.GeneratorClass {
+		return nil, nil, pgerror.Newf(pgcode.InvalidObjectDefinition,
+			"function %s must not return a set", fn.FuncName.String())
+	}
+	fnDesc, err := p.Descriptors().ByIDWithoutLeased(p.txn).Get().Function(
+		ctx, funcdesc.UserDefinedFunctionOIDToID(ol.Oid),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := p.CheckPrivilege(ctx, fnDesc, privilege.EXECUTE); err != nil {
+		return nil, nil, err
+	}
+	return fnDesc, ol, nil
+}
+
+// schemaOfFunction returns the database and schema that contain the given
+// function.
+func (p *planner) schemaOfFunction(
+	ctx context.Context, fnDesc catalog.FunctionDescriptor,
+) (catalog.DatabaseDescriptor, catalog.SchemaDescriptor, error) {
+	g := p.Descriptors().ByIDWithoutLeased(p.txn).Get()
+	db, err := g.Database(ctx, fnDesc.GetParentID())
+	if err != nil {
+		return nil, nil, err
+	}
+	sc, err := g.Schema(ctx, fnDesc.GetParentSchemaID())
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, sc, nil
+}
+
+// functionUsedByCastOrOperator returns an error if a user-defined cast or
+// operator in the database of the given function calls it.
+func (p *planner) functionUsedByCastOrOperator(
+	ctx context.Context, fnDesc catalog.FunctionDescriptor,
+) error {
+	g := p.Descriptors().ByIDWithoutLeased(p.txn).Get()
+	db, err := g.Database(ctx, fnDesc.GetParentID())
+	if err != nil {
+		return err
+	}
+	dependent, err := catalog.FindCastOrOperatorUsingFunction(db, fnDesc.GetID(), func(
+		id descpb.ID,
+	) (catalog.SchemaDescriptor, error) {
+		return g.Schema(ctx, id)
+	}, func(t *types.T) string {
+		if t.UserDefined() {
+			if typ, err := p.ResolveTypeByOID(ctx, t.Oid()); err == nil {
+				return typ.SQLString()
+			}
+		}
+		return t.SQLString()
+	})
+	if err != nil || dependent == "" {
+		return err
+	}
+	return pgerror.Newf(pgcode.DependentObjectsStillExist,
+		"cannot drop function %q because other objects ([%s]) still depend on it",
+		fnDesc.GetName(), dependent)
+}
@@ -478,6 +478,12 @@ func addSystemDescriptorsToSchema(target *MetadataSchema) {
 
 	// Tables introduced in 26.2
 	target.AddDescriptor(systemschema.ClusterMetricsTable)
+	target.AddDescriptor(systemschema.LargeObjectsTable)
+	target.AddDescriptor(systemschema.LargeObjectPagesTable)
+	target.AddDescriptor(systemschema.UserLoginFailuresTable)
+	target.AddDescriptor(systemschema.PasswordHistoryTable)
+	target.AddDescriptor(systemschema.AuditLogCheckpointsTable)
+	target.AddDescriptor(systemschema.LargeObjectOIDSequence)
 
 	// Adding a new system table? It should be added here to the metadata schema,
 	// and also created as a migration for older clusters.
@@ -491,7 +497,7 @@ func addSystemDescriptorsToSchema(target *MetadataSchema) {
 // NumSystemTablesForSystemTenant is the number of system tables defined on
 // the system tenant. This constant is only defined to avoid having to manually
 // update auto stats tests every time a new system table is added.
-const NumSystemTablesForSystemTenant = 68
+const NumSystemTablesForSystemTenant = 74
 
 // addSplitIDs adds a split point for each of the PseudoTableIDs to the supplied
 // MetadataSchema.
@@ -131,9 +131,13 @@ func indexForDisplay(
 			f.WriteString(" gin")
 		case idxtype.VECTOR:
 			f.WriteString(" cspann")
+		case idxtype.BRIN:
+			f.WriteString(" brin")
 		default:
 			f.WriteString(" btree")
 		}
+	} else if index.Type == idxtype.BRIN {
+		f.WriteString(" USING brin")
 	}
 
 	f.WriteString(" (")
@@ -85,10 +85,16 @@ var (
 		catconstants.InspectErrorsTableName,
 		catconstants.TableStatisticsLocksTableName,
 		catconstants.ClusterMetricsTableName,
+		catconstants.LargeObjectsTableName,
+		catconstants.LargeObjectPagesTableName,
+		catconstants.UserLoginFailuresTableName,
+		catconstants.PasswordHistoryTableName,
+		catconstants.AuditLogCheckpointsTableName,
 	}
 
 	readWriteSystemSequences = []catconstants.SystemTableName{
 		catconstants.RoleIDSequenceName,
+		catconstants.LargeObjectOIDSequenceName,
 	}
 
 	systemSuperuserPrivileges = func() map[descpb.NameInfo]privilege.List {
@@ -135,6 +135,14 @@ func ValidateColumnDefType(ctx context.Context, st *cluster.Settings, t *types.T
 			)
 		}
 
+	case types.XMLFamily:
+		if !st.Version.IsActive(ctx, clusterversion.V26_2_XMLType) {
+			return pgerror.Newf(
+				pgcode.FeatureNotSupported,
+				"xml not supported until version 26.2",
+			)
+		}
+
 	case types.TupleFamily:
 		if !t.UserDefined() {
 			return pgerror.New(pgcode.InvalidTableDefinition, "cannot use anonymous record type as table column")
@@ -188,7 +196,7 @@ func ColumnTypeIsInvertedIndexable(t *types.T) bool {
 	switch t.Family() {
 	case types.ArrayFamily:
 		switch t.ArrayContents().Family() {
-		case types.RefCursorFamily, types.JsonpathFamily:
+		case types.RefCursorFamily, types.JsonpathFamily, types.XMLFamily:
 			return false
 		default:
 			return true
@@ -239,7 +247,7 @@ func MustBeValueEncoded(semanticType *types.T) bool {
 		return true
 	case types.TSVectorFamily, types.TSQueryFamily:
 		return true
-	case types.PGVectorFamily:
+	case types.PGVectorFamily, types.XMLFamily:
 		return true
 		// NB: if you're adding a new type here, you probably also want to
 		// include it into rowenc.mustUseValueEncodingForFingerprinting.
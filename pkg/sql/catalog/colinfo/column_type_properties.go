@@ -84,7 +84,8 @@ func CanHaveCompositeKeyEncoding(typ *types.T) bool {
 		types.TSQueryFamily,
 		types.TSVectorFamily,
 		types.JsonpathFamily,
-		types.LTreeFamily:
+		types.LTreeFamily,
+		types.XMLFamily:
 		return false
 	case types.UnknownFamily,
 		types.AnyFamily:
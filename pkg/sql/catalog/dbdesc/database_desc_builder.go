@@ -405,6 +405,16 @@ func WithPublicSchemaID(publicSchemaID descpb.ID) NewInitialOption {
 	}
 }
 
+// WithDefaultCollation is used to create a DatabaseDescriptor whose string
+// columns are collated with the given locale by default.
+func WithDefaultCollation(locale string) NewInitialOption {
+	return func(desc *descpb.DatabaseDescriptor) {
+		if locale != "" {
+			desc.DefaultCollation = &locale
+		}
+	}
+}
+
 // NewInitial constructs a new Mutable for an initial version from an id and
 // name with default privileges.
 func NewInitial(
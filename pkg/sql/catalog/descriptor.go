@@ -335,6 +335,10 @@ type DatabaseDescriptor interface {
 	// HasPublicSchemaWithDescriptor returns true iff the database has a public
 	// schema which itself has a descriptor.
 	HasPublicSchemaWithDescriptor() bool
+	// GetDefaultCollation returns the locale of the collation given to string
+	// columns created in this database without a COLLATE clause, or the empty
+	// string if the database uses the default collation.
+	GetDefaultCollation() string
 }
 
 // TableDescriptor is an interface around the table descriptor types.
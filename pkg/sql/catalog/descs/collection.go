@@ -1545,6 +1545,12 @@ func (tc *Collection) AddSyntheticDescriptor(desc catalog.Descriptor) {
 	tc.synthetic.add(desc)
 }
 
+// RemoveSyntheticDescriptor removes the synthetic descriptor with the given ID
+// from the Collection, if there is one.
+func (tc *Collection) RemoveSyntheticDescriptor(id descpb.ID) {
+	tc.synthetic.remove(id)
+}
+
 func (tc *Collection) codec() keys.SQLCodec {
 	return tc.cr.Codec()
 }
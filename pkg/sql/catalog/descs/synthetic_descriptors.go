@@ -22,6 +22,10 @@ func (sd *syntheticDescriptors) add(desc catalog.Descriptor) {
 	sd.descs.Upsert(desc, desc.SkipNamespace())
 }
 
+func (sd *syntheticDescriptors) remove(id descpb.ID) {
+	sd.descs.Remove(id)
+}
+
 func (sd *syntheticDescriptors) reset() {
 	sd.descs.Clear()
 }
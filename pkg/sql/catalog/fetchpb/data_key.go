@@ -0,0 +1,77 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package fetchpb
+
+import (
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/redact"
+)
+
+// DataKey is an unwrapped data key of an encrypted column. Fetch specs are
+// sent to remote nodes and may be logged, traced or included in statement
+// diagnostics bundles, so the key is only available through Bytes and the
+// binary encoding of the spec. Every text representation of a DataKey is
+// redacted.
+type DataKey struct {
+	key []byte
+}
+
+const redactedDataKey = "<redacted>"
+
+// MakeDataKey returns a DataKey holding the given key.
+func MakeDataKey(key []byte) DataKey {
+	return DataKey{key: key}
+}
+
+// Bytes returns the key.
+func (k DataKey) Bytes() []byte {
+	return k.key
+}
+
+// Size implements the gogoproto custom type interface.
+func (k DataKey) Size() int {
+	return len(k.key)
+}
+
+// MarshalTo implements the gogoproto custom type interface.
+func (k DataKey) MarshalTo(data []byte) (int, error) {
+	return copy(data, k.key), nil
+}
+
+// Unmarshal implements the gogoproto custom type interface.
+func (k *DataKey) Unmarshal(data []byte) error {
+	k.key = append([]byte(nil), data...)
+	return nil
+}
+
+// String implements fmt.Stringer.
+func (k DataKey) String() string {
+	return redactedDataKey
+}
+
+// SafeFormat implements redact.SafeFormatter.
+func (k DataKey) SafeFormat(w redact.SafePrinter, _ rune) {
+	w.SafeString(redactedDataKey)
+}
+
+// MarshalText implements encoding.TextMarshaler. It is used by the text
+// format of the spec.
+func (k DataKey) MarshalText() ([]byte, error) {
+	return []byte(redactedDataKey), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (k DataKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactedDataKey)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Data keys are redacted in their
+// JSON representation, so they cannot be decoded from it.
+func (k *DataKey) UnmarshalJSON([]byte) error {
+	return errors.New("cannot decode a redacted data key")
+}
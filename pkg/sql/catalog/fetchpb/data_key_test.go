@@ -0,0 +1,42 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package fetchpb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
+	"github.com/cockroachdb/redact"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataKeyRedacted(t *testing.T) {
+	const secret = "supersecretkey"
+	spec := IndexFetchSpec{
+		EncryptionKeys: []IndexFetchSpec_EncryptionKey{
+			{ColumnID: 2, Version: 1, Key: MakeDataKey([]byte(secret))},
+		},
+	}
+
+	// The key does not appear in any text representation of the spec.
+	for _, s := range []string{
+		spec.String(),
+		fmt.Sprintf("%v", spec),
+		fmt.Sprintf("%+v", spec),
+		fmt.Sprintf("%x", spec.EncryptionKeys[0].Key),
+		redact.Sprint(spec.EncryptionKeys[0].Key).StripMarkers(),
+	} {
+		require.NotContains(t, s, secret)
+	}
+
+	// The binary encoding preserves the key.
+	data, err := protoutil.Marshal(&spec)
+	require.NoError(t, err)
+	var decoded IndexFetchSpec
+	require.NoError(t, protoutil.Unmarshal(data, &decoded))
+	require.Equal(t, []byte(secret), decoded.EncryptionKeys[0].Key.Bytes())
+}
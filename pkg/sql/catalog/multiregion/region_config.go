@@ -168,6 +168,22 @@ func (r *RegionConfig) WithPlacementDefault() RegionConfig {
 	return cpy
 }
 
+// WithPlacement returns a copy of the RegionConfig with the given data
+// placement strategy.
+func (r *RegionConfig) WithPlacement(placement descpb.DataPlacement) RegionConfig {
+	cpy := *r
+	cpy.placement = placement
+	return cpy
+}
+
+// WithSurvivalGoal returns a copy of the RegionConfig with the given survival
+// goal.
+func (r *RegionConfig) WithSurvivalGoal(survivalGoal descpb.SurvivalGoal) RegionConfig {
+	cpy := *r
+	cpy.survivalGoal = survivalGoal
+	return cpy
+}
+
 // SuperRegions returns the list of super regions in the database.
 func (r *RegionConfig) SuperRegions() []descpb.SuperRegion {
 	return r.superRegions
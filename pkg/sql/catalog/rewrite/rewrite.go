@@ -329,6 +329,9 @@ func TableDescs(
 				// just rewrite ids.
 				col.UsesFunctionIds[i] = descriptorRewrites[fnID].ID
 			}
+			if mp := col.MaskingPolicy; mp != nil {
+				mp.FunctionID = descriptorRewrites[mp.FunctionID].ID
+			}
 
 			return nil
 		}
@@ -350,14 +353,17 @@ func TableDescs(
 		for idx := range table.Triggers {
 			trigger := &table.Triggers[idx]
 
-			// Rewrite trigger function reference.
-			if triggerFnRewrite, ok := descriptorRewrites[trigger.FuncID]; ok {
-				trigger.FuncID = triggerFnRewrite.ID
-			} else {
-				return nil, errors.AssertionFailedf(
-					"cannot restore trigger %s on table %q because referenced function %d was not found",
-					trigger.Name, table.Name, trigger.FuncID,
-				)
+			// Rewrite trigger function reference. Builtin trigger functions are
+			// not referenced by ID.
+			if trigger.BuiltinFuncName == "" {
+				if triggerFnRewrite, ok := descriptorRewrites[trigger.FuncID]; ok {
+					trigger.FuncID = triggerFnRewrite.ID
+				} else {
+					return nil, errors.AssertionFailedf(
+						"cannot restore trigger %s on table %q because referenced function %d was not found",
+						trigger.Name, table.Name, trigger.FuncID,
+					)
+				}
 			}
 
 			// Rewrite forward-references.
@@ -1250,6 +1256,14 @@ func dropColumnExpressionsMissingDeps(
 			}
 		}
 
+		// Masking policies are never dropped: restoring the column without its
+		// policy would expose the values it masks.
+		if mp := col.MaskingPolicy; mp != nil {
+			if _, ok := descriptorRewrites[mp.FunctionID]; !ok {
+				return errors.Errorf("column %q cannot be restored when the UDF of its masking policy is missing (even with skip_missing_udfs option)", col.Name)
+			}
+		}
+
 		// Rebuild UsesFunctionIds based on remaining expressions.
 		allFnIDs, err := table.GetAllReferencedFunctionIDsInColumnExprs(col.ID)
 		if err != nil {
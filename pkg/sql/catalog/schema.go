@@ -7,9 +7,12 @@ package catalog
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/iterutil"
 )
 
 // SchemaDescriptor encapsulates the basic
@@ -42,6 +45,30 @@ type SchemaDescriptor interface {
 	// ForEachFunctionSignature iterates through all function signatures within
 	// the schema and calls fn on each signature.
 	ForEachFunctionSignature(fn func(sig descpb.SchemaDescriptor_FunctionSignature) error) error
+
+	// GetTextSearchConfig returns the text search configuration with the given
+	// name that was created in the schema.
+	GetTextSearchConfig(name string) (descpb.SchemaDescriptor_TextSearchConfig, bool)
+
+	// GetTextSearchDictionary returns the text search dictionary with the given
+	// name that was created in the schema.
+	GetTextSearchDictionary(name string) (descpb.SchemaDescriptor_TextSearchDictionary, bool)
+
+	// GetCollation returns the collation with the given name that was created
+	// in the schema.
+	GetCollation(name string) (descpb.SchemaDescriptor_Collation, bool)
+
+	// ForEachCollation iterates through the collations created in the schema
+	// in order of their names and calls fn on each collation.
+	ForEachCollation(fn func(coll descpb.SchemaDescriptor_Collation) error) error
+
+	// ForEachCast iterates through the user-defined casts whose functions are
+	// in the schema and calls fn on each cast.
+	ForEachCast(fn func(cast descpb.SchemaDescriptor_Cast) error) error
+
+	// ForEachOperator iterates through the user-defined operators created in
+	// the schema and calls fn on each operator.
+	ForEachOperator(fn func(op descpb.SchemaDescriptor_Operator) error) error
 }
 
 // ResolvedSchemaKind is an enum that represents what kind of schema
@@ -73,3 +100,52 @@ func (kind ResolvedSchemaKind) String() string {
 		panic("unknown kind")
 	}
 }
+
+// FindCastOrOperatorUsingFunction returns a description of a user-defined
+// cast or operator in the database that is evaluated by calling the function
+// with the given ID, or the empty string if there is none. The schemas of the
+// database are retrieved with getSchema, and the types of the casts and
+// operators, which are not hydrated, are named with typeName.
+func FindCastOrOperatorUsingFunction(
+	db DatabaseDescriptor,
+	fnID descpb.ID,
+	getSchema func(id descpb.ID) (SchemaDescriptor, error),
+	typeName func(t *types.T) string,
+) (string, error) {
+	var ret string
+	if err := db.ForEachSchema(func(id descpb.ID, _ string) error {
+		sc, err := getSchema(id)
+		if err != nil {
+			return err
+		}
+		if err := sc.ForEachCast(func(cast descpb.SchemaDescriptor_Cast) error {
+			if cast.FunctionID == fnID {
+				ret = fmt.Sprintf("cast from %s to %s", typeName(cast.SourceType), typeName(cast.TargetType))
+				return iterutil.StopIteration()
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if ret != "" {
+			return iterutil.StopIteration()
+		}
+		if err := sc.ForEachOperator(func(op descpb.SchemaDescriptor_Operator) error {
+			if op.FunctionID == fnID {
+				ret = fmt.Sprintf("operator %s.%s(%s, %s)", sc.GetName(), op.Name,
+					typeName(op.LeftType), typeName(op.RightType))
+				return iterutil.StopIteration()
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if ret != "" {
+			return iterutil.StopIteration()
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	return ret, nil
+}
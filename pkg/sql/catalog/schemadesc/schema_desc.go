@@ -8,6 +8,7 @@ package schemadesc
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/pkg/keys"
@@ -29,6 +30,8 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
+	"github.com/lib/pq/oid"
+	"golang.org/x/text/language"
 )
 
 var _ catalog.SchemaDescriptor = (*immutable)(nil)
@@ -65,6 +68,65 @@ func (desc *immutable) GetFunction(name string) (descpb.SchemaDescriptor_Functio
 	return fn, found
 }
 
+// GetTextSearchConfig implements the SchemaDescriptor interface.
+func (desc *immutable) GetTextSearchConfig(
+	name string,
+) (descpb.SchemaDescriptor_TextSearchConfig, bool) {
+	config, found := desc.TextSearchConfigs[name]
+	return config, found
+}
+
+// GetTextSearchDictionary implements the SchemaDescriptor interface.
+func (desc *immutable) GetTextSearchDictionary(
+	name string,
+) (descpb.SchemaDescriptor_TextSearchDictionary, bool) {
+	dict, found := desc.TextSearchDictionaries[name]
+	return dict, found
+}
+
+// GetCollation implements the SchemaDescriptor interface.
+func (desc *immutable) GetCollation(name string) (descpb.SchemaDescriptor_Collation, bool) {
+	coll, found := desc.Collations[name]
+	return coll, found
+}
+
+// ForEachCollation implements the SchemaDescriptor interface.
+func (desc *immutable) ForEachCollation(
+	fn func(coll descpb.SchemaDescriptor_Collation) error,
+) error {
+	names := make([]string, 0, len(desc.Collations))
+	for name := range desc.Collations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := fn(desc.Collations[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachCast implements the SchemaDescriptor interface.
+func (desc *immutable) ForEachCast(fn func(cast descpb.SchemaDescriptor_Cast) error) error {
+	for _, cast := range desc.Casts {
+		if err := fn(cast); err != nil {
+			return iterutil.Map(err)
+		}
+	}
+	return nil
+}
+
+// ForEachOperator implements the SchemaDescriptor interface.
+func (desc *immutable) ForEachOperator(fn func(op descpb.SchemaDescriptor_Operator) error) error {
+	for _, op := range desc.Operators {
+		if err := fn(op); err != nil {
+			return iterutil.Map(err)
+		}
+	}
+	return nil
+}
+
 // SkipNamespace implements the descriptor interface.
 func (desc *immutable) SkipNamespace() bool {
 	return false
@@ -266,6 +328,79 @@ func (desc *immutable) ValidateSelf(vea catalog.ValidationErrorAccumulator) {
 			}
 		}
 	}
+
+	for name, dict := range desc.TextSearchDictionaries {
+		if name != dict.Name {
+			vea.Report(errors.AssertionFailedf("text search dictionary %q stored under name %q",
+				dict.Name, name))
+		}
+	}
+	for name, config := range desc.TextSearchConfigs {
+		if name != config.Name {
+			vea.Report(errors.AssertionFailedf("text search configuration %q stored under name %q",
+				config.Name, name))
+		}
+		for _, m := range config.Mappings {
+			for _, dict := range m.Dictionaries {
+				if strings.HasPrefix(dict, catconstants.PgCatalogName+".") {
+					continue
+				}
+				if _, ok := desc.TextSearchDictionaries[dict]; !ok {
+					vea.Report(errors.AssertionFailedf(
+						"text search configuration %q maps token type %q to unknown dictionary %q",
+						config.Name, m.TokenType, dict))
+				}
+			}
+		}
+	}
+	for name, coll := range desc.Collations {
+		if name != coll.Name {
+			vea.Report(errors.AssertionFailedf("collation %q stored under name %q",
+				coll.Name, name))
+		}
+		if _, err := language.Parse(coll.Locale); err != nil {
+			vea.Report(errors.Wrapf(err, "collation %q has invalid locale %q", coll.Name, coll.Locale))
+		}
+	}
+
+	type castKey struct{ source, target oid.Oid }
+	casts := make(map[castKey]struct{}, len(desc.Casts))
+	for _, cast := range desc.Casts {
+		if cast.SourceType == nil || cast.TargetType == nil {
+			vea.Report(errors.AssertionFailedf("cast with function %d is missing a type", cast.FunctionID))
+			continue
+		}
+		key := castKey{source: cast.SourceType.Oid(), target: cast.TargetType.Oid()}
+		if _, ok := casts[key]; ok {
+			vea.Report(errors.AssertionFailedf("duplicate cast from %s to %s",
+				cast.SourceType.SQLString(), cast.TargetType.SQLString()))
+		}
+		casts[key] = struct{}{}
+		if cast.FunctionID == descpb.InvalidID {
+			vea.Report(errors.AssertionFailedf("cast from %s to %s has invalid function ID %d",
+				cast.SourceType.SQLString(), cast.TargetType.SQLString(), cast.FunctionID))
+		}
+	}
+	type operatorKey struct {
+		name        string
+		left, right oid.Oid
+	}
+	operators := make(map[operatorKey]struct{}, len(desc.Operators))
+	for _, op := range desc.Operators {
+		if op.LeftType == nil || op.RightType == nil {
+			vea.Report(errors.AssertionFailedf("operator %s is missing an operand type", op.Name))
+			continue
+		}
+		if op.FunctionID == descpb.InvalidID {
+			vea.Report(errors.AssertionFailedf("operator %s has invalid function ID %d", op.Name, op.FunctionID))
+		}
+		key := operatorKey{name: op.Name, left: op.LeftType.Oid(), right: op.RightType.Oid()}
+		if _, ok := operators[key]; ok {
+			vea.Report(errors.AssertionFailedf("duplicate operator %s(%s, %s)",
+				op.Name, op.LeftType.SQLString(), op.RightType.SQLString()))
+		}
+		operators[key] = struct{}{}
+	}
 }
 
 // GetReferencedDescIDs returns the IDs of all descriptors referenced by
@@ -563,6 +698,83 @@ func (desc *Mutable) ReplaceOverload(
 	return errors.AssertionFailedf("unexpectedly didn't find overload match for function %s with types %v", name, existing.Types.Types())
 }
 
+// SetTextSearchDictionary adds or replaces a text search dictionary in the
+// schema descriptor.
+func (desc *Mutable) SetTextSearchDictionary(dict descpb.SchemaDescriptor_TextSearchDictionary) {
+	if desc.TextSearchDictionaries == nil {
+		desc.TextSearchDictionaries = make(map[string]descpb.SchemaDescriptor_TextSearchDictionary)
+	}
+	desc.TextSearchDictionaries[dict.Name] = dict
+}
+
+// RemoveTextSearchDictionary removes a text search dictionary from the schema
+// descriptor.
+func (desc *Mutable) RemoveTextSearchDictionary(name string) {
+	delete(desc.TextSearchDictionaries, name)
+}
+
+// SetTextSearchConfig adds or replaces a text search configuration in the
+// schema descriptor.
+func (desc *Mutable) SetTextSearchConfig(config descpb.SchemaDescriptor_TextSearchConfig) {
+	if desc.TextSearchConfigs == nil {
+		desc.TextSearchConfigs = make(map[string]descpb.SchemaDescriptor_TextSearchConfig)
+	}
+	desc.TextSearchConfigs[config.Name] = config
+}
+
+// RemoveTextSearchConfig removes a text search configuration from the schema
+// descriptor.
+func (desc *Mutable) RemoveTextSearchConfig(name string) {
+	delete(desc.TextSearchConfigs, name)
+}
+
+// SetCollation adds or replaces a collation in the schema descriptor.
+func (desc *Mutable) SetCollation(coll descpb.SchemaDescriptor_Collation) {
+	if desc.Collations == nil {
+		desc.Collations = make(map[string]descpb.SchemaDescriptor_Collation)
+	}
+	desc.Collations[coll.Name] = coll
+}
+
+// RemoveCollation removes a collation from the schema descriptor.
+func (desc *Mutable) RemoveCollation(name string) {
+	delete(desc.Collations, name)
+}
+
+// AddCast adds a cast to the schema descriptor.
+func (desc *Mutable) AddCast(cast descpb.SchemaDescriptor_Cast) {
+	desc.Casts = append(desc.Casts, cast)
+}
+
+// RemoveCast removes the cast between the given types from the schema
+// descriptor. It returns false if there is no such cast.
+func (desc *Mutable) RemoveCast(source, target oid.Oid) bool {
+	for i, cast := range desc.Casts {
+		if cast.SourceType.Oid() == source && cast.TargetType.Oid() == target {
+			desc.Casts = append(desc.Casts[:i], desc.Casts[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// AddOperator adds an operator to the schema descriptor.
+func (desc *Mutable) AddOperator(op descpb.SchemaDescriptor_Operator) {
+	desc.Operators = append(desc.Operators, op)
+}
+
+// RemoveOperator removes the operator with the given name and operand types
+// from the schema descriptor. It returns false if there is no such operator.
+func (desc *Mutable) RemoveOperator(name string, left, right oid.Oid) bool {
+	for i, op := range desc.Operators {
+		if op.Name == name && op.LeftType.Oid() == left && op.RightType.Oid() == right {
+			desc.Operators = append(desc.Operators[:i], desc.Operators[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // GetObjectType implements the Object interface.
 func (desc *immutable) GetObjectType() privilege.ObjectType {
 	return privilege.Schema
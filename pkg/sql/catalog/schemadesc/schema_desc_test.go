@@ -19,6 +19,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/nstree"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemadesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/redact"
 	"github.com/stretchr/testify/require"
@@ -113,6 +114,44 @@ func TestValidateSchemaSelf(t *testing.T) {
 				},
 			},
 		},
+		{ // 5
+			err: `text search configuration "cfg" maps token type "asciiword" to unknown dictionary "syn"`,
+			desc: descpb.SchemaDescriptor{
+				ID:         52,
+				ParentID:   51,
+				Name:       "schema1",
+				Privileges: defaultPrivilege,
+				TextSearchConfigs: map[string]descpb.SchemaDescriptor_TextSearchConfig{
+					"cfg": {Name: "cfg", Mappings: []descpb.SchemaDescriptor_TextSearchConfigMapping{
+						{TokenType: "asciiword", Dictionaries: []string{"syn", "pg_catalog.english_stem"}},
+					}},
+				},
+			},
+		},
+		{ // 6
+			err: `collation "ci" has invalid locale "not a locale": language: tag is not well-formed`,
+			desc: descpb.SchemaDescriptor{
+				ID:         52,
+				ParentID:   51,
+				Name:       "schema1",
+				Privileges: defaultPrivilege,
+				Collations: map[string]descpb.SchemaDescriptor_Collation{
+					"ci": {Name: "ci", Locale: "not a locale"},
+				},
+			},
+		},
+		{ // 7
+			err: `cast from INT8 to STRING has invalid function ID 0`,
+			desc: descpb.SchemaDescriptor{
+				ID:         52,
+				ParentID:   51,
+				Name:       "schema1",
+				Privileges: defaultPrivilege,
+				Casts: []descpb.SchemaDescriptor_Cast{
+					{SourceType: types.Int, TargetType: types.String},
+				},
+			},
+		},
 	}
 
 	for i, test := range tests {
@@ -144,6 +144,40 @@ func (p synthetic) ForEachFunctionSignature(
 	return nil
 }
 
+// GetTextSearchConfig implements the SchemaDescriptor interface.
+func (p synthetic) GetTextSearchConfig(
+	name string,
+) (descpb.SchemaDescriptor_TextSearchConfig, bool) {
+	return descpb.SchemaDescriptor_TextSearchConfig{}, false
+}
+
+// GetTextSearchDictionary implements the SchemaDescriptor interface.
+func (p synthetic) GetTextSearchDictionary(
+	name string,
+) (descpb.SchemaDescriptor_TextSearchDictionary, bool) {
+	return descpb.SchemaDescriptor_TextSearchDictionary{}, false
+}
+
+// GetCollation implements the SchemaDescriptor interface.
+func (p synthetic) GetCollation(name string) (descpb.SchemaDescriptor_Collation, bool) {
+	return descpb.SchemaDescriptor_Collation{}, false
+}
+
+// ForEachCollation implements the SchemaDescriptor interface.
+func (p synthetic) ForEachCollation(fn func(coll descpb.SchemaDescriptor_Collation) error) error {
+	return nil
+}
+
+// ForEachCast implements the SchemaDescriptor interface.
+func (p synthetic) ForEachCast(fn func(cast descpb.SchemaDescriptor_Cast) error) error {
+	return nil
+}
+
+// ForEachOperator implements the SchemaDescriptor interface.
+func (p synthetic) ForEachOperator(fn func(op descpb.SchemaDescriptor_Operator) error) error {
+	return nil
+}
+
 // ForEachUDTDependentForHydration implements the catalog.Descriptor interface.
 func (p synthetic) ForEachUDTDependentForHydration(fn func(t *types.T) error) error {
 	return nil
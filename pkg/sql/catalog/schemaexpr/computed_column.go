@@ -217,8 +217,9 @@ func ValidateComputedColumnExpressionWithLookup(
 	// region column, we need to check that the expression does not reference
 	// the region column. This is because the values of every (possibly computed)
 	// foreign-key column must be known in order to determine the value for the
-	// region column.
-	if desc.GetRegionalByRowUsingConstraint() != descpb.ConstraintID(0) {
+	// region column. desc is nil for tables which are still being created, and
+	// those cannot be REGIONAL BY ROW yet.
+	if desc != nil && desc.GetRegionalByRowUsingConstraint() != descpb.ConstraintID(0) {
 		regionColName, err := desc.GetRegionalByRowTableRegionColumnName()
 		if err != nil {
 			return "", nil, err
@@ -8,8 +8,12 @@ package schemaexpr
 import (
 	"context"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/parserutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc/valueside"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/transform"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
@@ -107,3 +111,56 @@ func ProcessColumnSet(
 	}
 	return ret
 }
+
+// addColumnWithoutBackfill controls whether columns which qualify for a missing
+// value are added to existing tables without backfilling their rows.
+var addColumnWithoutBackfill = settings.RegisterBoolSetting(
+	settings.ApplicationLevel,
+	"sql.schema.add_column_without_backfill.enabled",
+	"if true, NOT NULL columns with a constant default are added to existing tables "+
+		"without rewriting their rows",
+	false,
+)
+
+// MakeMissingValue returns the value-encoded missing value with which the
+// column can be added to an existing table without backfilling its rows, or
+// nil if the column needs a backfill. defaultExpr is the type-checked DEFAULT
+// expression of the column.
+//
+// Only NOT NULL columns with a constant default qualify: since such a column
+// cannot hold NULLs, a row which does not store a value for it unambiguously
+// stands for the missing value. See descpb.ColumnDescriptor.MissingValue.
+func MakeMissingValue(
+	ctx context.Context,
+	evalCtx *eval.Context,
+	col *descpb.ColumnDescriptor,
+	defaultExpr tree.TypedExpr,
+	activeVersion clusterversion.ClusterVersion,
+) []byte {
+	if !activeVersion.IsActive(clusterversion.V26_2_AddColumnMissingValue) ||
+		!addColumnWithoutBackfill.Get(&evalCtx.Settings.SV) ||
+		col.Nullable || col.IsComputed() || col.Encryption != nil ||
+		defaultExpr == nil || !eval.IsConst(evalCtx, defaultExpr) {
+		return nil
+	}
+	// User-defined functions cannot be evaluated outside of a query.
+	var v tree.UDFDisallowanceVisitor
+	tree.WalkExprConst(&v, defaultExpr)
+	if v.FoundUDF {
+		return nil
+	}
+	// Errors are left for the backfill to report, since they do not matter if
+	// the table is empty.
+	d, err := eval.Expr(ctx, evalCtx, defaultExpr)
+	if err != nil {
+		return nil
+	}
+	if d, err = eval.PerformAssignmentCast(ctx, evalCtx, d, col.Type); err != nil || d == tree.DNull {
+		return nil
+	}
+	missingValue, err := valueside.Encode(nil /* appendTo */, valueside.NoColumnID, d)
+	if err != nil {
+		return nil
+	}
+	return missingValue
+}
@@ -13,6 +13,19 @@ import "github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 //
 //	mod(fnv32(md5(crdb_internal.datums_to_bytes(...))),buckets)
 func MakeHashShardComputeExpr(colNames []string, buckets int) *string {
+	res := tree.Serialize(makeHashComputeExpr(colNames, buckets))
+	return &res
+}
+
+// MakeHashPartitionComputeExpr creates the computed expression for the hidden
+// partition column of a PARTITION BY HASH clause. It is the same expression as
+// that of a hash shard column, with the number of partitions as the number of
+// buckets.
+func MakeHashPartitionComputeExpr(colNames []string, partitions int) tree.Expr {
+	return makeHashComputeExpr(colNames, partitions)
+}
+
+func makeHashComputeExpr(colNames []string, buckets int) tree.Expr {
 	unresolvedFunc := func(funcName string) tree.ResolvableFunctionReference {
 		return tree.ResolvableFunctionReference{
 			FunctionReference: &tree.UnresolvedName{
@@ -53,6 +66,5 @@ func MakeHashShardComputeExpr(colNames []string, buckets int) *string {
 			},
 		}
 	}
-	res := tree.Serialize(modBuckets(hashedColumnsExpr()))
-	return &res
+	return modBuckets(hashedColumnsExpr())
 }
@@ -6,13 +6,16 @@
 package schemaexpr
 
 import (
+	"context"
 	"math"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/parserutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
-	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/errors"
 )
 
@@ -144,10 +147,9 @@ func AssignSequenceOptions(
 
 		switch option.Name {
 		case tree.SeqOptCycle:
-			return unimplemented.NewWithIssue(20961,
-				"CYCLE option is not supported")
+			opts.Cycle = true
 		case tree.SeqOptNoCycle:
-			// Do nothing; this is the default.
+			opts.Cycle = false
 		case tree.SeqOptCacheNode:
 			if v := *option.IntVal; v >= 1 {
 				opts.NodeCacheSize = v
@@ -292,3 +294,22 @@ func DefaultSequenceOptions() descpb.TableDescriptor_SequenceOpts {
 
 	return defaultOpts
 }
+
+// CheckSequenceCycleSupported returns an error if the sequence options
+// contain CYCLE and the cluster is not fully upgraded to a version that
+// supports it: nodes running older binaries would ignore the option and
+// return an error once the sequence reaches its bound.
+func CheckSequenceCycleSupported(
+	ctx context.Context, version clusterversion.Handle, optsNode tree.SequenceOptions,
+) error {
+	if version.IsActive(ctx, clusterversion.V26_2_SequenceCycle) {
+		return nil
+	}
+	for _, option := range optsNode {
+		if option.Name == tree.SeqOptCycle {
+			return pgerror.New(pgcode.FeatureNotSupported,
+				"CYCLE is not supported until the upgrade to v26.2 is finalized")
+		}
+	}
+	return nil
+}
@@ -1278,6 +1278,98 @@ CREATE TABLE system.table_statistics_locks (
     CONSTRAINT "primary" PRIMARY KEY (table_id ASC, kind ASC),
     FAMILY "primary" (table_id, kind, job_ids)
 );`
+
+	// LargeObjectsTableSchema defines the schema for the system.large_objects
+	// table, which holds one row per large object created with lo_create and
+	// friends. It plays the role of pg_largeobject_metadata in Postgres.
+	// * loid: the OID of the large object.
+	// * owner_id: the user ID of the role that created the large object.
+	// * created: the time at which the large object was created.
+	LargeObjectsTableSchema = `
+CREATE TABLE system.large_objects (
+    loid     OID NOT NULL,
+    owner_id OID NOT NULL,
+    created  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT "primary" PRIMARY KEY (loid ASC),
+    FAMILY "primary" (loid, owner_id, created)
+);`
+
+	// LargeObjectPagesTableSchema defines the schema for the
+	// system.large_object_pages table, which stores the contents of large
+	// objects split into pages of at most largeobject.PageSize bytes. It plays
+	// the role of pg_largeobject in Postgres. Pages that were never written are
+	// absent and read as zeroes.
+	LargeObjectPagesTableSchema = `
+CREATE TABLE system.large_object_pages (
+    loid   OID NOT NULL,
+    pageno INT4 NOT NULL,
+    data   BYTES NOT NULL,
+    CONSTRAINT "primary" PRIMARY KEY (loid ASC, pageno ASC),
+    FAMILY "primary" (loid, pageno, data)
+);`
+
+	// LargeObjectOIDSequenceSchema defines the sequence from which the OIDs of
+	// large objects created without an explicit OID are drawn. It starts at
+	// FirstNormalObjectId in Postgres, so that automatically assigned OIDs
+	// never collide with builtin objects.
+	LargeObjectOIDSequenceSchema = `
+CREATE SEQUENCE system.large_object_oid_seq START 16384 MINVALUE 16384 MAXVALUE 4294967295;`
+
+	// UserLoginFailuresTableSchema defines the schema for the
+	// system.user_login_failures table, which tracks consecutive failed
+	// password logins for the purpose of account lockout. A row only exists
+	// for users whose last login attempt failed.
+	// * user_id: the ID of the user, as in system.users.
+	// * failed_attempts: the number of consecutive failed login attempts.
+	// * last_failure: the time of the last failed login attempt.
+	// * locked_until: if set, the time until which the user cannot log in.
+	UserLoginFailuresTableSchema = `
+CREATE TABLE system.user_login_failures (
+    user_id         OID NOT NULL,
+    failed_attempts INT8 NOT NULL DEFAULT 0,
+    last_failure    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    locked_until    TIMESTAMPTZ NULL,
+    CONSTRAINT "primary" PRIMARY KEY (user_id ASC),
+    FAMILY "primary" (user_id, failed_attempts, last_failure, locked_until)
+);`
+
+	// PasswordHistoryTableSchema defines the schema for the
+	// system.password_history table, which stores the hashes of the
+	// passwords previously set for each user, so that they cannot be reused.
+	// * user_id: the ID of the user, as in system.users.
+	// * changed_at: the time at which the password was set.
+	// * hashed_password: the password hash, as stored in system.users.
+	PasswordHistoryTableSchema = `
+CREATE TABLE system.password_history (
+    user_id         OID NOT NULL,
+    changed_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    hashed_password BYTES NOT NULL,
+    CONSTRAINT "primary" PRIMARY KEY (user_id ASC, changed_at ASC),
+    FAMILY "primary" (user_id, changed_at, hashed_password)
+);`
+
+	// AuditLogCheckpointsTableSchema defines the schema for the
+	// system.audit_log_checkpoints table, which stores the checkpoints of the
+	// hash chains of the events logged on the SENSITIVE_ACCESS channel.
+	// * chain_id: the ID of the chain.
+	// * sequence: the sequence number of the last event of the chain at the
+	//   time of the checkpoint, or 0 when the chain was created.
+	// * hmac: the HMAC of that event.
+	// * signature: the HMAC of the other fields, with a key derived from the key
+	//   of the chain, which is only known to the nodes.
+	// * sql_instance_id: the SQL instance that logged the chain.
+	// * created: the time of the checkpoint.
+	AuditLogCheckpointsTableSchema = `
+CREATE TABLE system.audit_log_checkpoints (
+    chain_id        UUID NOT NULL,
+    sequence        INT8 NOT NULL,
+    hmac            BYTES NOT NULL,
+    signature       BYTES NOT NULL,
+    sql_instance_id INT8 NOT NULL,
+    created         TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT "primary" PRIMARY KEY (chain_id ASC, sequence ASC),
+    FAMILY "primary" (chain_id, sequence, hmac, signature, sql_instance_id, created)
+);`
 )
 
 func pk(name string) descpb.IndexDescriptor {
@@ -1322,7 +1414,7 @@ const SystemDatabaseName = catconstants.SystemDatabaseName
 // release version).
 //
 // NB: Don't set this to clusterversion.Latest; use a specific version instead.
-var SystemDatabaseSchemaBootstrapVersion = clusterversion.V26_2_AddSystemClusterMetricsTable.Version()
+var SystemDatabaseSchemaBootstrapVersion = clusterversion.V26_2_AddSystemAuditLogCheckpointsTable.Version()
 
 // MakeSystemDatabaseDesc constructs a copy of the system database
 // descriptor.
@@ -1524,6 +1616,12 @@ func MakeSystemTables() []SystemTable {
 		StatementHintsTable,
 		ClusterMetricsTable,
 		TableStatisticsLocksTable,
+		LargeObjectsTable,
+		LargeObjectPagesTable,
+		LargeObjectOIDSequence,
+		UserLoginFailuresTable,
+		PasswordHistoryTable,
+		AuditLogCheckpointsTable,
 	}
 }
 
@@ -5487,6 +5585,188 @@ var (
 			},
 		),
 	)
+
+	LargeObjectsTable = makeSystemTable(
+		LargeObjectsTableSchema,
+		systemTable(
+			catconstants.LargeObjectsTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "loid", ID: 1, Type: types.Oid},
+				{Name: "owner_id", ID: 2, Type: types.Oid},
+				{Name: "created", ID: 3, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:        "primary",
+					ID:          0,
+					ColumnNames: []string{"loid", "owner_id", "created"},
+					ColumnIDs:   []descpb.ColumnID{1, 2, 3},
+				},
+			},
+			pk("loid"),
+		),
+	)
+
+	LargeObjectPagesTable = makeSystemTable(
+		LargeObjectPagesTableSchema,
+		systemTable(
+			catconstants.LargeObjectPagesTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "loid", ID: 1, Type: types.Oid},
+				{Name: "pageno", ID: 2, Type: types.Int4},
+				{Name: "data", ID: 3, Type: types.Bytes},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:            "primary",
+					ID:              0,
+					ColumnNames:     []string{"loid", "pageno", "data"},
+					ColumnIDs:       []descpb.ColumnID{1, 2, 3},
+					DefaultColumnID: 3,
+				},
+			},
+			descpb.IndexDescriptor{
+				Name:                "primary",
+				ID:                  1,
+				Unique:              true,
+				KeyColumnNames:      []string{"loid", "pageno"},
+				KeyColumnDirections: []catenumpb.IndexColumn_Direction{catenumpb.IndexColumn_ASC, catenumpb.IndexColumn_ASC},
+				KeyColumnIDs:        []descpb.ColumnID{1, 2},
+			},
+		),
+	)
+
+	// LargeObjectOIDSequence is the descriptor for the large object OID
+	// sequence.
+	LargeObjectOIDSequence = makeSystemTable(
+		LargeObjectOIDSequenceSchema,
+		systemTable(
+			catconstants.LargeObjectOIDSequenceName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: tabledesc.SequenceColumnName, ID: tabledesc.SequenceColumnID, Type: types.Int},
+			},
+			[]descpb.ColumnFamilyDescriptor{{
+				Name:            "primary",
+				ID:              keys.SequenceColumnFamilyID,
+				ColumnNames:     []string{tabledesc.SequenceColumnName},
+				ColumnIDs:       []descpb.ColumnID{tabledesc.SequenceColumnID},
+				DefaultColumnID: tabledesc.SequenceColumnID,
+			}},
+			descpb.IndexDescriptor{
+				ID:                  keys.SequenceIndexID,
+				Name:                tabledesc.LegacyPrimaryKeyIndexName,
+				KeyColumnIDs:        []descpb.ColumnID{tabledesc.SequenceColumnID},
+				KeyColumnNames:      []string{tabledesc.SequenceColumnName},
+				KeyColumnDirections: []catenumpb.IndexColumn_Direction{catenumpb.IndexColumn_ASC},
+			},
+		),
+		func(tbl *descpb.TableDescriptor) {
+			tbl.SequenceOpts = &descpb.TableDescriptor_SequenceOpts{
+				Increment:        1,
+				MinValue:         16384,
+				MaxValue:         math.MaxUint32,
+				Start:            16384,
+				SessionCacheSize: 1,
+			}
+			tbl.NextColumnID = 0
+			tbl.NextFamilyID = 0
+			tbl.NextIndexID = 0
+			tbl.NextMutationID = 0
+			// Sequences never exposed their internal constraints,
+			// so all IDs will be left at zero. CREATE SEQUENCE has
+			// the same behaviour.
+			tbl.NextConstraintID = 0
+			tbl.PrimaryIndex.ConstraintID = 0
+		},
+	)
+
+	UserLoginFailuresTable = makeSystemTable(
+		UserLoginFailuresTableSchema,
+		systemTable(
+			catconstants.UserLoginFailuresTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "user_id", ID: 1, Type: types.Oid},
+				{Name: "failed_attempts", ID: 2, Type: types.Int, DefaultExpr: &zeroIntString},
+				{Name: "last_failure", ID: 3, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+				{Name: "locked_until", ID: 4, Type: types.TimestampTZ, Nullable: true},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:        "primary",
+					ID:          0,
+					ColumnNames: []string{"user_id", "failed_attempts", "last_failure", "locked_until"},
+					ColumnIDs:   []descpb.ColumnID{1, 2, 3, 4},
+				},
+			},
+			pk("user_id"),
+		),
+	)
+
+	PasswordHistoryTable = makeSystemTable(
+		PasswordHistoryTableSchema,
+		systemTable(
+			catconstants.PasswordHistoryTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "user_id", ID: 1, Type: types.Oid},
+				{Name: "changed_at", ID: 2, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+				{Name: "hashed_password", ID: 3, Type: types.Bytes},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:            "primary",
+					ID:              0,
+					ColumnNames:     []string{"user_id", "changed_at", "hashed_password"},
+					ColumnIDs:       []descpb.ColumnID{1, 2, 3},
+					DefaultColumnID: 3,
+				},
+			},
+			descpb.IndexDescriptor{
+				Name:                "primary",
+				ID:                  1,
+				Unique:              true,
+				KeyColumnNames:      []string{"user_id", "changed_at"},
+				KeyColumnDirections: []catenumpb.IndexColumn_Direction{catenumpb.IndexColumn_ASC, catenumpb.IndexColumn_ASC},
+				KeyColumnIDs:        []descpb.ColumnID{1, 2},
+			},
+		),
+	)
+
+	AuditLogCheckpointsTable = makeSystemTable(
+		AuditLogCheckpointsTableSchema,
+		systemTable(
+			catconstants.AuditLogCheckpointsTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "chain_id", ID: 1, Type: types.Uuid},
+				{Name: "sequence", ID: 2, Type: types.Int},
+				{Name: "hmac", ID: 3, Type: types.Bytes},
+				{Name: "signature", ID: 4, Type: types.Bytes},
+				{Name: "sql_instance_id", ID: 5, Type: types.Int},
+				{Name: "created", ID: 6, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:        "primary",
+					ID:          0,
+					ColumnNames: []string{"chain_id", "sequence", "hmac", "signature", "sql_instance_id", "created"},
+					ColumnIDs:   []descpb.ColumnID{1, 2, 3, 4, 5, 6},
+				},
+			},
+			descpb.IndexDescriptor{
+				Name:                "primary",
+				ID:                  1,
+				Unique:              true,
+				KeyColumnNames:      []string{"chain_id", "sequence"},
+				KeyColumnDirections: []catenumpb.IndexColumn_Direction{catenumpb.IndexColumn_ASC, catenumpb.IndexColumn_ASC},
+				KeyColumnIDs:        []descpb.ColumnID{1, 2},
+			},
+		),
+	)
 )
 
 // SpanConfigurationsTableName represents system.span_configurations.
@@ -364,6 +364,19 @@ type Column interface {
 	// IsInaccessible returns true iff the column is inaccessible.
 	IsInaccessible() bool
 
+	// IsEncrypted returns true iff the column's values are encrypted with
+	// KMS-managed keys.
+	IsEncrypted() bool
+
+	// HasMissingValue returns true iff the column was added to its table
+	// without a backfill, in which case rows which do not store a value for the
+	// column decode to its missing value.
+	HasMissingValue() bool
+
+	// GetMissingValue returns the value-encoded missing value of the column, or
+	// nil if it has none.
+	GetMissingValue() []byte
+
 	// IsExpressionIndexColumn returns true iff the column is an an inaccessible
 	// virtual computed column that represents an expression in an expression
 	// index.
@@ -746,6 +759,12 @@ type Partitioning interface {
 	// NumRanges returns the number of range elements in the underlying
 	// partitioning descriptor.
 	NumRanges() int
+
+	// HashColumnNames returns the names of the hashed columns if this
+	// partitioning was specified with PARTITION BY HASH, in which case each of
+	// the NumLists() list elements holds one value of the hidden partition
+	// column. Returns nil otherwise.
+	HashColumnNames() []string
 }
 
 func isIndexInSearchSet(desc TableDescriptor, opts IndexOpts, idx Index) bool {
@@ -1034,6 +1053,11 @@ func ColumnNeedsBackfill(col Column) bool {
 		// In all other cases, DROP requires backfill.
 		return true
 	}
+	if col.HasMissingValue() {
+		// Existing rows decode to the missing value of the column, so they do
+		// not need to be rewritten.
+		return false
+	}
 	// ADD requires backfill for:
 	//  - columns with non-NULL default value
 	//  - computed columns
@@ -162,6 +162,25 @@ func (w column) IsInaccessible() bool {
 	return w.desc.Inaccessible
 }
 
+// IsEncrypted returns true iff the column's values are encrypted with
+// KMS-managed keys.
+func (w column) IsEncrypted() bool {
+	return w.desc.Encryption != nil
+}
+
+// HasMissingValue returns true iff the column was added to its table
+// without a backfill, in which case rows which do not store a value for the
+// column decode to its missing value.
+func (w column) HasMissingValue() bool {
+	return w.desc.MissingValue != nil
+}
+
+// GetMissingValue returns the value-encoded missing value of the column, or
+// nil if it has none.
+func (w column) GetMissingValue() []byte {
+	return w.desc.MissingValue
+}
+
 // IsExpressionIndexColumn returns true iff the column is an an inaccessible
 // virtual computed column that represents an expression in an expression index.
 func (w column) IsExpressionIndexColumn() bool {
@@ -604,6 +604,12 @@ func (p partitioning) NumRanges() int {
 	return len(p.desc.Range)
 }
 
+// HashColumnNames returns the names of the hashed columns if this
+// partitioning was specified with PARTITION BY HASH, nil otherwise.
+func (p partitioning) HashColumnNames() []string {
+	return p.desc.HashColumnNames
+}
+
 // ForEachList applies fn on each list element of the wrapped partitioning.
 // Supports iterutil.StopIteration.
 func (p partitioning) ForEachList(
@@ -588,6 +588,9 @@ func (desc *wrapper) GetAllReferencedFunctionIDsInColumnExprs(
 		}
 		ret = ret.Union(ids)
 	}
+	if mp := col.ColumnDesc().MaskingPolicy; mp != nil {
+		ret.Add(mp.FunctionID)
+	}
 
 	return ret, nil
 }
@@ -441,6 +441,70 @@ func GetShardColumnName(colNames []string, buckets int32) string {
 	)
 }
 
+// GetHashPartitionColumnName generates a name for the hidden column holding
+// the partition of each row of an index partitioned with PARTITION BY HASH.
+// As with GetShardColumnName, `colNames` is sorted in place, and is expected to
+// be passed in this order to the partition column hash expression.
+func GetHashPartitionColumnName(colNames []string, partitions int32) string {
+	sort.Strings(colNames)
+	return strings.Join(
+		append(append([]string{`crdb_internal`}, colNames...), fmt.Sprintf(`partition_%v`, partitions)), `_`,
+	)
+}
+
+// ValidateHashPartitionCount checks the number of partitions of a PARTITION BY
+// HASH clause.
+func ValidateHashPartitionCount(partitions int32) error {
+	if partitions < 2 || partitions > MaxBucketAllowed {
+		return pgerror.Newf(pgcode.InvalidParameterValue,
+			"number of hash partitions must be in range [2, %d], got %d", MaxBucketAllowed, partitions)
+	}
+	return nil
+}
+
+// HashPartitionColumnNames returns the sorted names of the hashed columns of a
+// PARTITION BY HASH clause, along with the name of the hidden partition column.
+func HashPartitionColumnNames(partBy *tree.PartitionBy) (colNames []string, hashColName string) {
+	colNames = partBy.Fields.ToStrings()
+	hashColName = GetHashPartitionColumnName(colNames, partBy.HashPartitions)
+	return colNames, hashColName
+}
+
+// HashPartitionByToList returns the LIST partitioning which implements a
+// PARTITION BY HASH clause: the index is partitioned on the hidden partition
+// column, with one partition p<i> for each of its possible values i.
+func HashPartitionByToList(partBy *tree.PartitionBy) *tree.PartitionBy {
+	_, hashColName := HashPartitionColumnNames(partBy)
+	ret := &tree.PartitionBy{
+		Fields: tree.NameList{tree.Name(hashColName)},
+		List:   make([]tree.ListPartition, partBy.HashPartitions),
+	}
+	for i := range ret.List {
+		ret.List[i] = tree.ListPartition{
+			Name:  tree.Name(fmt.Sprintf("p%d", i)),
+			Exprs: tree.Exprs{tree.NewDInt(tree.DInt(i))},
+		}
+	}
+	return ret
+}
+
+// HashPartitionByFromPartitioning returns the PARTITION BY HASH clause which
+// was used to specify the given partitioning, or nil if it wasn't.
+func HashPartitionByFromPartitioning(part catalog.Partitioning) *tree.PartitionBy {
+	hashColNames := part.HashColumnNames()
+	if len(hashColNames) == 0 {
+		return nil
+	}
+	partBy := &tree.PartitionBy{
+		Fields:         make(tree.NameList, len(hashColNames)),
+		HashPartitions: int32(part.NumLists()),
+	}
+	for i, colName := range hashColNames {
+		partBy.Fields[i] = tree.Name(colName)
+	}
+	return partBy
+}
+
 // getExistingOrNewConstraintCache should be the only place where the constraintCache
 // field in wrapper is ever read.
 func (desc *wrapper) getExistingOrNewConstraintCache() *constraintCache {
@@ -703,6 +767,28 @@ func RenameColumnInTable(
 		maybeUpdateShardedDesc(&idx.IndexDesc().Sharded)
 	}
 
+	// Rename the column in the hashed columns of PARTITION BY HASH indexes. The
+	// hidden partition column, which leads the index, is renamed as well.
+	for _, idx := range tableDesc.NonDropIndexes() {
+		part := &idx.IndexDesc().Partitioning
+		var changed bool
+		for i, c := range part.HashColumnNames {
+			if c == string(col.ColName()) {
+				changed = true
+				part.HashColumnNames[i] = string(newName)
+			}
+		}
+		if !changed {
+			continue
+		}
+		oldPartitionColName := tree.Name(idx.GetKeyColumnName(0))
+		if _, alreadyRenamed := shardColumnsToRename[oldPartitionColName]; !alreadyRenamed {
+			shardColumnsToRename[oldPartitionColName] = tree.Name(GetHashPartitionColumnName(
+				append([]string(nil), part.HashColumnNames...), int32(len(part.List)),
+			))
+		}
+	}
+
 	// Rename the REGIONAL BY ROW column reference.
 	if tableDesc.IsLocalityRegionalByRow() {
 		rbrColName, err := tableDesc.GetRegionalByRowTableRegionColumnName()
@@ -717,8 +803,8 @@ func RenameColumnInTable(
 	// Rename the column name in the column, the column family, the indexes...
 	tableDesc.RenameColumnDescriptor(col, string(newName))
 
-	// Rename any shard columns which need to be renamed because their name was
-	// based on this column.
+	// Rename any shard or hash partition columns which need to be renamed
+	// because their name was based on this column.
 	for oldShardColName, newShardColName := range shardColumnsToRename {
 		shardCol, err := catalog.MustFindColumnByTreeName(tableDesc, oldShardColName)
 		if err != nil {
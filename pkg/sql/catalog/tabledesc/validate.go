@@ -23,7 +23,9 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/semenumpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
@@ -990,6 +992,7 @@ func (desc *wrapper) ValidateSelf(vea catalog.ValidationErrorAccumulator) {
 		desc.validateConstraintNamesAndIDs(vea)
 		newErrs := []error{
 			desc.validateColumnFamilies(columnsByID),
+			desc.validateColumnEncryption(),
 			desc.validateCheckConstraints(columnsByID),
 			desc.validateUniqueWithoutIndexConstraints(columnsByID),
 			desc.validateTableIndexes(columnsByID, vea.IsActive),
@@ -1297,6 +1300,26 @@ func (desc *wrapper) validateColumns() error {
 			return errors.Newf("column %q cannot be hidden and inaccessible", column.GetName())
 		}
 
+		if privs := column.ColumnDesc().Privileges; privs != nil {
+			validPrivs := privilege.ColumnPrivileges.ToBitField()
+			for _, u := range privs.Users {
+				if u.Privileges&^validPrivs != 0 || u.WithGrantOption&^u.Privileges != 0 {
+					return errors.AssertionFailedf("column %q has invalid privileges for user %s",
+						column.GetName(), u.User())
+				}
+			}
+		}
+
+		if mp := column.ColumnDesc().MaskingPolicy; mp != nil {
+			if mp.FunctionID == descpb.InvalidID || len(mp.RoleNames) == 0 {
+				return errors.AssertionFailedf("column %q has an invalid masking policy", column.GetName())
+			}
+			if !catalog.MakeDescriptorIDSet(column.ColumnDesc().UsesFunctionIds...).Contains(mp.FunctionID) {
+				return errors.AssertionFailedf("column %q does not reference the function of its masking policy",
+					column.GetName())
+			}
+		}
+
 		if column.IsComputed() && column.IsGeneratedAsIdentity() {
 			return errors.Newf("both generated identity and computed expression specified for column %q", column.GetName())
 		}
@@ -1438,6 +1461,63 @@ func (desc *wrapper) validateColumnFamilies(columnsByID map[descpb.ColumnID]cata
 	return nil
 }
 
+// validateColumnEncryption validates that encrypted columns have well-formed
+// keys, are the only column of their column family, and are not part of any
+// index other than as a stored column of a primary index.
+func (desc *wrapper) validateColumnEncryption() error {
+	var encrypted catalog.TableColSet
+	for _, col := range desc.DeletableColumns() {
+		enc := col.ColumnDesc().Encryption
+		if enc == nil {
+			continue
+		}
+		encrypted.Add(col.GetID())
+		if enc.KMSURI == "" || len(enc.Keys) == 0 {
+			return errors.AssertionFailedf("encrypted column %q has no keys", col.GetName())
+		}
+		versions := make(map[uint32]struct{}, len(enc.Keys))
+		for _, key := range enc.Keys {
+			if _, ok := versions[key.Version]; ok {
+				return errors.AssertionFailedf("encrypted column %q has duplicate key version %d",
+					col.GetName(), key.Version)
+			}
+			if len(key.EncryptedDataKey) == 0 {
+				return errors.AssertionFailedf("encrypted column %q has an empty key with version %d",
+					col.GetName(), key.Version)
+			}
+			versions[key.Version] = struct{}{}
+		}
+		if col.IsComputed() {
+			return errors.Newf("encrypted column %q cannot be computed", col.GetName())
+		}
+	}
+	if encrypted.Empty() {
+		return nil
+	}
+	for i := range desc.Families {
+		family := &desc.Families[i]
+		for j, colID := range family.ColumnIDs {
+			if !encrypted.Contains(colID) {
+				continue
+			}
+			if family.ID == 0 || len(family.ColumnIDs) != 1 {
+				return errors.Newf("encrypted column %q must be the only column of a "+
+					"column family other than the first", family.ColumnNames[j])
+			}
+		}
+	}
+	for _, idx := range desc.AllIndexes() {
+		cols := idx.CollectKeyColumnIDs()
+		if idx.GetEncodingType() != catenumpb.PrimaryIndexEncoding {
+			cols.UnionWith(idx.CollectSecondaryStoredColumnIDs())
+		}
+		if cols.Intersects(encrypted) {
+			return errors.Newf("encrypted column cannot be indexed by index %q", idx.GetName())
+		}
+	}
+	return nil
+}
+
 // validateTriggers validates that triggers are well-formed.
 func (desc *wrapper) validateTriggers() error {
 	var triggerIDs intsets.Fast
@@ -1488,12 +1568,17 @@ func (desc *wrapper) validateTriggers() error {
 			return err
 		}
 
-		// Verify that the trigger function ID is valid.
-		if trigger.FuncID == descpb.InvalidID {
-			return errors.Newf("invalid function id %d in trigger %q", trigger.FuncID, trigger.Name)
-		}
+		// Verify that the trigger function ID is valid. Builtin trigger functions
+		// have no ID.
 		routineIDs := catalog.MakeDescriptorIDSet(trigger.DependsOnRoutines...)
-		if !routineIDs.Contains(trigger.FuncID) {
+		if trigger.BuiltinFuncName != "" {
+			if trigger.FuncID != descpb.InvalidID {
+				return errors.Newf("trigger %q has both function id %d and builtin function %q",
+					trigger.Name, trigger.FuncID, trigger.BuiltinFuncName)
+			}
+		} else if trigger.FuncID == descpb.InvalidID {
+			return errors.Newf("invalid function id %d in trigger %q", trigger.FuncID, trigger.Name)
+		} else if !routineIDs.Contains(trigger.FuncID) {
 			return errors.Newf("expected function id %d to be in depends-on-routines for trigger %q",
 				trigger.FuncID, trigger.Name)
 		}
@@ -1808,6 +1893,20 @@ func (desc *wrapper) validateTableIndexes(
 				}
 			}
 		}
+		if idx.GetType() == idxtype.BRIN {
+			switch {
+			case idx.Primary():
+				return errors.Newf("primary index %q cannot be a BRIN index", idx.GetName())
+			case idx.IsUnique():
+				return errors.Newf("BRIN index %q cannot be unique", idx.GetName())
+			case idx.NumSecondaryStoredColumns() > 0:
+				return errors.Newf("BRIN index %q cannot store columns", idx.GetName())
+			case idx.IsSharded():
+				return errors.Newf("BRIN index %q cannot be hash sharded", idx.GetName())
+			case idx.IsPartial():
+				return errors.Newf("BRIN index %q cannot be partial", idx.GetName())
+			}
+		}
 		if idx.IsPartial() {
 			expr, err := parserutils.ParseExpr(idx.GetPredicate())
 			if err != nil {
@@ -415,6 +415,11 @@ func (b *BatchEncoder) encodePK(ctx context.Context, ind catalog.Index) error {
 // encodeSecondaryIndex is the vector version of rowenc.EncodeSecondaryIndex.
 func (b *BatchEncoder) encodeSecondaryIndex(ctx context.Context, ind catalog.Index) error {
 	var err error
+	// The pending entries of summary indexes are only encoded by the row
+	// encoder, see copyMachine.canSupportVectorized.
+	if ind.GetType().IsSummary() {
+		return errors.AssertionFailedf("cannot encode summary index %q", ind.GetName())
+	}
 	secondaryIndexKeyPrefix := rowenc.MakeIndexKeyPrefix(b.rh.Codec, b.rh.TableDesc.GetID(), ind.GetID())
 
 	// Use the primary key encoding for covering indexes.
@@ -0,0 +1,276 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package colencryption implements the encryption of the values of encrypted
+// table columns.
+//
+// The values of an encrypted column are encrypted with AES-256-GCM using a
+// data key that is generated when the column is created. The data key is
+// stored in the column descriptor, itself encrypted by the master key of an
+// external KMS. Since an encrypted column is always the only column of its
+// column family, each of its values is stored in a separate KV value. That
+// value is a BYTES value holding:
+//
+//	uvarint(key version) | nonce | sealed(tag and data of the plaintext value)
+//
+// where the plaintext value is the legacy value encoding of the datum. The key
+// version allows values to be decrypted while a key rotation re-encrypts them
+// with a newer version of the data key.
+//
+// The table ID, the column ID and the encoded primary key of the row are
+// authenticated as additional data with each value, so that a value copied to
+// another table, column or row fails to decrypt instead of being read as the
+// value of that row.
+package colencryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	crypto_rand "crypto/rand"
+	"encoding/binary"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/errors"
+)
+
+// DataKeySize is the size of a data key, in bytes.
+const DataKeySize = 32
+
+const nonceSize = 12 // GCM standard nonce
+
+// GenerateDataKey returns a new random data key.
+func GenerateDataKey() ([]byte, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := crypto_rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// KeyRing holds the versions of the data key of an encrypted column. Values
+// are encrypted with the key with the highest version, and can be decrypted
+// with any key in the ring.
+type KeyRing struct {
+	// tableID and columnID are authenticated with each value of the column.
+	tableID  descpb.ID
+	columnID descpb.ColumnID
+	keys     map[uint32]dataKey
+	active   uint32
+}
+
+// NewKeyRing returns an empty key ring for the given column. The table ID is
+// the one recorded in the column's descpb.ColumnEncryption.
+func NewKeyRing(tableID descpb.ID, columnID descpb.ColumnID) *KeyRing {
+	return &KeyRing{tableID: tableID, columnID: columnID}
+}
+
+type dataKey struct {
+	key  []byte
+	aead cipher.AEAD
+}
+
+// Add adds a version of the data key to the ring.
+func (r *KeyRing) Add(version uint32, key []byte) error {
+	if len(key) != DataKeySize {
+		return errors.AssertionFailedf("invalid data key size %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	if r.keys == nil {
+		r.keys = make(map[uint32]dataKey)
+	}
+	r.keys[version] = dataKey{key: key, aead: gcm}
+	if len(r.keys) == 1 || version > r.active {
+		r.active = version
+	}
+	return nil
+}
+
+// ActiveVersion returns the version of the key used to encrypt new values.
+func (r *KeyRing) ActiveVersion() uint32 {
+	return r.active
+}
+
+// Key returns the given version of the data key.
+func (r *KeyRing) Key(version uint32) ([]byte, error) {
+	k, ok := r.keys[version]
+	if !ok {
+		return nil, errors.AssertionFailedf("unknown data key version %d", version)
+	}
+	return k.key, nil
+}
+
+// additionalData returns the data authenticated with the value stored under
+// the given KV key: the table ID, the column ID and the encoded primary key of
+// the row. The tenant prefix, the index ID and the column family suffix of the
+// key are left out, since they change when the table's data is moved to
+// another tenant or primary index without the row changing.
+func (r *KeyRing) additionalData(key roachpb.Key) ([]byte, error) {
+	n, err := keys.GetRowPrefixLength(key)
+	if err != nil {
+		return nil, err
+	}
+	rowKey, err := keys.StripTenantPrefix(key[:n])
+	if err != nil {
+		return nil, err
+	}
+	pk, _, _, err := keys.DecodeTableIDIndexID(rowKey)
+	if err != nil {
+		return nil, err
+	}
+	ad := make([]byte, 0, 2*binary.MaxVarintLen32+len(pk))
+	ad = binary.AppendUvarint(ad, uint64(r.tableID))
+	ad = binary.AppendUvarint(ad, uint64(r.columnID))
+	return append(ad, pk...), nil
+}
+
+func (r *KeyRing) encrypt(plaintext []byte, key roachpb.Key, version uint32) ([]byte, error) {
+	k, ok := r.keys[version]
+	if !ok {
+		return nil, errors.AssertionFailedf("no data key version %d to encrypt with", version)
+	}
+	gcm := k.aead
+	buf := make([]byte, binary.MaxVarintLen32, binary.MaxVarintLen32+nonceSize+len(plaintext)+gcm.Overhead())
+	buf = buf[:binary.PutUvarint(buf, uint64(version))]
+	nonce := buf[len(buf) : len(buf)+nonceSize]
+	if _, err := crypto_rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	buf = buf[:len(buf)+nonceSize]
+	ad, err := r.additionalData(key)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Seal(buf, nonce, plaintext, ad), nil
+}
+
+func (r *KeyRing) decrypt(ciphertext []byte, key roachpb.Key) ([]byte, error) {
+	version, n, err := decodeVersion(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	k, ok := r.keys[version]
+	if !ok {
+		return nil, pgerror.Newf(pgcode.DataCorrupted,
+			"encrypted value uses unknown data key version %d", version)
+	}
+	gcm := k.aead
+	ciphertext = ciphertext[n:]
+	if len(ciphertext) < nonceSize {
+		return nil, pgerror.New(pgcode.DataCorrupted, "encrypted value is too short")
+	}
+	ad, err := r.additionalData(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], ad)
+	if err != nil {
+		return nil, pgerror.Wrap(err, pgcode.DataCorrupted, "failed to decrypt value")
+	}
+	return plaintext, nil
+}
+
+// EncryptValue encrypts the tag and data of the given value, to be stored
+// under the given KV key, and returns a BYTES value holding the ciphertext.
+func (r *KeyRing) EncryptValue(v roachpb.Value, key roachpb.Key) (roachpb.Value, error) {
+	return r.EncryptValueWithVersion(v, key, r.active)
+}
+
+// EncryptValueWithVersion is like EncryptValue, but uses the given version of
+// the data key rather than the active one.
+func (r *KeyRing) EncryptValueWithVersion(
+	v roachpb.Value, key roachpb.Key, version uint32,
+) (roachpb.Value, error) {
+	ciphertext, err := r.encrypt(v.TagAndDataBytes(), key, version)
+	if err != nil {
+		return roachpb.Value{}, err
+	}
+	var res roachpb.Value
+	res.SetBytes(ciphertext)
+	return res, nil
+}
+
+// DecryptValue decrypts a value produced by EncryptValue and stored under the
+// given KV key.
+func (r *KeyRing) DecryptValue(v roachpb.Value, key roachpb.Key) (roachpb.Value, error) {
+	ciphertext, err := v.GetBytes()
+	if err != nil {
+		return roachpb.Value{}, err
+	}
+	plaintext, err := r.decrypt(ciphertext, key)
+	if err != nil {
+		return roachpb.Value{}, err
+	}
+	var res roachpb.Value
+	res.SetTagAndData(plaintext)
+	return res, nil
+}
+
+// Version returns the version of the data key that an encrypted value was
+// encrypted with.
+func Version(v roachpb.Value) (uint32, error) {
+	ciphertext, err := v.GetBytes()
+	if err != nil {
+		return 0, err
+	}
+	version, _, err := decodeVersion(ciphertext)
+	return version, err
+}
+
+func decodeVersion(ciphertext []byte) (uint32, int, error) {
+	version, n := binary.Uvarint(ciphertext)
+	if n <= 0 || version > uint64(^uint32(0)) {
+		return 0, 0, pgerror.New(pgcode.DataCorrupted, "malformed encrypted value")
+	}
+	return uint32(version), n, nil
+}
+
+// TableKeys holds the key rings of the encrypted columns of a table.
+type TableKeys map[descpb.ColumnID]*KeyRing
+
+// KeysFromFetchSpec builds the key rings of the encrypted columns fetched by
+// the given spec.
+func KeysFromFetchSpec(spec *fetchpb.IndexFetchSpec) (TableKeys, error) {
+	if len(spec.EncryptionKeys) == 0 {
+		return nil, nil
+	}
+	keys := make(TableKeys)
+	for i := range spec.EncryptionKeys {
+		k := &spec.EncryptionKeys[i]
+		r, ok := keys[k.ColumnID]
+		if !ok {
+			r = NewKeyRing(k.TableID, k.ColumnID)
+			keys[k.ColumnID] = r
+		}
+		if err := r.Add(k.Version, k.Key.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// DecryptFetchedValue decrypts the value of the given fetched column, which
+// must have IsEncrypted set, fetched from the given KV key.
+func (keys TableKeys) DecryptFetchedValue(
+	col *fetchpb.IndexFetchSpec_Column, key roachpb.Key, v roachpb.Value,
+) (roachpb.Value, error) {
+	r, ok := keys[col.ColumnID]
+	if !ok {
+		return roachpb.Value{}, pgerror.Newf(pgcode.InsufficientPrivilege,
+			"cannot decrypt column %q", col.Name)
+	}
+	return r.DecryptValue(v, key)
+}
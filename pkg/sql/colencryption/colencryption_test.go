@@ -0,0 +1,142 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package colencryption
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/stretchr/testify/require"
+)
+
+// rowKey returns the KV key of the given column family of the row with the
+// given primary key in the primary index of the given table.
+func rowKey(codec keys.SQLCodec, tableID descpb.ID, pk int64, familyID uint32) roachpb.Key {
+	k := codec.IndexPrefix(uint32(tableID), 1 /* indexID */)
+	k = encoding.EncodeVarintAscending(k, pk)
+	return keys.MakeFamilyKey(k, familyID)
+}
+
+func TestEncryptDecryptValue(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	codec := keys.SystemSQLCodec
+	key := rowKey(codec, 104, 1, 1)
+	r := NewKeyRing(104, 2)
+	k1, err := GenerateDataKey()
+	require.NoError(t, err)
+	require.NoError(t, r.Add(1, k1))
+
+	var plain roachpb.Value
+	plain.SetString("123-45-6789")
+	enc1, err := r.EncryptValue(plain, key)
+	require.NoError(t, err)
+	require.NotContains(t, string(enc1.RawBytes), "123-45-6789")
+	v, err := Version(enc1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), v)
+
+	// After a rotation, new values use the new key, and values encrypted with
+	// the old key can still be decrypted.
+	k2, err := GenerateDataKey()
+	require.NoError(t, err)
+	require.NoError(t, r.Add(2, k2))
+	require.Equal(t, uint32(2), r.ActiveVersion())
+	enc2, err := r.EncryptValue(plain, key)
+	require.NoError(t, err)
+	v, err = Version(enc2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), v)
+
+	for _, enc := range []roachpb.Value{enc1, enc2} {
+		dec, err := r.DecryptValue(enc, key)
+		require.NoError(t, err)
+		s, err := dec.GetBytes()
+		require.NoError(t, err)
+		require.Equal(t, "123-45-6789", string(s))
+	}
+
+	// A key ring without the key cannot decrypt the value.
+	other := NewKeyRing(104, 2)
+	require.NoError(t, other.Add(2, k1))
+	_, err = other.DecryptValue(enc1, key)
+	require.Error(t, err)
+	_, err = other.DecryptValue(enc2, key)
+	require.Error(t, err)
+}
+
+func TestDecryptValueAdditionalData(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	k, err := GenerateDataKey()
+	require.NoError(t, err)
+	ring := func(tableID descpb.ID, columnID descpb.ColumnID) *KeyRing {
+		r := NewKeyRing(tableID, columnID)
+		require.NoError(t, r.Add(1, k))
+		return r
+	}
+
+	codec := keys.SystemSQLCodec
+	key := rowKey(codec, 104, 1, 1)
+	var plain roachpb.Value
+	plain.SetString("123-45-6789")
+	enc, err := ring(104, 2).EncryptValue(plain, key)
+	require.NoError(t, err)
+
+	// The value can be decrypted under the key of another column family of the
+	// row, or another tenant's copy of the table.
+	tenantCodec := keys.MakeSQLCodec(roachpb.MustMakeTenantID(10))
+	for _, k := range []roachpb.Key{key, rowKey(codec, 104, 1, 0), rowKey(tenantCodec, 104, 1, 1)} {
+		_, err = ring(104, 2).DecryptValue(enc, k)
+		require.NoError(t, err)
+	}
+
+	// It cannot be decrypted as the value of another row, column or table.
+	for _, tc := range []struct {
+		name string
+		r    *KeyRing
+		key  roachpb.Key
+	}{
+		{"row", ring(104, 2), rowKey(codec, 104, 2, 1)},
+		{"column", ring(104, 3), key},
+		{"table", ring(105, 2), key},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.r.DecryptValue(enc, tc.key)
+			require.ErrorContains(t, err, "failed to decrypt value")
+		})
+	}
+}
+
+func TestKeyCache(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	calls := 0
+	unwrap := func() ([]byte, error) {
+		calls++
+		return []byte("key"), nil
+	}
+	c := NewKeyCache()
+	for i := 0; i < 3; i++ {
+		k, err := c.GetOrUnwrap("kms://a", []byte("wrapped"), unwrap)
+		require.NoError(t, err)
+		require.Equal(t, "key", string(k))
+	}
+	require.Equal(t, 1, calls)
+
+	_, err := c.GetOrUnwrap("kms://b", []byte("wrapped"), unwrap)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	var nilCache *KeyCache
+	_, err = nilCache.GetOrUnwrap("kms://a", []byte("wrapped"), unwrap)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
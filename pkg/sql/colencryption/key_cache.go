@@ -0,0 +1,76 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package colencryption
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// keyCacheTTL is how long an unwrapped data key is cached for. It bounds how
+// long a node keeps using a data key after access to the KMS master key that
+// wraps it has been revoked.
+const keyCacheTTL = 5 * time.Minute
+
+// KeyCache caches unwrapped data keys, so that reading or writing an
+// encrypted column does not require a round trip to the KMS. A nil *KeyCache
+// caches nothing.
+type KeyCache struct {
+	mu struct {
+		syncutil.Mutex
+		entries map[keyCacheKey]keyCacheEntry
+	}
+}
+
+type keyCacheKey struct {
+	kmsURI     string
+	wrappedKey string
+}
+
+type keyCacheEntry struct {
+	key     []byte
+	expires time.Time
+}
+
+// NewKeyCache returns a new, empty KeyCache.
+func NewKeyCache() *KeyCache {
+	c := &KeyCache{}
+	c.mu.entries = make(map[keyCacheKey]keyCacheEntry)
+	return c
+}
+
+// GetOrUnwrap returns the data key wrapped by the given KMS URI, calling
+// unwrap to decrypt it if it is not cached.
+func (c *KeyCache) GetOrUnwrap(
+	kmsURI string, wrappedKey []byte, unwrap func() ([]byte, error),
+) ([]byte, error) {
+	if c == nil {
+		return unwrap()
+	}
+	k := keyCacheKey{kmsURI: kmsURI, wrappedKey: string(wrappedKey)}
+	now := timeutil.Now()
+	c.mu.Lock()
+	e, ok := c.mu.entries[k]
+	c.mu.Unlock()
+	if ok && now.Before(e.expires) {
+		return e.key, nil
+	}
+	key, err := unwrap()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for ek, ee := range c.mu.entries {
+		if !now.Before(ee.expires) {
+			delete(c.mu.entries, ek)
+		}
+	}
+	c.mu.entries[k] = keyCacheEntry{key: key, expires: now.Add(keyCacheTTL)}
+	return key, nil
+}
@@ -208,6 +208,12 @@ func supportedNatively(core *execinfrapb.ProcessorCoreUnion) error {
 					return errDefaultAggregateWindowFunction
 				}
 			}
+			if wf.Func.WindowFunc != nil {
+				switch *wf.Func.WindowFunc {
+				case execinfrapb.WindowerSpec_ST_CLUSTERDBSCAN, execinfrapb.WindowerSpec_ST_CLUSTERKMEANS:
+					return errSpatialClusterWindowFunction
+				}
+			}
 		}
 		return nil
 
@@ -245,6 +251,7 @@ var (
 	errNonInnerMergeJoinWithOnExpr    = errors.New("can't plan vectorized non-inner merge joins with ON expressions")
 	errWindowFunctionFilterClause     = errors.New("window functions with FILTER clause are not supported")
 	errDefaultAggregateWindowFunction = errors.New("default aggregate window functions not supported")
+	errSpatialClusterWindowFunction   = errors.New("spatial clustering window functions are not supported")
 	// TODO(yuzefovich): #55758 has been resolved, re-evaluate whether it's
 	// worth unskipping stream ingestion processors from being wrapped.
 	errStreamIngestionWrap = errors.New("core.StreamIngestion{Data,Frontier} is not supported because of #55758")
@@ -28,6 +28,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/colconv"
 	"github.com/cockroachdb/cockroach/pkg/sql/colencoding"
+	"github.com/cockroachdb/cockroach/pkg/sql/colencryption"
 	"github.com/cockroachdb/cockroach/pkg/sql/colexecerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/colmem"
 	"github.com/cockroachdb/cockroach/pkg/sql/execinfra/execreleasable"
@@ -114,6 +115,9 @@ type cTableInfo struct {
 	// timestamp.
 	rowLastModifiedWithoutOriginTimestamp hlc.Timestamp
 
+	// encryptionKeys holds the key rings of the fetched encrypted columns.
+	encryptionKeys colencryption.TableKeys
+
 	da tree.DatumAlloc
 }
 
@@ -434,6 +438,11 @@ func (cf *cFetcher) Init(
 		table.neededValueColsByIdx.AddRange(0 /* start */, nCols-1)
 	}
 
+	var err error
+	if table.encryptionKeys, err = colencryption.KeysFromFetchSpec(&tableArgs.spec); err != nil {
+		return err
+	}
+
 	// Check for system columns.
 	for idx := range tableArgs.spec.FetchedColumns {
 		colID := tableArgs.spec.FetchedColumns[idx].ColumnID
@@ -1291,6 +1300,13 @@ func (cf *cFetcher) processValueSingle(
 		return prettyKey, "", nil
 	}
 	typ := cf.table.spec.FetchedColumns[idx].Type
+	if cf.table.spec.FetchedColumns[idx].IsEncrypted {
+		if val, err = table.encryptionKeys.DecryptFetchedValue(
+			&table.spec.FetchedColumns[idx], cf.machine.nextKV.Key, val,
+		); err != nil {
+			return "", "", err
+		}
+	}
 	err = colencoding.UnmarshalColumnValueToCol(
 		&table.da, &cf.machine.colvecs, idx, cf.machine.rowIdx, typ, val,
 	)
@@ -1404,6 +1420,21 @@ func (cf *cFetcher) fillNulls() error {
 		if table.compositeIndexColOrdinals.Contains(i) {
 			continue
 		}
+		// Rows written before a column was added with a missing value decode to
+		// that value.
+		if missingValue := table.spec.FetchedColumns[i].MissingValue; missingValue != nil {
+			_, dataOffset, _, typ, err := encoding.DecodeValueTag(missingValue)
+			if err != nil {
+				return err
+			}
+			if _, err = colencoding.DecodeTableValueToCol(
+				&table.da, &cf.machine.colvecs, i, cf.machine.rowIdx, typ, dataOffset,
+				table.spec.FetchedColumns[i].Type, missingValue,
+			); err != nil {
+				return err
+			}
+			continue
+		}
 		if table.spec.FetchedColumns[i].IsNonNullable {
 			var indexColValues strings.Builder
 			cf.writeDecodedCols(&indexColValues, table.indexColOrdinals, ',')
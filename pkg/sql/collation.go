@@ -0,0 +1,209 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/util/collatedstring"
+	"github.com/cockroachdb/errors"
+	"golang.org/x/text/language"
+)
+
+// User-defined collations are stored in the descriptor of the schema that
+// contains them, like text search objects. A collation is a name for an ICU
+// locale: columns and expressions that use it are resolved to the locale, so
+// they don't depend on the collation and it can be dropped at any time.
+// Whether a collation is deterministic is a property of its locale, so the
+// DETERMINISTIC option only has to agree with the locale.
+
+type createCollationNode struct {
+	zeroInputPlanNode
+	n *tree.CreateCollation
+}
+
+// CreateCollation creates a collation.
+func (p *planner) CreateCollation(ctx context.Context, n *tree.CreateCollation) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"CREATE COLLATION",
+	); err != nil {
+		return nil, err
+	}
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_2_UserDefinedCollations) {
+		return nil, pgerror.New(pgcode.FeatureNotSupported,
+			"collations are not supported until the upgrade to v26.2 is finalized")
+	}
+	return &createCollationNode{n: n}, nil
+}
+
+func (n *createCollationNode) startExec(params runParams) error {
+	p := params.p
+	db, sc, _, err := p.ResolveTargetObject(params.ctx, n.n.Name)
+	if err != nil {
+		return err
+	}
+	mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "collations")
+	if err != nil {
+		return err
+	}
+	name := n.n.Name.Object()
+	if collatedstring.IsDefaultEquivalentCollation(name) {
+		return pgerror.Newf(pgcode.DuplicateObject, "collation %q already exists", name)
+	}
+	// Locale tags take precedence over collation names in COLLATE clauses, so
+	// a collation with such a name could never be used.
+	if _, err := language.Parse(name); err == nil {
+		return pgerror.Newf(pgcode.InvalidName,
+			"collation name %q is a valid locale and cannot be used", name)
+	}
+	if _, ok := mutSc.GetCollation(name); ok {
+		if n.n.IfNotExists {
+			p.BufferClientNotice(params.ctx, pgnotice.Newf("collation %q already exists, skipping", name))
+			return nil
+		}
+		return pgerror.Newf(pgcode.DuplicateObject, "collation %q already exists", name)
+	}
+
+	coll := descpb.SchemaDescriptor_Collation{Name: name}
+	if n.n.From != "" {
+		if coll.Locale, err = tree.ResolveCollationLocale(params.ctx, string(n.n.From), p); err != nil {
+			return err
+		}
+		coll.Deterministic = collatedstring.IsDeterministicCollation(language.Make(coll.Locale))
+	} else if coll, err = makeCollation(name, n.n.Params); err != nil {
+		return err
+	}
+	mutSc.SetCollation(coll)
+	return p.writeSchemaDescChange(params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()))
+}
+
+func (n *createCollationNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *createCollationNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *createCollationNode) Close(ctx context.Context)           {}
+func (n *createCollationNode) ReadingOwnWrites()                   {}
+
+// makeCollation builds a collation from the options of a CREATE COLLATION
+// statement.
+func makeCollation(
+	name string, params tree.StorageParams,
+) (descpb.SchemaDescriptor_Collation, error) {
+	coll := descpb.SchemaDescriptor_Collation{Name: name}
+	var deterministic *bool
+	for _, param := range params {
+		v, err := objectParamValue(param)
+		if err != nil {
+			return coll, err
+		}
+		switch key := strings.ToLower(param.Key); key {
+		case "provider":
+			if !strings.EqualFold(v, "icu") {
+				return coll, pgerror.Newf(pgcode.FeatureNotSupported,
+					"collation provider %q is not supported", v)
+			}
+		case "locale", "lc_collate", "lc_ctype":
+			if coll.Locale != "" && coll.Locale != v {
+				return coll, pgerror.New(pgcode.InvalidObjectDefinition,
+					"LOCALE, LC_COLLATE and LC_CTYPE must agree")
+			}
+			coll.Locale = v
+		case "deterministic":
+			d, err := tree.ParseDBool(v)
+			if err != nil {
+				return coll, pgerror.Wrapf(err, pgcode.InvalidParameterValue,
+					"invalid value for parameter %q", param.Key)
+			}
+			deterministic = (*bool)(d)
+		default:
+			return coll, pgerror.Newf(pgcode.SyntaxError, "collation attribute %q not recognized", key)
+		}
+	}
+	if coll.Locale == "" {
+		return coll, pgerror.New(pgcode.InvalidObjectDefinition, `parameter "locale" must be specified`)
+	}
+	tag, err := language.Parse(coll.Locale)
+	if err != nil {
+		return coll, pgerror.Wrapf(err, pgcode.InvalidParameterValue, "invalid locale %s", coll.Locale)
+	}
+	coll.Deterministic = collatedstring.IsDeterministicCollation(tag)
+	if deterministic != nil && *deterministic != coll.Deterministic {
+		if coll.Deterministic {
+			return coll, errors.WithHint(
+				pgerror.Newf(pgcode.InvalidParameterValue,
+					"locale %q compares strings deterministically", coll.Locale),
+				"use a locale with a strength of level1 or level2, such as und-u-ks-level2.",
+			)
+		}
+		return coll, pgerror.Newf(pgcode.InvalidParameterValue,
+			"locale %q does not compare strings deterministically", coll.Locale)
+	}
+	return coll, nil
+}
+
+type dropCollationNode struct {
+	zeroInputPlanNode
+	n *tree.DropCollation
+}
+
+// DropCollation drops collations.
+func (p *planner) DropCollation(ctx context.Context, n *tree.DropCollation) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"DROP COLLATION",
+	); err != nil {
+		return nil, err
+	}
+	return &dropCollationNode{n: n}, nil
+}
+
+func (n *dropCollationNode) startExec(params runParams) error {
+	p := params.p
+	for _, un := range n.n.Names {
+		name := un.Object()
+		db, sc, err := p.lookupObjectInSchema(params.ctx, un, false /* withLeased */, func(
+			sc catalog.SchemaDescriptor,
+		) bool {
+			_, ok := sc.GetCollation(name)
+			return ok
+		})
+		if err != nil {
+			return err
+		}
+		if sc == nil {
+			if !n.n.IfExists {
+				return pgerror.Newf(pgcode.UndefinedObject, "collation %q does not exist", un.String())
+			}
+			p.BufferClientNotice(params.ctx, pgnotice.Newf("collation %q does not exist, skipping", un.String()))
+			continue
+		}
+		mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "collations")
+		if err != nil {
+			return err
+		}
+		mutSc.RemoveCollation(name)
+		if err := p.writeSchemaDescChange(
+			params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *dropCollationNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *dropCollationNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *dropCollationNode) Close(ctx context.Context)           {}
+func (n *dropCollationNode) ReadingOwnWrites()                   {}
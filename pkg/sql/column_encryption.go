@@ -0,0 +1,424 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/cloud"
+	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn"
+	"github.com/cockroachdb/cockroach/pkg/cloud/filekms"
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/jobs"
+	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/colencryption"
+	"github.com/cockroachdb/cockroach/pkg/sql/decodeusername"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/lexbase"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/syntheticprivilege"
+	"github.com/cockroachdb/errors"
+)
+
+// Encrypted columns store their values encrypted with a per-column data key,
+// using the format described in package colencryption. The data keys are
+// stored in the column descriptor, wrapped by the master key of an external
+// KMS. When a statement is planned, the keys are unwrapped (through the
+// ExecutorConfig's ColumnEncryptionKeyCache) and handed to the row writers and
+// to the fetchers through the IndexFetchSpec, the latter only if the user is
+// authorized to read the decrypted values. Encrypted columns cannot be
+// indexed, and are always the only column of their column family so that each
+// ciphertext is a separate KV value.
+
+// columnEncryptionKMSEnv is the cloud.KMSEnv used to wrap and unwrap the data
+// keys of encrypted columns.
+type columnEncryptionKMSEnv struct {
+	settings *cluster.Settings
+	conf     *base.ExternalIODirConfig
+	db       isql.DB
+	user     username.SQLUsername
+}
+
+var _ cloud.KMSEnv = &columnEncryptionKMSEnv{}
+
+func makeColumnEncryptionKMSEnv(
+	execCfg *ExecutorConfig, user username.SQLUsername,
+) *columnEncryptionKMSEnv {
+	return &columnEncryptionKMSEnv{
+		settings: execCfg.Settings,
+		conf:     &execCfg.ExternalIODirConfig,
+		db:       execCfg.InternalDB,
+		user:     user,
+	}
+}
+
+// ClusterSettings implements the cloud.KMSEnv interface.
+func (e *columnEncryptionKMSEnv) ClusterSettings() *cluster.Settings { return e.settings }
+
+// KMSConfig implements the cloud.KMSEnv interface.
+func (e *columnEncryptionKMSEnv) KMSConfig() *base.ExternalIODirConfig { return e.conf }
+
+// DBHandle implements the cloud.KMSEnv interface.
+func (e *columnEncryptionKMSEnv) DBHandle() isql.DB { return e.db }
+
+// User implements the cloud.KMSEnv interface.
+func (e *columnEncryptionKMSEnv) User() username.SQLUsername { return e.user }
+
+// wrapDataKey encrypts a data key with the master key of the given KMS.
+func wrapDataKey(
+	ctx context.Context,
+	execCfg *ExecutorConfig,
+	user username.SQLUsername,
+	kmsURI string,
+	dataKey []byte,
+) ([]byte, error) {
+	kms, err := cloud.KMSFromURI(ctx, kmsURI, makeColumnEncryptionKMSEnv(execCfg, user))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = kms.Close() }()
+	return kms.Encrypt(ctx, dataKey)
+}
+
+// unwrapColumnKeys returns the key ring of an encrypted column. The data keys
+// are unwrapped on behalf of the given user. Using the column's KMS does not
+// require any privilege beyond those on the table: the KMS URI was checked
+// with checkColumnEncryptionKMSPrivileges when the column was encrypted with
+// it.
+func unwrapColumnKeys(
+	ctx context.Context, execCfg *ExecutorConfig, user username.SQLUsername, col catalog.Column,
+) (*colencryption.KeyRing, error) {
+	enc := col.ColumnDesc().Encryption
+	r := colencryption.NewKeyRing(enc.TableID, col.GetID())
+	for _, k := range enc.Keys {
+		key, err := execCfg.ColumnEncryptionKeyCache.GetOrUnwrap(
+			enc.KMSURI, k.EncryptedDataKey, func() ([]byte, error) {
+				kms, err := cloud.KMSFromURI(
+					ctx, enc.KMSURI, makeColumnEncryptionKMSEnv(execCfg, user),
+				)
+				if err != nil {
+					return nil, err
+				}
+				defer func() { _ = kms.Close() }()
+				return kms.Decrypt(ctx, k.EncryptedDataKey)
+			},
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unwrapping data key version %d", k.Version)
+		}
+		if err := r.Add(k.Version, key); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// checkColumnEncryptionKMSPrivileges ensures that the current user may wrap
+// data keys with the given KMS, with the same rules that
+// CheckDestinationPrivileges applies to the URIs of BACKUP and EXPORT: a KMS
+// that authenticates with the credentials of the node requires the admin role
+// or the EXTERNALIOIMPLICITACCESS system privilege, and an external connection
+// requires the USAGE privilege on it.
+func (p *planner) checkColumnEncryptionKMSPrivileges(ctx context.Context, kmsURI string) error {
+	isAdmin, err := p.UserHasAdminRole(ctx, p.User())
+	if err != nil {
+		return err
+	}
+	if isAdmin {
+		return nil
+	}
+	u, err := url.ParseRequestURI(kmsURI)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == externalconn.Scheme {
+		ecPrivilege := &syntheticprivilege.ExternalConnectionPrivilege{
+			ConnectionName: u.Host,
+		}
+		return p.CheckPrivilege(ctx, ecPrivilege, privilege.USAGE)
+	}
+	requiresImplicitAccess := u.Scheme == filekms.Scheme ||
+		u.Query().Get(cloud.AuthParam) == cloud.AuthParamImplicit
+	if !requiresImplicitAccess || p.ExecCfg().ExternalIODirConfig.EnableNonAdminImplicitAndArbitraryOutbound {
+		return nil
+	}
+	hasImplicitAccessPrivilege, err := p.HasPrivilege(
+		ctx, syntheticprivilege.GlobalPrivilegeObject, privilege.EXTERNALIOIMPLICITACCESS, p.User(),
+	)
+	if err != nil {
+		return err
+	}
+	if !hasImplicitAccessPrivilege {
+		return pgerror.Newf(pgcode.InsufficientPrivilege,
+			"only users with the admin role or the EXTERNALIOIMPLICITACCESS system privilege are allowed to use the specified %s KMS URI",
+			u.Scheme)
+	}
+	return nil
+}
+
+// columnHasFamily returns whether the named column was assigned to a column
+// family.
+func columnHasFamily(desc *tabledesc.Mutable, colName string) bool {
+	for i := range desc.Families {
+		for _, name := range desc.Families[i].ColumnNames {
+			if name == colName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkNoEncryptedColumns returns an error if the table has encrypted columns.
+// It guards operations that rebuild the primary index, which the index
+// backfiller cannot do without the column keys.
+func checkNoEncryptedColumns(desc catalog.TableDescriptor, op string) error {
+	for _, col := range desc.DeletableColumns() {
+		if col.IsEncrypted() {
+			return pgerror.Newf(pgcode.FeatureNotSupported,
+				"cannot %s table %q because column %q is encrypted", op, desc.GetName(), col.GetName())
+		}
+	}
+	return nil
+}
+
+// formatColumnEncryption appends the ENCRYPTED WITH KEY clause of a column
+// definition to buf. The KMS URI is redacted, since it may embed credentials.
+func formatColumnEncryption(buf *bytes.Buffer, enc *descpb.ColumnEncryption) error {
+	kmsURI, err := cloud.RedactKMSURI(enc.KMSURI)
+	if err != nil {
+		return err
+	}
+	buf.WriteString(" ENCRYPTED WITH KEY ")
+	lexbase.EncodeSQLString(buf, kmsURI)
+	if len(enc.RoleNames) > 0 {
+		buf.WriteString(" FOR ROLES (")
+		for i, name := range enc.RoleNames {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			lexbase.EncodeRestrictedSQLIdent(buf, name, lexbase.EncNoFlags)
+		}
+		buf.WriteString(")")
+	}
+	return nil
+}
+
+// initColumnEncryption generates the data key of a new encrypted column of the
+// given table, wraps it with the column's KMS and records it in the column
+// descriptor.
+func (p *planner) initColumnEncryption(
+	ctx context.Context, tableID descpb.ID, d *tree.ColumnTableDef, col *descpb.ColumnDescriptor,
+) error {
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_2_ColumnEncryption) {
+		return pgerror.New(pgcode.FeatureNotSupported,
+			"encrypted columns are not supported until the upgrade to v26.2 is finalized")
+	}
+	kmsURI, err := p.ExprEvaluator("ENCRYPTED WITH KEY").String(ctx, d.Encryption.KMSURI)
+	if err != nil {
+		return err
+	}
+	if err := p.checkColumnEncryptionKMSPrivileges(ctx, kmsURI); err != nil {
+		return err
+	}
+	roles, err := decodeusername.FromRoleSpecList(
+		p.SessionData(), username.PurposeValidation, d.Encryption.Roles,
+	)
+	if err != nil {
+		return err
+	}
+	roleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if err := p.CheckRoleExists(ctx, role); err != nil {
+			return err
+		}
+		roleNames = append(roleNames, role.Normalized())
+	}
+	dataKey, err := colencryption.GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	wrapped, err := wrapDataKey(ctx, p.ExecCfg(), p.User(), kmsURI, dataKey)
+	if err != nil {
+		return errors.Wrapf(err, "wrapping data key of column %q", col.Name)
+	}
+	col.Encryption = &descpb.ColumnEncryption{
+		KMSURI:    kmsURI,
+		Keys:      []descpb.ColumnEncryption_Key{{Version: 1, EncryptedDataKey: wrapped}},
+		RoleNames: roleNames,
+		TableID:   tableID,
+	}
+	return nil
+}
+
+// canDecryptColumn returns whether the current user is authorized to read the
+// decrypted values of an encrypted column.
+func (p *planner) canDecryptColumn(ctx context.Context, col catalog.Column) (bool, error) {
+	roleNames := col.ColumnDesc().Encryption.RoleNames
+	if len(roleNames) == 0 || p.User().IsNodeUser() {
+		return true, nil
+	}
+	memberOf, err := p.MemberOfWithAdminOption(ctx, p.User())
+	if err != nil {
+		return false, err
+	}
+	for _, name := range roleNames {
+		role := username.MakeSQLUsernameFromPreNormalizedString(name)
+		if _, ok := memberOf[role]; ok || role == p.User() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// addColumnEncryptionKeys adds to the spec the data keys of the encrypted
+// columns it fetches and that the current user may decrypt. Fetching a value
+// of any other encrypted column fails.
+func (p *planner) addColumnEncryptionKeys(
+	ctx context.Context, desc catalog.TableDescriptor, spec *fetchpb.IndexFetchSpec,
+) error {
+	for i := range spec.FetchedColumns {
+		if !spec.FetchedColumns[i].IsEncrypted {
+			continue
+		}
+		col, err := catalog.MustFindColumnByID(desc, spec.FetchedColumns[i].ColumnID)
+		if err != nil {
+			return err
+		}
+		if ok, err := p.canDecryptColumn(ctx, col); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		enc := col.ColumnDesc().Encryption
+		r, err := unwrapColumnKeys(ctx, p.ExecCfg(), p.User(), col)
+		if err != nil {
+			return err
+		}
+		for _, k := range enc.Keys {
+			key, err := r.Key(k.Version)
+			if err != nil {
+				return err
+			}
+			spec.EncryptionKeys = append(spec.EncryptionKeys, fetchpb.IndexFetchSpec_EncryptionKey{
+				TableID:  enc.TableID,
+				ColumnID: col.GetID(),
+				Version:  k.Version,
+				Key:      fetchpb.MakeDataKey(key),
+			})
+		}
+	}
+	return nil
+}
+
+// columnEncryptionKeysForWrite returns the key rings of the encrypted columns
+// of the table, to be used by row writers. Writing does not require
+// membership in the column's roles.
+func (p *planner) columnEncryptionKeysForWrite(
+	ctx context.Context, desc catalog.TableDescriptor,
+) (colencryption.TableKeys, error) {
+	var keys colencryption.TableKeys
+	for _, col := range desc.WritableColumns() {
+		if !col.IsEncrypted() {
+			continue
+		}
+		r, err := unwrapColumnKeys(ctx, p.ExecCfg(), p.User(), col)
+		if err != nil {
+			return nil, err
+		}
+		if keys == nil {
+			keys = make(colencryption.TableKeys)
+		}
+		keys[col.GetID()] = r
+	}
+	return keys, nil
+}
+
+// rotateColumnEncryptionKey applies an ALTER COLUMN ROTATE ENCRYPTION KEY
+// command. A new version of the data key is added to the column, and a job is
+// queued to re-encrypt the existing values with it and then discard the older
+// versions.
+func (p *planner) rotateColumnEncryptionKey(
+	ctx context.Context,
+	tableDesc *tabledesc.Mutable,
+	col catalog.Column,
+	n *tree.AlterTableRotateEncryptionKey,
+) error {
+	if !col.IsEncrypted() {
+		return pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+			"column %q is not encrypted", col.GetName())
+	}
+	enc := col.ColumnDesc().Encryption
+	if len(tableDesc.Mutations) > 0 {
+		return pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+			"cannot rotate the encryption key of column %q while a schema change is in progress",
+			col.GetName())
+	}
+	kmsURI := enc.KMSURI
+	if n.KMSURI != nil {
+		var err error
+		if kmsURI, err = p.ExprEvaluator("ROTATE ENCRYPTION KEY").String(ctx, n.KMSURI); err != nil {
+			return err
+		}
+	}
+	if err := p.checkColumnEncryptionKMSPrivileges(ctx, kmsURI); err != nil {
+		return err
+	}
+	dataKey, err := colencryption.GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	newKey := descpb.ColumnEncryption_Key{Version: enc.Keys[len(enc.Keys)-1].Version + 1}
+	if newKey.EncryptedDataKey, err = wrapDataKey(ctx, p.ExecCfg(), p.User(), kmsURI, dataKey); err != nil {
+		return errors.Wrapf(err, "wrapping data key of column %q", col.GetName())
+	}
+	if kmsURI != enc.KMSURI {
+		// All keys of a column are wrapped by the same KMS, so the current key
+		// must be re-wrapped by the new one.
+		r, err := unwrapColumnKeys(ctx, p.ExecCfg(), p.User(), col)
+		if err != nil {
+			return err
+		}
+		for i := range enc.Keys {
+			key, err := r.Key(enc.Keys[i].Version)
+			if err != nil {
+				return err
+			}
+			if enc.Keys[i].EncryptedDataKey, err = wrapDataKey(ctx, p.ExecCfg(), p.User(), kmsURI, key); err != nil {
+				return err
+			}
+		}
+		enc.KMSURI = kmsURI
+	}
+	enc.Keys = append(enc.Keys, newKey)
+
+	p.extendedEvalCtx.QueueJob(&jobs.Record{
+		Description: fmt.Sprintf("rotating encryption key of column %s of table %s",
+			tree.Name(col.GetName()), tree.Name(tableDesc.GetName())),
+		Username:      p.User(),
+		DescriptorIDs: descpb.IDs{tableDesc.GetID()},
+		Details: jobspb.ColumnEncryptionKeyRotationDetails{
+			TableID:    tableDesc.GetID(),
+			ColumnID:   col.GetID(),
+			KeyVersion: newKey.Version,
+		},
+		Progress: jobspb.ColumnEncryptionKeyRotationProgress{},
+	})
+	return nil
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/jobs"
+	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/colencryption"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/regions"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
+)
+
+var columnEncryptionKeyRotationBatchSize = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"sql.column_encryption.key_rotation.batch_size",
+	"number of keys scanned per transaction when re-encrypting a column with a new data key",
+	1000,
+	settings.PositiveInt,
+)
+
+// columnEncryptionKeyRotationResumer implements the job that re-encrypts the
+// values of an encrypted column after ALTER COLUMN ROTATE ENCRYPTION KEY. It
+// waits for all nodes to write with the new data key, rewrites every value
+// encrypted with an older key, and then drops the older keys from the column.
+type columnEncryptionKeyRotationResumer struct {
+	job *jobs.Job
+}
+
+var _ jobs.Resumer = &columnEncryptionKeyRotationResumer{}
+
+// Resume is part of the jobs.Resumer interface.
+func (r *columnEncryptionKeyRotationResumer) Resume(
+	ctx context.Context, execCtx interface{},
+) error {
+	execCfg := execCtx.(JobExecContext).ExecCfg()
+	details := r.job.Details().(jobspb.ColumnEncryptionKeyRotationDetails)
+
+	// Once a single version of the descriptor is leased, all writers encrypt
+	// with the new key, so the scan below cannot be overtaken by values
+	// encrypted with an older one.
+	cachedRegions, err := regions.NewCachedDatabaseRegions(ctx, execCfg.DB, execCfg.LeaseManager)
+	if err != nil {
+		return err
+	}
+	desc, err := WaitToUpdateLeases(ctx, execCfg.LeaseManager, cachedRegions, details.TableID)
+	if err != nil {
+		if errors.Is(err, catalog.ErrDescriptorNotFound) {
+			return nil
+		}
+		return err
+	}
+	tbl, ok := desc.(catalog.TableDescriptor)
+	if !ok {
+		return errors.AssertionFailedf("descriptor %d is not a table", details.TableID)
+	}
+	col := catalog.FindColumnByID(tbl, details.ColumnID)
+	if col == nil || !col.IsEncrypted() || tbl.Dropped() {
+		// The column or the table was dropped in the meantime.
+		return nil
+	}
+	ring, err := unwrapColumnKeys(ctx, execCfg, r.job.Payload().UsernameProto.Decode(), col)
+	if err != nil {
+		return err
+	}
+	if _, err := ring.Key(details.KeyVersion); err != nil {
+		return err
+	}
+	var familyID descpb.FamilyID
+	for _, family := range tbl.GetFamilies() {
+		for _, id := range family.ColumnIDs {
+			if id == col.GetID() {
+				familyID = family.ID
+			}
+		}
+	}
+
+	progress, err := r.loadProgress(ctx, execCfg.InternalDB)
+	if err != nil {
+		return err
+	}
+	span := tbl.PrimaryIndexSpan(execCfg.Codec)
+	start := span.Key
+	if len(progress.ResumeKey) > 0 {
+		start = progress.ResumeKey
+	}
+	batchSize := columnEncryptionKeyRotationBatchSize.Get(execCfg.SV())
+	for start != nil {
+		var next roachpb.Key
+		if err := execCfg.InternalDB.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+			next = nil
+			kvs, err := txn.KV().Scan(ctx, start, span.EndKey, batchSize)
+			if err != nil {
+				return err
+			}
+			b := txn.KV().NewBatch()
+			for _, row := range kvs {
+				if err := reencryptColumnValue(ring, familyID, details.KeyVersion, row, b); err != nil {
+					return err
+				}
+			}
+			if err := txn.KV().Run(ctx, b); err != nil {
+				return err
+			}
+			if int64(len(kvs)) < batchSize {
+				return nil
+			}
+			next = kvs[len(kvs)-1].Key.Next()
+			return jobs.StoreLegacyProgress(ctx, txn, r.job.ID(),
+				jobspb.ColumnEncryptionKeyRotationProgress{ResumeKey: next})
+		}); err != nil {
+			return err
+		}
+		start = next
+	}
+	log.Dev.Infof(ctx, "re-encrypted column %q of table %q with data key version %d",
+		col.GetName(), tbl.GetName(), details.KeyVersion)
+
+	// No value is encrypted with an older key anymore, so they can go.
+	return execCfg.InternalDB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+		mut, err := txn.Descriptors().MutableByID(txn.KV()).Table(ctx, details.TableID)
+		if err != nil {
+			return err
+		}
+		mutCol := catalog.FindColumnByID(mut, details.ColumnID)
+		if mutCol == nil || !mutCol.IsEncrypted() {
+			return nil
+		}
+		enc := mutCol.ColumnDesc().Encryption
+		retained := enc.Keys[:0]
+		for _, k := range enc.Keys {
+			if k.Version >= details.KeyVersion {
+				retained = append(retained, k)
+			}
+		}
+		enc.Keys = retained
+		return txn.Descriptors().WriteDesc(ctx, false /* kvTrace */, mut, txn.KV())
+	})
+}
+
+// reencryptColumnValue adds to the batch a Put re-encrypting the given value
+// with the data key of the given version, if it is a value of the encrypted
+// column's family that was encrypted with an older key. Values encrypted with
+// a newer key, added by a concurrent rotation, are left alone.
+func reencryptColumnValue(
+	ring *colencryption.KeyRing,
+	familyID descpb.FamilyID,
+	keyVersion uint32,
+	row kv.KeyValue,
+	b *kv.Batch,
+) error {
+	fam, err := keys.DecodeFamilyKey(row.Key)
+	if err != nil {
+		return err
+	}
+	if descpb.FamilyID(fam) != familyID || !row.Exists() {
+		return nil
+	}
+	version, err := colencryption.Version(*row.Value)
+	if err != nil {
+		return err
+	}
+	if version >= keyVersion {
+		return nil
+	}
+	plaintext, err := ring.DecryptValue(*row.Value, row.Key)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := ring.EncryptValueWithVersion(plaintext, row.Key, keyVersion)
+	if err != nil {
+		return err
+	}
+	b.Put(row.Key, &ciphertext)
+	return nil
+}
+
+func (r *columnEncryptionKeyRotationResumer) loadProgress(
+	ctx context.Context, db isql.DB,
+) (progress jobspb.ColumnEncryptionKeyRotationProgress, _ error) {
+	err := db.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+		details, err := jobs.LoadLegacyProgress(ctx, txn, r.job.ID())
+		if err != nil || details == nil {
+			return err
+		}
+		progress = details.(jobspb.ColumnEncryptionKeyRotationProgress)
+		return nil
+	})
+	return progress, err
+}
+
+// OnFailOrCancel is part of the jobs.Resumer interface. The column keeps all
+// its data keys, so that values encrypted with any of them remain readable; a
+// later rotation removes the ones it no longer needs.
+func (r *columnEncryptionKeyRotationResumer) OnFailOrCancel(
+	context.Context, interface{}, error,
+) error {
+	return nil
+}
+
+// CollectProfile is part of the jobs.Resumer interface.
+func (r *columnEncryptionKeyRotationResumer) CollectProfile(context.Context, interface{}) error {
+	return nil
+}
+
+func init() {
+	jobs.RegisterConstructor(
+		jobspb.TypeColumnEncryptionKeyRotation,
+		func(job *jobs.Job, _ *cluster.Settings) jobs.Resumer {
+			return &columnEncryptionKeyRotationResumer{job: job}
+		},
+		jobs.UsesTenantCostControl,
+	)
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/desctestutils"
+	"github.com/cockroachdb/cockroach/pkg/testutils/jobutils"
+	"github.com/cockroachdb/cockroach/pkg/testutils/serverutils"
+	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnEncryption(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	keyPath := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(keyPath, []byte("column encryption test key"), 0600))
+
+	s, sqlDB, kvDB := serverutils.StartServer(t, base.TestServerArgs{})
+	defer s.Stopper().Stop(ctx)
+	codec := s.ApplicationLayer().Codec()
+
+	db := sqlutils.MakeSQLRunner(sqlDB)
+	db.Exec(t, `CREATE USER reader`)
+	db.Exec(t, `CREATE USER other`)
+	db.Exec(t, fmt.Sprintf(`CREATE TABLE t (
+  k INT PRIMARY KEY,
+  v STRING ENCRYPTED WITH KEY 'file-kms://%s' FOR ROLES (reader)
+)`, keyPath))
+	db.Exec(t, `GRANT SELECT ON t TO reader, other`)
+	db.Exec(t, `INSERT INTO t VALUES (1, 'secret one'), (2, 'secret two'), (3, NULL)`)
+	db.Exec(t, `UPDATE t SET v = 'secret three' WHERE k = 3`)
+
+	// The values are stored encrypted.
+	checkCiphertext := func() {
+		desc := desctestutils.TestingGetPublicTableDescriptor(kvDB, codec, "defaultdb", "t")
+		span := desc.PrimaryIndexSpan(codec)
+		kvs, err := kvDB.Scan(ctx, span.Key, span.EndKey, 0 /* maxRows */)
+		require.NoError(t, err)
+		require.NotEmpty(t, kvs)
+		for _, kv := range kvs {
+			require.False(t, bytes.Contains(kv.Value.RawBytes, []byte("secret")))
+		}
+	}
+	checkCiphertext()
+
+	reader := sqlutils.MakeSQLRunner(s.ApplicationLayer().SQLConn(t, serverutils.User("reader")))
+	other := sqlutils.MakeSQLRunner(s.ApplicationLayer().SQLConn(t, serverutils.User("other")))
+	expected := [][]string{{"1", "secret one"}, {"2", "secret two"}, {"3", "secret three"}}
+
+	// Only members of the column's roles can read the decrypted values.
+	reader.CheckQueryResults(t, `SELECT k, v FROM t ORDER BY k`, expected)
+	other.CheckQueryResults(t, `SELECT k FROM t ORDER BY k`, [][]string{{"1"}, {"2"}, {"3"}})
+	other.ExpectErr(t, `cannot decrypt column "v"`, `SELECT v FROM t`)
+	db.ExpectErr(t, `cannot decrypt column "v"`, `SELECT v FROM t`)
+
+	// Encrypted columns cannot be indexed.
+	db.ExpectErr(t, `encrypted column cannot be indexed`, `CREATE INDEX ON t (v)`)
+
+	// SHOW CREATE redacts the KMS URI.
+	var createStmt string
+	db.QueryRow(t, `SELECT create_statement FROM [SHOW CREATE TABLE t]`).Scan(&createStmt)
+	require.Contains(t, createStmt, `ENCRYPTED WITH KEY 'file-kms:///redacted' FOR ROLES (reader)`)
+	require.NotContains(t, createStmt, keyPath)
+
+	// Rotating the key re-encrypts the values and drops the old key.
+	db.Exec(t, `ALTER TABLE t ALTER COLUMN v ROTATE ENCRYPTION KEY`)
+	var jobID int64
+	db.QueryRow(t, `SELECT job_id FROM [SHOW JOBS] WHERE job_type = 'COLUMN ENCRYPTION KEY ROTATION'`).Scan(&jobID)
+	jobutils.WaitForJobToSucceed(t, db, jobspb.JobID(jobID))
+	desc := desctestutils.TestingGetPublicTableDescriptor(kvDB, codec, "defaultdb", "t")
+	col, err := catalog.MustFindColumnByName(desc, "v")
+	require.NoError(t, err)
+	require.Len(t, col.ColumnDesc().Encryption.Keys, 1)
+	require.Equal(t, uint32(2), col.ColumnDesc().Encryption.Keys[0].Version)
+	checkCiphertext()
+	reader.CheckQueryResults(t, `SELECT k, v FROM t ORDER BY k`, expected)
+
+	// Encrypted values are bound to their row: a ciphertext copied to another
+	// row fails to decrypt.
+	span := desc.PrimaryIndexSpan(codec)
+	kvs, err := kvDB.Scan(ctx, span.Key, span.EndKey, 0 /* maxRows */)
+	require.NoError(t, err)
+	var encKeys []roachpb.Key
+	var encValues []*roachpb.Value
+	for _, kv := range kvs {
+		if familyID, err := keys.DecodeFamilyKey(kv.Key); err == nil && familyID != 0 {
+			encKeys = append(encKeys, kv.Key)
+			encValues = append(encValues, kv.Value)
+		}
+	}
+	require.Len(t, encKeys, 3)
+	put := func(key roachpb.Key, value *roachpb.Value) {
+		v := *value
+		v.ClearChecksum()
+		require.NoError(t, kvDB.Put(ctx, key, &v))
+	}
+	put(encKeys[0], encValues[1])
+	reader.ExpectErr(t, `failed to decrypt value`, `SELECT v FROM t WHERE k = 1`)
+	put(encKeys[0], encValues[0])
+	reader.CheckQueryResults(t, `SELECT k, v FROM t ORDER BY k`, expected)
+
+	// A KMS that uses the credentials of the node requires the admin role or
+	// the EXTERNALIOIMPLICITACCESS system privilege, like BACKUP and EXPORT.
+	db.Exec(t, `GRANT CREATE ON DATABASE defaultdb TO other`)
+	createT2 := fmt.Sprintf(`CREATE TABLE t2 (
+  k INT PRIMARY KEY,
+  v STRING ENCRYPTED WITH KEY 'file-kms://%s'
+)`, keyPath)
+	const implicitErr = `only users with the admin role or the EXTERNALIOIMPLICITACCESS system privilege are allowed to use the specified file-kms KMS URI`
+	other.ExpectErr(t, implicitErr, createT2)
+	db.Exec(t, `GRANT SYSTEM EXTERNALIOIMPLICITACCESS TO other`)
+	other.Exec(t, createT2)
+	db.Exec(t, `REVOKE SYSTEM EXTERNALIOIMPLICITACCESS FROM other`)
+	other.ExpectErr(t, implicitErr, `ALTER TABLE t2 ALTER COLUMN v ROTATE ENCRYPTION KEY`)
+}
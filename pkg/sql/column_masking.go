@@ -0,0 +1,112 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/funcdesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/decodeusername"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// Masking policies are stored in the MaskingPolicy field of the column
+// descriptors of a table. The optimizer applies them when it builds a scan of
+// the table for a user that is a member of one of the policy's roles: the
+// column is replaced by the result of the masking function, so that neither
+// the query's projections nor its filters can observe the original values.
+// See cat.Table.MaskingPolicies.
+
+// setColumnMaskingPolicy applies an ALTER COLUMN SET MASKING POLICY or DROP
+// MASKING POLICY command to the given column.
+func (p *planner) setColumnMaskingPolicy(
+	ctx context.Context,
+	tableDesc *tabledesc.Mutable,
+	col catalog.Column,
+	n *tree.AlterTableSetMaskingPolicy,
+) error {
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_2_ColumnMaskingPolicies) {
+		return pgerror.New(pgcode.FeatureNotSupported,
+			"masking policies are not supported until the upgrade to v26.2 is finalized")
+	}
+	colDesc := col.ColumnDesc()
+	if n.Func == nil {
+		if colDesc.MaskingPolicy == nil {
+			return pgerror.Newf(pgcode.UndefinedObject,
+				"column %q does not have a masking policy", col.GetName())
+		}
+		colDesc.MaskingPolicy = nil
+		return p.maybeUpdateFunctionReferencesForColumn(ctx, tableDesc, colDesc)
+	}
+
+	fnID, err := p.resolveMaskingFunction(ctx, n.Func, col)
+	if err != nil {
+		return err
+	}
+	roles, err := decodeusername.FromRoleSpecList(
+		p.SessionData(), username.PurposeValidation, n.Roles,
+	)
+	if err != nil {
+		return err
+	}
+	roleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if err := p.CheckRoleExists(ctx, role); err != nil {
+			return err
+		}
+		roleNames = append(roleNames, role.Normalized())
+	}
+	colDesc.MaskingPolicy = &descpb.ColumnMaskingPolicy{
+		FunctionID: fnID,
+		RoleNames:  roleNames,
+	}
+	return p.maybeUpdateFunctionReferencesForColumn(ctx, tableDesc, colDesc)
+}
+
+// resolveMaskingFunction returns the ID of the user-defined function named by
+// a SET MASKING POLICY command. The function must take a single argument of
+// the column's type and return a value of the same type, so that masked values
+// can stand in for the originals.
+func (p *planner) resolveMaskingFunction(
+	ctx context.Context, fnName *tree.UnresolvedName, col catalog.Column,
+) (descpb.ID, error) {
+	routineName, err := fnName.ToRoutineName()
+	if err != nil {
+		return descpb.InvalidID, err
+	}
+	path := p.CurrentSearchPath()
+	fnDef, err := p.ResolveFunction(ctx, tree.MakeUnresolvedFunctionName(fnName), &path)
+	if err != nil {
+		return descpb.InvalidID, err
+	}
+	routineObj := tree.RoutineObj{
+		FuncName: routineName,
+		Params:   tree.RoutineParams{{Type: col.GetType(), Class: tree.RoutineParamIn}},
+	}
+	ol, err := fnDef.MatchOverload(
+		ctx, p, &routineObj, &path, tree.UDFRoutine, false /* inDropContext */, false, /* tryDefaultExprs */
+	)
+	if err != nil {
+		return descpb.InvalidID, err
+	}
+	if ol.Type != tree.UDFRoutine {
+		return descpb.InvalidID, pgerror.Newf(pgcode.WrongObjectType,
+			"masking policy function %s must be a user-defined function", fnDef.Name)
+	}
+	if ol.Class == tree.GeneratorClass || !ol.FixedReturnType().Identical(col.GetType()) {
+		return descpb.InvalidID, pgerror.Newf(pgcode.DatatypeMismatch,
+			"masking policy function %s must return a single value of type %s",
+			fnDef.Name, col.GetType().SQLString())
+	}
+	return funcdesc.UserDefinedFunctionOIDToID(ol.Oid), nil
+}
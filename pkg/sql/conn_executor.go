@@ -46,6 +46,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/execstats"
 	"github.com/cockroachdb/cockroach/pkg/sql/idxrecommendations"
 	"github.com/cockroachdb/cockroach/pkg/sql/idxusage"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
@@ -1684,6 +1685,11 @@ type connExecutor struct {
 		// and are destroyed when the transaction finishes.
 		sqlCursors cursorMap
 
+		// largeObjectDescs contains the large object descriptors opened with
+		// lo_open. Like in Postgres, they are closed when the transaction that
+		// opened them finishes.
+		largeObjectDescs largeobject.Descriptors
+
 		// shouldExecuteOnTxnFinish indicates that ex.onTxnFinish will be called
 		// when txn is finished (either committed or aborted). It is true when
 		// txn is started but can remain false when txn is executed within
@@ -2214,6 +2220,9 @@ func (ex *connExecutor) resetExtraTxnState(ctx context.Context, ev txnEvent, pay
 		log.Dev.Warningf(ctx, "error closing cursors: %v", err)
 	}
 
+	// Close all large object descriptors.
+	ex.extraTxnState.largeObjectDescs.Reset()
+
 	switch ev.eventType {
 	case txnCommit, txnRollback, txnPrepare:
 		ex.extraTxnState.prepStmtsNamespace.closeSnapshotPortals(
@@ -2577,6 +2586,39 @@ func (ex *connExecutor) execCmd() (retErr error) {
 			return err
 		}
 
+	case FunctionCall:
+		ex.phaseTimes.SetSessionPhaseTime(sessionphase.SessionQueryReceived, tcmd.TimeReceived)
+		// There is no SQL to parse for a function call.
+		ex.phaseTimes.SetSessionPhaseTime(sessionphase.SessionStartParse, 0)
+		ex.phaseTimes.SetSessionPhaseTime(sessionphase.SessionEndParse, 0)
+		err := func() error {
+			stmt, err := ex.makeFunctionCallStmt(ctx, tcmd)
+			if err != nil {
+				ev = eventNonRetryableErr{IsCommit: fsm.False}
+				payload = eventNonRetryableErrPayload{err: err}
+				res = ex.clientComm.CreateErrorResult(pos)
+				return nil
+			}
+			ex.curStmtAST = stmt.AST
+			fnRes := ex.clientComm.CreateFunctionCallResult(
+				pos,
+				tcmd.ResultFormat,
+				ex.sessionData().DataConversionConfig,
+				ex.sessionData().GetLocation(),
+			)
+			res = fnRes
+			// A function call message is always followed by an implicit Sync, so
+			// it can be auto-committed like the last statement of a simple query.
+			ev, payload, err = ex.execStmt(
+				ctx, stmt, nil /* portal */, nil /* pinfo */, fnRes, ex.implicitTxn(),
+			)
+			return err
+		}()
+		ex.statsCollector.PhaseTimes().SetSessionPhaseTime(sessionphase.SessionQueryServiced, crtime.NowMono())
+		if err != nil {
+			return err
+		}
+
 	case PrepareStmt:
 		ex.curStmtAST = tcmd.AST
 		res = ex.clientComm.CreatePrepareResult(pos)
@@ -2932,6 +2974,9 @@ func (ex *connExecutor) updateTxnRewindPosMaybe(
 				canAdvance = true
 			case DeletePreparedStmt:
 				canAdvance = true
+			case FunctionCall:
+				// Function calls can have side effects (e.g. writes to large
+				// objects), so they are treated like statements that need retries.
 			case SendError:
 				canAdvance = true
 			case Sync:
@@ -4054,6 +4099,7 @@ func (ex *connExecutor) initPlanner(ctx context.Context, p *planner) {
 	p.routineMetadataForwarder = nil
 	p.storedProcTxnState = ex.getStoredProcTxnStateAccessor()
 	p.createdSequences = ex.getCreatedSequencesAccessor()
+	p.largeObjectDescs = &ex.extraTxnState.largeObjectDescs
 
 	p.queryCacheSession.Init()
 	p.optPlanningCtx.init(p)
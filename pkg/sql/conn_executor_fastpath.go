@@ -0,0 +1,87 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgwirebase"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// makeFunctionCallStmt builds the statement that evaluates a FunctionCall
+// command. Only builtin functions can be invoked through the fastpath
+// protocol; the call is planned and executed as
+//
+//	SELECT schema.func(arg1::type1, ..., argN::typeN)
+//
+// where each argument is decoded according to the type of the corresponding
+// parameter of the builtin overload identified by the command's OID.
+func (ex *connExecutor) makeFunctionCallStmt(
+	ctx context.Context, cmd FunctionCall,
+) (statements.Statement[tree.Statement], error) {
+	var stmt statements.Statement[tree.Statement]
+	ol, ok := tree.OidToQualifiedBuiltinOverload[cmd.FuncOID]
+	if !ok {
+		return stmt, pgerror.Newf(pgcode.UndefinedFunction,
+			"function with OID %d does not exist", cmd.FuncOID)
+	}
+	paramTypes := ol.Types.Types()
+	if len(cmd.Args) != len(paramTypes) {
+		return stmt, pgwirebase.NewProtocolViolationErrorf(
+			"function call message supplies %d arguments, but function %s requires %d",
+			len(cmd.Args), tree.OidToBuiltinName[cmd.FuncOID], len(paramTypes))
+	}
+	formatCodes := cmd.ArgFormatCodes
+	if len(formatCodes) > 1 && len(formatCodes) != len(cmd.Args) {
+		return stmt, pgwirebase.NewProtocolViolationErrorf(
+			"wrong number of format codes specified: %d for %d arguments",
+			len(formatCodes), len(cmd.Args))
+	}
+
+	exprs := make(tree.Exprs, len(cmd.Args))
+	for i, arg := range cmd.Args {
+		code := pgwirebase.FormatText
+		if len(formatCodes) == 1 {
+			code = formatCodes[0]
+		} else if len(formatCodes) > 1 {
+			code = formatCodes[i]
+		}
+		var d tree.Datum = tree.DNull
+		if arg != nil {
+			var err error
+			d, err = pgwirebase.DecodeDatum(
+				ctx, ex.planner.EvalContext(), paramTypes[i], code, arg, ex.planner.datumAlloc,
+			)
+			if err != nil {
+				return stmt, pgerror.Wrapf(err, pgcode.ProtocolViolation,
+					"error in argument %d of function call", i+1)
+			}
+		}
+		// The explicit cast makes overload resolution pick the overload that
+		// the client asked for, even if the datum alone would be ambiguous.
+		exprs[i] = &tree.CastExpr{
+			Expr: d, Type: paramTypes[i], SyntaxMode: tree.CastShort,
+		}
+	}
+
+	name := tree.MakeUnresolvedName(ol.Schema, tree.OidToBuiltinName[cmd.FuncOID])
+	stmt.AST = &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: tree.SelectExprs{{
+				Expr: &tree.FuncExpr{
+					Func:  tree.ResolvableFunctionReference{FunctionReference: &name},
+					Exprs: exprs,
+				},
+			}},
+		},
+	}
+	stmt.SQL = tree.AsString(stmt.AST)
+	return stmt, nil
+}
@@ -452,6 +452,38 @@ func (DrainRequest) String() string {
 
 var _ Command = DrainRequest{}
 
+// FunctionCall is the command for invoking a function through the pgwire
+// "fastpath" function call protocol. Client drivers use it to implement
+// client-side large object functions.
+type FunctionCall struct {
+	// FuncOID is the OID of the function to call.
+	FuncOID oid.Oid
+	// Args are the encoded function arguments. A nil element represents a NULL
+	// argument.
+	Args [][]byte
+	// ArgFormatCodes describe how each argument is encoded. As with BindStmt,
+	// it can be empty (all arguments are text), contain a single code that
+	// applies to all arguments, or contain one code per argument.
+	ArgFormatCodes []pgwirebase.FormatCode
+	// ResultFormat is the format in which the result is to be encoded.
+	ResultFormat pgwirebase.FormatCode
+	// TimeReceived is the time at which the message was received from the
+	// client. Used to compute the service latency.
+	TimeReceived crtime.Mono
+}
+
+// command implements the Command interface.
+func (FunctionCall) command() string { return "function call" }
+
+// isExtendedProtocolCmd implements the Command interface.
+func (e FunctionCall) isExtendedProtocolCmd() bool { return false }
+
+func (e FunctionCall) String() string {
+	return fmt.Sprintf("FunctionCall: oid=%d nargs=%d", e.FuncOID, len(e.Args))
+}
+
+var _ Command = FunctionCall{}
+
 // SendError is a command that, upon execution, send a specific error to the
 // client. This is used by pgwire to schedule errors to be sent at an
 // appropriate time.
@@ -779,6 +811,14 @@ type ClientComm interface {
 	CreateCopyOutResult(cmd CopyOut, pos CmdPos) CopyOutResult
 	// CreateDrainResult creates a result for a Drain command.
 	CreateDrainResult(pos CmdPos) DrainResult
+	// CreateFunctionCallResult creates a result for a FunctionCall command. The
+	// single value produced by the function is encoded using resultFormat.
+	CreateFunctionCallResult(
+		pos CmdPos,
+		resultFormat pgwirebase.FormatCode,
+		conv sessiondatapb.DataConversionConfig,
+		location *time.Location,
+	) CommandResult
 
 	// LockCommunication ensures that no further results are delivered to the
 	// client. The returned ClientLock can be queried to see what results have
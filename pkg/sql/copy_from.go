@@ -428,6 +428,18 @@ func (c *copyMachine) canSupportVectorized(table catalog.TableDescriptor) bool {
 	if len(table.VectorIndexes()) > 0 {
 		return false
 	}
+	// The columnar row encoder does not encode the entries of BRIN indexes.
+	for _, idx := range table.WritableNonPrimaryIndexes() {
+		if idx.GetType().IsSummary() {
+			return false
+		}
+	}
+	// The columnar row encoder cannot encrypt values.
+	for _, col := range table.WritableColumns() {
+		if col.IsEncrypted() {
+			return false
+		}
+	}
 	forcePut := table.GetPrimaryIndex().ForcePut()
 	secondaryIndexes := table.WritableNonPrimaryIndexes()
 	for i := 0; !forcePut && i < len(secondaryIndexes); i++ {
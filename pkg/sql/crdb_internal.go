@@ -384,6 +384,7 @@ CREATE TABLE crdb_internal.databases (
 				createNode := tree.CreateDatabase{}
 				createNode.ConnectionLimit = -1
 				createNode.Name = tree.Name(db.GetName())
+				createNode.Collate = db.GetDefaultCollation()
 				if db.IsMultiRegion() {
 					primaryRegion = tree.NewDString(string(db.GetRegionConfig().PrimaryRegion))
 					createNode.PrimaryRegion = tree.Name(db.GetRegionConfig().PrimaryRegion)
@@ -9,6 +9,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
@@ -59,17 +60,18 @@ func (p *planner) CreateDatabase(ctx context.Context, n *tree.CreateDatabase) (p
 		}
 	}
 
-	if col := n.Collate; col != "" {
-		// We only support C and C.UTF-8.
-		if col != "C" && col != "C.UTF-8" {
-			return nil, unimplemented.NewWithIssueDetailf(16618, "create.db.collation",
-				"unsupported collation: %s", col)
-		}
+	if locale, err := n.DefaultCollation(); err != nil {
+		return nil, err
+	} else if locale != "" &&
+		!p.EvalContext().Settings.Version.ActiveVersion(ctx).IsActive(clusterversion.V26_2_DatabaseDefaultCollation) {
+		return nil, pgerror.New(pgcode.FeatureNotSupported,
+			"cannot create a database with a default collation until finalizing on 26.2")
 	}
 
 	if ctype := n.CType; ctype != "" {
-		// We only support C and C.UTF-8.
-		if ctype != "C" && ctype != "C.UTF-8" {
+		// Character classification always follows Unicode, so we only support
+		// C, C.UTF-8 and the locale of the collation.
+		if ctype != "C" && ctype != "C.UTF-8" && ctype != n.Collate {
 			return nil, unimplemented.NewWithIssueDetailf(35882, "create.db.classification",
 				"unsupported character classification: %s", ctype)
 		}
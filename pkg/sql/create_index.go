@@ -59,6 +59,11 @@ func (p *planner) CreateIndex(ctx context.Context, n *tree.CreateIndex) (planNod
 		return nil, err
 	}
 
+	if n.Type == idxtype.BRIN &&
+		!p.EvalContext().Settings.Version.ActiveVersion(ctx).IsActive(clusterversion.V26_2_BrinIndexes) {
+		return nil, pgerror.Newf(pgcode.FeatureNotSupported, "cannot create a BRIN index until finalizing on 26.2")
+	}
+
 	// Check if sql_safe_updates is enabled and this is a vector index
 	if n.Type == idxtype.VECTOR {
 		if !p.EvalContext().Settings.Version.ActiveVersion(ctx).AtLeast(clusterversion.V25_2.Version()) {
@@ -238,6 +243,15 @@ func makeIndexDescriptor(
 			"%s indexes can't be unique", strings.ToLower(n.Type.String()))
 	}
 
+	if n.Type == idxtype.BRIN {
+		if n.Predicate != nil {
+			return nil, pgerror.New(pgcode.InvalidSQLStatementName, "brin indexes can't be partial")
+		}
+		if n.PartitionByIndex.ContainsPartitions() {
+			return nil, pgerror.New(pgcode.InvalidSQLStatementName, "brin indexes don't support partitioning")
+		}
+	}
+
 	if n.Type == idxtype.INVERTED {
 		invCol := columns[len(columns)-1]
 		column, err := catalog.MustFindColumnByTreeName(tableDesc, invCol.Column)
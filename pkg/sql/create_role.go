@@ -173,6 +173,11 @@ func (n *CreateRoleNode) startExec(params runParams) error {
 			rowsAffected,
 		)
 	}
+	if hashedPassword != nil {
+		if err := params.p.recordPasswordHistory(params.ctx, n.roleName, hashedPassword); err != nil {
+			return err
+		}
+	}
 
 	_, err = updateRoleOptions(params, opName, n.roleOptions, n.roleName, sqltelemetry.CreateRole)
 	if err != nil {
@@ -333,6 +338,9 @@ func (p *planner) checkPasswordAndGetHash(
 		return nil, errors.WithHintf(security.ErrPasswordTooShort,
 			"Passwords must be %d characters or longer.", minLength)
 	}
+	if err := security.CheckPasswordComplexity(&st.SV, passwordStr); err != nil {
+		return nil, err
+	}
 
 	method := security.GetConfiguredPasswordHashMethod(&st.SV)
 	cost, err := security.GetConfiguredPasswordCost(ctx, &st.SV, method)
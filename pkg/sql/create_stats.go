@@ -525,6 +525,10 @@ func (n *createStatsNode) makeJobRecord(ctx context.Context) (*jobs.Record, erro
 						"on virtual columns",
 				)
 			}
+			if columns[i].IsEncrypted() {
+				return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+					"cannot create statistics on encrypted column %q", columns[i].ColName())
+			}
 			if typFam := columns[i].GetType().Family(); n.Options.UsingExtremes &&
 				(typFam == types.BoolFamily || typFam == types.EnumFamily) &&
 				!n.p.SessionData().EnableCreateStatsUsingExtremesBoolEnum {
@@ -903,8 +907,9 @@ func createStatsDefaultColumns(
 	for i := 0; i < len(desc.PublicColumns()) && nonIdxCols < maxNonIndexCols; i++ {
 		col := desc.PublicColumns()[i]
 
-		// Skip unsupported virtual computed columns.
-		if isUnsupportedVirtual(col) {
+		// Skip unsupported virtual computed columns, and encrypted columns, whose
+		// histograms would reveal their values.
+		if isUnsupportedVirtual(col) || col.IsEncrypted() {
 			continue
 		}
 
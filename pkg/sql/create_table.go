@@ -1597,10 +1597,17 @@ func NewTableDesc(
 		}
 	}
 
+	// Add the hidden partition columns of PARTITION BY HASH clauses.
+	for _, def := range hashPartitionColumnDefs(n) {
+		n.Defs = append(n.Defs, def)
+		cdd = append(cdd, nil)
+	}
+
 	if n.PartitionByTable.ContainsPartitioningClause() {
 		// Table PARTITION BY columns are always part of the primary index
-		// column set.
-		if n.PartitionByTable.PartitionBy != nil {
+		// column set. The columns of a PARTITION BY HASH are not, as it is the
+		// hidden partition column which prefixes the primary index.
+		if n.PartitionByTable.PartitionBy != nil && !n.PartitionByTable.PartitionBy.IsHash() {
 			for _, field := range n.PartitionByTable.PartitionBy.Fields {
 				primaryIndexColumnSet[string(field)] = struct{}{}
 			}
@@ -1638,6 +1645,9 @@ func NewTableDesc(
 			if d.IsComputed() {
 				d.Computed.Expr = schemaexpr.MaybeRewriteComputedColumn(d.Computed.Expr, evalCtx.SessionData())
 			}
+			if db != nil {
+				d = d.WithDatabaseCollation(db.GetDefaultCollation())
+			}
 			// NewTableDesc is called sometimes with a nil SemaCtx (for example
 			// during bootstrapping). In order to not panic, pass a nil TypeResolver
 			// when attempting to resolve the columns type.
@@ -2187,6 +2197,19 @@ func NewTableDesc(
 			})
 		}
 	}
+	// Encrypted columns without an explicit family are placed in a family of
+	// their own, so that each ciphertext occupies a whole KV value. Make sure
+	// family 0 exists first so that it doesn't end up holding the ciphertext.
+	for _, def := range n.Defs {
+		d, ok := def.(*tree.ColumnTableDef)
+		if !ok || !d.IsEncrypted() || columnHasFamily(desc, string(d.Name)) {
+			continue
+		}
+		if len(desc.Families) == 0 {
+			desc.AddFamily(descpb.ColumnFamilyDescriptor{Name: "primary"})
+		}
+		desc.AddFamily(descpb.ColumnFamilyDescriptor{ColumnNames: []string{string(d.Name)}})
+	}
 	if err := desc.AllocateIDs(ctx, version); err != nil {
 		return nil, err
 	}
@@ -2552,6 +2575,22 @@ func newTableDesc(
 		return nil, err
 	}
 
+	// Generate and wrap the data keys of any encrypted columns.
+	for _, def := range n.Defs {
+		d, ok := def.(*tree.ColumnTableDef)
+		if !ok || !d.IsEncrypted() {
+			continue
+		}
+		for i := range ret.Columns {
+			if ret.Columns[i].Name == string(d.Name) {
+				if err := params.p.initColumnEncryption(params.ctx, id, d, &ret.Columns[i]); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+	}
+
 	// We need to ensure sequence ownerships so that column owned sequences are
 	// correctly dropped when a column/table is dropped.
 	for colName, seqDesc := range colNameToOwnedSeq {
@@ -2627,6 +2666,53 @@ func rowLevelTTLAutomaticColumnDef(ttl *catpb.RowLevelTTL) (*tree.ColumnTableDef
 	return def, nil
 }
 
+// hashPartitionColumnDefs returns the definitions of the hidden partition
+// columns needed by the PARTITION BY HASH clauses of a CREATE TABLE, skipping
+// those which are already defined, e.g. in the output of SHOW CREATE TABLE.
+func hashPartitionColumnDefs(n *tree.CreateTable) []*tree.ColumnTableDef {
+	var partBys []*tree.PartitionBy
+	if n.PartitionByTable != nil {
+		partBys = append(partBys, n.PartitionByTable.PartitionBy)
+	}
+	definedColNames := make(map[tree.Name]struct{})
+	for _, def := range n.Defs {
+		switch d := def.(type) {
+		case *tree.ColumnTableDef:
+			definedColNames[d.Name] = struct{}{}
+		case *tree.IndexTableDef:
+			if d.PartitionByIndex != nil {
+				partBys = append(partBys, d.PartitionByIndex.PartitionBy)
+			}
+		case *tree.UniqueConstraintTableDef:
+			if d.PartitionByIndex != nil {
+				partBys = append(partBys, d.PartitionByIndex.PartitionBy)
+			}
+		}
+	}
+	var defs []*tree.ColumnTableDef
+	for _, partBy := range partBys {
+		if !partBy.IsHash() {
+			continue
+		}
+		colNames, hashColName := tabledesc.HashPartitionColumnNames(partBy)
+		if _, ok := definedColNames[tree.Name(hashColName)]; ok {
+			continue
+		}
+		definedColNames[tree.Name(hashColName)] = struct{}{}
+		def := &tree.ColumnTableDef{
+			Name:   tree.Name(hashColName),
+			Type:   types.Int,
+			Hidden: true,
+		}
+		def.Nullable.Nullability = tree.NotNull
+		def.Computed.Computed = true
+		def.Computed.Virtual = true
+		def.Computed.Expr = schemaexpr.MakeHashPartitionComputeExpr(colNames, int(partBy.HashPartitions))
+		defs = append(defs, def)
+	}
+	return defs
+}
+
 func rowLevelTTLAutomaticColumnExpr(intervalExpr tree.Expr) tree.Expr {
 	return &tree.BinaryExpr{
 		Operator: treebin.MakeBinaryOperator(treebin.Plus),
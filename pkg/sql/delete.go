@@ -160,8 +160,10 @@ func (d *deleteNode) processBatch(params runParams) (lastBatch bool, err error)
 		if err = d.run.td.finalize(params.ctx); err != nil {
 			return false, err
 		}
-		// Possibly initiate a run of CREATE STATISTICS.
+		// Possibly initiate a run of CREATE STATISTICS and a summarization of
+		// the BRIN indexes of the table.
 		params.ExecCfg().StatsRefresher.NotifyMutation(params.ctx, d.run.td.tableDesc(), int(d.run.rowsAffected()))
+		params.ExecCfg().BrinSummarizer.NotifyMutation(params.ctx, d.run.td.tableDesc(), int(d.run.rowsAffected()))
 	}
 	return lastBatch, nil
 }
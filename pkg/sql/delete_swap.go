@@ -113,8 +113,10 @@ func (d *deleteSwapNode) processBatch(params runParams) error {
 		return err
 	}
 
-	// Possibly initiate a run of CREATE STATISTICS.
+	// Possibly initiate a run of CREATE STATISTICS and a summarization of
+	// the BRIN indexes of the table.
 	params.ExecCfg().StatsRefresher.NotifyMutation(params.ctx, d.run.td.tableDesc(), int(d.run.rowsAffected()))
+	params.ExecCfg().BrinSummarizer.NotifyMutation(params.ctx, d.run.td.tableDesc(), int(d.run.rowsAffected()))
 
 	return nil
 }
@@ -113,6 +113,11 @@ func (p *planner) createDatabase(
 		return nil, false, err
 	}
 
+	defaultCollation, err := database.DefaultCollation()
+	if err != nil {
+		return nil, false, err
+	}
+
 	owner := p.SessionData().User()
 	if !database.Owner.Undefined() {
 		owner, err = decodeusername.FromRoleSpec(
@@ -129,6 +134,7 @@ func (p *planner) createDatabase(
 		owner,
 		dbdesc.MaybeWithDatabaseRegionConfig(regionConfig),
 		dbdesc.WithPublicSchemaID(publicSchemaID),
+		dbdesc.WithDefaultCollation(defaultCollation),
 	)
 	includeCreatePriv := sqlclustersettings.PublicSchemaCreatePrivilegeEnabled.Get(&p.execCfg.Settings.SV)
 	publicSchema := schemadesc.NewBuilder(&descpb.SchemaDescriptor{
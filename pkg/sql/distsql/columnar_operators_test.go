@@ -104,6 +104,10 @@ var aggregateFuncToNumArguments = map[execinfrapb.AggregatorSpec_Func]int{
 	execinfrapb.MergeStatementStats:         1,
 	execinfrapb.MergeTransactionStats:       1,
 	execinfrapb.MergeAggregatedStmtMetadata: 1,
+	execinfrapb.XMLAgg:                      1,
+	execinfrapb.STClusterIntersecting:       1,
+	execinfrapb.STClusterWithin:             2,
+	execinfrapb.STPolygonize:                1,
 }
 
 // TestAggregateFuncToNumArguments ensures that all aggregate functions are
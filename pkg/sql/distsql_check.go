@@ -15,6 +15,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/buildutil"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/tsearch"
 	"github.com/cockroachdb/errors"
 )
 
@@ -56,6 +57,7 @@ const (
 	vectorSearchProhibited
 	systemColumnsAndBufferedWritesProhibited
 	valuesNodeProhibited
+	textSearchConfigProhibited
 	numDistSQLBlockers
 )
 
@@ -106,6 +108,9 @@ func (v *distSQLExprCheckVisitor) VisitPre(expr tree.Expr) (recurse bool, newExp
 		if t.IsDistSQLBlocklist() {
 			v.blockers.addSingle(funcDistSQLBlocklist)
 		}
+		if mayUseUserDefinedTextSearchConfig(t) {
+			v.blockers.addSingle(textSearchConfigProhibited)
+		}
 	case *tree.RoutineExpr:
 		v.blockers.addSingle(routineProhibited)
 	case *tree.DOid:
@@ -166,6 +171,25 @@ func hasOidType(t *types.T) bool {
 	return false
 }
 
+// mayUseUserDefinedTextSearchConfig returns whether the given function call
+// uses a text search configuration that might not be built in. User-defined
+// configurations are resolved through the planner, so they can't be used on
+// remote nodes.
+func mayUseUserDefinedTextSearchConfig(f *tree.FuncExpr) bool {
+	ov := f.ResolvedOverload()
+	if ov == nil || ov.Type == tree.UDFRoutine || ov.Type == tree.ProcedureRoutine {
+		return false
+	}
+	params, ok := ov.Types.(tree.ParamTypes)
+	if !ok || len(params) == 0 || params[0].Name != "config" || len(f.Exprs) == 0 {
+		return false
+	}
+	if d, ok := f.Exprs[0].(*tree.DString); ok {
+		return !tsearch.IsBuiltinConfig(string(*d))
+	}
+	return true
+}
+
 // checkExprForDistSQL verifies that an expression doesn't contain things that
 // are not yet supported by distSQL, like distSQL-blocklisted functions. Zero
 // value indicates that everything is supported.
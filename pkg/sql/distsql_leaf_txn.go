@@ -322,6 +322,12 @@ func (v *reducedLeafExprVisitor) VisitPre(expr tree.Expr) (recurse bool, newExpr
 			v.unsafe = true
 			return false, expr
 		}
+		if mayUseUserDefinedTextSearchConfig(t) {
+			// User-defined text search configurations are resolved via the
+			// planner.
+			v.unsafe = true
+			return false, expr
+		}
 	case *tree.RoutineExpr:
 		// Routines could do arbitrary things.
 		v.unsafe = true
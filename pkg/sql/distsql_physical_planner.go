@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catenumpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/colflow"
 	"github.com/cockroachdb/cockroach/pkg/sql/distsql"
 	"github.com/cockroachdb/cockroach/pkg/sql/execinfra"
@@ -526,6 +527,18 @@ func (p *PlanningCtx) getPortalPauseInfo() *portalPauseInfo {
 	return nil
 }
 
+// addColumnEncryptionKeys adds to the fetch spec the data keys of the
+// encrypted columns that the planning user may decrypt. Without a planner, no
+// keys are added and fetching encrypted values fails.
+func (p *PlanningCtx) addColumnEncryptionKeys(
+	ctx context.Context, desc catalog.TableDescriptor, spec *fetchpb.IndexFetchSpec,
+) error {
+	if p.planner == nil {
+		return nil
+	}
+	return p.planner.addColumnEncryptionKeys(ctx, desc, spec)
+}
+
 // setUpForMainQuery updates the PlanningCtx for the main query path.
 func (p *PlanningCtx) setUpForMainQuery(
 	ctx context.Context, planner *planner, recv *DistSQLReceiver,
@@ -1742,6 +1755,9 @@ func (dsp *DistSQLPlanner) createTableReaders(
 	if err != nil {
 		return nil, err
 	}
+	if err := planCtx.addColumnEncryptionKeys(ctx, n.desc, &spec.FetchSpec); err != nil {
+		return nil, err
+	}
 
 	p := planCtx.NewPhysicalPlan()
 	err = dsp.planTableReaders(
@@ -2902,6 +2918,9 @@ func (dsp *DistSQLPlanner) planIndexJoin(
 	); err != nil {
 		return err
 	}
+	if err := planCtx.addColumnEncryptionKeys(ctx, planInfo.fetch.desc, &joinReaderSpec.FetchSpec); err != nil {
+		return err
+	}
 
 	var splitter span.Splitter
 	// This logic matches opt.Locking.MustLockAllRequestedColumnFamilies.
@@ -3007,6 +3026,9 @@ func (dsp *DistSQLPlanner) planLookupJoin(
 	); err != nil {
 		return err
 	}
+	if err := planCtx.addColumnEncryptionKeys(ctx, planInfo.fetch.desc, &joinReaderSpec.FetchSpec); err != nil {
+		return err
+	}
 
 	var splitter span.Splitter
 	// This logic matches opt.Locking.MustLockAllRequestedColumnFamilies.
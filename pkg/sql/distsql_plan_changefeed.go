@@ -144,6 +144,7 @@ func PlanCDCExpression(
 
 	// Walk the plan, perform sanity checks and extract information we need.
 	var spans roachpb.Spans
+	user := p.User()
 	var validatePlanAndCollectSpans func(p planNode) error
 	validatePlanAndCollectSpans = func(p planNode) error {
 		switch n := p.(type) {
@@ -159,6 +160,35 @@ func PlanCDCExpression(
 					"expect scan of primary index, found scan of %d", n.index.GetID())
 			}
 			spans = n.spans
+			if masked := memo.Metadata().MaskedColumns(cat.StableID(n.desc.GetID())); !masked.Empty() {
+				// The changefeed's key is made of the stored values of the
+				// primary key columns, regardless of the projection, so none of
+				// them may be masked for the user.
+				pk := n.desc.GetPrimaryIndex()
+				for i := 0; i < pk.NumKeyColumns(); i++ {
+					col, err := catalog.MustFindColumnByID(n.desc, pk.GetKeyColumnID(i))
+					if err != nil {
+						return err
+					}
+					if masked.Contains(col.Ordinal()) {
+						return pgerror.Newf(pgcode.InsufficientPrivilege,
+							"primary key column %q of table %q is masked for user %s",
+							col.GetName(), n.desc.GetName(), user)
+					}
+				}
+				// The extra columns, such as the changefeed's cdc_prev tuple,
+				// hold the stored values of the table's columns, so they cannot
+				// be read if some of these columns are masked for the user.
+				for _, col := range n.catalogCols {
+					for _, extra := range cfg.extraColumns {
+						if col.GetID() == extra.GetID() {
+							return pgerror.Newf(pgcode.InsufficientPrivilege,
+								"%s cannot be used because table %q has columns masked for user %s",
+								extra.GetName(), n.desc.GetName(), user)
+						}
+					}
+				}
+			}
 		case *zeroNode:
 			return errors.Newf(
 				"changefeed expression %s does not match any rows", tree.AsString(cdcExpr))
@@ -321,6 +321,9 @@ func (e *distSQLSpecExecFactory) ConstructScan(
 	if err := rowenc.InitIndexFetchSpec(&trSpec.FetchSpec, e.planner.ExecCfg().Codec, tabDesc, idx, columnIDs); err != nil {
 		return nil, err
 	}
+	if err := e.planner.addColumnEncryptionKeys(e.ctx, tabDesc, &trSpec.FetchSpec); err != nil {
+		return nil, err
+	}
 	trSpec.LockingStrength = descpb.ToScanLockingStrength(params.Locking.Strength)
 	trSpec.LockingWaitPolicy = descpb.ToScanLockingWaitPolicy(params.Locking.WaitPolicy)
 	trSpec.LockingDurability = descpb.ToScanLockingDurability(params.Locking.Durability)
@@ -29,7 +29,8 @@ func _() {
 	_ = x[vectorSearchProhibited-8192]
 	_ = x[systemColumnsAndBufferedWritesProhibited-16384]
 	_ = x[valuesNodeProhibited-32768]
-	_ = x[numDistSQLBlockers-65536]
+	_ = x[textSearchConfigProhibited-65536]
+	_ = x[numDistSQLBlockers-131072]
 }
 
 func (i distSQLBlocker) String() string {
@@ -66,6 +67,8 @@ func (i distSQLBlocker) String() string {
 		return "systemColumnsAndBufferedWritesProhibited"
 	case valuesNodeProhibited:
 		return "valuesNodeProhibited"
+	case textSearchConfigProhibited:
+		return "textSearchConfigProhibited"
 	case numDistSQLBlockers:
 		return "numDistSQLBlockers"
 	default:
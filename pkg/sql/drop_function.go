@@ -88,6 +88,9 @@ func (p *planner) DropFunction(ctx context.Context, n *tree.DropRoutine) (ret pl
 				mut.Name, strings.Join(depNames, ", "),
 			)
 		}
+		if err := p.functionUsedByCastOrOperator(ctx, mut); err != nil {
+			return nil, err
+		}
 		dropNode.toDrop = append(dropNode.toDrop, mut)
 	}
 
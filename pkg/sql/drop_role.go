@@ -8,6 +8,8 @@ package sql
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 
@@ -203,6 +205,25 @@ func (n *DropRoleNode) startExec(params runParams) error {
 				break
 			}
 		}
+		// Privileges granted on columns also prevent the roles from being dropped.
+		for _, col := range tableDescriptor.PublicColumns() {
+			colPrivs := col.ColumnDesc().Privileges
+			if colPrivs == nil {
+				continue
+			}
+			for _, u := range colPrivs.Users {
+				if _, ok := userNames[u.User()]; ok {
+					if privilegeObjectFormatter.Len() > 0 {
+						privilegeObjectFormatter.WriteString(", ")
+					}
+					parentName := lCtx.getDatabaseName(tableDescriptor)
+					schemaName := lCtx.getSchemaName(tableDescriptor)
+					tn := tree.MakeTableNameWithSchema(tree.Name(parentName), tree.Name(schemaName), tree.Name(tableDescriptor.GetName()))
+					privilegeObjectFormatter.FormatNode(tree.NewColumnItem(&tn, tree.Name(col.GetName())))
+					break
+				}
+			}
+		}
 		// Check that any of the roles we are dropping aren't referenced in any of
 		// the row-level security policies defined on this table.
 		for _, p := range tableDescriptor.GetPolicies() {
@@ -217,6 +238,23 @@ func (n *DropRoleNode) startExec(params runParams) error {
 				}
 			}
 		}
+		// The same holds for the masking policies on the table's columns.
+		for _, col := range tableDescriptor.PublicColumns() {
+			mp := col.ColumnDesc().MaskingPolicy
+			if mp == nil {
+				continue
+			}
+			for _, rn := range mp.RoleNames {
+				roleName := username.MakeSQLUsernameFromPreNormalizedString(rn)
+				if _, found := userNames[roleName]; found {
+					return errors.WithDetailf(
+						pgerror.Newf(pgcode.DependentObjectsStillExist,
+							"role %q cannot be dropped because some objects depend on it",
+							roleName),
+						"target of masking policy on column %q of table %q", col.GetName(), tableDescriptor.GetName())
+				}
+			}
+		}
 	}
 	for _, schemaDesc := range lCtx.schemaDescs {
 		if !descriptorIsVisible(schemaDesc, true /* allowAdding */, false /* includeDropped */) {
@@ -235,6 +273,31 @@ func (n *DropRoleNode) startExec(params runParams) error {
 				})
 		}
 
+		// Text search objects are stored in their schema's descriptor, and are
+		// owned by the roles that created them.
+		if sc := schemaDesc.SchemaDesc(); sc != nil {
+			for _, name := range slices.Sorted(maps.Keys(sc.TextSearchDictionaries)) {
+				roleName := username.MakeSQLUsernameFromPreNormalizedString(sc.TextSearchDictionaries[name].Owner)
+				if _, found := userNames[roleName]; found {
+					return errors.WithDetailf(
+						pgerror.Newf(pgcode.DependentObjectsStillExist,
+							"role %q cannot be dropped because some objects depend on it",
+							roleName),
+						"owner of text search dictionary %q in schema %q", name, schemaDesc.GetName())
+				}
+			}
+			for _, name := range slices.Sorted(maps.Keys(sc.TextSearchConfigs)) {
+				roleName := username.MakeSQLUsernameFromPreNormalizedString(sc.TextSearchConfigs[name].Owner)
+				if _, found := userNames[roleName]; found {
+					return errors.WithDetailf(
+						pgerror.Newf(pgcode.DependentObjectsStillExist,
+							"role %q cannot be dropped because some objects depend on it",
+							roleName),
+						"owner of text search configuration %q in schema %q", name, schemaDesc.GetName())
+				}
+			}
+		}
+
 		dbDesc, err := lCtx.getDatabaseByID(schemaDesc.GetParentID())
 		if err != nil {
 			return err
@@ -429,6 +492,10 @@ func (n *DropRoleNode) startExec(params runParams) error {
 				normalizedUsername, numSchedules)
 		}
 
+		if err := params.p.deleteLoginPolicyRows(params.ctx, normalizedUsername); err != nil {
+			return err
+		}
+
 		numUsersDeleted, err := params.p.InternalSQLTxn().ExecEx(
 			params.ctx,
 			opName,
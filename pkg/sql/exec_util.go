@@ -61,6 +61,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/lease"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
 	"github.com/cockroachdb/cockroach/pkg/sql/clusterunique"
+	"github.com/cockroachdb/cockroach/pkg/sql/colencryption"
 	"github.com/cockroachdb/cockroach/pkg/sql/contention"
 	"github.com/cockroachdb/cockroach/pkg/sql/distsql"
 	"github.com/cockroachdb/cockroach/pkg/sql/execinfra"
@@ -74,6 +75,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
 	"github.com/cockroachdb/cockroach/pkg/sql/parserutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/hba"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgwirebase"
@@ -1724,6 +1726,7 @@ type ExecutorConfig struct {
 	DistSQLPlanner      *DistSQLPlanner
 	TableStatsCache     *stats.TableStatisticsCache
 	StatsRefresher      *stats.Refresher
+	BrinSummarizer      *BrinSummarizer
 	QueryCache          *querycache.C
 	StatementHintsCache *hints.StatementHintsCache
 	VecIndexManager     *vecindex.Manager
@@ -1789,6 +1792,10 @@ type ExecutorConfig struct {
 
 	ExternalIODirConfig base.ExternalIODirConfig
 
+	// ColumnEncryptionKeyCache caches the unwrapped data keys of encrypted
+	// columns.
+	ColumnEncryptionKeyCache *colencryption.KeyCache
+
 	GCJobNotifier *gcjobnotifier.Notifier
 
 	RangeFeedFactory *rangefeed.Factory
@@ -1925,6 +1932,11 @@ type ExecutorConfig struct {
 	// CidrLookup is used to look up the tag name for a given IP address.
 	CidrLookup *cidr.Lookup
 
+	// HBAConfiguration returns the host-based authentication rules
+	// currently in effect on this node. It is used to populate
+	// pg_catalog.pg_hba_file_rules and may be nil in tests.
+	HBAConfiguration func() *hba.Conf
+
 	// LicenseEnforcer is used to enforce the license profiles.
 	LicenseEnforcer *license.Enforcer
 }
@@ -2537,6 +2549,7 @@ func checkResultType(typ *types.T, fmtCode pgwirebase.FormatCode) error {
 	case types.EnumFamily:
 	case types.VoidFamily:
 	case types.LTreeFamily:
+	case types.XMLFamily:
 	case types.ArrayFamily:
 		if fmtCode == pgwirebase.FormatBinary && typ.ArrayContents().Family() == types.ArrayFamily {
 			return unimplemented.NewWithIssueDetail(32552,
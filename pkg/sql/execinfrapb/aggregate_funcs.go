@@ -73,4 +73,8 @@ const (
 	MergeStatementStats         = AggregatorSpec_MERGE_STATEMENT_STATS
 	MergeTransactionStats       = AggregatorSpec_MERGE_TRANSACTION_STATS
 	MergeAggregatedStmtMetadata = AggregatorSpec_MERGE_AGGREGATED_STMT_METADATA
+	XMLAgg                      = AggregatorSpec_XMLAGG
+	STClusterIntersecting       = AggregatorSpec_ST_CLUSTERINTERSECTING
+	STClusterWithin             = AggregatorSpec_ST_CLUSTERWITHIN
+	STPolygonize                = AggregatorSpec_ST_POLYGONIZE
 )
@@ -8,6 +8,7 @@ package faketreeeval
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/clusterversion"
@@ -16,6 +17,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/hintpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
@@ -30,6 +32,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/mon"
 	"github.com/cockroachdb/cockroach/pkg/util/rangedesc"
+	"github.com/cockroachdb/cockroach/pkg/util/tsearch"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
 	"github.com/lib/pq/oid"
@@ -257,8 +260,17 @@ func (*DummyEvalPlanner) ExternalReadFile(ctx context.Context, uri string) ([]by
 	return nil, errors.WithStack(errEvalPlanner)
 }
 
+// ExternalStreamFile is part of the Planner interface.
+func (*DummyEvalPlanner) ExternalStreamFile(
+	ctx context.Context, uri string, fn func(io.Reader) error,
+) error {
+	return errors.WithStack(errEvalPlanner)
+}
+
 // ExternalWriteFile is part of the Planner interface.
-func (*DummyEvalPlanner) ExternalWriteFile(ctx context.Context, uri string, content []byte) error {
+func (*DummyEvalPlanner) ExternalWriteFile(
+	ctx context.Context, uri string, content io.Reader,
+) error {
 	return errors.WithStack(errEvalPlanner)
 }
 
@@ -327,6 +339,13 @@ func (*DummyEvalPlanner) RepairTTLScheduledJobForTable(ctx context.Context, tabl
 	return errors.WithStack(errEvalPlanner)
 }
 
+// BrinSummarize is part of the Planner interface.
+func (*DummyEvalPlanner) BrinSummarize(
+	ctx context.Context, tableID int64, indexName string, rowsPerBlock int64,
+) (int64, error) {
+	return 0, errors.WithStack(errEvalPlanner)
+}
+
 // TxnMon is part of the eval.Planner interface.
 func (ep *DummyEvalPlanner) TxnMon() *mon.BytesMonitor {
 	// DummyEvalPlanner is only used for remote flows during the execution, so
@@ -359,6 +378,21 @@ func (*DummyEvalPlanner) PLpgSQLCloseCursor(_ tree.Name) error {
 	return errors.WithStack(errEvalPlanner)
 }
 
+// LargeObjects is part of the eval.Planner interface.
+func (*DummyEvalPlanner) LargeObjects() (*largeobject.Manager, error) {
+	return nil, errors.WithStack(errEvalPlanner)
+}
+
+// ResolveTextSearchConfig is part of the eval.Planner interface. Built-in
+// configurations are resolved without the planner, so this is only reached for
+// user-defined ones.
+func (*DummyEvalPlanner) ResolveTextSearchConfig(
+	_ context.Context, name string,
+) (*tsearch.Config, error) {
+	return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+		"user-defined text search configuration %q cannot be used in this context", name)
+}
+
 // PLpgSQLFetchCursor is part of the Planner interface.
 func (*DummyEvalPlanner) PLpgSQLFetchCursor(
 	context.Context, *tree.CursorStmt,
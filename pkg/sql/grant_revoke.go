@@ -47,6 +47,9 @@ func (p *planner) Grant(ctx context.Context, n *tree.Grant) (planNode, error) {
 	if err := privilege.ValidatePrivileges(n.Privileges, grantOn); err != nil {
 		return nil, err
 	}
+	if err := p.validateColumnPrivileges(ctx, n.ColumnPrivileges, grantOn); err != nil {
+		return nil, err
+	}
 
 	grantees, err := decodeusername.FromRoleSpecList(
 		p.SessionData(), username.PurposeValidation, n.Grantees,
@@ -75,6 +78,7 @@ func (p *planner) Grant(ctx context.Context, n *tree.Grant) (planNode, error) {
 			targets:         n.Targets,
 			grantees:        grantees,
 			desiredprivs:    n.Privileges,
+			columnPrivs:     n.ColumnPrivileges,
 			grantOn:         grantOn,
 		},
 		changePrivilege: func(
@@ -106,6 +110,9 @@ func (p *planner) Revoke(ctx context.Context, n *tree.Revoke) (planNode, error)
 	if err := privilege.ValidatePrivileges(n.Privileges, grantOn); err != nil {
 		return nil, err
 	}
+	if err := p.validateColumnPrivileges(ctx, n.ColumnPrivileges, grantOn); err != nil {
+		return nil, err
+	}
 
 	grantees, err := decodeusername.FromRoleSpecList(
 		p.SessionData(), username.PurposeValidation, n.Grantees,
@@ -134,6 +141,7 @@ func (p *planner) Revoke(ctx context.Context, n *tree.Revoke) (planNode, error)
 			targets:         n.Targets,
 			grantees:        grantees,
 			desiredprivs:    n.Privileges,
+			columnPrivs:     n.ColumnPrivileges,
 			grantOn:         grantOn,
 		},
 		changePrivilege: func(
@@ -162,6 +170,7 @@ type changePrivilegesNode struct {
 	withGrantOption bool
 	grantees        []username.SQLUsername
 	desiredprivs    privilege.List
+	columnPrivs     tree.ColumnPrivilegeList
 	targets         tree.GrantTargetList
 	grantOn         privilege.ObjectType
 }
@@ -352,6 +361,14 @@ func (n *changeDescriptorBackedPrivilegesNode) startExec(params runParams) error
 			}
 		}
 
+		if len(n.columnPrivs) > 0 {
+			changed, err := n.changeColumnPrivileges(ctx, p, descriptor)
+			if err != nil {
+				return err
+			}
+			descPrivsChanged = descPrivsChanged || changed
+		}
+
 		if !descPrivsChanged {
 			// no privileges will be changed from this 'GRANT' or 'REVOKE', skip it.
 			continue
@@ -359,9 +376,11 @@ func (n *changeDescriptorBackedPrivilegesNode) startExec(params runParams) error
 
 		eventDetails := eventpb.CommonSQLPrivilegeEventDetails{}
 		if n.isGrant {
-			eventDetails.GrantedPrivileges = n.desiredprivs.SortedDisplayNames()
+			eventDetails.GrantedPrivileges = append(
+				n.desiredprivs.SortedDisplayNames(), columnPrivilegeNames(n.columnPrivs)...)
 		} else {
-			eventDetails.RevokedPrivileges = n.desiredprivs.SortedDisplayNames()
+			eventDetails.RevokedPrivileges = append(
+				n.desiredprivs.SortedDisplayNames(), columnPrivilegeNames(n.columnPrivs)...)
 		}
 
 		switch d := descriptor.(type) {
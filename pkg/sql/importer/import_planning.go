@@ -655,6 +655,11 @@ func importPlanHook(
 					"IMPORT INTO is not supported for tables with vector indexes"),
 					"Consider dropping the vector index before importing, then recreating it afterwards.")
 			}
+			if idx.GetType() == idxtype.BRIN {
+				return errors.WithHint(pgerror.New(pgcode.FeatureNotSupported,
+					"IMPORT INTO is not supported for tables with BRIN indexes"),
+					"Consider dropping the BRIN index before importing, then recreating it afterwards.")
+			}
 		}
 
 		if len(found.LDRJobIDs) > 0 {
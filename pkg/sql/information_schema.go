@@ -434,6 +434,37 @@ https://www.postgresql.org/docs/9.5/infoschema-column-privileges.html`,
 					}
 				}
 			}
+			// Add the privileges granted on individual columns, unless they are
+			// already implied by the same privilege on the table.
+			for _, cd := range table.PublicColumns() {
+				colPrivs := cd.ColumnDesc().Privileges
+				if colPrivs == nil {
+					continue
+				}
+				for _, u := range colPrivs.Users {
+					var tablePrivs uint64
+					if tu, ok := privDesc.FindUser(u.User()); ok {
+						tablePrivs = tu.Privileges
+					}
+					for _, priv := range privilege.ColumnPrivileges {
+						if priv.Mask()&u.Privileges == 0 || priv.Mask()&tablePrivs != 0 {
+							continue
+						}
+						if err := addRow(
+							tree.DNull,                                       // grantor
+							tree.NewDString(u.User().Normalized()),           // grantee
+							dbNameStr,                                        // table_catalog
+							scNameStr,                                        // table_schema
+							tree.NewDString(table.GetName()),                 // table_name
+							tree.NewDString(cd.GetName()),                    // column_name
+							tree.NewDString(string(priv.DisplayName())),      // privilege_type
+							yesOrNoDatum(priv.Mask()&u.WithGrantOption != 0), // is_grantable
+						); err != nil {
+							return err
+						}
+					}
+				}
+			}
 			return nil
 		})
 	},
@@ -546,6 +577,7 @@ https://www.postgresql.org/docs/9.5/infoschema-columns.html`,
 				identityIncrement := tree.DNull
 				identityMax := tree.DNull
 				identityMin := tree.DNull
+				identityCycle := tree.DNull
 				generatedAsIdentitySeqOpt, err := column.GetGeneratedAsIdentitySequenceOption(column.GetType().Width())
 				if err != nil {
 					return err
@@ -555,6 +587,7 @@ https://www.postgresql.org/docs/9.5/infoschema-columns.html`,
 					identityIncrement = tree.NewDString(strconv.FormatInt(generatedAsIdentitySeqOpt.Increment, 10))
 					identityMax = tree.NewDString(strconv.FormatInt(generatedAsIdentitySeqOpt.MaxValue, 10))
 					identityMin = tree.NewDString(strconv.FormatInt(generatedAsIdentitySeqOpt.MinValue, 10))
+					identityCycle = yesOrNoDatum(generatedAsIdentitySeqOpt.Cycle)
 				}
 
 				err = addRow(
@@ -599,11 +632,9 @@ https://www.postgresql.org/docs/9.5/infoschema-columns.html`,
 					identityIncrement,                            // identity_increment
 					identityMax,                                  // identity_maximum
 					identityMin,                                  // identity_minimum
-					// TODO(janexing): we don't support CYCLE syntax for sequences yet.
-					// https://github.com/cockroachdb/cockroach/issues/20961
-					tree.DNull,                              // identity_cycle
-					alwaysOrNeverDatum(column.IsComputed()), // is_generated
-					colComputed,                             // generation_expression
+					identityCycle,                                // identity_cycle
+					alwaysOrNeverDatum(column.IsComputed()),      // is_generated
+					colComputed,                                  // generation_expression
 					yesOrNoDatum(table.IsTable() &&
 						!table.IsVirtualTable() &&
 						!column.IsComputed(),
@@ -1338,7 +1369,7 @@ https://www.postgresql.org/docs/9.5/infoschema-sequences.html`,
 					tree.NewDString(strconv.FormatInt(table.GetSequenceOpts().MinValue, 10)),  // min value
 					tree.NewDString(strconv.FormatInt(table.GetSequenceOpts().MaxValue, 10)),  // max value
 					tree.NewDString(strconv.FormatInt(table.GetSequenceOpts().Increment, 10)), // increment
-					noString, // cycle
+					yesOrNoDatum(table.GetSequenceOpts().Cycle),                               // cycle
 				)
 			})
 	},
@@ -2463,11 +2494,14 @@ https://www.postgresql.org/docs/current/infoschema-triggers.html`,
 						}
 
 						// Build the action statement.
-						funcDesc, err := descs.GetCatalogDescriptorGetter(ctx, p.Descriptors(), p.Txn(), p.EvalContext().Settings).Get().Function(ctx, trigger.FuncID)
-						if err != nil {
-							return err
+						funcName := tree.Name(trigger.BuiltinFuncName)
+						if funcName == "" {
+							funcDesc, err := descs.GetCatalogDescriptorGetter(ctx, p.Descriptors(), p.Txn(), p.EvalContext().Settings).Get().Function(ctx, trigger.FuncID)
+							if err != nil {
+								return err
+							}
+							funcName = tree.Name(funcDesc.GetName())
 						}
-						funcName := tree.Name(funcDesc.GetName())
 						actionStatement := fmt.Sprintf(`EXECUTE FUNCTION %s`, funcName.String())
 						if len(trigger.FuncArgs) > 0 {
 							actionStatement += fmt.Sprintf("(%s)", strings.Join(trigger.FuncArgs, ", "))
@@ -357,8 +357,10 @@ func (n *insertNode) processBatch(params runParams) (lastBatch bool, err error)
 		if err = n.run.ti.finalize(params.ctx); err != nil {
 			return false, err
 		}
-		// Possibly initiate a run of CREATE STATISTICS.
+		// Possibly initiate a run of CREATE STATISTICS and a summarization of
+		// the BRIN indexes of the table.
 		params.ExecCfg().StatsRefresher.NotifyMutation(params.ctx, n.run.ti.tableDesc(), int(n.run.rowsAffected()))
+		params.ExecCfg().BrinSummarizer.NotifyMutation(params.ctx, n.run.ti.tableDesc(), int(n.run.rowsAffected()))
 	}
 	return lastBatch, nil
 }
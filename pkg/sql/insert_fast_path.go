@@ -544,8 +544,10 @@ func (n *insertFastPathNode) processBatch(params runParams) error {
 		return err
 	}
 
-	// Possibly initiate a run of CREATE STATISTICS.
+	// Possibly initiate a run of CREATE STATISTICS and a summarization of
+	// the BRIN indexes of the table.
 	params.ExecCfg().StatsRefresher.NotifyMutation(params.ctx, n.run.ti.ri.Helper.TableDesc, len(n.input))
+	params.ExecCfg().BrinSummarizer.NotifyMutation(params.ctx, n.run.ti.ri.Helper.TableDesc, len(n.input))
 
 	return nil
 }
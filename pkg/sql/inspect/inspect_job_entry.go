@@ -243,7 +243,7 @@ func isSupportedIndexForIndexConsistencyCheck(
 
 	switch t := index.GetType(); t {
 	// TODO(154860): support inverted indexes
-	case idxtype.INVERTED, idxtype.VECTOR:
+	case idxtype.INVERTED, idxtype.VECTOR, idxtype.BRIN:
 		return t.String()
 	}
 
@@ -1693,6 +1693,16 @@ func (icc *internalClientComm) CreateDrainResult(pos CmdPos) DrainResult {
 	panic("unimplemented")
 }
 
+// CreateFunctionCallResult is part of the ClientComm interface.
+func (icc *internalClientComm) CreateFunctionCallResult(
+	pos CmdPos,
+	resultFormat pgwirebase.FormatCode,
+	conv sessiondatapb.DataConversionConfig,
+	location *time.Location,
+) CommandResult {
+	panic("unimplemented")
+}
+
 // Close is part of the ClientLock interface.
 func (icc *internalClientComm) Close() {}
 
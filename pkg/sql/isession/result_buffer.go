@@ -181,6 +181,16 @@ func (i *resultBuffer) CreateDrainResult(pos sql.CmdPos) sql.DrainResult {
 	return i.newCommand(pos)
 }
 
+// CreateFunctionCallResult implements ClientComm.
+func (i *resultBuffer) CreateFunctionCallResult(
+	pos sql.CmdPos,
+	resultFormat pgwirebase.FormatCode,
+	conv sessiondatapb.DataConversionConfig,
+	location *time.Location,
+) sql.CommandResult {
+	return i.newCommand(pos)
+}
+
 // CreateEmptyQueryResult implements ClientComm.
 func (i *resultBuffer) CreateEmptyQueryResult(pos sql.CmdPos) sql.EmptyQueryResult {
 	return i.newCommand(pos)
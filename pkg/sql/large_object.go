@@ -0,0 +1,207 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq/oid"
+)
+
+// largeObjectStore implements largeobject.Store on top of the
+// system.large_objects and system.large_object_pages tables. All reads and
+// writes happen in the planner's transaction, which gives large objects the
+// same transactional semantics as any other table data.
+type largeObjectStore struct {
+	p *planner
+}
+
+var _ largeobject.Store = largeObjectStore{}
+
+// LargeObjects is part of the eval.Planner interface.
+func (p *planner) LargeObjects() (*largeobject.Manager, error) {
+	if p.largeObjectDescs == nil {
+		return nil, errors.AssertionFailedf("large objects are not available in this context")
+	}
+	return largeobject.NewManager(largeObjectStore{p: p}, p.largeObjectDescs, p.TxnMon()), nil
+}
+
+// Create is part of the largeobject.Store interface.
+func (s largeObjectStore) Create(ctx context.Context, loid oid.Oid) error {
+	n, err := s.p.InternalSQLTxn().ExecEx(
+		ctx, "lo-create", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+		`INSERT INTO system.large_objects (loid, owner_id)
+VALUES ($1, (SELECT user_id FROM system.users WHERE username = $2))
+ON CONFLICT (loid) DO NOTHING`,
+		tree.NewDOid(loid), s.p.User().Normalized(),
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return largeobject.NewDuplicateObjectError(loid)
+	}
+	return nil
+}
+
+// AllocateOID is part of the largeobject.Store interface. OIDs are drawn
+// from the system.large_object_oid_seq sequence, which is incremented outside
+// of the transaction, so concurrent transactions never receive the same OID.
+// An OID may still have been claimed explicitly, in which case the caller
+// allocates another one.
+func (s largeObjectStore) AllocateOID(ctx context.Context) (oid.Oid, error) {
+	row, err := s.p.InternalSQLTxn().QueryRowEx(
+		ctx, "lo-allocate-oid", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+		`SELECT nextval('system.large_object_oid_seq')`,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return oid.Oid(tree.MustBeDInt(row[0])), nil
+}
+
+// Exists is part of the largeobject.Store interface.
+func (s largeObjectStore) Exists(ctx context.Context, loid oid.Oid) (bool, error) {
+	row, err := s.p.InternalSQLTxn().QueryRowEx(
+		ctx, "lo-exists", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+		`SELECT 1 FROM system.large_objects WHERE loid = $1`,
+		tree.NewDOid(loid),
+	)
+	if err != nil {
+		return false, err
+	}
+	return row != nil, nil
+}
+
+// IsOwner is part of the largeobject.Store interface.
+func (s largeObjectStore) IsOwner(ctx context.Context, loid oid.Oid) (bool, error) {
+	row, err := s.p.InternalSQLTxn().QueryRowEx(
+		ctx, "lo-owner", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+		`SELECT u.username FROM system.large_objects AS lo
+JOIN system.users AS u ON lo.owner_id = u.user_id
+WHERE lo.loid = $1`,
+		tree.NewDOid(loid),
+	)
+	if err != nil {
+		return false, err
+	}
+	if row != nil {
+		owner := username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[0])))
+		isOwner, err := s.p.checkRolePredicate(ctx, s.p.User(), func(role username.SQLUsername) (bool, error) {
+			return role == owner, nil
+		})
+		if err != nil || isOwner {
+			return isOwner, err
+		}
+	}
+	return s.p.HasAdminRole(ctx)
+}
+
+// Unlink is part of the largeobject.Store interface.
+func (s largeObjectStore) Unlink(ctx context.Context, loid oid.Oid) error {
+	for _, stmt := range []string{
+		`DELETE FROM system.large_object_pages WHERE loid = $1`,
+		`DELETE FROM system.large_objects WHERE loid = $1`,
+	} {
+		if _, err := s.p.InternalSQLTxn().ExecEx(
+			ctx, "lo-unlink", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+			stmt, tree.NewDOid(loid),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScanPages is part of the largeobject.Store interface.
+func (s largeObjectStore) ScanPages(
+	ctx context.Context, loid oid.Oid, start, end int32, fn func(largeobject.Page) error,
+) (retErr error) {
+	it, err := s.p.QueryIteratorEx(
+		ctx, "lo-scan-pages", sessiondata.NodeUserSessionDataOverride,
+		`SELECT pageno, data FROM system.large_object_pages
+WHERE loid = $1 AND pageno BETWEEN $2 AND $3
+ORDER BY pageno`,
+		tree.NewDOid(loid), start, end,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { retErr = errors.CombineErrors(retErr, it.Close()) }()
+	var ok bool
+	for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+		if err := fn(decodeLargeObjectPage(it.Cur())); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// PutPages is part of the largeobject.Store interface. All pages are written
+// by a single UPSERT statement.
+func (s largeObjectStore) PutPages(
+	ctx context.Context, loid oid.Oid, pages []largeobject.Page,
+) error {
+	if len(pages) == 0 {
+		return nil
+	}
+	var buf strings.Builder
+	buf.WriteString(`UPSERT INTO system.large_object_pages (loid, pageno, data) VALUES `)
+	args := make([]interface{}, 0, 1+2*len(pages))
+	args = append(args, tree.NewDOid(loid))
+	for i, page := range pages {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "($1, $%d, $%d)", len(args)+1, len(args)+2)
+		args = append(args, page.PageNo, page.Data)
+	}
+	_, err := s.p.InternalSQLTxn().ExecEx(
+		ctx, "lo-put-pages", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+		buf.String(), args...,
+	)
+	return err
+}
+
+// DeletePagesFrom is part of the largeobject.Store interface.
+func (s largeObjectStore) DeletePagesFrom(ctx context.Context, loid oid.Oid, pageNo int32) error {
+	_, err := s.p.InternalSQLTxn().ExecEx(
+		ctx, "lo-delete-pages", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+		`DELETE FROM system.large_object_pages WHERE loid = $1 AND pageno >= $2`,
+		tree.NewDOid(loid), pageNo,
+	)
+	return err
+}
+
+// LastPage is part of the largeobject.Store interface.
+func (s largeObjectStore) LastPage(
+	ctx context.Context, loid oid.Oid,
+) (_ largeobject.Page, ok bool, _ error) {
+	row, err := s.p.InternalSQLTxn().QueryRowEx(
+		ctx, "lo-last-page", s.p.txn, sessiondata.NodeUserSessionDataOverride,
+		`SELECT pageno, data FROM system.large_object_pages
+WHERE loid = $1 ORDER BY pageno DESC LIMIT 1`,
+		tree.NewDOid(loid),
+	)
+	if err != nil || row == nil {
+		return largeobject.Page{}, false, err
+	}
+	return decodeLargeObjectPage(row), true, nil
+}
+
+func decodeLargeObjectPage(row tree.Datums) largeobject.Page {
+	return largeobject.Page{
+		PageNo: int32(tree.MustBeDInt(row[0])),
+		Data:   []byte(tree.MustBeDBytes(row[1])),
+	}
+}
@@ -0,0 +1,650 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package largeobject implements the PostgreSQL large object facility on top
+// of a page store. A large object is identified by an OID and its contents
+// are split into fixed-size pages, mirroring the layout of Postgres'
+// pg_largeobject catalog. Access to the contents goes through descriptors
+// that are opened with lo_open and that are only valid until the end of the
+// transaction that opened them.
+package largeobject
+
+import (
+	"context"
+	"io"
+	"math"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/util/mon"
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq/oid"
+)
+
+// PageSize is the number of bytes stored in each page of a large object. It
+// matches LOBLKSIZE in Postgres, which is BLCKSZ / 4.
+const PageSize = 2048
+
+// MaxSize is the maximum size of a large object, in bytes. It matches the
+// limit imposed by Postgres.
+const MaxSize = int64(math.MaxInt32) * PageSize
+
+// Mode flags that can be passed to lo_open and lo_creat. The values match
+// the INV_READ and INV_WRITE constants of libpq.
+const (
+	ModeWrite int32 = 0x00020000
+	ModeRead  int32 = 0x00040000
+)
+
+// chunkPages is the number of pages read or written at a time when the
+// contents of a large object are streamed in or out. It is also the maximum
+// number of pages passed to a single Store.PutPages call.
+const chunkPages = 64
+
+// maxAllocationAttempts bounds the number of OIDs tried when a large object
+// is created without an explicit OID, in case the allocated ones were already
+// claimed explicitly.
+const maxAllocationAttempts = 100
+
+// Whence values accepted by lo_lseek.
+const (
+	SeekSet int32 = 0
+	SeekCur int32 = 1
+	SeekEnd int32 = 2
+)
+
+// Page is a single page of a large object.
+type Page struct {
+	PageNo int32
+	Data   []byte
+}
+
+// Store is the persistence layer for large objects. All methods operate
+// within the transaction the Store is bound to.
+type Store interface {
+	// Create registers a new, empty, large object with the given OID. It
+	// returns an error with code DuplicateObject if the OID is already used.
+	Create(ctx context.Context, loid oid.Oid) error
+	// AllocateOID returns an OID that is not used by any existing large object.
+	AllocateOID(ctx context.Context) (oid.Oid, error)
+	// Exists returns whether a large object with the given OID exists.
+	Exists(ctx context.Context, loid oid.Oid) (bool, error)
+	// IsOwner returns whether the current user owns the large object, either
+	// directly or through one of its roles, or is an admin.
+	IsOwner(ctx context.Context, loid oid.Oid) (bool, error)
+	// Unlink removes the large object and all of its pages.
+	Unlink(ctx context.Context, loid oid.Oid) error
+	// ScanPages calls fn with each existing page in the range [start, end] in
+	// ascending page number order. Pages that were never written are omitted.
+	ScanPages(ctx context.Context, loid oid.Oid, start, end int32, fn func(Page) error) error
+	// PutPages stores (or overwrites) the given pages.
+	PutPages(ctx context.Context, loid oid.Oid, pages []Page) error
+	// DeletePagesFrom removes all pages with a page number greater than or
+	// equal to pageNo.
+	DeletePagesFrom(ctx context.Context, loid oid.Oid, pageNo int32) error
+	// LastPage returns the page with the highest page number, or ok=false if
+	// the large object has no pages.
+	LastPage(ctx context.Context, loid oid.Oid) (_ Page, ok bool, _ error)
+}
+
+// Size returns the size in bytes of the large object, which is defined by
+// the end of its last page.
+func Size(ctx context.Context, store Store, loid oid.Oid) (int64, error) {
+	last, ok, err := store.LastPage(ctx, loid)
+	if err != nil || !ok {
+		return 0, err
+	}
+	return int64(last.PageNo)*PageSize + int64(len(last.Data)), nil
+}
+
+// Read returns up to n bytes of the large object starting at offset. Holes
+// left by writes past the end of the object read as zeroes.
+func Read(ctx context.Context, store Store, loid oid.Oid, offset int64, n int) ([]byte, error) {
+	if n <= 0 || offset >= MaxSize {
+		return []byte{}, nil
+	}
+	size, err := Size(ctx, store, loid)
+	if err != nil {
+		return nil, err
+	}
+	end := offset + int64(n)
+	if end > size {
+		end = size
+	}
+	if end <= offset {
+		return []byte{}, nil
+	}
+	res := make([]byte, end-offset)
+	if err := store.ScanPages(ctx, loid, int32(offset/PageSize), int32((end-1)/PageSize), func(p Page) error {
+		pageStart := int64(p.PageNo) * PageSize
+		// Copy the intersection of [pageStart, pageStart+len(p.Data)) and
+		// [offset, end) into the result.
+		lo, hi := max(pageStart, offset), min(pageStart+int64(len(p.Data)), end)
+		if lo < hi {
+			copy(res[lo-offset:hi-offset], p.Data[lo-pageStart:hi-pageStart])
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// getPage returns the contents of a single page, or nil if it was never
+// written.
+func getPage(ctx context.Context, store Store, loid oid.Oid, pageNo int32) ([]byte, error) {
+	var data []byte
+	err := store.ScanPages(ctx, loid, pageNo, pageNo, func(p Page) error {
+		data = p.Data
+		return nil
+	})
+	return data, err
+}
+
+// Write writes data into the large object starting at offset, extending it
+// if necessary. The pages are stored in batches of chunkPages.
+func Write(ctx context.Context, store Store, loid oid.Oid, offset int64, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	end := offset + int64(len(data))
+	if offset < 0 || end > MaxSize {
+		return pgerror.Newf(pgcode.InvalidParameterValue,
+			"invalid large object write request size: %d", len(data))
+	}
+	first, last := int32(offset/PageSize), int32((end-1)/PageSize)
+	// Only the first and the last page can be partially overwritten, so those
+	// are the only ones whose existing contents need to be read.
+	existing := make(map[int32][]byte, 2)
+	for _, pageNo := range []int32{first, last} {
+		if _, ok := existing[pageNo]; ok {
+			continue
+		}
+		data, err := getPage(ctx, store, loid, pageNo)
+		if err != nil {
+			return err
+		}
+		if data != nil {
+			existing[pageNo] = data
+		}
+	}
+	batch := make([]Page, 0, min(int(last-first)+1, chunkPages))
+	for pageNo := first; pageNo <= last; pageNo++ {
+		pageStart := int64(pageNo) * PageSize
+		lo, hi := max(pageStart, offset), min(pageStart+PageSize, end)
+		buf := existing[pageNo]
+		if need := int(hi - pageStart); len(buf) < need {
+			grown := make([]byte, need)
+			copy(grown, buf)
+			buf = grown
+		} else {
+			buf = append([]byte(nil), buf...)
+		}
+		copy(buf[lo-pageStart:hi-pageStart], data[lo-offset:hi-offset])
+		batch = append(batch, Page{PageNo: pageNo, Data: buf})
+		if len(batch) == chunkPages || pageNo == last {
+			if err := store.PutPages(ctx, loid, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	return nil
+}
+
+// Truncate sets the size of the large object to length bytes, either
+// discarding trailing data or extending the object with zeroes.
+func Truncate(ctx context.Context, store Store, loid oid.Oid, length int64) error {
+	if length < 0 || length > MaxSize {
+		return pgerror.Newf(pgcode.InvalidParameterValue,
+			"invalid large object truncation target: %d", length)
+	}
+	size, err := Size(ctx, store, loid)
+	if err != nil {
+		return err
+	}
+	if length == size {
+		return nil
+	}
+	if length > size {
+		// Extending the object only requires materializing the new last page;
+		// the pages in between are holes that read as zeroes.
+		pageNo := int32((length - 1) / PageSize)
+		data, err := getPage(ctx, store, loid, pageNo)
+		if err != nil {
+			return err
+		}
+		buf := make([]byte, length-int64(pageNo)*PageSize)
+		copy(buf, data)
+		return store.PutPages(ctx, loid, []Page{{PageNo: pageNo, Data: buf}})
+	}
+	pageNo := int32(length / PageSize)
+	keep := int(length % PageSize)
+	if keep == 0 {
+		return store.DeletePagesFrom(ctx, loid, pageNo)
+	}
+	if err := store.DeletePagesFrom(ctx, loid, pageNo+1); err != nil {
+		return err
+	}
+	data, err := getPage(ctx, store, loid, pageNo)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, keep)
+	copy(buf, data)
+	return store.PutPages(ctx, loid, []Page{{PageNo: pageNo, Data: buf}})
+}
+
+// NewUndefinedObjectError returns the error used when a large object does not
+// exist.
+func NewUndefinedObjectError(loid oid.Oid) error {
+	return pgerror.Newf(pgcode.UndefinedObject, "large object %d does not exist", loid)
+}
+
+// errInvalidDescriptor is returned when a descriptor was never opened or was
+// already closed.
+func errInvalidDescriptor(fd int32) error {
+	return pgerror.Newf(pgcode.UndefinedObject, "invalid large-object descriptor: %d", fd)
+}
+
+// descriptor is the state of an open large object.
+type descriptor struct {
+	loid   oid.Oid
+	mode   int32
+	offset int64
+}
+
+// Descriptors is the set of large object descriptors opened by a
+// transaction. The zero value is ready to use.
+type Descriptors struct {
+	open   map[int32]*descriptor
+	nextFD int32
+}
+
+// Reset closes all descriptors. It must be called when the transaction that
+// opened them finishes.
+func (d *Descriptors) Reset() {
+	*d = Descriptors{}
+}
+
+// Len returns the number of open descriptors.
+func (d *Descriptors) Len() int {
+	return len(d.open)
+}
+
+func (d *Descriptors) get(fd int32) (*descriptor, error) {
+	desc, ok := d.open[fd]
+	if !ok {
+		return nil, errInvalidDescriptor(fd)
+	}
+	return desc, nil
+}
+
+// Manager implements the large object functions for a single session by
+// combining a Store with the descriptors of the current transaction.
+//
+// Only the owner of a large object, or an admin, may read or write it. As in
+// Postgres, descriptors are checked when they are opened.
+type Manager struct {
+	store Store
+	descs *Descriptors
+	// mon, if set, accounts for the memory of the contents read from large
+	// objects.
+	mon *mon.BytesMonitor
+}
+
+// NewManager returns a Manager operating on the given store and descriptors.
+// The memory read from large objects is accounted against monitor, if it is
+// not nil.
+func NewManager(store Store, descs *Descriptors, monitor *mon.BytesMonitor) *Manager {
+	return &Manager{store: store, descs: descs, mon: monitor}
+}
+
+// Create creates a new empty large object. If loid is zero, a new OID is
+// allocated. The OID of the new object is returned.
+func (m *Manager) Create(ctx context.Context, loid oid.Oid) (oid.Oid, error) {
+	if loid != 0 {
+		if err := m.store.Create(ctx, loid); err != nil {
+			return 0, err
+		}
+		return loid, nil
+	}
+	// Allocated OIDs are never handed out twice, but they may collide with
+	// OIDs that were chosen explicitly, in which case another one is tried.
+	for i := 0; i < maxAllocationAttempts; i++ {
+		loid, err := m.store.AllocateOID(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if err := m.store.Create(ctx, loid); err == nil {
+			return loid, nil
+		} else if pgerror.GetPGCode(err) != pgcode.DuplicateObject {
+			return 0, err
+		}
+	}
+	return 0, pgerror.New(pgcode.ProgramLimitExceeded, "could not allocate an unused large object OID")
+}
+
+// Open opens the large object for reading and/or writing according to mode
+// and returns a descriptor valid until the end of the transaction.
+func (m *Manager) Open(ctx context.Context, loid oid.Oid, mode int32) (int32, error) {
+	if mode&(ModeRead|ModeWrite) == 0 {
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue, "invalid flags for opening a large object: %d", mode)
+	}
+	if err := m.checkAccess(ctx, loid); err != nil {
+		return 0, err
+	}
+	if m.descs.open == nil {
+		m.descs.open = make(map[int32]*descriptor)
+	}
+	fd := m.descs.nextFD
+	m.descs.nextFD++
+	m.descs.open[fd] = &descriptor{loid: loid, mode: mode}
+	return fd, nil
+}
+
+// Close closes the descriptor.
+func (m *Manager) Close(fd int32) error {
+	if _, err := m.descs.get(fd); err != nil {
+		return err
+	}
+	delete(m.descs.open, fd)
+	return nil
+}
+
+// Read reads up to n bytes from the descriptor's current position and
+// advances it. As in Postgres, descriptors opened only with ModeWrite can be
+// read as well.
+func (m *Manager) Read(ctx context.Context, fd int32, n int32) ([]byte, error) {
+	desc, err := m.descs.get(fd)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "requested length cannot be negative")
+	}
+	data, err := m.read(ctx, desc.loid, desc.offset, int(n))
+	if err != nil {
+		return nil, err
+	}
+	desc.offset += int64(len(data))
+	return data, nil
+}
+
+// Write writes data at the descriptor's current position and advances it.
+// It returns the number of bytes written.
+func (m *Manager) Write(ctx context.Context, fd int32, data []byte) (int32, error) {
+	desc, err := m.writableDescriptor(fd)
+	if err != nil {
+		return 0, err
+	}
+	if err := Write(ctx, m.store, desc.loid, desc.offset, data); err != nil {
+		return 0, err
+	}
+	desc.offset += int64(len(data))
+	return int32(len(data)), nil
+}
+
+// Seek moves the descriptor's position and returns the new position.
+func (m *Manager) Seek(ctx context.Context, fd int32, offset int64, whence int32) (int64, error) {
+	desc, err := m.descs.get(fd)
+	if err != nil {
+		return 0, err
+	}
+	var base int64
+	switch whence {
+	case SeekSet:
+	case SeekCur:
+		base = desc.offset
+	case SeekEnd:
+		if base, err = Size(ctx, m.store, desc.loid); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue, "invalid whence setting: %d", whence)
+	}
+	pos := base + offset
+	if pos < 0 || pos > MaxSize {
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue, "invalid large object seek target: %d", pos)
+	}
+	desc.offset = pos
+	return pos, nil
+}
+
+// Tell returns the descriptor's current position.
+func (m *Manager) Tell(fd int32) (int64, error) {
+	desc, err := m.descs.get(fd)
+	if err != nil {
+		return 0, err
+	}
+	return desc.offset, nil
+}
+
+// Truncate truncates or extends the large object behind the descriptor to
+// length bytes. The descriptor's position is unchanged.
+func (m *Manager) Truncate(ctx context.Context, fd int32, length int64) error {
+	desc, err := m.writableDescriptor(fd)
+	if err != nil {
+		return err
+	}
+	return Truncate(ctx, m.store, desc.loid, length)
+}
+
+// Unlink removes the large object. Descriptors open on it become invalid.
+func (m *Manager) Unlink(ctx context.Context, loid oid.Oid) error {
+	if err := m.mustExist(ctx, loid); err != nil {
+		return err
+	}
+	if isOwner, err := m.store.IsOwner(ctx, loid); err != nil {
+		return err
+	} else if !isOwner {
+		return pgerror.Newf(pgcode.InsufficientPrivilege, "must be owner of large object %d", loid)
+	}
+	if err := m.store.Unlink(ctx, loid); err != nil {
+		return err
+	}
+	for fd, desc := range m.descs.open {
+		if desc.loid == loid {
+			delete(m.descs.open, fd)
+		}
+	}
+	return nil
+}
+
+// Get returns the contents of the large object starting at offset. If length
+// is negative, the rest of the object is returned.
+func (m *Manager) Get(ctx context.Context, loid oid.Oid, offset int64, length int64) ([]byte, error) {
+	if err := m.checkAccess(ctx, loid); err != nil {
+		return nil, err
+	}
+	if offset < 0 {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "invalid large object offset: %d", offset)
+	}
+	if length < 0 {
+		size, err := Size(ctx, m.store, loid)
+		if err != nil {
+			return nil, err
+		}
+		length = size - offset
+	}
+	if length > math.MaxInt32 {
+		return nil, pgerror.Newf(pgcode.ProgramLimitExceeded, "large object read request is too large")
+	}
+	return m.read(ctx, loid, offset, int(length))
+}
+
+// Export calls fn with a reader over the contents of the large object. The
+// contents are fetched a few pages at a time as the reader is consumed, so
+// the whole object is never held in memory.
+func (m *Manager) Export(ctx context.Context, loid oid.Oid, fn func(io.Reader) error) error {
+	if err := m.checkAccess(ctx, loid); err != nil {
+		return err
+	}
+	size, err := Size(ctx, m.store, loid)
+	if err != nil {
+		return err
+	}
+	if m.mon != nil {
+		acc := m.mon.MakeBoundAccount()
+		defer acc.Close(ctx)
+		if err := acc.Grow(ctx, chunkPages*PageSize); err != nil {
+			return err
+		}
+	}
+	return fn(&reader{ctx: ctx, store: m.store, loid: loid, size: size})
+}
+
+// reader streams the contents of a large object.
+type reader struct {
+	ctx    context.Context
+	store  Store
+	loid   oid.Oid
+	size   int64
+	offset int64
+	buf    []byte
+}
+
+var _ io.Reader = &reader{}
+
+// Read implements the io.Reader interface.
+func (r *reader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if r.offset >= r.size {
+			return 0, io.EOF
+		}
+		n := min(r.size-r.offset, chunkPages*PageSize)
+		var err error
+		if r.buf, err = Read(r.ctx, r.store, r.loid, r.offset, int(n)); err != nil {
+			return 0, err
+		}
+		r.offset += int64(len(r.buf))
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Put writes data into the large object at offset.
+func (m *Manager) Put(ctx context.Context, loid oid.Oid, offset int64, data []byte) error {
+	if err := m.checkAccess(ctx, loid); err != nil {
+		return err
+	}
+	return Write(ctx, m.store, loid, offset, data)
+}
+
+// Import creates a large object holding the contents read from r and returns
+// its OID. If loid is zero, a new OID is allocated. The contents are written
+// a few pages at a time as they are read, so the whole object is never held
+// in memory.
+func (m *Manager) Import(ctx context.Context, loid oid.Oid, r io.Reader) (oid.Oid, error) {
+	if m.mon != nil {
+		acc := m.mon.MakeBoundAccount()
+		defer acc.Close(ctx)
+		if err := acc.Grow(ctx, chunkPages*PageSize); err != nil {
+			return 0, err
+		}
+	}
+	loid, err := m.Create(ctx, loid)
+	if err != nil {
+		return 0, err
+	}
+	buf := make([]byte, chunkPages*PageSize)
+	for offset := int64(0); ; {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if offset+int64(n) > MaxSize {
+				return 0, pgerror.Newf(pgcode.ProgramLimitExceeded, "large object is too large")
+			}
+			if err := Write(ctx, m.store, loid, offset, buf[:n]); err != nil {
+				return 0, err
+			}
+			offset += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return loid, nil
+		} else if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// FromBytes creates a large object holding data and returns its OID. If loid
+// is zero, a new OID is allocated.
+func (m *Manager) FromBytes(ctx context.Context, loid oid.Oid, data []byte) (oid.Oid, error) {
+	loid, err := m.Create(ctx, loid)
+	if err != nil {
+		return 0, err
+	}
+	if err := Write(ctx, m.store, loid, 0, data); err != nil {
+		return 0, err
+	}
+	return loid, nil
+}
+
+func (m *Manager) mustExist(ctx context.Context, loid oid.Oid) error {
+	exists, err := m.store.Exists(ctx, loid)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return NewUndefinedObjectError(loid)
+	}
+	return nil
+}
+
+// checkAccess returns an error unless the large object exists and the current
+// user may read and write it.
+func (m *Manager) checkAccess(ctx context.Context, loid oid.Oid) error {
+	if err := m.mustExist(ctx, loid); err != nil {
+		return err
+	}
+	isOwner, err := m.store.IsOwner(ctx, loid)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return pgerror.Newf(pgcode.InsufficientPrivilege, "permission denied for large object %d", loid)
+	}
+	return nil
+}
+
+// read reads up to n bytes of the large object starting at offset. The memory
+// of the result is reserved from the manager's monitor while it is read, so
+// that reads larger than the available memory fail instead of exhausting it.
+func (m *Manager) read(ctx context.Context, loid oid.Oid, offset int64, n int) ([]byte, error) {
+	if m.mon != nil && n > 0 {
+		size, err := Size(ctx, m.store, loid)
+		if err != nil {
+			return nil, err
+		}
+		acc := m.mon.MakeBoundAccount()
+		defer acc.Close(ctx)
+		if err := acc.Grow(ctx, max(min(int64(n), size-offset), 0)); err != nil {
+			return nil, err
+		}
+	}
+	return Read(ctx, m.store, loid, offset, n)
+}
+
+func (m *Manager) writableDescriptor(fd int32) (*descriptor, error) {
+	desc, err := m.descs.get(fd)
+	if err != nil {
+		return nil, err
+	}
+	if desc.mode&ModeWrite == 0 {
+		return nil, pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+			"large object descriptor %d was not opened for writing", fd)
+	}
+	return desc, nil
+}
+
+// NewDuplicateObjectError returns the error Store implementations use when
+// creating a large object whose OID is already in use.
+func NewDuplicateObjectError(loid oid.Oid) error {
+	return errors.WithHint(
+		pgerror.Newf(pgcode.DuplicateObject, "large object %d already exists", loid),
+		"pass 0 as the OID to allocate a new one",
+	)
+}
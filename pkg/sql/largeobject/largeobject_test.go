@@ -0,0 +1,316 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package largeobject
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/lib/pq/oid"
+	"github.com/stretchr/testify/require"
+)
+
+// memStore is an in-memory Store used for testing.
+type memStore struct {
+	objects map[oid.Oid]map[int32][]byte
+	nextOID oid.Oid
+	// notOwned contains the large objects the current user does not own.
+	notOwned map[oid.Oid]bool
+	// putCalls counts the calls to PutPages.
+	putCalls int
+}
+
+var _ Store = &memStore{}
+
+func newMemStore() *memStore {
+	return &memStore{
+		objects:  make(map[oid.Oid]map[int32][]byte),
+		nextOID:  16384,
+		notOwned: make(map[oid.Oid]bool),
+	}
+}
+
+func (s *memStore) Create(_ context.Context, loid oid.Oid) error {
+	if _, ok := s.objects[loid]; ok {
+		return NewDuplicateObjectError(loid)
+	}
+	s.objects[loid] = make(map[int32][]byte)
+	return nil
+}
+
+func (s *memStore) AllocateOID(context.Context) (oid.Oid, error) {
+	s.nextOID++
+	return s.nextOID, nil
+}
+
+func (s *memStore) Exists(_ context.Context, loid oid.Oid) (bool, error) {
+	_, ok := s.objects[loid]
+	return ok, nil
+}
+
+func (s *memStore) IsOwner(_ context.Context, loid oid.Oid) (bool, error) {
+	return !s.notOwned[loid], nil
+}
+
+func (s *memStore) Unlink(_ context.Context, loid oid.Oid) error {
+	delete(s.objects, loid)
+	return nil
+}
+
+func (s *memStore) ScanPages(
+	_ context.Context, loid oid.Oid, start, end int32, fn func(Page) error,
+) error {
+	var res []Page
+	for pageNo, data := range s.objects[loid] {
+		if pageNo >= start && pageNo <= end {
+			res = append(res, Page{PageNo: pageNo, Data: data})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].PageNo < res[j].PageNo })
+	for _, p := range res {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memStore) PutPages(_ context.Context, loid oid.Oid, pages []Page) error {
+	if len(pages) > chunkPages {
+		return pgerror.Newf(pgcode.Internal, "too many pages in batch: %d", len(pages))
+	}
+	s.putCalls++
+	for _, page := range pages {
+		if len(page.Data) > PageSize {
+			return pgerror.Newf(pgcode.Internal, "page %d too large: %d", page.PageNo, len(page.Data))
+		}
+		s.objects[loid][page.PageNo] = page.Data
+	}
+	return nil
+}
+
+func (s *memStore) DeletePagesFrom(_ context.Context, loid oid.Oid, pageNo int32) error {
+	for p := range s.objects[loid] {
+		if p >= pageNo {
+			delete(s.objects[loid], p)
+		}
+	}
+	return nil
+}
+
+func (s *memStore) LastPage(_ context.Context, loid oid.Oid) (Page, bool, error) {
+	var last Page
+	found := false
+	for pageNo, data := range s.objects[loid] {
+		if !found || pageNo > last.PageNo {
+			last, found = Page{PageNo: pageNo, Data: data}, true
+		}
+	}
+	return last, found, nil
+}
+
+func TestReadWrite(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	store := newMemStore()
+	const loid = 1000
+	require.NoError(t, store.Create(ctx, loid))
+
+	// Build a reference buffer alongside the large object and check that both
+	// agree after writes that straddle page boundaries.
+	var ref []byte
+	write := func(offset int, data []byte) {
+		require.NoError(t, Write(ctx, store, loid, int64(offset), data))
+		if need := offset + len(data); len(ref) < need {
+			ref = append(ref, make([]byte, need-len(ref))...)
+		}
+		copy(ref[offset:], data)
+	}
+	write(0, bytes.Repeat([]byte("a"), 10))
+	write(PageSize-3, bytes.Repeat([]byte("b"), 7))
+	write(3*PageSize+5, bytes.Repeat([]byte("c"), 2*PageSize))
+	write(4, []byte("dd"))
+
+	size, err := Size(ctx, store, loid)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(ref)), size)
+
+	for _, tc := range []struct{ offset, n int }{
+		{0, len(ref)},
+		{0, len(ref) + 100},
+		{PageSize - 5, 20},
+		{2 * PageSize, PageSize},
+		{len(ref) - 1, 10},
+		{len(ref), 10},
+	} {
+		got, err := Read(ctx, store, loid, int64(tc.offset), tc.n)
+		require.NoError(t, err)
+		end := min(tc.offset+tc.n, len(ref))
+		require.Equal(t, ref[tc.offset:end], got, "offset=%d n=%d", tc.offset, tc.n)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	store := newMemStore()
+	const loid = 1000
+	require.NoError(t, store.Create(ctx, loid))
+	data := bytes.Repeat([]byte("x"), 3*PageSize+17)
+	require.NoError(t, Write(ctx, store, loid, 0, data))
+
+	for _, length := range []int64{3*PageSize + 17, 2*PageSize + 1, 2 * PageSize, 5, 0, PageSize + 3} {
+		require.NoError(t, Truncate(ctx, store, loid, length))
+		size, err := Size(ctx, store, loid)
+		require.NoError(t, err)
+		require.Equal(t, length, size)
+	}
+	// The extension after truncating to zero must read back as zeroes.
+	got, err := Read(ctx, store, loid, 0, PageSize+3)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, PageSize+3), got)
+}
+
+func TestManager(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	var descs Descriptors
+	store := newMemStore()
+	m := NewManager(store, &descs, nil /* monitor */)
+
+	// Allocated OIDs skip the ones that were claimed explicitly.
+	_, err := m.Create(ctx, store.nextOID+1)
+	require.NoError(t, err)
+	loid, err := m.Create(ctx, 0 /* loid */)
+	require.NoError(t, err)
+	require.Equal(t, store.nextOID, loid)
+	_, err = m.Create(ctx, loid)
+	require.Equal(t, pgcode.DuplicateObject, pgerror.GetPGCode(err))
+
+	_, err = m.Open(ctx, loid+1, ModeRead)
+	require.Equal(t, pgcode.UndefinedObject, pgerror.GetPGCode(err))
+
+	fd, err := m.Open(ctx, loid, ModeRead|ModeWrite)
+	require.NoError(t, err)
+	n, err := m.Write(ctx, fd, []byte("hello world"))
+	require.NoError(t, err)
+	require.Equal(t, int32(11), n)
+	pos, err := m.Tell(fd)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), pos)
+
+	pos, err = m.Seek(ctx, fd, -5, SeekEnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), pos)
+	got, err := m.Read(ctx, fd, 100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("world"), got)
+
+	require.NoError(t, m.Truncate(ctx, fd, 5))
+	got, err = m.Get(ctx, loid, 0, -1 /* length */)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), got)
+
+	// Writing through a read-only descriptor is not allowed.
+	roFD, err := m.Open(ctx, loid, ModeRead)
+	require.NoError(t, err)
+	_, err = m.Write(ctx, roFD, []byte("x"))
+	require.Equal(t, pgcode.ObjectNotInPrerequisiteState, pgerror.GetPGCode(err))
+
+	require.NoError(t, m.Close(fd))
+	require.Equal(t, pgcode.UndefinedObject, pgerror.GetPGCode(m.Close(fd)))
+
+	// Unlinking the object invalidates the remaining descriptors.
+	require.NoError(t, m.Unlink(ctx, loid))
+	_, err = m.Tell(roFD)
+	require.Equal(t, pgcode.UndefinedObject, pgerror.GetPGCode(err))
+	require.Equal(t, 0, descs.Len())
+
+	// FromBytes round-trips its input.
+	loid, err = m.FromBytes(ctx, 0 /* loid */, []byte("payload"))
+	require.NoError(t, err)
+	require.NoError(t, m.Put(ctx, loid, 3, []byte("LOA")))
+	got, err = m.Get(ctx, loid, 0, -1 /* length */)
+	require.NoError(t, err)
+	require.Equal(t, []byte("payLOAd"), got)
+
+	// Resetting the descriptors, as done at the end of a transaction, closes
+	// all of them.
+	_, err = m.Open(ctx, loid, ModeRead)
+	require.NoError(t, err)
+	descs.Reset()
+	require.Equal(t, 0, descs.Len())
+
+	// Large objects owned by other users can neither be read nor written.
+	store.notOwned[loid] = true
+	_, err = m.Open(ctx, loid, ModeRead)
+	require.Equal(t, pgcode.InsufficientPrivilege, pgerror.GetPGCode(err))
+	_, err = m.Get(ctx, loid, 0, -1 /* length */)
+	require.Equal(t, pgcode.InsufficientPrivilege, pgerror.GetPGCode(err))
+	err = m.Put(ctx, loid, 0, []byte("x"))
+	require.Equal(t, pgcode.InsufficientPrivilege, pgerror.GetPGCode(err))
+	err = m.Export(ctx, loid, func(io.Reader) error { return nil })
+	require.Equal(t, pgcode.InsufficientPrivilege, pgerror.GetPGCode(err))
+	err = m.Unlink(ctx, loid)
+	require.Equal(t, pgcode.InsufficientPrivilege, pgerror.GetPGCode(err))
+}
+
+func TestExport(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	var descs Descriptors
+	m := NewManager(newMemStore(), &descs, nil /* monitor */)
+
+	data := make([]byte, 3*chunkPages*PageSize+123)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	loid, err := m.FromBytes(ctx, 0 /* loid */, data)
+	require.NoError(t, err)
+	require.NoError(t, m.Export(ctx, loid, func(r io.Reader) error {
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+		return nil
+	}))
+}
+
+func TestImport(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	var descs Descriptors
+	store := newMemStore()
+	m := NewManager(store, &descs, nil /* monitor */)
+
+	data := make([]byte, 2*chunkPages*PageSize+PageSize/2)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	loid, err := m.Import(ctx, 0 /* loid */, bytes.NewReader(data))
+	require.NoError(t, err)
+	// The pages are written in batches of chunkPages.
+	require.Equal(t, 3, store.putCalls)
+	got, err := m.Get(ctx, loid, 0 /* offset */, -1 /* length */)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	// Importing into an existing OID fails.
+	_, err = m.Import(ctx, loid, bytes.NewReader(data))
+	require.Equal(t, pgcode.DuplicateObject, pgerror.GetPGCode(err))
+
+	// Empty inputs create empty objects.
+	loid, err = m.Import(ctx, 0 /* loid */, bytes.NewReader(nil))
+	require.NoError(t, err)
+	size, err := Size(ctx, store, loid)
+	require.NoError(t, err)
+	require.Zero(t, size)
+}
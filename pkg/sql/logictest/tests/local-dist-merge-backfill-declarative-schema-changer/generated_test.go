@@ -80,6 +80,13 @@ func TestLogic_alter_column_type(
 	runLogicTest(t, "alter_column_type")
 }
 
+func TestLogic_alter_column_type_general(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "alter_column_type_general")
+}
+
 func TestLogic_alter_table(
 	t *testing.T,
 ) {
@@ -283,6 +283,13 @@ func TestLogic_bpchar(
 	runLogicTest(t, "bpchar")
 }
 
+func TestLogic_brin_index(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "brin_index")
+}
+
 func TestLogic_buffered_writes(
 	t *testing.T,
 ) {
@@ -430,6 +437,20 @@ func TestLogic_collatedstring_uniqueindex2(
 	runLogicTest(t, "collatedstring_uniqueindex2")
 }
 
+func TestLogic_column_masking(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_masking")
+}
+
+func TestLogic_column_privileges(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_privileges")
+}
+
 func TestLogic_comment_on(
 	t *testing.T,
 ) {
@@ -549,6 +570,13 @@ func TestLogic_database(
 	runLogicTest(t, "database")
 }
 
+func TestLogic_database_collation(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "database_collation")
+}
+
 func TestLogic_datetime(
 	t *testing.T,
 ) {
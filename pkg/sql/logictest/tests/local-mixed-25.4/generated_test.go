@@ -766,6 +766,13 @@ func TestLogic_drop_type(
 	runLogicTest(t, "drop_type")
 }
 
+func TestLogic_drop_type_cascade(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "drop_type_cascade")
+}
+
 func TestLogic_drop_user(
 	t *testing.T,
 ) {
@@ -87,6 +87,13 @@ func TestLogic_alter_column_type(
 	runLogicTest(t, "alter_column_type")
 }
 
+func TestLogic_alter_column_type_general(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "alter_column_type_general")
+}
+
 func TestLogic_alter_database_convert_to_schema(
 	t *testing.T,
 ) {
@@ -241,6 +248,13 @@ func TestLogic_bpchar(
 	runLogicTest(t, "bpchar")
 }
 
+func TestLogic_brin_index(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "brin_index")
+}
+
 func TestLogic_buffered_writes(
 	t *testing.T,
 ) {
@@ -325,6 +339,20 @@ func TestLogic_collatedstring_uniqueindex2(
 	runLogicTest(t, "collatedstring_uniqueindex2")
 }
 
+func TestLogic_column_masking(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_masking")
+}
+
+func TestLogic_column_privileges(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_privileges")
+}
+
 func TestLogic_composite_types(
 	t *testing.T,
 ) {
@@ -381,6 +409,13 @@ func TestLogic_database(
 	runLogicTest(t, "database")
 }
 
+func TestLogic_database_collation(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "database_collation")
+}
+
 func TestLogic_default(
 	t *testing.T,
 ) {
@@ -549,6 +584,13 @@ func TestLogic_drop_type(
 	runLogicTest(t, "drop_type")
 }
 
+func TestLogic_drop_type_cascade(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "drop_type_cascade")
+}
+
 func TestLogic_drop_user(
 	t *testing.T,
 ) {
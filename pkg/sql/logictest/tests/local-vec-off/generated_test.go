@@ -94,6 +94,13 @@ func TestLogic_alter_column_type(
 	runLogicTest(t, "alter_column_type")
 }
 
+func TestLogic_alter_column_type_general(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "alter_column_type_general")
+}
+
 func TestLogic_alter_database_convert_to_schema(
 	t *testing.T,
 ) {
@@ -290,6 +297,13 @@ func TestLogic_bpchar(
 	runLogicTest(t, "bpchar")
 }
 
+func TestLogic_brin_index(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "brin_index")
+}
+
 func TestLogic_buffered_writes(
 	t *testing.T,
 ) {
@@ -437,6 +451,20 @@ func TestLogic_collatedstring_uniqueindex2(
 	runLogicTest(t, "collatedstring_uniqueindex2")
 }
 
+func TestLogic_column_masking(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_masking")
+}
+
+func TestLogic_column_privileges(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_privileges")
+}
+
 func TestLogic_comment_on(
 	t *testing.T,
 ) {
@@ -563,6 +591,13 @@ func TestLogic_database(
 	runLogicTest(t, "database")
 }
 
+func TestLogic_database_collation(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "database_collation")
+}
+
 func TestLogic_datetime(
 	t *testing.T,
 ) {
@@ -773,6 +808,13 @@ func TestLogic_drop_type(
 	runLogicTest(t, "drop_type")
 }
 
+func TestLogic_drop_type_cascade(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "drop_type_cascade")
+}
+
 func TestLogic_drop_user(
 	t *testing.T,
 ) {
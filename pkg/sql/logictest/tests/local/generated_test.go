@@ -94,6 +94,13 @@ func TestLogic_alter_column_type(
 	runLogicTest(t, "alter_column_type")
 }
 
+func TestLogic_alter_column_type_general(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "alter_column_type_general")
+}
+
 func TestLogic_alter_database_convert_to_schema(
 	t *testing.T,
 ) {
@@ -297,6 +304,13 @@ func TestLogic_bpchar(
 	runLogicTest(t, "bpchar")
 }
 
+func TestLogic_brin_index(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "brin_index")
+}
+
 func TestLogic_buffered_writes(
 	t *testing.T,
 ) {
@@ -458,6 +472,20 @@ func TestLogic_column_families(
 	runLogicTest(t, "column_families")
 }
 
+func TestLogic_column_masking(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_masking")
+}
+
+func TestLogic_column_privileges(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "column_privileges")
+}
+
 func TestLogic_comment_on(
 	t *testing.T,
 ) {
@@ -542,6 +570,20 @@ func TestLogic_create_as_non_metamorphic(
 	runLogicTest(t, "create_as_non_metamorphic")
 }
 
+func TestLogic_create_cast_operator(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "create_cast_operator")
+}
+
+func TestLogic_create_collation(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "create_collation")
+}
+
 func TestLogic_create_index(
 	t *testing.T,
 ) {
@@ -598,6 +640,13 @@ func TestLogic_database(
 	runLogicTest(t, "database")
 }
 
+func TestLogic_database_collation(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "database_collation")
+}
+
 func TestLogic_datetime(
 	t *testing.T,
 ) {
@@ -808,6 +857,13 @@ func TestLogic_drop_type(
 	runLogicTest(t, "drop_type")
 }
 
+func TestLogic_drop_type_cascade(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "drop_type_cascade")
+}
+
 func TestLogic_drop_user(
 	t *testing.T,
 ) {
@@ -1389,6 +1445,13 @@ func TestLogic_kv_builtin_functions_local(
 	runLogicTest(t, "kv_builtin_functions_local")
 }
 
+func TestLogic_large_object(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "large_object")
+}
+
 func TestLogic_limit(
 	t *testing.T,
 ) {
@@ -2530,6 +2593,13 @@ func TestLogic_tenant_builtins(
 	runLogicTest(t, "tenant_builtins")
 }
 
+func TestLogic_text_search_config(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "text_search_config")
+}
+
 func TestLogic_time(
 	t *testing.T,
 ) {
@@ -3034,6 +3104,13 @@ func TestLogic_workload_indexrecs(
 	runLogicTest(t, "workload_indexrecs")
 }
 
+func TestLogic_xml(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "xml")
+}
+
 func TestLogic_zero(
 	t *testing.T,
 ) {
@@ -140,6 +140,10 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.alterRenameTenant(ctx, n)
 	case *tree.AlterTenantService:
 		return p.alterTenantService(ctx, n)
+	case *tree.AlterTextSearchConfig:
+		return p.AlterTextSearchConfig(ctx, n)
+	case *tree.AlterTextSearchDictionary:
+		return p.AlterTextSearchDictionary(ctx, n)
 	case *tree.AlterType:
 		return p.AlterType(ctx, n)
 	case *tree.AlterRole:
@@ -171,16 +175,24 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		// it can't have placeholder arguments, and the execution can use the same
 		// logic as if it were a simple query. This matches the Postgres behavior.
 		return &zeroNode{}, nil
+	case *tree.CreateCast:
+		return p.CreateCast(ctx, n)
+	case *tree.CreateCollation:
+		return p.CreateCollation(ctx, n)
 	case *tree.CreateDatabase:
 		return p.CreateDatabase(ctx, n)
 	case *tree.CreateIndex:
 		return p.CreateIndex(ctx, n)
+	case *tree.CreateOperator:
+		return p.CreateOperator(ctx, n)
 	case *tree.CreatePolicy:
 		return p.CreatePolicy(ctx, n)
 	case *tree.CreateSchema:
 		return p.CreateSchema(ctx, n)
 	case *tree.CreateTrigger:
 		return p.CreateTrigger(ctx, n)
+	case *tree.CreateTextSearch:
+		return p.CreateTextSearch(ctx, n)
 	case *tree.CreateType:
 		return p.CreateType(ctx, n)
 	case *tree.CreateRole:
@@ -203,12 +215,18 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.DeclareCursor(ctx, n)
 	case *tree.Discard:
 		return p.Discard(ctx, n)
+	case *tree.DropCast:
+		return p.DropCast(ctx, n)
+	case *tree.DropCollation:
+		return p.DropCollation(ctx, n)
 	case *tree.DropDatabase:
 		return p.DropDatabase(ctx, n)
 	case *tree.DropRoutine:
 		return p.DropFunction(ctx, n)
 	case *tree.DropIndex:
 		return p.DropIndex(ctx, n)
+	case *tree.DropOperator:
+		return p.DropOperator(ctx, n)
 	case *tree.DropOwnedBy:
 		return p.DropOwnedBy(ctx)
 	case *tree.DropPolicy:
@@ -225,6 +243,8 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.DropTenant(ctx, n)
 	case *tree.DropTrigger:
 		return p.DropTrigger(ctx, n)
+	case *tree.DropTextSearch:
+		return p.DropTextSearch(ctx, n)
 	case *tree.DropType:
 		return p.DropType(ctx, n)
 	case *tree.DropView:
@@ -366,6 +386,8 @@ func init() {
 		&tree.AlterTenantRename{},
 		&tree.AlterTenantSetClusterSetting{},
 		&tree.AlterTenantService{},
+		&tree.AlterTextSearchConfig{},
+		&tree.AlterTextSearchDictionary{},
 		&tree.AlterType{},
 		&tree.AlterSequence{},
 		&tree.AlterRole{},
@@ -380,26 +402,33 @@ func init() {
 		&tree.CommentOnType{},
 		&tree.CommitPrepared{},
 		&tree.CopyTo{},
+		&tree.CreateCast{},
+		&tree.CreateCollation{},
 		&tree.CreateDatabase{},
 		&tree.CreateExtension{},
 		&tree.CreateExternalConnection{},
 		&tree.AlterExternalConnection{},
 		&tree.CreateTenant{},
 		&tree.CreateIndex{},
+		&tree.CreateOperator{},
 		&tree.CreatePolicy{},
 		&tree.CreateSchema{},
 		&tree.CreateSequence{},
 		&tree.CreateTrigger{},
+		&tree.CreateTextSearch{},
 		&tree.CreateType{},
 		&tree.CreateRole{},
 		&tree.Deallocate{},
 		&tree.DeclareCursor{},
 		&tree.Discard{},
+		&tree.DropCast{},
+		&tree.DropCollation{},
 		&tree.DropDatabase{},
 		&tree.DropExternalConnection{},
 		&tree.DropRoutine{},
 		&tree.DropTrigger{},
 		&tree.DropIndex{},
+		&tree.DropOperator{},
 		&tree.DropOwnedBy{},
 		&tree.DropPolicy{},
 		&tree.DropRole{},
@@ -407,6 +436,7 @@ func init() {
 		&tree.DropSequence{},
 		&tree.DropTable{},
 		&tree.DropTenant{},
+		&tree.DropTextSearch{},
 		&tree.DropType{},
 		&tree.DropView{},
 		&tree.FetchCursor{},
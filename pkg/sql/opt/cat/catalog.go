@@ -18,6 +18,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/intsets"
 	"github.com/lib/pq/oid"
 )
 
@@ -229,6 +230,14 @@ type Catalog interface {
 	// the given catalog object. If not, then CheckAnyPrivilege returns an error.
 	CheckAnyPrivilege(ctx context.Context, o Object) error
 
+	// ColumnsWithPrivilege returns the ordinals of the columns of the given
+	// table on which the given user holds the given privilege through a
+	// column-level grant, either directly or through role membership.
+	// Privileges held on the table itself are not taken into account.
+	ColumnsWithPrivilege(
+		ctx context.Context, tab Table, user username.SQLUsername, priv privilege.Kind,
+	) (intsets.Fast, error)
+
 	// CheckExecutionPrivilege verifies that the given user has execution
 	// privileges for the UDF with the given OID. If not, then CheckPrivilege
 	// returns an error.
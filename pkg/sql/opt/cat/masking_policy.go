@@ -0,0 +1,62 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package cat
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/util/intsets"
+	"github.com/lib/pq/oid"
+)
+
+// MaskingPolicy is a dynamic data masking policy on a table column. When a
+// user that the policy applies to reads the column, the column's values are
+// replaced by the result of the masking function.
+type MaskingPolicy struct {
+	// ColumnOrdinal is the ordinal of the masked column in its table.
+	ColumnOrdinal int
+	// FunctionOID is the OID of the user-defined masking function. It takes a
+	// single argument of the column's type and returns a value of that type.
+	FunctionOID oid.Oid
+	// roles are the roles the policy applies to. If the policy applies to all
+	// roles (aka public), this will be nil.
+	roles map[username.SQLUsername]struct{}
+}
+
+// InitRoles builds up the list of roles in the masking policy.
+func (p *MaskingPolicy) InitRoles(roleNames []string) {
+	p.roles = makeRoleSet(roleNames)
+}
+
+// AppliesToRole checks whether the masking policy applies to the given role.
+func (p *MaskingPolicy) AppliesToRole(
+	ctx context.Context, cat Catalog, user username.SQLUsername,
+) bool {
+	if p.roles == nil {
+		return true
+	}
+	belongs, err := cat.UserIsMemberOfAnyRole(ctx, user, p.roles)
+	if err != nil {
+		panic(err)
+	}
+	return belongs
+}
+
+// MaskedColumns returns the ordinals of the columns of the given table whose
+// masking policies apply to the given user.
+func MaskedColumns(
+	ctx context.Context, cat Catalog, tab Table, user username.SQLUsername,
+) intsets.Fast {
+	var masked intsets.Fast
+	policies := tab.MaskingPolicies()
+	for i := range policies {
+		if policies[i].AppliesToRole(ctx, cat, user) {
+			masked.Add(policies[i].ColumnOrdinal)
+		}
+	}
+	return masked
+}
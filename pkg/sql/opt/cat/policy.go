@@ -74,9 +74,15 @@ type Policies struct {
 
 // InitRoles builds up the list of roles in the policy.
 func (p *Policy) InitRoles(roleNames []string) {
+	p.roles = makeRoleSet(roleNames)
+}
+
+// makeRoleSet builds the set of roles with the given normalized names. If the
+// public role is among them, or if there are no roles, nil is returned to
+// signal that all roles apply.
+func makeRoleSet(roleNames []string) map[username.SQLUsername]struct{} {
 	if len(roleNames) == 0 {
-		p.roles = nil
-		return
+		return nil
 	}
 	roles := make(map[username.SQLUsername]struct{})
 	for _, r := range roleNames {
@@ -84,13 +90,12 @@ func (p *Policy) InitRoles(roleNames []string) {
 			// If the public role is defined, there is no need to check the
 			// remaining roles since the policy applies to everyone. We will clear
 			// out the roles map to signal that all roles apply.
-			roles = nil
-			break
+			return nil
 		}
 		roleUsername := username.MakeSQLUsernameFromPreNormalizedString(r)
 		roles[roleUsername] = struct{}{}
 	}
-	p.roles = roles
+	return roles
 }
 
 // AppliesToRole checks whether the policy applies to the given role.
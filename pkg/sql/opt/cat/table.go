@@ -205,6 +205,10 @@ type Table interface {
 
 	// Policies returns all the policies defined for this table.
 	Policies() *Policies
+
+	// MaskingPolicies returns the masking policies defined on the columns of
+	// this table.
+	MaskingPolicies() []MaskingPolicy
 }
 
 // CheckConstraint represents a check constraint on a table. Check constraints
@@ -52,9 +52,14 @@ type Trigger interface {
 	WhenExpr() string
 
 	// FuncID is the ID of the function that will be called when the trigger
-	// fires.
+	// fires. It is unset if the trigger calls a builtin function.
 	FuncID() StableID
 
+	// FuncBuiltin is the name of the builtin function that will be called when
+	// the trigger fires, or the empty string if it calls a user-defined
+	// function.
+	FuncBuiltin() string
+
 	// FuncArgs is a list of constant string arguments for the trigger function.
 	FuncArgs() tree.Datums
 
@@ -143,6 +143,14 @@ type Builder struct {
 	// staleness and contains a scan.
 	containsBoundedStalenessScan bool
 
+	// blockFilters are the filters that buildSelect found for the scan
+	// directly below it. They are used by that scan to skip blocks of the
+	// primary index that are summarized by BRIN indexes.
+	blockFilters struct {
+		scan    *memo.ScanExpr
+		filters []exec.BlockFilter
+	}
+
 	// MaxFullScanRows is the maximum number of rows scanned by a full scan, as
 	// estimated by the optimizer.
 	MaxFullScanRows float64
@@ -852,6 +852,10 @@ func (b *Builder) buildScan(scan *memo.ScanExpr) (_ execPlan, outputCols colOrdM
 		return execPlan{}, colOrdMap{}, errors.AssertionFailedf(
 			"only VectorSearch operators can use vector indexes")
 	}
+	if idx.Type().IsSummary() {
+		return execPlan{}, colOrdMap{}, errors.AssertionFailedf(
+			"summary indexes cannot be scanned")
+	}
 	b.IndexesUsed.add(tab.ID(), idx.ID())
 
 	// Save if we planned a full (large) table/index scan on the builder so that
@@ -938,6 +942,10 @@ func (b *Builder) buildScan(scan *memo.ScanExpr) (_ execPlan, outputCols colOrdM
 	if err != nil {
 		return execPlan{}, colOrdMap{}, err
 	}
+	if b.blockFilters.scan == scan {
+		params.BlockFilters = b.blockFilters.filters
+		b.blockFilters.scan, b.blockFilters.filters = nil, nil
+	}
 	reqOrdering, err := reqOrdering(scan, outputCols)
 	if err != nil {
 		return execPlan{}, colOrdMap{}, err
@@ -1054,6 +1062,11 @@ func (b *Builder) buildPlaceholderScan(
 }
 
 func (b *Builder) buildSelect(sel *memo.SelectExpr) (_ execPlan, outputCols colOrdMap, err error) {
+	if scan, ok := sel.Input.(*memo.ScanExpr); ok {
+		if filters := b.scanBlockFilters(scan, sel.Filters); len(filters) > 0 {
+			b.blockFilters.scan, b.blockFilters.filters = scan, filters
+		}
+	}
 	input, inputCols, err := b.buildRelational(sel.Input)
 	if err != nil {
 		return execPlan{}, colOrdMap{}, err
@@ -1076,6 +1089,53 @@ func (b *Builder) buildSelect(sel *memo.SelectExpr) (_ execPlan, outputCols colO
 	return res, inputCols, nil
 }
 
+// scanBlockFilters returns the single-column constraints implied by the given
+// filters on columns of a primary index scan that are summarized by BRIN
+// indexes.
+func (b *Builder) scanBlockFilters(
+	scan *memo.ScanExpr, filters memo.FiltersExpr,
+) []exec.BlockFilter {
+	if scan.Index != cat.PrimaryIndex {
+		return nil
+	}
+	tab := b.mem.Metadata().Table(scan.Table)
+	var summarizedCols opt.ColSet
+	for i, n := 0, tab.IndexCount(); i < n; i++ {
+		index := tab.Index(i)
+		if !index.Type().IsSummary() {
+			continue
+		}
+		for j, m := 0, index.KeyColumnCount(); j < m; j++ {
+			summarizedCols.Add(scan.Table.ColumnID(index.Column(j).Ordinal()))
+		}
+	}
+	if summarizedCols.Empty() {
+		return nil
+	}
+	var res []exec.BlockFilter
+	for i := range filters {
+		cs := filters[i].ScalarProps().Constraints
+		if cs == nil {
+			continue
+		}
+		for j, n := 0, cs.Length(); j < n; j++ {
+			c := cs.Constraint(j)
+			if c.Columns.Count() != 1 || c.IsUnconstrained() {
+				continue
+			}
+			col := c.Columns.Get(0).ID()
+			if !summarizedCols.Contains(col) {
+				continue
+			}
+			res = append(res, exec.BlockFilter{
+				Column:     exec.TableColumnOrdinal(scan.Table.ColumnOrdinal(col)),
+				Constraint: c,
+			})
+		}
+	}
+	return res
+}
+
 func (b *Builder) buildInvertedFilter(
 	invFilter *memo.InvertedFilterExpr,
 ) (_ execPlan, outputCols colOrdMap, err error) {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
@@ -735,6 +736,7 @@ func (e *emitter) emitNodeAttributes(ctx context.Context, evalCtx *eval.Context,
 		if a.Table != nil && !(a.Table.IsVirtualTable() && a.Params.IndexConstraint == nil) {
 			e.emitSpans("spans", a.Table, a.Index, a.Params)
 		}
+		e.emitBlockFilter(a.Table, a.Params.BlockFilters)
 		if extraAttribute != "" {
 			ob.Attr(extraAttribute, "")
 		}
@@ -1389,6 +1391,32 @@ func (e *emitter) spansStr(table cat.Table, index cat.Index, scanParams exec.Sca
 	return sp.String()
 }
 
+// emitBlockFilter emits the names of the BRIN indexes that a scan may use to
+// skip blocks of the primary index.
+func (e *emitter) emitBlockFilter(table cat.Table, filters []exec.BlockFilter) {
+	if len(filters) == 0 {
+		return
+	}
+	var names []string
+	for i, n := 0, table.IndexCount(); i < n; i++ {
+		index := table.Index(i)
+		if !index.Type().IsSummary() {
+			continue
+		}
+		for j, m := 0, index.KeyColumnCount(); j < m; j++ {
+			if slices.ContainsFunc(filters, func(f exec.BlockFilter) bool {
+				return int(f.Column) == index.Column(j).Ordinal()
+			}) {
+				names = append(names, string(index.Name()))
+				break
+			}
+		}
+	}
+	if len(names) > 0 {
+		e.ob.Attr("block filter", strings.Join(names, ", "))
+	}
+}
+
 func (e *emitter) emitLockingPolicy(locking opt.Locking) {
 	e.emitLockingPolicyWithPrefix("", locking)
 }
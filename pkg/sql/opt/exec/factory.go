@@ -153,6 +153,18 @@ type ScanParams struct {
 	// to work correctly, the execution engine must create a local DistSQL plan
 	// for the main query (subqueries and postqueries need not be local).
 	LocalityOptimized bool
+
+	// BlockFilters, if set, constrain columns of a primary index scan that are
+	// summarized by BRIN indexes. Blocks of the primary index whose summaries
+	// do not satisfy the filters can be skipped.
+	BlockFilters []BlockFilter
+}
+
+// BlockFilter is a single-column constraint implied by the filters on a
+// primary index scan.
+type BlockFilter struct {
+	Column     TableColumnOrdinal
+	Constraint *constraint.Constraint
 }
 
 // OutputOrdering indicates the required output ordering on a Node that is being
@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/intsets"
 	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq/oid"
@@ -42,6 +43,20 @@ type SchemaID int32
 // 1 << privilege.Kind, so that multiple privileges can be stored.
 type privilegeBitmap uint64
 
+// columnPrivilegeDep identifies a privilege that the query requires on some
+// columns of a table, because the privilege is not held on the table itself.
+type columnPrivilegeDep struct {
+	id   cat.StableID
+	priv privilege.Kind
+}
+
+// maskedColumnsDep records the columns of a table that were masked for the
+// user that built the query.
+type maskedColumnsDep struct {
+	tab  cat.Table
+	cols intsets.Fast
+}
+
 type routineDep struct {
 	overload        *tree.Overload
 	invocationTypes []*types.T
@@ -139,6 +154,16 @@ type Metadata struct {
 	// query depends on.
 	privileges map[cat.StableID]privilegeBitmap
 
+	// columnPrivileges stores, for each table that the query accesses through
+	// column privileges rather than privileges on the table, the ordinals of
+	// the columns on which the privilege was held when the query was built.
+	columnPrivileges map[columnPrivilegeDep]intsets.Fast
+
+	// maskedColumns stores, for each table with masking policies that the query
+	// reads, the ordinals of the columns that were masked for the current user
+	// when the query was built.
+	maskedColumns map[cat.StableID]maskedColumnsDep
+
 	// builtinRefsByName stores the names used to reference builtin functions in
 	// the query. This is necessary to handle the case where changes to the search
 	// path cause a function call to be resolved to a UDF with the same signature
@@ -258,6 +283,7 @@ func (md *Metadata) CopyFrom(from *Metadata, copyScalarFn func(Expr) Expr) {
 		len(md.sequences) != 0 || len(md.views) != 0 || len(md.userDefinedTypes) != 0 ||
 		len(md.userDefinedTypesSlice) != 0 || len(md.dataSourceDeps) != 0 ||
 		len(md.routineDeps) != 0 || len(md.objectRefsByName) != 0 || len(md.privileges) != 0 ||
+		len(md.columnPrivileges) != 0 || len(md.maskedColumns) != 0 ||
 		len(md.builtinRefsByName) != 0 || md.rlsMeta.IsInitialized || len(md.hintIDs) != 0 {
 		panic(errors.AssertionFailedf("CopyFrom requires empty destination"))
 	}
@@ -326,6 +352,20 @@ func (md *Metadata) CopyFrom(from *Metadata, copyScalarFn func(Expr) Expr) {
 		md.privileges[id] = privilegeSet
 	}
 
+	for dep, cols := range from.columnPrivileges {
+		if md.columnPrivileges == nil {
+			md.columnPrivileges = make(map[columnPrivilegeDep]intsets.Fast)
+		}
+		md.columnPrivileges[dep] = cols.Copy()
+	}
+
+	for id, dep := range from.maskedColumns {
+		if md.maskedColumns == nil {
+			md.maskedColumns = make(map[cat.StableID]maskedColumnsDep)
+		}
+		md.maskedColumns[id] = maskedColumnsDep{tab: dep.tab, cols: dep.cols.Copy()}
+	}
+
 	for name := range from.builtinRefsByName {
 		if md.builtinRefsByName == nil {
 			md.builtinRefsByName = make(map[tree.UnresolvedName]struct{})
@@ -385,6 +425,41 @@ func (md *Metadata) AddDependency(name MDDepName, ds cat.DataSource, priv privil
 	}
 }
 
+// AddColumnPrivilegeDependency tracks that the query accesses the given table
+// through the given privilege on the given columns, rather than through the
+// privilege on the table. It must be called in addition to AddDependency. If
+// the Memo using this metadata is cached, then CheckDependencies verifies that
+// the current user still holds the privilege on the table or on (at least) the
+// same columns.
+func (md *Metadata) AddColumnPrivilegeDependency(
+	tab cat.Table, priv privilege.Kind, cols intsets.Fast,
+) {
+	if md.columnPrivileges == nil {
+		md.columnPrivileges = make(map[columnPrivilegeDep]intsets.Fast)
+	}
+	dep := columnPrivilegeDep{id: tab.ID(), priv: priv}
+	prev := md.columnPrivileges[dep]
+	md.columnPrivileges[dep] = prev.Union(cols)
+}
+
+// AddMaskedColumnsDependency tracks that the given columns of the given table
+// were masked for the current user when the query was built. If the Memo using
+// this metadata is cached, then CheckDependencies verifies that the same
+// columns are masked for the current user, since masking policies apply based
+// on role membership.
+func (md *Metadata) AddMaskedColumnsDependency(tab cat.Table, cols intsets.Fast) {
+	if md.maskedColumns == nil {
+		md.maskedColumns = make(map[cat.StableID]maskedColumnsDep)
+	}
+	md.maskedColumns[tab.ID()] = maskedColumnsDep{tab: tab, cols: cols.Copy()}
+}
+
+// MaskedColumns returns the ordinals of the columns of the given table that
+// were masked for the current user when the query was built.
+func (md *Metadata) MaskedColumns(tabID cat.StableID) intsets.Fast {
+	return md.maskedColumns[tabID].cols
+}
+
 // dependencyDigestEquals checks if the stored dependency digest matches the
 // current dependency digest.
 func (md *Metadata) dependencyDigestEquals(currentDigest *cat.DependencyDigest) bool {
@@ -594,8 +669,14 @@ func (md *Metadata) CheckDependencies(
 	// NOTE: this check has to happen after the object resolution checks, or else
 	// we may end up returning a privilege error when the memo should have just
 	// been invalidated.
-	if err := md.checkDataSourcePrivileges(ctx, optCatalog); err != nil {
-		return false, err
+	if upToDate, err := md.checkDataSourcePrivileges(ctx, optCatalog); err != nil || !upToDate {
+		return upToDate, err
+	}
+	if upToDate, err := md.checkColumnPrivileges(ctx, optCatalog); err != nil || !upToDate {
+		return upToDate, err
+	}
+	if !md.checkMaskedColumns(ctx, optCatalog) {
+		return false, nil
 	}
 	for _, dep := range md.routineDeps {
 		if err := optCatalog.CheckExecutionPrivilege(ctx, dep.overload.Oid, optCatalog.GetCurrentUser()); err != nil {
@@ -648,10 +729,14 @@ func maybeSwallowMetadataResolveErr(err error) error {
 }
 
 // checkDataSourcePrivileges checks that none of the privileges required by the
-// query for the referenced data sources have been revoked.
-func (md *Metadata) checkDataSourcePrivileges(ctx context.Context, optCatalog cat.Catalog) error {
+// query for the referenced data sources have been revoked. It returns false if
+// the user lacks a privilege on a table but holds it on some of its columns,
+// since the query must then be rebuilt to check which columns it accesses.
+func (md *Metadata) checkDataSourcePrivileges(
+	ctx context.Context, optCatalog cat.Catalog,
+) (upToDate bool, _ error) {
 	for _, dataSource := range md.dataSourceDeps {
-		err := func() error {
+		upToDate, err := func() (bool, error) {
 			privileges := md.privileges[dataSource.ID()]
 
 			// Check if this dependency has the special builtin-allowed privilege.
@@ -671,21 +756,67 @@ func (md *Metadata) checkDataSourcePrivileges(ctx context.Context, optCatalog ca
 				// privileges do not need to be checked). Ignore the "zero privilege".
 				priv := privilege.Kind(bits.TrailingZeros32(uint32(privs)))
 				if priv != 0 {
-					if err := optCatalog.CheckPrivilege(ctx, dataSource, optCatalog.GetCurrentUser(), priv); err != nil {
-						return err
+					user := optCatalog.GetCurrentUser()
+					if err := optCatalog.CheckPrivilege(ctx, dataSource, user, priv); err != nil {
+						if tab, ok := dataSource.(cat.Table); ok {
+							cols, colErr := optCatalog.ColumnsWithPrivilege(ctx, tab, user, priv)
+							if colErr != nil {
+								return false, colErr
+							}
+							if !cols.Empty() {
+								return false, nil
+							}
+						}
+						return false, err
 					}
 				}
 				// Set the just-handled privilege bit to zero and look for next.
 				privs &= ^(1 << priv)
 			}
 
-			return nil
+			return true, nil
 		}()
+		if err != nil || !upToDate {
+			return upToDate, err
+		}
+	}
+	return true, nil
+}
+
+// checkColumnPrivileges checks that the user still holds the privileges on the
+// columns of tables that the query accesses through column privileges. It
+// returns false if some of these privileges have been revoked, so that the
+// query is rebuilt and reports which column cannot be accessed.
+func (md *Metadata) checkColumnPrivileges(
+	ctx context.Context, optCatalog cat.Catalog,
+) (upToDate bool, _ error) {
+	user := optCatalog.GetCurrentUser()
+	for dep, cols := range md.columnPrivileges {
+		tab := md.dataSourceDeps[dep.id].(cat.Table)
+		if err := optCatalog.CheckPrivilege(ctx, tab, user, dep.priv); err == nil {
+			continue
+		}
+		allowed, err := optCatalog.ColumnsWithPrivilege(ctx, tab, user, dep.priv)
 		if err != nil {
-			return err
+			return false, err
+		}
+		if !cols.SubsetOf(allowed) {
+			return false, nil
 		}
 	}
-	return nil
+	return true, nil
+}
+
+// checkMaskedColumns returns false if the columns masked for the current user
+// differ from those that were masked when the query was built.
+func (md *Metadata) checkMaskedColumns(ctx context.Context, optCatalog cat.Catalog) bool {
+	user := optCatalog.GetCurrentUser()
+	for _, dep := range md.maskedColumns {
+		if !cat.MaskedColumns(ctx, optCatalog, dep.tab, user).Equals(dep.cols) {
+			return false
+		}
+	}
+	return true
 }
 
 // AddSchema indexes a new reference to a schema used by the query.
@@ -1242,6 +1373,20 @@ func (md *Metadata) TestingPrivileges() map[cat.StableID]privilegeBitmap {
 	return md.privileges
 }
 
+// TestingMaskedColumns exposes the masked columns for testing.
+func (md *Metadata) TestingMaskedColumns() map[cat.StableID]intsets.Fast {
+	res := make(map[cat.StableID]intsets.Fast, len(md.maskedColumns))
+	for id, dep := range md.maskedColumns {
+		res[id] = dep.cols
+	}
+	return res
+}
+
+// TestingColumnPrivileges exposes the column privileges for testing.
+func (md *Metadata) TestingColumnPrivileges() map[columnPrivilegeDep]intsets.Fast {
+	return md.columnPrivileges
+}
+
 // SetRLSEnabled will update the metadata to indicate we came across a table
 // that had row-level security enabled.
 func (md *Metadata) SetRLSEnabled(
@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/intsets"
 	"github.com/stretchr/testify/require"
 )
 
@@ -103,6 +104,9 @@ func TestMetadata(t *testing.T) {
 		t.Fatalf("expected table privilege to be revoked")
 	}
 
+	md.AddColumnPrivilegeDependency(tab, privilege.SELECT, intsets.MakeFast(0, 2))
+	md.AddMaskedColumnsDependency(tab, intsets.MakeFast(1))
+
 	udfName := tree.MakeQualifiedRoutineName("t", "public", "udf")
 	md.AddUserDefinedRoutine(
 		&tree.Overload{Oid: catid.FuncIDToOID(1111)},
@@ -196,6 +200,26 @@ func TestMetadata(t *testing.T) {
 		}
 	}
 
+	newColPrivileges, oldColPrivileges := mdNew.TestingColumnPrivileges(), md.TestingColumnPrivileges()
+	if len(oldColPrivileges) != 1 {
+		t.Fatalf("expected column privileges to be tracked")
+	}
+	for dep, cols := range oldColPrivileges {
+		if !newColPrivileges[dep].Equals(cols) {
+			t.Fatalf("expected column privileges to be copied")
+		}
+	}
+
+	newMasked, oldMasked := mdNew.TestingMaskedColumns(), md.TestingMaskedColumns()
+	if len(oldMasked) != 1 {
+		t.Fatalf("expected masked columns to be tracked")
+	}
+	for id, cols := range oldMasked {
+		if !newMasked[id].Equals(cols) {
+			t.Fatalf("expected masked columns to be copied")
+		}
+	}
+
 	depsUpToDate, err = md.CheckDependencies(context.Background(), &evalCtx, testCat)
 	if err == nil || depsUpToDate {
 		t.Fatalf("expected table privilege to be revoked in metadata copy")
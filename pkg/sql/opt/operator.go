@@ -214,6 +214,10 @@ var AggregateOpReverseMap = map[Operator]string{
 	VarianceOp:                    "variance",
 	StdDevOp:                      "stddev",
 	XorAggOp:                      "xor_agg",
+	XMLAggOp:                      "xmlagg",
+	STClusterIntersectingOp:       "st_clusterintersecting",
+	STClusterWithinOp:             "st_clusterwithin",
+	STPolygonizeOp:                "st_polygonize",
 	JsonAggOp:                     "json_agg",
 	JsonbAggOp:                    "jsonb_agg",
 	JsonObjectAggOp:               "json_object_agg",
@@ -239,17 +243,19 @@ var AggregateOpReverseMap = map[Operator]string{
 // WindowOpReverseMap maps from an optimizer operator type to the name of a
 // window function.
 var WindowOpReverseMap = map[Operator]string{
-	RankOp:        "rank",
-	RowNumberOp:   "row_number",
-	DenseRankOp:   "dense_rank",
-	PercentRankOp: "percent_rank",
-	CumeDistOp:    "cume_dist",
-	NtileOp:       "ntile",
-	LagOp:         "lag",
-	LeadOp:        "lead",
-	FirstValueOp:  "first_value",
-	LastValueOp:   "last_value",
-	NthValueOp:    "nth_value",
+	RankOp:            "rank",
+	RowNumberOp:       "row_number",
+	DenseRankOp:       "dense_rank",
+	PercentRankOp:     "percent_rank",
+	CumeDistOp:        "cume_dist",
+	NtileOp:           "ntile",
+	LagOp:             "lag",
+	LeadOp:            "lead",
+	FirstValueOp:      "first_value",
+	LastValueOp:       "last_value",
+	NthValueOp:        "nth_value",
+	STClusterDBSCANOp: "st_clusterdbscan",
+	STClusterKMeansOp: "st_clusterkmeans",
 }
 
 // NegateOpMap maps from a comparison operator type to its negated operator
@@ -333,7 +339,8 @@ func AggregateIgnoresNulls(op Operator) bool {
 		VarPopOp, CovarPopOp, CovarSampOp, RegressionAvgXOp, RegressionAvgYOp,
 		RegressionInterceptOp, RegressionR2Op, RegressionSlopeOp, RegressionSXXOp,
 		RegressionSXYOp, RegressionSYYOp, RegressionCountOp, MergeStatsMetadataOp,
-		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp:
+		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp,
+		XMLAggOp, STClusterIntersectingOp, STClusterWithinOp, STPolygonizeOp:
 		return true
 
 	case ArrayAggOp, ArrayCatAggOp, ConcatAggOp, ConstAggOp, CountRowsOp,
@@ -360,7 +367,8 @@ func AggregateIsNullOnEmpty(op Operator) bool {
 		VarPopOp, CovarPopOp, CovarSampOp, RegressionAvgXOp, RegressionAvgYOp,
 		RegressionInterceptOp, RegressionR2Op, RegressionSlopeOp, RegressionSXXOp,
 		RegressionSXYOp, RegressionSYYOp, MergeStatsMetadataOp, MergeStatementStatsOp,
-		MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp:
+		MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp, XMLAggOp,
+		STClusterIntersectingOp, STClusterWithinOp, STPolygonizeOp:
 		return true
 
 	case CountOp, CountRowsOp, RegressionCountOp:
@@ -389,7 +397,8 @@ func AggregateIsNeverNullOnNonNullInput(op Operator) bool {
 		JsonObjectAggOp, JsonbObjectAggOp, StdDevPopOp, STCollectOp, STUnionOp,
 		VarPopOp, CovarPopOp, RegressionAvgXOp, RegressionAvgYOp, RegressionSXXOp,
 		RegressionSXYOp, RegressionSYYOp, RegressionCountOp, MergeStatsMetadataOp,
-		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp:
+		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp,
+		XMLAggOp, STClusterIntersectingOp, STClusterWithinOp, STPolygonizeOp:
 		return true
 
 	case VarianceOp, StdDevOp, CorrOp, CovarSampOp, RegressionInterceptOp,
@@ -445,7 +454,8 @@ func AggregatesCanMerge(inner, outer Operator) bool {
 		VarPopOp, CovarPopOp, CovarSampOp, RegressionAvgXOp, RegressionAvgYOp,
 		RegressionInterceptOp, RegressionR2Op, RegressionSlopeOp, RegressionSXXOp,
 		RegressionSXYOp, RegressionSYYOp, RegressionCountOp, MergeStatsMetadataOp,
-		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp:
+		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp,
+		XMLAggOp, STClusterIntersectingOp, STClusterWithinOp, STPolygonizeOp:
 		return false
 
 	default:
@@ -471,7 +481,8 @@ func AggregateIgnoresDuplicates(op Operator) bool {
 		CovarSampOp, RegressionAvgXOp, RegressionAvgYOp, RegressionInterceptOp,
 		RegressionR2Op, RegressionSlopeOp, RegressionSXXOp, RegressionSXYOp,
 		RegressionSYYOp, RegressionCountOp, MergeStatsMetadataOp, MergeStatementStatsOp,
-		MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp:
+		MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp, XMLAggOp,
+		STClusterIntersectingOp, STClusterWithinOp, STPolygonizeOp:
 		return false
 
 	default:
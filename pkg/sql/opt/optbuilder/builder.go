@@ -641,6 +641,16 @@ func (o *optTrackingTypeResolver) ResolveType(
 	return typ, nil
 }
 
+// ResolveCollation implements the tree.CollationResolver interface.
+func (o *optTrackingTypeResolver) ResolveCollation(
+	ctx context.Context, name string,
+) (locale string, found bool, _ error) {
+	if cr, ok := o.res.(tree.CollationResolver); ok {
+		return cr.ResolveCollation(ctx, name)
+	}
+	return "", false, nil
+}
+
 // ResolveTypeByOID implements the tree.TypeResolver interface.
 func (o *optTrackingTypeResolver) ResolveTypeByOID(
 	ctx context.Context, oid oid.Oid,
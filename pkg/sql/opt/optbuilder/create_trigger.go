@@ -61,8 +61,10 @@ func (b *Builder) buildCreateTrigger(ct *tree.CreateTrigger, inScope *scope) (ou
 		panic(errors.AssertionFailedf("%s is not a function", funcExpr.Func.String()))
 	}
 	o := f.ResolvedOverload()
-	if err := b.catalog.CheckExecutionPrivilege(b.ctx, o.Oid, b.checkPrivilegeUser); err != nil {
-		panic(err)
+	if o.Type != tree.BuiltinRoutine {
+		if err := b.catalog.CheckExecutionPrivilege(b.ctx, o.Oid, b.checkPrivilegeUser); err != nil {
+			panic(err)
+		}
 	}
 
 	var allEventTypes tree.TriggerEventTypeSet
@@ -175,8 +177,32 @@ func (b *Builder) buildFunctionForTrigger(
 		// NOTE: Trigger functions never use SQL.
 		panic(errors.AssertionFailedf("SQL language not supported for triggers"))
 	}
-	// The trigger always references the trigger function.
-	b.schemaFunctionDeps.Add(int(funcdesc.UserDefinedFunctionOIDToID(o.Oid)))
+	body := o.Body
+	if o.Type == tree.BuiltinRoutine {
+		// Builtin trigger functions have no descriptor. Their body is generated
+		// from the trigger definition; it is stored with the trigger, but
+		// regenerated each time the trigger is built.
+		if o.TriggerBody == nil {
+			panic(errors.AssertionFailedf("builtin trigger function %s has no body", ct.FuncName))
+		}
+		call := tree.BuiltinTriggerCall{
+			ActionTime: ct.ActionTime,
+			ForEachRow: ct.ForEach == tree.TriggerForEachRow,
+			Args:       ct.FuncArgs,
+			TableTyp:   tableTyp,
+		}
+		for _, event := range ct.Events {
+			call.Events.Add(event.EventType)
+		}
+		var err error
+		if body, err = o.TriggerBody(&call); err != nil {
+			panic(err)
+		}
+		ct.FuncBuiltin = f.Func.FunctionReference.(*tree.ResolvedFunctionDefinition).Name
+	} else {
+		// The trigger always references the trigger function.
+		b.schemaFunctionDeps.Add(int(funcdesc.UserDefinedFunctionOIDToID(o.Oid)))
+	}
 
 	// The trigger function can reference the NEW and OLD transition relations,
 	// aliased in the trigger definition.
@@ -233,7 +259,7 @@ func (b *Builder) buildFunctionForTrigger(
 	// We need to disable stable function folding because we want to catch the
 	// volatility of stable functions. If folded, we only get a scalar and lose
 	// the volatility.
-	stmt, err := parser.Parse(o.Body)
+	stmt, err := parser.Parse(body)
 	if err != nil {
 		panic(err)
 	}
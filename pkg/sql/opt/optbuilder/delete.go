@@ -57,7 +57,7 @@ func (b *Builder) buildDelete(del *tree.Delete, inScope *scope) (outScope *scope
 	}
 
 	// Find which table we're working on, check the permissions.
-	tab, depName, alias, refColumns := b.resolveTableForMutation(del.Table, privilege.DELETE)
+	tab, depName, alias, refColumns, _ := b.resolveTableForMutation(del.Table, privilege.DELETE)
 
 	if tab.IsVirtualTable() {
 		panic(pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
@@ -70,14 +70,29 @@ func (b *Builder) buildDelete(del *tree.Delete, inScope *scope) (outScope *scope
 			"cannot specify a list of column IDs with DELETE"))
 	}
 
-	// Check Select permission as well, since existing values must be read.
-	b.checkPrivilege(depName, tab, privilege.SELECT)
+	// Check Select permission as well, since existing values must be read. If
+	// the user only holds it on some columns, only these columns may be read by
+	// the WHERE and RETURNING clauses. The ORDER BY clause is not tracked, so it
+	// requires the privilege on the whole table.
+	selectCols, selectRestricted := b.checkPrivilegeWithColumns(depName, tab, privilege.SELECT)
+	if selectRestricted && del.OrderBy != nil {
+		b.checkPrivilege(depName, tab, privilege.SELECT)
+	}
+
+	// Masked columns may only be read through their masking functions. The
+	// columns read by the WHERE clause are checked below; the RETURNING and
+	// ORDER BY clauses are not tracked, so they may not be used.
+	masked := b.maskedColumns(tab)
+	if del.OrderBy != nil || resultsNeeded(del.Returning) {
+		b.checkNoMaskedColumns(tab, masked)
+	}
 
 	// Check if this table has already been mutated in another subquery.
 	b.checkMultipleMutations(tab, generalMutation)
 
 	var mb mutationBuilder
 	mb.init(b, "delete", tab, alias)
+	mb.returningCols, mb.returningRestricted = selectCols, selectRestricted
 
 	// Build the input expression that selects the rows that will be deleted:
 	//
@@ -86,7 +101,12 @@ func (b *Builder) buildDelete(del *tree.Delete, inScope *scope) (outScope *scope
 	//   ORDER BY <order-by> LIMIT <limit>
 	//
 	// All columns from the delete table will be projected.
-	mb.buildInputForDelete(inScope, del.Table, del.Where, del.Using, del.Limit, del.OrderBy)
+	var whereColRefs opt.ColSet
+	mb.buildInputForDelete(inScope, del.Table, del.Where, &whereColRefs, del.Using, del.Limit, del.OrderBy)
+	if selectRestricted {
+		mb.checkFetchColPrivileges(privilege.SELECT, selectCols, whereColRefs)
+	}
+	mb.checkMaskedColRefs(masked, whereColRefs)
 
 	// Project row-level BEFORE triggers for DELETE.
 	mb.buildRowLevelBeforeTriggers(tree.TriggerEventDelete, false /* cascade */)
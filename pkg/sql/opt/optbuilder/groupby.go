@@ -248,7 +248,8 @@ func (a aggregateInfo) isOrderingSensitive() bool {
 	switch a.def.Name {
 	case "array_agg", "array_cat_agg", "concat_agg", "string_agg", "json_agg",
 		"jsonb_agg", "json_object_agg", "jsonb_object_agg", "st_makeline",
-		"st_collect", "st_memcollect":
+		"st_collect", "st_memcollect", "xmlagg", "st_clusterintersecting",
+		"st_clusterwithin", "st_polygonize":
 		return true
 	default:
 		return false
@@ -795,6 +796,10 @@ func (b *Builder) constructWindowFn(name string, args []opt.ScalarExpr) opt.Scal
 		return b.factory.ConstructLastValue(args[0])
 	case "nth_value":
 		return b.factory.ConstructNthValue(args[0], args[1])
+	case "st_clusterdbscan":
+		return b.factory.ConstructSTClusterDBSCAN(args[0], args[1], args[2])
+	case "st_clusterkmeans":
+		return b.factory.ConstructSTClusterKMeans(args[0], args[1], args[2])
 	default:
 		return b.constructAggregate(name, args)
 	}
@@ -896,6 +901,14 @@ func (b *Builder) constructAggregate(name string, args []opt.ScalarExpr) opt.Sca
 		return b.factory.ConstructMergeTransactionStats(args[0])
 	case "merge_aggregated_stmt_metadata":
 		return b.factory.ConstructMergeAggregatedStmtMetadata(args[0])
+	case "xmlagg":
+		return b.factory.ConstructXMLAgg(args[0])
+	case "st_clusterintersecting":
+		return b.factory.ConstructSTClusterIntersecting(args[0])
+	case "st_clusterwithin":
+		return b.factory.ConstructSTClusterWithin(args[0], args[1])
+	case "st_polygonize":
+		return b.factory.ConstructSTPolygonize(args[0])
 	}
 
 	panic(errors.AssertionFailedf("unhandled aggregate: %s", name))
@@ -177,7 +177,7 @@ func init() {
 // and thereby scrambles the input ordering.
 func (b *Builder) buildInsert(ins *tree.Insert, inScope *scope) (outScope *scope) {
 	// Find which table we're working on, check the permissions.
-	tab, depName, alias, refColumns := b.resolveTableForMutation(ins.Table, privilege.INSERT)
+	tab, depName, alias, refColumns, allowedCols := b.resolveTableForMutation(ins.Table, privilege.INSERT)
 
 	if tab.IsVirtualTable() {
 		panic(pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
@@ -214,6 +214,18 @@ func (b *Builder) buildInsert(ins *tree.Insert, inScope *scope) (outScope *scope
 		}
 	}
 
+	// Masked columns may only be read through their masking functions. An
+	// UPSERT or ON CONFLICT DO UPDATE can return the existing values of
+	// conflicting rows, so RETURNING may not be used with masked columns. The
+	// columns read by the DO UPDATE clause are checked below.
+	var masked intsets.Fast
+	if ins.OnConflict != nil && !ins.OnConflict.DoNothing {
+		masked = b.maskedColumns(tab)
+		if resultsNeeded(ins.Returning) {
+			b.checkNoMaskedColumns(tab, masked)
+		}
+	}
+
 	// Check if this table has already been mutated in another subquery.
 	mutType := generalMutation
 	if ins.OnConflict == nil {
@@ -277,6 +289,11 @@ func (b *Builder) buildInsert(ins *tree.Insert, inScope *scope) (outScope *scope
 		mb.buildInputForInsert(inScope, nil /* rows */)
 	}
 
+	// If the user only holds the INSERT privilege on some columns, ensure that
+	// no other column is targeted. Columns that are not targeted are set to
+	// their default values, which does not require privileges.
+	mb.checkTargetColPrivileges(privilege.INSERT, allowedCols)
+
 	// Add default columns that were not explicitly specified by name or
 	// implicitly targeted by input columns. Also add any computed columns. In
 	// both cases, include columns undergoing mutations in the write-only state.
@@ -381,15 +398,19 @@ func (b *Builder) buildInsert(ins *tree.Insert, inScope *scope) (outScope *scope
 		// Add a filter from the WHERE clause if one exists. This must happen after
 		// the INSERT triggers are added, since BEFORE INSERT triggers are called
 		// for every input row, even if it doesn't end up being inserted.
+		// Note that colRefs will include the canary column if there is a WHERE
+		// clause, so a masked canary column conservatively disallows it.
+		var colRefs opt.ColSet
 		if ins.OnConflict.Where != nil {
-			mb.buildOnConflictWhereClause(ins.OnConflict.Where, canaryCol)
+			mb.buildOnConflictWhereClause(ins.OnConflict.Where, canaryCol, &colRefs)
 		}
 
 		// Derive the columns that will be updated from the SET expressions.
 		mb.addTargetColsForUpdate(ins.OnConflict.Exprs)
 
 		// Build each of the SET expressions.
-		mb.addUpdateCols(ins.OnConflict.Exprs, nil /* colRefs */)
+		mb.addUpdateCols(ins.OnConflict.Exprs, &colRefs)
+		mb.checkMaskedColRefs(masked, colRefs)
 
 		// Project row-level BEFORE triggers for UPDATE.
 		mb.buildRowLevelBeforeTriggers(tree.TriggerEventUpdate, false /* cascade */)
@@ -1155,7 +1176,7 @@ func (mb *mutationBuilder) projectUpsertColumns() {
 // The filter applies only to conflicting rows, as indicated by the canary
 // column.
 func (mb *mutationBuilder) buildOnConflictWhereClause(
-	whereClause *tree.Where, canaryCol tree.TypedExpr,
+	whereClause *tree.Where, canaryCol tree.TypedExpr, colRefs *opt.ColSet,
 ) {
 	where := &tree.Where{
 		Type: whereClause.Type,
@@ -1168,5 +1189,5 @@ func (mb *mutationBuilder) buildOnConflictWhereClause(
 			Right: whereClause.Expr,
 		},
 	}
-	mb.b.buildWhere(where, mb.outScope, nil /* colRefs */)
+	mb.b.buildWhere(where, mb.outScope, colRefs)
 }
@@ -474,6 +474,16 @@ func (jb *usingJoinBuilder) findUsingColumn(
 // either the left or right column value, or, in the case of a FULL JOIN, an
 // IFNULL(left, right) expression.
 func (jb *usingJoinBuilder) addEqualityCondition(leftCol, rightCol *scopeColumn) {
+	// The join condition references both columns, so they must be accessible.
+	// This also ensures that the merged column, which may be a copy of either
+	// column or an IFNULL of both, never exposes a restricted column.
+	if leftCol.resolveErr != nil {
+		panic(leftCol.resolveErr)
+	}
+	if rightCol.resolveErr != nil {
+		panic(rightCol.resolveErr)
+	}
+
 	// First, check if the comparison would even be valid.
 	if !leftCol.typ.Equivalent(rightCol.typ) {
 		if !tree.EqualComparisonFunctionExists(leftCol.typ, rightCol.typ) {
@@ -237,6 +237,12 @@ type mutationBuilder struct {
 	// RETURNING clause, respectively.
 	extraAccessibleCols []scopeColumn
 
+	// returningCols, if returningRestricted is true, contains the ordinals of
+	// the target table columns that the RETURNING clause may refer to, because
+	// the current user only holds the SELECT privilege on these columns.
+	returningCols       intsets.Fast
+	returningRestricted bool
+
 	// fkCheckHelper is used to prevent allocating the helper separately.
 	fkCheckHelper fkCheckHelper
 
@@ -461,6 +467,7 @@ func (mb *mutationBuilder) buildInputForDelete(
 	inScope *scope,
 	texpr tree.TableExpr,
 	where *tree.Where,
+	whereColRefs *opt.ColSet,
 	using tree.TableExprs,
 	limit *tree.Limit,
 	orderBy tree.OrderBy,
@@ -535,7 +542,7 @@ func (mb *mutationBuilder) buildInputForDelete(
 	}
 
 	// WHERE
-	mb.b.buildWhere(where, mb.outScope, nil /* colRefs */)
+	mb.b.buildWhere(where, mb.outScope, whereColRefs)
 
 	// SELECT + ORDER BY (which may add projected expressions)
 	projectionsScope := mb.outScope.replace()
@@ -616,6 +623,65 @@ func (mb *mutationBuilder) addTargetCol(ord int) {
 	mb.explicitTargetColOrds.Add(ord)
 }
 
+// checkTargetColPrivileges raises an error if a column that is not in the
+// allowed set of table ordinals was explicitly targeted. allowedCols is
+// returned by resolveTableForMutation, and is empty if the current user holds
+// the given privilege on the whole table.
+func (mb *mutationBuilder) checkTargetColPrivileges(priv privilege.Kind, allowedCols intsets.Fast) {
+	if allowedCols.Empty() {
+		return
+	}
+	for ord, ok := mb.explicitTargetColOrds.Next(0); ok; ord, ok = mb.explicitTargetColOrds.Next(ord + 1) {
+		if !allowedCols.Contains(ord) {
+			panic(sqlerrors.NewInsufficientColumnPrivilegeError(mb.b.checkPrivilegeUser, priv,
+				string(mb.tab.Column(ord).ColName()), string(mb.tab.Name())))
+		}
+	}
+}
+
+// checkFetchColPrivileges raises an error if one of the given columns is a
+// fetch column whose table ordinal is not in the allowed set. It is used when
+// the current user only holds the given privilege on some columns of the
+// target table.
+func (mb *mutationBuilder) checkFetchColPrivileges(
+	priv privilege.Kind, allowedCols intsets.Fast, cols opt.ColSet,
+) {
+	for i := range mb.fetchScope.cols {
+		col := &mb.fetchScope.cols[i]
+		if cols.Contains(col.id) && !allowedCols.Contains(col.tableOrdinal) {
+			panic(sqlerrors.NewInsufficientColumnPrivilegeError(mb.b.checkPrivilegeUser, priv,
+				string(mb.tab.Column(col.tableOrdinal).ColName()), string(mb.tab.Name())))
+		}
+	}
+}
+
+// checkMaskedColRefs raises an error if one of the given columns is a fetch
+// column that is masked for the current user. The original values of masked
+// columns may not be used to select or compute the rows of a mutation, since
+// they could then be inferred from its effects.
+func (mb *mutationBuilder) checkMaskedColRefs(masked intsets.Fast, cols opt.ColSet) {
+	if masked.Empty() {
+		return
+	}
+	for i := range mb.fetchScope.cols {
+		col := &mb.fetchScope.cols[i]
+		if cols.Contains(col.id) && masked.Contains(col.tableOrdinal) {
+			panic(sqlerrors.NewMaskedColumnReferenceError(mb.b.checkPrivilegeUser,
+				string(mb.tab.Column(col.tableOrdinal).ColName()), string(mb.tab.Name())))
+		}
+	}
+}
+
+// checkNoMaskedColumns raises an error if any column of the given table is
+// masked for the current user. It is used for the clauses of mutations whose
+// column references are not tracked, such as RETURNING.
+func (b *Builder) checkNoMaskedColumns(tab cat.Table, masked intsets.Fast) {
+	if ord, ok := masked.Next(0); ok {
+		panic(sqlerrors.NewMaskedColumnReferenceError(b.checkPrivilegeUser,
+			string(tab.Column(ord).ColName()), string(tab.Name())))
+	}
+}
+
 // trackTargetColDeps adds column dependencies for the target columns that were
 // explicitly specified by the user.
 func (mb *mutationBuilder) trackTargetColDeps() {
@@ -1941,6 +2007,15 @@ func (mb *mutationBuilder) buildReturningScopes(
 	//
 	inScope = mb.outScope.replace()
 	mb.b.appendOrdinaryColumnsFromTable(inScope, mb.md.TableMeta(mb.tabID), &mb.alias)
+	if mb.returningRestricted {
+		for i := range inScope.cols {
+			col := &inScope.cols[i]
+			if ord := mb.tabID.ColumnOrdinal(col.id); !mb.returningCols.Contains(ord) {
+				col.resolveErr = sqlerrors.NewInsufficientColumnPrivilegeError(mb.b.checkPrivilegeUser,
+					privilege.SELECT, string(col.name.ReferenceName()), string(mb.tab.Name()))
+			}
+		}
+	}
 
 	// extraAccessibleCols contains all the columns that the RETURNING
 	// clause can refer to in addition to the table columns. This is useful for
@@ -549,6 +549,13 @@ func (b *Builder) buildFunction(
 	if overload.HasSQLBody() {
 		return b.buildUDF(f, def, inScope, outScope, outCol, colRefs)
 	}
+	// Like user-defined trigger functions, builtin trigger functions cannot be
+	// directly invoked.
+	if f.ResolvedType().Identical(types.Trigger) {
+		panic(pgerror.New(pgcode.FeatureNotSupported,
+			"trigger functions can only be called as triggers",
+		))
+	}
 	unsafeOverride := b.evalCtx.TestingKnobs.UnsafeOverride
 	if b.isUnsafeBuiltin(overload, def) {
 		if err := unsafesql.CheckInternalsAccess(b.ctx, b.evalCtx.SessionData(), b.stmt, b.evalCtx.Annotations, &b.evalCtx.Settings.SV, unsafeOverride); err != nil {
@@ -131,7 +131,8 @@ func (b *Builder) buildDataSource(
 			return outScope
 		}
 
-		ds, depName, resName := b.resolveDataSource(tn, privilege.SELECT)
+		ds, depName, resName := b.lookupDataSource(tn)
+		allowedCols, restricted := b.checkPrivilegeWithColumns(depName, ds, privilege.SELECT)
 		lockCtx.filter(tn.ObjectName)
 		if lockCtx.locking.isSet() {
 			// If this table was on the null-extended side of an outer join, we are not
@@ -156,7 +157,7 @@ func (b *Builder) buildDataSource(
 		case cat.Table:
 			tabMeta := b.addTable(t, &resName)
 			policyCommandScope, locking := b.prepForTableScan(lockCtx.locking, tabMeta)
-			return b.buildScan(
+			outScope = b.buildScan(
 				tabMeta,
 				tableOrdinals(t, columnKinds{
 					includeMutations: false,
@@ -167,6 +168,10 @@ func (b *Builder) buildDataSource(
 				false, /* disableNotVisibleIndex */
 				policyCommandScope,
 			)
+			if restricted {
+				b.restrictColumnAccess(outScope, t, privilege.SELECT, allowedCols)
+			}
+			return b.applyMaskingPolicies(t, outScope)
 
 		case cat.Sequence:
 			return b.buildSequenceSelect(t, &resName, inScope)
@@ -253,7 +258,8 @@ func (b *Builder) buildDataSource(
 		return outScope
 
 	case *tree.TableRef:
-		ds, depName := b.resolveDataSourceRef(source, privilege.SELECT)
+		ds, depName := b.lookupDataSourceRef(source)
+		allowedCols, restricted := b.checkPrivilegeWithColumns(depName, ds, privilege.SELECT)
 
 		lockCtx.filter(source.As.Alias)
 		if lockCtx.locking.isSet() {
@@ -278,6 +284,10 @@ func (b *Builder) buildDataSource(
 		switch t := ds.(type) {
 		case cat.Table:
 			outScope = b.buildScanFromTableRef(t, source, indexFlags, lockCtx.locking, inScope)
+			if restricted {
+				b.restrictColumnAccess(outScope, t, privilege.SELECT, allowedCols)
+			}
+			outScope = b.applyMaskingPolicies(t, outScope)
 		case cat.View:
 			if source.Columns != nil {
 				panic(pgerror.Newf(pgcode.FeatureNotSupported,
@@ -486,6 +496,76 @@ func (b *Builder) buildScanFromTableRef(
 	)
 }
 
+// restrictColumnAccess prevents references to the columns of the given table
+// that are not in the allowed set of table ordinals, because the current user
+// only holds the given privilege on the allowed columns. Such columns remain in
+// the scope, so that they can be used internally (e.g. by computed columns or
+// row-level security policies), but resolving them by name or expanding them
+// via a star raises an error.
+func (b *Builder) restrictColumnAccess(
+	s *scope, tab cat.Table, priv privilege.Kind, allowed intsets.Fast,
+) {
+	for i := range s.cols {
+		col := &s.cols[i]
+		if allowed.Contains(col.tableOrdinal) {
+			continue
+		}
+		col.resolveErr = sqlerrors.NewInsufficientColumnPrivilegeError(
+			b.checkPrivilegeUser, priv, string(col.name.ReferenceName()), string(tab.Name()))
+	}
+}
+
+// maskedColumns returns the ordinals of the columns of the given table whose
+// masking policies apply to the current user, and records them in the metadata
+// so that cached plans are rebuilt if they change.
+func (b *Builder) maskedColumns(tab cat.Table) intsets.Fast {
+	if len(tab.MaskingPolicies()) == 0 {
+		return intsets.Fast{}
+	}
+	masked := cat.MaskedColumns(b.ctx, b.catalog, tab, b.checkPrivilegeUser)
+	b.factory.Metadata().AddMaskedColumnsDependency(tab, masked)
+	return masked
+}
+
+// applyMaskingPolicies replaces the columns of the given scan scope that are
+// masked for the current user with the results of their masking functions.
+// The masked columns keep the names of the original columns, so that the rest
+// of the query, including its WHERE clause and star expansions, can only
+// observe the masked values.
+func (b *Builder) applyMaskingPolicies(tab cat.Table, inScope *scope) (outScope *scope) {
+	masked := b.maskedColumns(tab)
+	if masked.Empty() {
+		return inScope
+	}
+	policies := tab.MaskingPolicies()
+	outScope = inScope.replace()
+	outScope.appendColumnsFromScope(inScope)
+	for i := range outScope.cols {
+		col := &outScope.cols[i]
+		if col.kind != cat.Ordinary || !masked.Contains(col.tableOrdinal) {
+			continue
+		}
+		var policy *cat.MaskingPolicy
+		for j := range policies {
+			if policies[j].ColumnOrdinal == col.tableOrdinal {
+				policy = &policies[j]
+				break
+			}
+		}
+		funcRef := &tree.FunctionOID{OID: policy.FunctionOID}
+		funcExpr := &tree.FuncExpr{
+			Func:  tree.ResolvableFunctionReference{FunctionReference: funcRef},
+			Exprs: tree.Exprs{&inScope.cols[i]},
+		}
+		texpr := inScope.resolveAndRequireType(funcExpr, col.typ)
+		scalar := b.buildScalar(texpr, inScope, nil /* outScope */, nil /* outCol */, nil /* colRefs */)
+		col.name = col.name.WithMetadataName(fmt.Sprintf("%s_masked", col.name.ReferenceName()))
+		b.populateSynthesizedColumn(col, scalar)
+	}
+	b.constructProjectForScope(inScope, outScope)
+	return outScope
+}
+
 // addTable adds a table to the metadata and returns the TableMeta. The table
 // name is passed separately in order to preserve knowledge of whether the
 // catalog and schema names were explicitly specified.
@@ -666,6 +746,10 @@ func (b *Builder) buildScan(
 				}
 				panic(err)
 			}
+			if tab.Index(idx).Type().IsSummary() {
+				panic(pgerror.Newf(pgcode.WrongObjectType,
+					"index %q is a BRIN index and cannot be scanned", tab.Index(idx).Name()))
+			}
 			private.Flags.ForceIndex = true
 			private.Flags.Index = idx
 			private.Flags.Direction = indexFlags.Direction
@@ -796,8 +796,13 @@ func (b *Builder) buildTriggerFunction(
 
 	f := b.factory
 	triggerFuncScope := b.allocScope()
-	funcRef := &tree.FunctionOID{OID: catid.FuncIDToOID(catid.DescID(trigger.FuncID()))}
-	funcExpr := tree.FuncExpr{Func: tree.ResolvableFunctionReference{FunctionReference: funcRef}}
+	var funcExpr tree.FuncExpr
+	if name := trigger.FuncBuiltin(); name != "" {
+		funcExpr.Func = tree.WrapFunction(name)
+	} else {
+		funcRef := &tree.FunctionOID{OID: catid.FuncIDToOID(catid.DescID(trigger.FuncID()))}
+		funcExpr.Func = tree.ResolvableFunctionReference{FunctionReference: funcRef}
+	}
 	triggerFuncScope.resolveType(&funcExpr, types.AnyElement)
 	resolvedDef := funcExpr.Func.FunctionReference.(*tree.ResolvedFunctionDefinition)
 	o := funcExpr.ResolvedOverload()
@@ -844,8 +849,31 @@ func (b *Builder) buildTriggerFunction(
 		},
 	)
 
-	// Parse and build the function body.
-	stmt, err := plpgsql.Parse(trigger.FuncBody())
+	// Parse and build the function body. The body of a builtin trigger function
+	// is generated again, so that the columns named by the trigger arguments are
+	// resolved against the current definition of the table.
+	body := trigger.FuncBody()
+	if trigger.FuncBuiltin() != "" {
+		if o.TriggerBody == nil {
+			panic(errors.AssertionFailedf("builtin trigger function %s has no body", resolvedDef.Name))
+		}
+		call := tree.BuiltinTriggerCall{
+			ActionTime: trigger.ActionTime(),
+			ForEachRow: trigger.ForEachRow(),
+			TableTyp:   tableTyp,
+		}
+		for i := 0; i < trigger.EventCount(); i++ {
+			call.Events.Add(trigger.Event(i).EventType)
+		}
+		for _, arg := range trigger.FuncArgs() {
+			call.Args = append(call.Args, string(tree.MustBeDString(arg)))
+		}
+		var err error
+		if body, err = o.TriggerBody(&call); err != nil {
+			panic(err)
+		}
+	}
+	stmt, err := plpgsql.Parse(body)
 	if err != nil {
 		panic(err)
 	}
@@ -71,7 +71,7 @@ func (b *Builder) buildUpdate(upd *tree.Update, inScope *scope) (outScope *scope
 	}
 
 	// Find which table we're working on, check the permissions.
-	tab, depName, alias, refColumns := b.resolveTableForMutation(upd.Table, privilege.UPDATE)
+	tab, depName, alias, refColumns, allowedCols := b.resolveTableForMutation(upd.Table, privilege.UPDATE)
 
 	if tab.IsVirtualTable() {
 		panic(pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
@@ -84,14 +84,29 @@ func (b *Builder) buildUpdate(upd *tree.Update, inScope *scope) (outScope *scope
 			"cannot specify a list of column IDs with UPDATE"))
 	}
 
-	// Check Select permission as well, since existing values must be read.
-	b.checkPrivilege(depName, tab, privilege.SELECT)
+	// Check Select permission as well, since existing values must be read. If
+	// the user only holds it on some columns, only these columns may be read by
+	// the SET, WHERE and RETURNING clauses. The ORDER BY clause is not tracked,
+	// so it requires the privilege on the whole table.
+	selectCols, selectRestricted := b.checkPrivilegeWithColumns(depName, tab, privilege.SELECT)
+	if selectRestricted && upd.OrderBy != nil {
+		b.checkPrivilege(depName, tab, privilege.SELECT)
+	}
+
+	// Masked columns may only be read through their masking functions. The
+	// columns read by the SET and WHERE clauses are checked below; the RETURNING
+	// and ORDER BY clauses are not tracked, so they may not be used.
+	masked := b.maskedColumns(tab)
+	if upd.OrderBy != nil || resultsNeeded(upd.Returning) {
+		b.checkNoMaskedColumns(tab, masked)
+	}
 
 	// Check if this table has already been mutated in another subquery.
 	b.checkMultipleMutations(tab, generalMutation)
 
 	var mb mutationBuilder
 	mb.init(b, "update", tab, alias)
+	mb.returningCols, mb.returningRestricted = selectCols, selectRestricted
 
 	// exprColRefs tracks the columns referenced by expressions in the
 	// SET and WHERE clauses.
@@ -110,9 +125,14 @@ func (b *Builder) buildUpdate(upd *tree.Update, inScope *scope) (outScope *scope
 
 	// Derive the columns that will be updated from the SET expressions.
 	mb.addTargetColsForUpdate(upd.Exprs)
+	mb.checkTargetColPrivileges(privilege.UPDATE, allowedCols)
 
 	// Build each of the SET expressions.
 	mb.addUpdateCols(upd.Exprs, &exprColRefs)
+	if selectRestricted {
+		mb.checkFetchColPrivileges(privilege.SELECT, selectCols, exprColRefs)
+	}
+	mb.checkMaskedColRefs(masked, exprColRefs)
 
 	// Project row-level BEFORE triggers for UPDATE.
 	mb.buildRowLevelBeforeTriggers(tree.TriggerEventUpdate, false /* cascade */)
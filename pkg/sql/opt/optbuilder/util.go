@@ -125,6 +125,9 @@ func (b *Builder) expandStar(
 		for i := range refScope.cols {
 			col := &refScope.cols[i]
 			if col.table == *src && (col.visibility == visible || col.visibility == accessibleByQualifiedStar) {
+				if col.resolveErr != nil {
+					panic(col.resolveErr)
+				}
 				exprs = append(exprs, col)
 				aliases = append(aliases, string(col.name.ReferenceName()))
 			}
@@ -140,6 +143,9 @@ func (b *Builder) expandStar(
 		for i := range inScope.cols {
 			col := &inScope.cols[i]
 			if col.visibility == visible {
+				if col.resolveErr != nil {
+					panic(col.resolveErr)
+				}
 				exprs = append(exprs, col)
 				aliases = append(aliases, string(col.name.ReferenceName()))
 			}
@@ -572,10 +578,20 @@ func (b *Builder) checkMultipleMutationsCascade(
 //
 // If the name does not resolve to a table, then resolveTableForMutation raises
 // an error. Privileges are checked when resolving the table, and an error is
-// raised if the current user does not have the given privilege.
+// raised if the current user does not have the given privilege. If the given
+// privilege can be granted on columns and the user only holds it on some of
+// the table's columns, their ordinals are returned in allowedCols, and the
+// caller must ensure that no other column is written; see
+// mutationBuilder.checkTargetColPrivileges. Otherwise, allowedCols is empty.
 func (b *Builder) resolveTableForMutation(
 	n tree.TableExpr, priv privilege.Kind,
-) (tab cat.Table, depName opt.MDDepName, alias tree.TableName, columns []tree.ColumnID) {
+) (
+	tab cat.Table,
+	depName opt.MDDepName,
+	alias tree.TableName,
+	columns []tree.ColumnID,
+	allowedCols intsets.Fast,
+) {
 	// Strip off an outer AliasedTableExpr if there is one.
 	var outerAlias *tree.TableName
 	if ate, ok := n.(*tree.AliasedTableExpr); ok {
@@ -588,15 +604,14 @@ func (b *Builder) resolveTableForMutation(
 		}
 	}
 
+	var ds cat.DataSource
 	switch t := n.(type) {
 	case *tree.TableName:
-		tab, alias = b.resolveTable(t, priv)
-		depName = opt.DepByName(t)
+		ds, depName, alias = b.lookupDataSource(t)
 
 	case *tree.TableRef:
-		tab = b.resolveTableRef(t, priv)
+		ds, depName = b.lookupDataSourceRef(t)
 		alias = tree.MakeUnqualifiedTableName(t.As.Alias)
-		depName = opt.DepByID(cat.StableID(t.TableID))
 
 		// See tree.TableRef: "Note that a nil [Columns] array means 'unspecified'
 		// (all columns). whereas an array of length 0 means 'zero columns'.
@@ -612,6 +627,16 @@ func (b *Builder) resolveTableForMutation(
 			"%q does not resolve to a table", tree.ErrString(n)))
 	}
 
+	if privilege.ColumnPrivileges.Contains(priv) {
+		allowedCols, _ = b.checkPrivilegeWithColumns(depName, ds, priv)
+	} else {
+		b.checkPrivilege(depName, ds, priv)
+	}
+	tab, ok := ds.(cat.Table)
+	if !ok {
+		panic(sqlerrors.NewWrongObjectTypeError(n, "table"))
+	}
+
 	if outerAlias != nil {
 		alias = *outerAlias
 	}
@@ -621,7 +646,7 @@ func (b *Builder) resolveTableForMutation(
 		panic(pgerror.Newf(pgcode.WrongObjectType, "cannot mutate materialized view %q", tab.Name()))
 	}
 
-	return tab, depName, alias, columns
+	return tab, depName, alias, columns, allowedCols
 }
 
 // resolveTable returns the table in the catalog with the given name. If the
@@ -659,6 +684,21 @@ func (b *Builder) resolveTableRef(ref *tree.TableRef, priv privilege.Kind) cat.T
 // the fully qualified name.
 func (b *Builder) resolveDataSource(
 	tn *tree.TableName, priv privilege.Kind,
+) (cat.DataSource, opt.MDDepName, cat.DataSourceName) {
+	ds, depName, resName := b.lookupDataSource(tn)
+	b.checkPrivilege(depName, ds, priv)
+	return ds, depName, resName
+}
+
+// lookupDataSource returns the data source in the catalog with the given name,
+// along with the table's MDDepName and data source name, without checking
+// privileges. If the name does not resolve to a table, then lookupDataSource
+// raises an error.
+//
+// If the b.qualifyDataSourceNamesInAST flag is set, tn is updated to contain
+// the fully qualified name.
+func (b *Builder) lookupDataSource(
+	tn *tree.TableName,
 ) (cat.DataSource, opt.MDDepName, cat.DataSourceName) {
 	var flags cat.Flags
 	if b.insideViewDef || b.insideFuncDef || b.insideTriggerDef {
@@ -671,7 +711,6 @@ func (b *Builder) resolveDataSource(
 		panic(err)
 	}
 	depName := opt.DepByName(tn)
-	b.checkPrivilege(depName, ds, priv)
 
 	if b.qualifyDataSourceNamesInAST {
 		*tn = resName
@@ -688,6 +727,16 @@ func (b *Builder) resolveDataSource(
 func (b *Builder) resolveDataSourceRef(
 	ref *tree.TableRef, priv privilege.Kind,
 ) (cat.DataSource, opt.MDDepName) {
+	ds, depName := b.lookupDataSourceRef(ref)
+	b.checkPrivilege(depName, ds, priv)
+	return ds, depName
+}
+
+// lookupDataSourceRef returns the data source in the catalog that matches the
+// given TableRef spec, along with the table's MDDepName, without checking
+// privileges. If no data source matches, then lookupDataSourceRef raises an
+// error.
+func (b *Builder) lookupDataSourceRef(ref *tree.TableRef) (cat.DataSource, opt.MDDepName) {
 	var flags cat.Flags
 	if b.insideViewDef || b.insideFuncDef || b.insideTriggerDef {
 		// Avoid taking table leases when we're creating a view or a function.
@@ -697,9 +746,7 @@ func (b *Builder) resolveDataSourceRef(
 	if err != nil {
 		panic(pgerror.Wrapf(err, pgcode.UndefinedObject, "%s", tree.ErrString(ref)))
 	}
-	depName := opt.DepByID(cat.StableID(ref.TableID))
-	b.checkPrivilege(depName, ds, priv)
-	return ds, depName
+	return ds, opt.DepByID(cat.StableID(ref.TableID))
 }
 
 // checkPrivilege ensures that the current user has the privilege needed to
@@ -717,7 +764,52 @@ func (b *Builder) checkPrivilege(name opt.MDDepName, ds cat.DataSource, priv pri
 		// The check is skipped, so don't recheck when dependencies are checked.
 		priv = 0
 	}
+	b.addPrivilegeDependency(name, ds, priv)
+}
+
+// checkPrivilegeWithColumns is like checkPrivilege, but if the object is a
+// table on which the current user lacks the given privilege, it falls back to
+// the privilege on the table's columns. In that case, restricted is true and
+// cols contains the ordinals of the columns on which the user holds the
+// privilege; the caller must ensure that no other column is accessed. If the
+// user holds the privilege on no column either, checkPrivilegeWithColumns
+// raises the error for the table.
+func (b *Builder) checkPrivilegeWithColumns(
+	name opt.MDDepName, ds cat.DataSource, priv privilege.Kind,
+) (cols intsets.Fast, restricted bool) {
+	tab, ok := ds.(cat.Table)
+	if !ok || (priv == privilege.SELECT && b.skipSelectPrivilegeChecks) {
+		b.checkPrivilege(name, ds, priv)
+		return intsets.Fast{}, false
+	}
+	err := b.catalog.CheckPrivilege(b.ctx, ds, b.checkPrivilegeUser, priv)
+	if err == nil {
+		b.addPrivilegeDependency(name, ds, priv)
+		return intsets.Fast{}, false
+	}
+	if pgerror.GetPGCode(err) != pgcode.InsufficientPrivilege {
+		panic(err)
+	}
+	cols, colErr := b.catalog.ColumnsWithPrivilege(b.ctx, tab, b.checkPrivilegeUser, priv)
+	if colErr != nil {
+		panic(colErr)
+	}
+	if cols.Empty() {
+		panic(err)
+	}
+	// The privilege on the table is not rechecked when dependencies are
+	// checked; the privilege on the accessed columns is rechecked instead.
+	b.addPrivilegeDependency(name, ds, 0 /* priv */)
+	b.factory.Metadata().AddColumnPrivilegeDependency(tab, priv, cols)
+	return cols, true
+}
 
+// addPrivilegeDependency adds the given object and its original unresolved
+// name as a dependency to the metadata. If priv is non-zero, it is rechecked
+// on reuse of the memo.
+func (b *Builder) addPrivilegeDependency(
+	name opt.MDDepName, ds cat.DataSource, priv privilege.Kind,
+) {
 	// Add dependency on this object to the metadata, so that the metadata can be
 	// cached and later checked for freshness.
 	b.factory.Metadata().AddDependency(name, ds, priv)
@@ -345,6 +345,12 @@ func (b *Builder) getTypedWindowArgs(w *windowInfo) []tree.TypedExpr {
 			null := reType(tree.DNull, argExprs[0].ResolvedType())
 			argExprs = append(argExprs, null)
 		}
+	// The third argument of st_clusterkmeans is NULL by default, which places
+	// no limit on the radius of the clusters.
+	case "st_clusterkmeans":
+		if len(argExprs) < 3 {
+			argExprs = append(argExprs, reType(tree.DNull, types.Float))
+		}
 	}
 
 	return argExprs
@@ -295,6 +295,13 @@ func (tc *Catalog) CheckPrivilege(
 	return tc.CheckAnyPrivilege(ctx, o)
 }
 
+// ColumnsWithPrivilege is part of the cat.Catalog interface.
+func (tc *Catalog) ColumnsWithPrivilege(
+	ctx context.Context, tab cat.Table, user username.SQLUsername, priv privilege.Kind,
+) (intsets.Fast, error) {
+	return intsets.Fast{}, nil
+}
+
 // CheckAnyPrivilege is part of the cat.Catalog interface.
 func (tc *Catalog) CheckAnyPrivilege(ctx context.Context, o cat.Object) error {
 	switch t := o.(type) {
@@ -1237,6 +1244,9 @@ func (tt *Table) Policies() *cat.Policies {
 	return &tt.policies
 }
 
+// MaskingPolicies is part of the cat.Table interface.
+func (tt *Table) MaskingPolicies() []cat.MaskingPolicy { return nil }
+
 // findPolicyByName will lookup the policy by its name. It returns it's policy
 // type and index within that policy type slice so that callers can do removal
 // if needed.
@@ -1989,6 +1999,7 @@ type Trigger struct {
 	TriggerForEachRow         bool
 	TriggerWhenExpr           string
 	TriggerFuncID             cat.StableID
+	TriggerFuncBuiltin        string
 	TriggerFuncArgs           tree.Datums
 	TriggerFuncBody           string
 	TriggerEnabled            bool
@@ -2036,6 +2047,10 @@ func (t *Trigger) FuncID() cat.StableID {
 	return t.TriggerFuncID
 }
 
+func (t *Trigger) FuncBuiltin() string {
+	return t.TriggerFuncBuiltin
+}
+
 func (t *Trigger) FuncArgs() tree.Datums {
 	return t.TriggerFuncArgs
 }
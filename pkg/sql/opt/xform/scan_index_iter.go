@@ -234,6 +234,12 @@ func (it *scanIndexIter) ForEachStartingAfter(ord int, f enumerateIndexFunc) {
 			}
 		}
 
+		// Always skip over summary indexes. They have no entry per row and are
+		// only used to skip parts of the primary index during a scan.
+		if index.Type().IsSummary() {
+			continue
+		}
+
 		// Skip over inverted indexes if rejectInvertedIndexes is set.
 		if it.hasRejectFlags(rejectInvertedIndexes) && index.Type() == idxtype.INVERTED {
 			continue
@@ -43,6 +43,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/sql/vecindex/vecpb"
 	"github.com/cockroachdb/cockroach/pkg/util/buildutil"
+	"github.com/cockroachdb/cockroach/pkg/util/intsets"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq/oid"
@@ -467,6 +468,31 @@ func (oc *optCatalog) CheckAnyPrivilege(ctx context.Context, o cat.Object) error
 	return oc.planner.CheckAnyPrivilege(ctx, desc)
 }
 
+// ColumnsWithPrivilege is part of the cat.Catalog interface.
+func (oc *optCatalog) ColumnsWithPrivilege(
+	ctx context.Context, tab cat.Table, user username.SQLUsername, priv privilege.Kind,
+) (intsets.Fast, error) {
+	var cols intsets.Fast
+	desc, err := getDescForDataSource(tab)
+	if err != nil {
+		return cols, err
+	}
+	for ord, n := 0, tab.ColumnCount(); ord < n; ord++ {
+		col := catalog.FindColumnByID(desc, descpb.ColumnID(tab.Column(ord).ColID()))
+		if col == nil || col.ColumnDesc().Privileges == nil {
+			continue
+		}
+		ok, err := oc.planner.hasColumnPrivilege(ctx, col, priv, user)
+		if err != nil {
+			return intsets.Fast{}, err
+		}
+		if ok {
+			cols.Add(ord)
+		}
+	}
+	return cols, nil
+}
+
 // CheckExecutionPrivilege is part of the cat.Catalog interface.
 func (oc *optCatalog) CheckExecutionPrivilege(
 	ctx context.Context, oid oid.Oid, user username.SQLUsername,
@@ -943,6 +969,9 @@ type optTable struct {
 	rlsForced  bool
 	policies   cat.Policies
 
+	// maskingPolicies are the masking policies of the table's columns.
+	maskingPolicies []cat.MaskingPolicy
+
 	// colMap is a mapping from unique ColumnID to column ordinal within the
 	// table. This is a common lookup that needs to be fast.
 	colMap catalog.TableColMap
@@ -1225,6 +1254,7 @@ func newOptTable(
 	ot.rlsEnabled = desc.IsRowLevelSecurityEnabled()
 	ot.rlsForced = desc.IsRowLevelSecurityForced()
 	ot.policies = getOptPolicies(desc.GetPolicies())
+	ot.maskingPolicies = getOptMaskingPolicies(desc)
 
 	// Synthesize any check constraints for user defined types.
 	var synthesizedChecks []optCheckConstraint
@@ -1640,6 +1670,9 @@ func (ot *optTable) Policies() *cat.Policies {
 	return &ot.policies
 }
 
+// MaskingPolicies is part of the cat.Table interface.
+func (ot *optTable) MaskingPolicies() []cat.MaskingPolicy { return ot.maskingPolicies }
+
 // LookupColumnOrdinal returns the ordinal of the column with the given ID. A
 // cache makes the lookup O(1).
 func (ot *optTable) LookupColumnOrdinal(colID descpb.ColumnID) (int, error) {
@@ -2788,6 +2821,9 @@ func (ot *optVirtualTable) IsRowLevelSecurityForced() bool { return false }
 // Policies is part of the cat.Table interface.
 func (ot *optVirtualTable) Policies() *cat.Policies { return nil }
 
+// MaskingPolicies is part of the cat.Table interface.
+func (ot *optVirtualTable) MaskingPolicies() []cat.MaskingPolicy { return nil }
+
 // optVirtualIndex is a dummy implementation of cat.Index for the indexes
 // reported by a virtual table. The index assumes that table column 0 is a dummy
 // PK column.
@@ -3043,6 +3079,7 @@ type optTrigger struct {
 	forEachRow         bool
 	whenExpr           string
 	funcID             cat.StableID
+	funcBuiltin        string
 	funcArgs           tree.Datums
 	funcBody           string
 	enabled            bool
@@ -3095,6 +3132,11 @@ func (o *optTrigger) FuncID() cat.StableID {
 	return o.funcID
 }
 
+// FuncBuiltin is part of the cat.Trigger interface.
+func (o *optTrigger) FuncBuiltin() string {
+	return o.funcBuiltin
+}
+
 // FuncArgs is part of the cat.Trigger interface.
 func (o *optTrigger) FuncArgs() tree.Datums {
 	return o.funcArgs
@@ -3136,6 +3178,7 @@ func getOptTriggers(descTriggers []descpb.TriggerDescriptor) []optTrigger {
 			forEachRow:         descTrigger.ForEachRow,
 			whenExpr:           descTrigger.WhenExpr,
 			funcID:             cat.StableID(descTrigger.FuncID),
+			funcBuiltin:        descTrigger.BuiltinFuncName,
 			funcArgs:           funcArgs,
 			funcBody:           descTrigger.FuncBody,
 			enabled:            descTrigger.Enabled,
@@ -3171,6 +3214,25 @@ func getOptPolicies(descPolicies []descpb.PolicyDescriptor) cat.Policies {
 	return policies
 }
 
+// getOptMaskingPolicies returns the masking policies of the public columns of
+// the given table.
+func getOptMaskingPolicies(desc catalog.TableDescriptor) []cat.MaskingPolicy {
+	var policies []cat.MaskingPolicy
+	for _, col := range desc.PublicColumns() {
+		mp := col.ColumnDesc().MaskingPolicy
+		if mp == nil {
+			continue
+		}
+		policy := cat.MaskingPolicy{
+			ColumnOrdinal: col.Ordinal(),
+			FunctionOID:   catid.FuncIDToOID(mp.FunctionID),
+		}
+		policy.InitRoles(mp.RoleNames)
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
 // collectTypes walks the given column's default and computed expression,
 // and collects any user defined types it finds. If the column itself is of
 // a user defined type, it will also be added to the set of user defined types.
@@ -160,6 +160,12 @@ func (ef *execFactory) ConstructScan(
 	scan.isFull = len(scan.spans) == 1 && scan.spans[0].EqualValue(
 		scan.desc.IndexSpanAllowingExternalRowData(ef.planner.ExecCfg().Codec, scan.index.GetID()),
 	)
+	if len(params.BlockFilters) > 0 {
+		scan.spans, err = pruneBrinBlocks(ef.ctx, ef.planner, table, tabDesc, scan.spans, params.BlockFilters)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if err = colCfg.assertValidReqOrdering(reqOrdering); err != nil {
 		return nil, err
 	}
@@ -1406,6 +1412,9 @@ func (ef *execFactory) ConstructInsert(
 	if err != nil {
 		return nil, err
 	}
+	if ri.Helper.EncryptionKeys, err = ef.planner.columnEncryptionKeysForWrite(ef.ctx, tabDesc); err != nil {
+		return nil, err
+	}
 
 	// Regular path for INSERT.
 	ins := insertNodePool.Get().(*insertNode)
@@ -1467,6 +1476,9 @@ func (ef *execFactory) ConstructInsertFastPath(
 	if err != nil {
 		return nil, err
 	}
+	if ri.Helper.EncryptionKeys, err = ef.planner.columnEncryptionKeysForWrite(ef.ctx, tabDesc); err != nil {
+		return nil, err
+	}
 
 	// Regular path for INSERT.
 	ins := insertFastPathNodePool.Get().(*insertFastPathNode)
@@ -1667,6 +1679,11 @@ func (ef *execFactory) constructUpdateRun(
 	if err != nil {
 		return err
 	}
+	encryptionKeys, err := ef.planner.columnEncryptionKeysForWrite(ef.ctx, tabDesc)
+	if err != nil {
+		return err
+	}
+	ru.SetEncryptionKeys(encryptionKeys)
 
 	run.tu = tableUpdater{ru: ru}
 	run.checkOrds = checks
@@ -1725,6 +1742,9 @@ func (ef *execFactory) ConstructUpsert(
 	if err != nil {
 		return nil, err
 	}
+	if ri.Helper.EncryptionKeys, err = ef.planner.columnEncryptionKeysForWrite(ef.ctx, tabDesc); err != nil {
+		return nil, err
+	}
 
 	// Create the table updater, which does the bulk of the update-related work.
 	tombstoneIdxs, lockIdxs := ordinalsToIndexes2(table, uniqueWithTombstoneIndexes, lockedIndexes)
@@ -1743,6 +1763,7 @@ func (ef *execFactory) ConstructUpsert(
 	if err != nil {
 		return nil, err
 	}
+	ru.SetEncryptionKeys(ri.Helper.EncryptionKeys)
 
 	// Instantiate the upsert node.
 	ups := upsertNodePool.Get().(*upsertNode)
@@ -2016,6 +2037,13 @@ func (ef *execFactory) ConstructCreateTable(
 	); err != nil {
 		return nil, err
 	}
+	// The declarative schema changer takes over the statement if it supports
+	// it, otherwise the legacy schema changer creates the table.
+	if plan, err := ef.planner.SchemaChange(ef.ctx, ct); err != nil {
+		return nil, err
+	} else if plan != nil {
+		return plan, nil
+	}
 	return &createTableNode{
 		n:      ct,
 		dbDesc: schema.(*optSchema).database,
@@ -2060,6 +2088,14 @@ func (ef *execFactory) ConstructCreateView(
 		return nil, err
 	}
 
+	plan, err := ef.planner.SchemaChange(ef.ctx, createView)
+	if err != nil {
+		return nil, err
+	}
+	if plan != nil {
+		return plan, nil
+	}
+
 	planDeps, typeDepSet, funcDepSet, err := toPlanDependencies(deps, typeDeps, funcDeps)
 	if err != nil {
 		return nil, err
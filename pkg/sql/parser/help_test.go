@@ -211,6 +211,26 @@ func TestContextualHelp(t *testing.T) {
 		{`CREATE TYPE blah AS ENUM ??`, `CREATE TYPE`},
 		{`DROP TYPE ??`, `DROP TYPE`},
 
+		{`CREATE CAST ??`, `CREATE CAST`},
+		{`CREATE CAST (a AS b) WITH ??`, `CREATE CAST`},
+		{`CREATE COLLATION ??`, `CREATE COLLATION`},
+		{`CREATE COLLATION IF NOT ??`, `CREATE COLLATION`},
+		{`CREATE TEXT SEARCH ??`, `CREATE TEXT SEARCH`},
+		{`CREATE TEXT SEARCH DICTIONARY blah ??`, `CREATE TEXT SEARCH`},
+		{`ALTER TEXT SEARCH ??`, `ALTER TEXT SEARCH`},
+		{`ALTER TEXT SEARCH CONFIGURATION blah ??`, `ALTER TEXT SEARCH`},
+		{`DROP CAST ??`, `DROP CAST`},
+		{`DROP CAST IF ??`, `DROP CAST`},
+		{`DROP COLLATION ??`, `DROP COLLATION`},
+		{`DROP COLLATION IF ??`, `DROP COLLATION`},
+		{`DROP TEXT SEARCH ??`, `DROP TEXT SEARCH`},
+		{`DROP TEXT SEARCH CONFIGURATION IF ??`, `DROP TEXT SEARCH`},
+
+		{`CREATE OPERATOR ??`, `CREATE OPERATOR`},
+		{`CREATE OPERATOR + (??`, `CREATE OPERATOR`},
+		{`DROP OPERATOR ??`, `DROP OPERATOR`},
+		{`DROP OPERATOR IF ??`, `DROP OPERATOR`},
+
 		{`CREATE SCHEMA IF ??`, `CREATE SCHEMA`},
 		{`CREATE SCHEMA IF NOT ??`, `CREATE SCHEMA`},
 		{`CREATE SCHEMA bli ??`, `CREATE SCHEMA`},
@@ -36,6 +36,7 @@ import (
     "github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
     "github.com/cockroachdb/cockroach/pkg/sql/privilege"
     "github.com/cockroachdb/cockroach/pkg/sql/scanner"
+    "github.com/cockroachdb/cockroach/pkg/sql/sem/cast"
     "github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
     "github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treebin"
     "github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
@@ -169,6 +170,42 @@ func processUnaryQualOpInternal(
   return nil, 1
 }
 
+// makeXMLElement lowers XMLELEMENT(NAME name [, XMLATTRIBUTES(...)] [, content])
+// to a call to crdb_internal.xmlelement. nameKw is the identifier preceding
+// the element name, which must be NAME. NAME is not a keyword so that "name"
+// remains usable as an identifier and as a type name.
+func makeXMLElement(
+  sqllex sqlLexer,
+  nameKw string,
+  name string,
+  attrs tree.SelectExprs,
+  content tree.Exprs,
+) (tree.Expr, int) {
+  if nameKw != "name" {
+    sqllex.Error(fmt.Sprintf("expected NAME, found %s", nameKw))
+    return nil, 1
+  }
+  names := &tree.Array{Exprs: make(tree.Exprs, 0, len(attrs))}
+  values := &tree.Array{Exprs: make(tree.Exprs, 0, len(attrs))}
+  for _, attr := range attrs {
+    attrName := string(attr.As)
+    if attrName == "" {
+      col, ok := attr.Expr.(*tree.UnresolvedName)
+      if !ok || col.Star {
+        return nil, setErr(sqllex, pgerror.New(pgcode.Syntax,
+          "unnamed XML attribute value must be a column reference"))
+      }
+      attrName = col.Parts[0]
+    }
+    names.Exprs = append(names.Exprs, tree.NewStrVal(attrName))
+    values.Exprs = append(values.Exprs, &tree.CastExpr{
+      Expr: attr.Expr, Type: types.String, SyntaxMode: tree.CastShort,
+    })
+  }
+  exprs := append(tree.Exprs{tree.NewStrVal(name), names, values}, content...)
+  return &tree.FuncExpr{Func: tree.WrapFunction("crdb_internal.xmlelement"), Exprs: exprs}, 0
+}
+
 %}
 
 %{
@@ -565,6 +602,9 @@ func (u *sqlSymUnion) privilegeType() privilege.Kind {
 func (u *sqlSymUnion) privilegeList() privilege.List {
     return u.val.(privilege.List)
 }
+func (u *sqlSymUnion) columnPrivileges() *columnPrivileges {
+    return u.val.(*columnPrivileges)
+}
 func (u *sqlSymUnion) onConflict() *tree.OnConflict {
     return u.val.(*tree.OnConflict)
 }
@@ -658,6 +698,12 @@ func (u *sqlSymUnion) indexInvisibility() tree.IndexInvisibility {
 func (u *sqlSymUnion) dropBehavior() tree.DropBehavior {
     return u.val.(tree.DropBehavior)
 }
+func (u *sqlSymUnion) textSearchObjectType() tree.TextSearchObjectType {
+    return u.val.(tree.TextSearchObjectType)
+}
+func (u *sqlSymUnion) alterTextSearchConfigCmd() tree.AlterTextSearchConfigCmd {
+    return u.val.(tree.AlterTextSearchConfigCmd)
+}
 func (u *sqlSymUnion) rlsTableMode() tree.TableRLSMode {
     return u.val.(tree.TableRLSMode)
 }
@@ -821,6 +867,21 @@ func (u *sqlSymUnion) geoShapeType() geopb.ShapeType {
 func newNameFromStr(s string) *tree.Name {
     return (*tree.Name)(&s)
 }
+
+// columnPrivileges is the value of privilege lists in which some
+// privileges apply to specific columns, e.g. SELECT (a, b), UPDATE.
+type columnPrivileges struct {
+    privileges privilege.List
+    columns    tree.ColumnPrivilegeList
+}
+
+func makeColumnPrivilege(priv string, cols tree.NameList) (tree.ColumnPrivilege, error) {
+    privList, err := privilege.ListFromStrings([]string{priv}, privilege.OriginFromUserInput)
+    if err != nil {
+        return tree.ColumnPrivilege{}, err
+    }
+    return tree.ColumnPrivilege{Privilege: privList[0], Columns: cols}, nil
+}
 func (u *sqlSymUnion) typeReference() tree.ResolvableTypeReference {
     return u.val.(tree.ResolvableTypeReference)
 }
@@ -905,6 +966,21 @@ func (u *sqlSymUnion) functionObj() tree.RoutineObj {
 func (u *sqlSymUnion) routineObjs() tree.RoutineObjs {
     return u.val.(tree.RoutineObjs)
 }
+func (u *sqlSymUnion) castContext() cast.Context {
+    return u.val.(cast.Context)
+}
+func (u *sqlSymUnion) operatorOption() tree.OperatorOption {
+    return u.val.(tree.OperatorOption)
+}
+func (u *sqlSymUnion) operatorOptions() tree.OperatorOptions {
+    return u.val.(tree.OperatorOptions)
+}
+func (u *sqlSymUnion) operatorWithArgs() tree.OperatorWithArgs {
+    return u.val.(tree.OperatorWithArgs)
+}
+func (u *sqlSymUnion) operatorsWithArgs() []tree.OperatorWithArgs {
+    return u.val.([]tree.OperatorWithArgs)
+}
 func (u *sqlSymUnion) tenantReplicationOptions() *tree.TenantReplicationOptions {
   return u.val.(*tree.TenantReplicationOptions)
 }
@@ -1009,7 +1085,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> CHARACTER CHARACTERISTICS CHECK CHECK_FILES CLOSE
 %token <str> CLUSTER CLUSTERS COALESCE COLLATE COLLATION COLUMN COLUMNS COMMENT COMMENTS COMMIT
 %token <str> COMMITTED COMPACT COMPLETE COMPLETIONS CONCAT CONCURRENTLY CONFIGURATION CONFIGURATIONS CONFIGURE
-%token <str> CONFLICT CONNECTION CONNECTIONS CONSTRAINT CONSTRAINTS CONTAINS CONTROLCHANGEFEED CONTROLJOB
+%token <str> CONFLICT CONNECTION CONNECTIONS CONSTRAINT CONSTRAINTS CONTAINS CONTENT CONTROLCHANGEFEED CONTROLJOB
 %token <str> CONVERSION CONVERT COPY COS_DISTANCE COST COVERING CREATE CREATEDB CREATELOGIN CREATEROLE
 %token <str> CROSS CSV CUBE CURRENT CURRENT_CATALOG CURRENT_DATE CURRENT_SCHEMA
 %token <str> CURRENT_ROLE CURRENT_TIME CURRENT_TIMESTAMP
@@ -1017,9 +1093,9 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 
 %token <str> DATA DATABASE DATABASES DATE DAY DEBUG_IDS DEC DECIMAL DEFAULT DEFAULTS DEFINER
 %token <str> DEALLOCATE DECLARE DEFERRABLE DEFERRED DELETE DELIMITER DEPENDS DESC DESTINATION DETACHED DETAILS
-%token <str> DISABLE DISCARD DISTANCE DISTINCT DO DOMAIN DOUBLE DROP
+%token <str> DICTIONARY DISABLE DISCARD DISTANCE DISTINCT DO DOCUMENT DOMAIN DOUBLE DROP
 
-%token <str> EACH ELSE ENABLE ENCODING ENCRYPTED ENCRYPTION_PASSPHRASE END ENUM ENUMS ERRORS ESCAPE
+%token <str> EACH ELSE ENABLE ENCODING ENCRYPTED ENCRYPTION ENCRYPTION_PASSPHRASE END ENUM ENUMS ERRORS ESCAPE
 %token <str> EXCEPT EXCLUDE EXCLUDING EXPLICIT EXISTS EXECUTE EXECUTION EXPERIMENTAL
 %token <str> EXPERIMENTAL_FINGERPRINTS EXPERIMENTAL_REPLICA
 %token <str> EXPERIMENTAL_AUDIT EXPERIMENTAL_RELOCATE
@@ -1055,7 +1131,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> LINESTRING LINESTRINGM LINESTRINGZ LINESTRINGZM
 %token <str> LIST LOCAL LOCALITY LOCALTIME LOCALTIMESTAMP LOCKED LOGGED LOGICAL LOGICALLY LOGIN LOOKUP LOW LSHIFT
 
-%token <str> MATCH MATERIALIZED MERGE MINVALUE MAXVALUE METHOD MINUTE MODIFYCLUSTERSETTING MODE MONTH MOVE
+%token <str> MAPPING MASKING MATCH MATERIALIZED MERGE MINVALUE MAXVALUE METHOD MINUTE MODIFYCLUSTERSETTING MODE MONTH MOVE
 %token <str> MULTILINESTRING MULTILINESTRINGM MULTILINESTRINGZ MULTILINESTRINGZM
 %token <str> MULTIPOINT MULTIPOINTM MULTIPOINTZ MULTIPOINTZM
 %token <str> MULTIPOLYGON MULTIPOLYGONM MULTIPOLYGONZ MULTIPOLYGONZM
@@ -1070,7 +1146,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> OF OFF OFFSET OID OIDS OIDVECTOR OLD OLDER OLD_KMS ON ONLY OPT OPTION OPTIONS OR
 %token <str> ORDER ORDINALITY OTHERS OUT OUTER OVER OVERLAPS OVERLAY OWNED OWNER OPERATOR
 
-%token <str> PARALLEL PARENT PARTIAL PARTITION PARTITIONS PASSWORD PAUSE PAUSED PER PERMISSIVE PHYSICAL PLACEMENT PLACING
+%token <str> PARALLEL PARENT PARTIAL PARTITION PARTITIONS PASSING PASSWORD PAUSE PAUSED PER PERMISSIVE PHYSICAL PLACEMENT PLACING
 %token <str> PLAN PLANS POINT POINTM POINTZ POINTZM POLICIES POLICY POLYGON POLYGONM POLYGONZ POLYGONZM
 %token <str> POSITION PRECEDING PRECISION PREPARE PREPARED PRESERVE PRIMARY PRIOR PRIORITY PRIVILEGES PUSH
 %token <str> PROCEDURAL PROCEDURE PROCEDURES PROVISIONSRC PUBLIC PUBLICATION
@@ -1081,7 +1157,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> REGCLASS REGION REGIONAL REGIONS REGNAMESPACE REGPROC REGPROCEDURE REGROLE REGTYPE REINDEX
 %token <str> RELATIVE RELOCATE REMOVE_PATH REMOVE_REGIONS RENAME REPEATABLE REPLACE REPLICATED REPLICATION
 %token <str> RELEASE RESET RESOLVED RESTART RESTORE RESTRICT RESTRICTED RESTRICTIVE RESUME RETENTION RETURNING RETURN RETURNS REVISION REVISION_HISTORY
-%token <str> REVOKE RIGHT ROLE ROLES ROLLBACK ROLLUP ROUTINES ROW ROWS RSHIFT RULE RUN RUNNING
+%token <str> REVOKE RIGHT ROLE ROLES ROLLBACK ROLLUP ROTATE ROUTINES ROW ROWS RSHIFT RULE RUN RUNNING
 
 %token <str> SAVEPOINT SCANS SCATTER SCHEDULE SCHEDULES SCROLL SCHEMA SCHEMA_ONLY SCHEMAS SCRUB
 %token <str> SEARCH SECOND SECONDARY SECURITY SECURITY_INVOKER SELECT SEQUENCE SEQUENCES
@@ -1107,6 +1183,8 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 
 %token <str> WATCHED_TABLES WHEN WHERE WINDOW WITH WITHIN WITHOUT WORK WRITE
 
+%token <str> XMLATTRIBUTES XMLELEMENT XMLEXISTS XMLPARSE XMLSERIALIZE
+
 %token <str> YEAR
 
 %token <str> ZONE
@@ -1161,6 +1239,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.SetVar> set_or_reset_clause
 %type <tree.Statement> alter_type_stmt
 %type <tree.Statement> alter_schema_stmt
+%type <tree.Statement> alter_text_search_stmt
 %type <tree.Statement> alter_unsupported_stmt
 %type <tree.Statement> alter_func_stmt
 %type <tree.Statement> alter_proc_stmt
@@ -1328,6 +1407,10 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.CheckExternalConnectionOptions> opt_with_check_external_connection_options_list check_external_connection_options_list check_external_connection_options
 
 %type <tree.Statement> create_type_stmt
+%type <tree.Statement> create_text_search_stmt
+%type <tree.Statement> create_collation_stmt
+%type <tree.Statement> create_cast_stmt
+%type <tree.Statement> create_operator_stmt
 %type <tree.Statement> delete_stmt
 %type <tree.Statement> discard_stmt
 
@@ -1340,6 +1423,10 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Statement> drop_schema_stmt
 %type <tree.Statement> drop_table_stmt
 %type <tree.Statement> drop_type_stmt
+%type <tree.Statement> drop_text_search_stmt
+%type <tree.Statement> drop_collation_stmt
+%type <tree.Statement> drop_cast_stmt
+%type <tree.Statement> drop_operator_stmt
 %type <tree.Statement> drop_view_stmt
 %type <tree.Statement> drop_sequence_stmt
 %type <tree.Statement> drop_func_stmt
@@ -1558,6 +1645,9 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.UnresolvedObjectName> table_name db_name standalone_index_name sequence_name type_name
 %type <*tree.UnresolvedObjectName> view_name db_object_name simple_db_object_name complex_db_object_name
 %type <[]*tree.UnresolvedObjectName> type_name_list
+%type <[]*tree.UnresolvedObjectName> text_search_name_list collation_name_list
+%type <tree.TextSearchObjectType> text_search_object_type
+%type <tree.AlterTextSearchConfigCmd> alter_text_search_config_cmd
 %type <str> schema_name opt_in_schema
 %type <tree.ObjectNamePrefix>  qualifiable_schema_name opt_schema_name wildcard_pattern
 %type <tree.ObjectNamePrefixList> schema_name_list
@@ -1646,6 +1736,10 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Exprs> position_list
 %type <tree.Exprs> substr_list
 %type <tree.Exprs> trim_list
+%type <str> document_or_content
+%type <tree.SelectExprs> xml_attributes xml_attribute_list
+%type <tree.SelectExpr> xml_attribute_el
+%type <tree.Expr> xmlexists_argument
 %type <tree.Exprs> execute_param_clause
 %type <types.IntervalTypeMetadata> opt_interval_qualifier interval_qualifier interval_second
 %type <tree.Expr> overlay_placing
@@ -1794,6 +1888,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.GrantTargetList> opt_on_targets_roles
 %type <tree.RoleSpecList> for_grantee_clause
 %type <privilege.List> privileges
+%type <*columnPrivileges> column_privileges
 %type <[]tree.KVOption> opt_role_options role_options
 %type <tree.AuditMode> audit_mode
 
@@ -1844,6 +1939,11 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.RoutineBody> opt_routine_body
 %type <tree.RoutineObj> function_with_paramtypes
 %type <tree.RoutineObjs> function_with_paramtypes_list
+%type <cast.Context> opt_cast_context
+%type <tree.OperatorOption> operator_option
+%type <tree.OperatorOptions> operator_option_list
+%type <tree.OperatorWithArgs> operator_with_args
+%type <[]tree.OperatorWithArgs> operator_with_args_list
 %type <empty> opt_link_sym
 
 // Trigger relevant components.
@@ -2005,6 +2105,7 @@ alter_ddl_stmt:
 | alter_backup_schedule  // EXTEND WITH HELP: ALTER BACKUP SCHEDULE
 | alter_policy_stmt             // EXTEND WITH HELP: ALTER POLICY
 | alter_job_stmt                // EXTEND WITH HELP: ALTER JOB
+| alter_text_search_stmt        // EXTEND WITH HELP: ALTER TEXT SEARCH
 
 // %Help: ALTER TABLE - change the definition of a table
 // %Category: DDL
@@ -2024,6 +2125,9 @@ alter_ddl_stmt:
 //   ALTER TABLE ... ALTER [COLUMN] <colname> SET GENERATED { ALWAYS | BY DEFAULT }
 //   ALTER TABLE ... ALTER [COLUMN] <colname> <identity_option_list>
 //   ALTER TABLE ... ALTER [COLUMN] <colname> DROP IDENTITY [ IF EXISTS ]
+//   ALTER TABLE ... ALTER [COLUMN] <colname> SET MASKING POLICY <funcname> FOR ROLES ( <rolename> [, ...] )
+//   ALTER TABLE ... ALTER [COLUMN] <colname> DROP MASKING POLICY
+//   ALTER TABLE ... ALTER [COLUMN] <colname> ROTATE ENCRYPTION KEY [TO <kms_uri>]
 //   ALTER TABLE ... ALTER [COLUMN] <colname> [SET DATA] TYPE <type> [COLLATE <collation>]
 //   ALTER TABLE ... ALTER PRIMARY KEY USING COLUMNS ( <colnames...> )
 //   ALTER TABLE ... RENAME TO <newname>
@@ -2038,6 +2142,7 @@ alter_ddl_stmt:
 //   ALTER TABLE ... RELOCATE [ LEASE | VOTERS | NONVOTERS ] <selectclause>  (experimental)
 //   ALTER TABLE ... PARTITION BY RANGE ( <name...> ) ( <rangespec> )
 //   ALTER TABLE ... PARTITION BY LIST ( <name...> ) ( <listspec> )
+//   ALTER TABLE ... PARTITION BY HASH ( <name...> ) PARTITIONS <n>
 //   ALTER TABLE ... PARTITION BY NOTHING
 //   ALTER TABLE ... CONFIGURE ZONE <zoneconfig>
 //   ALTER TABLE ... SET SCHEMA <newschemaname>
@@ -2920,6 +3025,25 @@ alter_table_cmd:
   {
     $$.val = &tree.AlterTableSetVisible{Column: tree.Name($3), Visible: $4.bool()}
   }
+  // ALTER TABLE <name> ALTER [COLUMN] <colname> SET MASKING POLICY <funcname> FOR ROLES ( <rolename> [, ...] )
+| ALTER opt_column column_name SET MASKING POLICY func_name FOR ROLES '(' role_spec_list ')'
+  {
+    $$.val = &tree.AlterTableSetMaskingPolicy{Column: tree.Name($3), Func: $7.unresolvedName(), Roles: $11.roleSpecList()}
+  }
+  // ALTER TABLE <name> ALTER [COLUMN] <colname> DROP MASKING POLICY
+| ALTER opt_column column_name DROP MASKING POLICY
+  {
+    $$.val = &tree.AlterTableSetMaskingPolicy{Column: tree.Name($3)}
+  }
+  // ALTER TABLE <name> ALTER [COLUMN] <colname> ROTATE ENCRYPTION KEY [TO <kms_uri>]
+| ALTER opt_column column_name ROTATE ENCRYPTION KEY
+  {
+    $$.val = &tree.AlterTableRotateEncryptionKey{Column: tree.Name($3)}
+  }
+| ALTER opt_column column_name ROTATE ENCRYPTION KEY TO SCONST
+  {
+    $$.val = &tree.AlterTableRotateEncryptionKey{Column: tree.Name($3), KMSURI: tree.NewStrVal($8)}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> DROP NOT NULL
 | ALTER opt_column column_name DROP NOT NULL
   {
@@ -6155,20 +6279,17 @@ drop_trigger_stmt:
 create_unsupported:
   CREATE ACCESS METHOD error { return unimplemented(sqllex, "create access method") }
 | CREATE AGGREGATE error { return unimplementedWithIssueDetail(sqllex, 74775, "create aggregate") }
-| CREATE CAST error { return unimplemented(sqllex, "create cast") }
 | CREATE CONSTRAINT TRIGGER error { return unimplementedWithIssueDetail(sqllex, 28296, "create constraint") }
 | CREATE CONVERSION error { return unimplemented(sqllex, "create conversion") }
 | CREATE DEFAULT CONVERSION error { return unimplemented(sqllex, "create def conv") }
 | CREATE FOREIGN TABLE error { return unimplemented(sqllex, "create foreign table") }
 | CREATE FOREIGN DATA error { return unimplemented(sqllex, "create fdw") }
 | CREATE opt_or_replace opt_trusted opt_procedural LANGUAGE name error { return unimplementedWithIssueDetail(sqllex, 17511, "create language " + $6) }
-| CREATE OPERATOR error { return unimplementedWithIssue(sqllex, 65017) }
 | CREATE PUBLICATION error { return unimplemented(sqllex, "create publication") }
 | CREATE opt_or_replace RULE error { return unimplemented(sqllex, "create rule") }
 | CREATE SERVER error { return unimplemented(sqllex, "create server") }
 | CREATE SUBSCRIPTION error { return unimplemented(sqllex, "create subscription") }
 | CREATE TABLESPACE error { return unimplementedWithIssueDetail(sqllex, 54113, "create tablespace") }
-| CREATE TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "create text") }
 
 opt_trusted:
   TRUSTED {}
@@ -6181,8 +6302,6 @@ opt_procedural:
 drop_unsupported:
   DROP ACCESS METHOD error { return unimplemented(sqllex, "drop access method") }
 | DROP AGGREGATE error { return unimplementedWithIssueDetail(sqllex, 74775, "drop aggregate") }
-| DROP CAST error { return unimplemented(sqllex, "drop cast") }
-| DROP COLLATION error { return unimplemented(sqllex, "drop collation") }
 | DROP CONVERSION error { return unimplemented(sqllex, "drop conversion") }
 | DROP DOMAIN error { return unimplementedWithIssueDetail(sqllex, 27796, "drop") }
 | DROP EXTENSION IF EXISTS name error { return unimplementedWithIssueDetail(sqllex, 74777, "drop extension if exists") }
@@ -6190,12 +6309,10 @@ drop_unsupported:
 | DROP FOREIGN TABLE error { return unimplemented(sqllex, "drop foreign table") }
 | DROP FOREIGN DATA error { return unimplemented(sqllex, "drop fdw") }
 | DROP opt_procedural LANGUAGE name error { return unimplementedWithIssueDetail(sqllex, 17511, "drop language " + $4) }
-| DROP OPERATOR error { return unimplemented(sqllex, "drop operator") }
 | DROP PUBLICATION error { return unimplemented(sqllex, "drop publication") }
 | DROP RULE error { return unimplemented(sqllex, "drop rule") }
 | DROP SERVER error { return unimplemented(sqllex, "drop server") }
 | DROP SUBSCRIPTION error { return unimplemented(sqllex, "drop subscription") }
-| DROP TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "drop text") }
 
 create_ddl_stmt:
   create_database_stmt // EXTEND WITH HELP: CREATE DATABASE
@@ -6212,6 +6329,10 @@ create_ddl_stmt:
 | create_proc_stmt     // EXTEND WITH HELP: CREATE PROCEDURE
 | create_trigger_stmt  // EXTEND WITH HELP: CREATE TRIGGER
 | create_policy_stmt   // EXTEND WITH HELP: CREATE POLICY
+| create_text_search_stmt // EXTEND WITH HELP: CREATE TEXT SEARCH
+| create_collation_stmt // EXTEND WITH HELP: CREATE COLLATION
+| create_cast_stmt     // EXTEND WITH HELP: CREATE CAST
+| create_operator_stmt // EXTEND WITH HELP: CREATE OPERATOR
 
 // %Help: CREATE STATISTICS - create a new table statistic
 // %Category: Misc
@@ -6632,6 +6753,10 @@ drop_ddl_stmt:
 | drop_proc_stmt     // EXTEND WITH HELP: DROP FUNCTION
 | drop_trigger_stmt  // EXTEND WITH HELP: DROP TRIGGER
 | drop_policy_stmt   // EXTEND WITH HELP: DROP POLICY
+| drop_text_search_stmt // EXTEND WITH HELP: DROP TEXT SEARCH
+| drop_collation_stmt // EXTEND WITH HELP: DROP COLLATION
+| drop_cast_stmt     // EXTEND WITH HELP: DROP CAST
+| drop_operator_stmt // EXTEND WITH HELP: DROP OPERATOR
 
 // %Help: DROP VIEW - remove a view
 // %Category: DDL
@@ -6766,6 +6891,374 @@ drop_type_stmt:
   }
 | DROP TYPE error // SHOW HELP: DROP TYPE
 
+// %Help: CREATE TEXT SEARCH - create a text search configuration or dictionary
+// %Category: DDL
+// %Text:
+// CREATE TEXT SEARCH CONFIGURATION <name> ( { PARSER = default | COPY = <source_config> } )
+// CREATE TEXT SEARCH DICTIONARY <name> ( TEMPLATE = <template> [, <option> = <value> [, ...]] )
+//
+// Templates:
+//   simple    StopWords, Accept
+//   snowball  Language, StopWords
+//   synonym   Synonyms, CaseSensitive
+//   ispell    DictFile, AffFile, StopWords
+// %SeeAlso: ALTER TEXT SEARCH, DROP TEXT SEARCH
+create_text_search_stmt:
+  CREATE TEXT SEARCH text_search_object_type db_object_name '(' storage_parameter_list ')'
+  {
+    $$.val = &tree.CreateTextSearch{
+      Type: $4.textSearchObjectType(),
+      Name: $5.unresolvedObjectName(),
+      Params: $7.storageParams(),
+    }
+  }
+| CREATE TEXT SEARCH error // SHOW HELP: CREATE TEXT SEARCH
+
+// %Help: ALTER TEXT SEARCH - change a text search configuration or dictionary
+// %Category: DDL
+// %Text:
+// ALTER TEXT SEARCH DICTIONARY <name> ( <option> = <value> [, ...] )
+// ALTER TEXT SEARCH CONFIGURATION <name> <command>
+//
+// Commands:
+//   ALTER TEXT SEARCH CONFIGURATION ... ADD MAPPING FOR <token_type> [, ...] WITH <dictionary> [, ...]
+//   ALTER TEXT SEARCH CONFIGURATION ... ALTER MAPPING FOR <token_type> [, ...] WITH <dictionary> [, ...]
+//   ALTER TEXT SEARCH CONFIGURATION ... ALTER MAPPING [FOR <token_type> [, ...]] REPLACE <old_dictionary> WITH <new_dictionary>
+//   ALTER TEXT SEARCH CONFIGURATION ... DROP MAPPING [IF EXISTS] FOR <token_type> [, ...]
+//   ALTER TEXT SEARCH CONFIGURATION ... RENAME TO <newname>
+// %SeeAlso: CREATE TEXT SEARCH, DROP TEXT SEARCH
+alter_text_search_stmt:
+  ALTER TEXT SEARCH DICTIONARY db_object_name '(' storage_parameter_list ')'
+  {
+    $$.val = &tree.AlterTextSearchDictionary{
+      Name: $5.unresolvedObjectName(),
+      Params: $7.storageParams(),
+    }
+  }
+| ALTER TEXT SEARCH CONFIGURATION db_object_name alter_text_search_config_cmd
+  {
+    $$.val = &tree.AlterTextSearchConfig{
+      Name: $5.unresolvedObjectName(),
+      Cmd: $6.alterTextSearchConfigCmd(),
+    }
+  }
+| ALTER TEXT SEARCH error // SHOW HELP: ALTER TEXT SEARCH
+
+alter_text_search_config_cmd:
+  ADD MAPPING FOR name_list WITH text_search_name_list
+  {
+    $$.val = &tree.AlterTextSearchConfigAddMapping{
+      TokenTypes: $4.nameList(),
+      Dictionaries: $6.unresolvedObjectNames(),
+    }
+  }
+| ALTER MAPPING FOR name_list WITH text_search_name_list
+  {
+    $$.val = &tree.AlterTextSearchConfigAlterMapping{
+      TokenTypes: $4.nameList(),
+      Dictionaries: $6.unresolvedObjectNames(),
+    }
+  }
+| ALTER MAPPING REPLACE db_object_name WITH db_object_name
+  {
+    $$.val = &tree.AlterTextSearchConfigReplaceMapping{
+      Old: $4.unresolvedObjectName(),
+      New: $6.unresolvedObjectName(),
+    }
+  }
+| ALTER MAPPING FOR name_list REPLACE db_object_name WITH db_object_name
+  {
+    $$.val = &tree.AlterTextSearchConfigReplaceMapping{
+      TokenTypes: $4.nameList(),
+      Old: $6.unresolvedObjectName(),
+      New: $8.unresolvedObjectName(),
+    }
+  }
+| DROP MAPPING FOR name_list
+  {
+    $$.val = &tree.AlterTextSearchConfigDropMapping{TokenTypes: $4.nameList()}
+  }
+| DROP MAPPING IF EXISTS FOR name_list
+  {
+    $$.val = &tree.AlterTextSearchConfigDropMapping{IfExists: true, TokenTypes: $6.nameList()}
+  }
+| RENAME TO name
+  {
+    $$.val = &tree.AlterTextSearchConfigRename{NewName: tree.Name($3)}
+  }
+
+// %Help: DROP TEXT SEARCH - remove a text search configuration or dictionary
+// %Category: DDL
+// %Text: DROP TEXT SEARCH { CONFIGURATION | DICTIONARY } [IF EXISTS] <name> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE TEXT SEARCH, ALTER TEXT SEARCH
+drop_text_search_stmt:
+  DROP TEXT SEARCH text_search_object_type text_search_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropTextSearch{
+      Type: $4.textSearchObjectType(),
+      Names: $5.unresolvedObjectNames(),
+      DropBehavior: $6.dropBehavior(),
+    }
+  }
+| DROP TEXT SEARCH text_search_object_type IF EXISTS text_search_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropTextSearch{
+      Type: $4.textSearchObjectType(),
+      Names: $7.unresolvedObjectNames(),
+      IfExists: true,
+      DropBehavior: $8.dropBehavior(),
+    }
+  }
+| DROP TEXT SEARCH error // SHOW HELP: DROP TEXT SEARCH
+
+text_search_object_type:
+  CONFIGURATION
+  {
+    $$.val = tree.TextSearchConfiguration
+  }
+| DICTIONARY
+  {
+    $$.val = tree.TextSearchDictionary
+  }
+
+text_search_name_list:
+  db_object_name
+  {
+    $$.val = []*tree.UnresolvedObjectName{$1.unresolvedObjectName()}
+  }
+| text_search_name_list ',' db_object_name
+  {
+    $$.val = append($1.unresolvedObjectNames(), $3.unresolvedObjectName())
+  }
+
+// %Help: CREATE COLLATION - create a collation
+// %Category: DDL
+// %Text:
+// CREATE COLLATION [IF NOT EXISTS] <name> ( [PROVIDER = icu,] LOCALE = <locale> [, DETERMINISTIC = <bool>] )
+// CREATE COLLATION [IF NOT EXISTS] <name> FROM <existing_collation>
+//
+// The collation can be used in COLLATE clauses in place of its locale.
+// %SeeAlso: DROP COLLATION
+create_collation_stmt:
+  CREATE COLLATION db_object_name '(' storage_parameter_list ')'
+  {
+    $$.val = &tree.CreateCollation{
+      Name: $3.unresolvedObjectName(),
+      Params: $5.storageParams(),
+    }
+  }
+| CREATE COLLATION IF NOT EXISTS db_object_name '(' storage_parameter_list ')'
+  {
+    $$.val = &tree.CreateCollation{
+      IfNotExists: true,
+      Name: $6.unresolvedObjectName(),
+      Params: $8.storageParams(),
+    }
+  }
+| CREATE COLLATION db_object_name FROM collation_name
+  {
+    $$.val = &tree.CreateCollation{
+      Name: $3.unresolvedObjectName(),
+      From: tree.Name($5),
+    }
+  }
+| CREATE COLLATION IF NOT EXISTS db_object_name FROM collation_name
+  {
+    $$.val = &tree.CreateCollation{
+      IfNotExists: true,
+      Name: $6.unresolvedObjectName(),
+      From: tree.Name($8),
+    }
+  }
+| CREATE COLLATION error // SHOW HELP: CREATE COLLATION
+
+// %Help: DROP COLLATION - remove a collation
+// %Category: DDL
+// %Text: DROP COLLATION [IF EXISTS] <name> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE COLLATION
+drop_collation_stmt:
+  DROP COLLATION collation_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropCollation{
+      Names: $3.unresolvedObjectNames(),
+      DropBehavior: $4.dropBehavior(),
+    }
+  }
+| DROP COLLATION IF EXISTS collation_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropCollation{
+      Names: $5.unresolvedObjectNames(),
+      IfExists: true,
+      DropBehavior: $6.dropBehavior(),
+    }
+  }
+| DROP COLLATION error // SHOW HELP: DROP COLLATION
+
+collation_name_list:
+  text_search_name_list
+
+// %Help: CREATE CAST - define a user-defined cast
+// %Category: DDL
+// %Text:
+// CREATE CAST (<source_type> AS <target_type>)
+//   WITH FUNCTION <function_name> [ ( <argument_type> ) ]
+//
+// The function is called to convert values of the source type to the target
+// type in explicit casts between types that have no built-in cast.
+// %SeeAlso: DROP CAST, CREATE FUNCTION
+create_cast_stmt:
+  CREATE CAST '(' typename AS typename ')' WITH FUNCTION function_with_paramtypes opt_cast_context
+  {
+    $$.val = &tree.CreateCast{
+      SourceType: $4.typeReference(),
+      TargetType: $6.typeReference(),
+      Function: $10.functionObj(),
+      Context: $11.castContext(),
+    }
+  }
+| CREATE CAST '(' typename AS typename ')' WITHOUT FUNCTION opt_cast_context
+  {
+    return unimplemented(sqllex, "create cast without function")
+  }
+| CREATE CAST '(' typename AS typename ')' WITH INOUT opt_cast_context
+  {
+    return unimplemented(sqllex, "create cast with inout")
+  }
+| CREATE CAST error // SHOW HELP: CREATE CAST
+
+opt_cast_context:
+  AS name
+  {
+    switch $2 {
+    case "assignment":
+      $$.val = cast.ContextAssignment
+    case "implicit":
+      $$.val = cast.ContextImplicit
+    default:
+      sqllex.Error("expected AS ASSIGNMENT or AS IMPLICIT")
+      return 1
+    }
+  }
+| /* EMPTY */
+  {
+    $$.val = cast.ContextExplicit
+  }
+
+// %Help: DROP CAST - remove a user-defined cast
+// %Category: DDL
+// %Text: DROP CAST [IF EXISTS] (<source_type> AS <target_type>) [CASCADE | RESTRICT]
+// %SeeAlso: CREATE CAST
+drop_cast_stmt:
+  DROP CAST '(' typename AS typename ')' opt_drop_behavior
+  {
+    $$.val = &tree.DropCast{
+      SourceType: $4.typeReference(),
+      TargetType: $6.typeReference(),
+      DropBehavior: $8.dropBehavior(),
+    }
+  }
+| DROP CAST IF EXISTS '(' typename AS typename ')' opt_drop_behavior
+  {
+    $$.val = &tree.DropCast{
+      SourceType: $6.typeReference(),
+      TargetType: $8.typeReference(),
+      IfExists: true,
+      DropBehavior: $10.dropBehavior(),
+    }
+  }
+| DROP CAST error // SHOW HELP: DROP CAST
+
+// %Help: CREATE OPERATOR - define a user-defined operator
+// %Category: DDL
+// %Text:
+// CREATE OPERATOR <operator> (
+//   LEFTARG = <left_type>, RIGHTARG = <right_type>, FUNCTION = <function_name>
+// )
+//
+// Only binary operators with the symbol of a built-in binary operator, such
+// as + or ||, are supported. The function is called with both operands when
+// no built-in operator applies to their types.
+// %SeeAlso: DROP OPERATOR, CREATE FUNCTION
+create_operator_stmt:
+  CREATE OPERATOR all_op '(' operator_option_list ')'
+  {
+    op, ok := $3.op().(treebin.BinaryOperator)
+    if !ok {
+      return unimplementedWithIssue(sqllex, 65017)
+    }
+    $$.val = &tree.CreateOperator{
+      Operator: op,
+      Options: $5.operatorOptions(),
+    }
+  }
+| CREATE OPERATOR error // SHOW HELP: CREATE OPERATOR
+
+operator_option_list:
+  operator_option
+  {
+    $$.val = tree.OperatorOptions{$1.operatorOption()}
+  }
+| operator_option_list ',' operator_option
+  {
+    $$.val = append($1.operatorOptions(), $3.operatorOption())
+  }
+
+operator_option:
+  name '=' typename
+  {
+    $$.val = tree.OperatorOption{Name: tree.Name($1), Value: $3.typeReference()}
+  }
+| name
+  {
+    $$.val = tree.OperatorOption{Name: tree.Name($1)}
+  }
+
+// %Help: DROP OPERATOR - remove a user-defined operator
+// %Category: DDL
+// %Text: DROP OPERATOR [IF EXISTS] <operator> (<left_type>, <right_type>) [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE OPERATOR
+drop_operator_stmt:
+  DROP OPERATOR operator_with_args_list opt_drop_behavior
+  {
+    $$.val = &tree.DropOperator{
+      Operators: $3.operatorsWithArgs(),
+      DropBehavior: $4.dropBehavior(),
+    }
+  }
+| DROP OPERATOR IF EXISTS operator_with_args_list opt_drop_behavior
+  {
+    $$.val = &tree.DropOperator{
+      Operators: $5.operatorsWithArgs(),
+      IfExists: true,
+      DropBehavior: $6.dropBehavior(),
+    }
+  }
+| DROP OPERATOR error // SHOW HELP: DROP OPERATOR
+
+operator_with_args_list:
+  operator_with_args
+  {
+    $$.val = []tree.OperatorWithArgs{$1.operatorWithArgs()}
+  }
+| operator_with_args_list ',' operator_with_args
+  {
+    $$.val = append($1.operatorsWithArgs(), $3.operatorWithArgs())
+  }
+
+operator_with_args:
+  all_op '(' typename ',' typename ')'
+  {
+    op, ok := $1.op().(treebin.BinaryOperator)
+    if !ok {
+      return unimplementedWithIssue(sqllex, 65017)
+    }
+    $$.val = tree.OperatorWithArgs{
+      Operator: op,
+      LeftType: $3.typeReference(),
+      RightType: $5.typeReference(),
+    }
+  }
+
 // %Help: DROP VIRTUAL CLUSTER - remove a virtual cluster
 // %Category: Experimental
 // %Text: DROP VIRTUAL CLUSTER [IF EXISTS] <virtual_cluster_spec> [IMMEDIATE]
@@ -7420,12 +7913,17 @@ deallocate_stmt:
 // %Text:
 // Grant privileges:
 //   GRANT {ALL [PRIVILEGES] | <privileges...> } ON <targets...> TO <grantees...>
+// Grant privileges on columns:
+//   GRANT <privilege> ( <colnames...> ) [, ...] ON [TABLE] <tablename> [, ...] TO <grantees...>
 // Grant role membership:
 //   GRANT <roles...> TO <grantees...> [WITH ADMIN OPTION]
 //
 // Privileges:
 //   CREATE, DROP, GRANT, SELECT, INSERT, DELETE, UPDATE, USAGE, EXECUTE
 //
+// Column privileges:
+//   SELECT, INSERT, UPDATE
+//
 // Targets:
 //   DATABASE <databasename> [, ...]
 //   [TABLE] [<databasename> .] { <tablename> | * } [, ...]
@@ -7440,6 +7938,11 @@ grant_stmt:
   {
     $$.val = &tree.Grant{Privileges: $2.privilegeList(), Grantees: $6.roleSpecList(), Targets: $4.grantTargetList(), WithGrantOption: $7.bool(),}
   }
+| GRANT column_privileges ON grant_targets TO role_spec_list opt_with_grant_option
+  {
+    privs := $2.columnPrivileges()
+    $$.val = &tree.Grant{Privileges: privs.privileges, ColumnPrivileges: privs.columns, Grantees: $6.roleSpecList(), Targets: $4.grantTargetList(), WithGrantOption: $7.bool(),}
+  }
 | GRANT privilege_list TO role_spec_list
   {
     $$.val = &tree.GrantRole{Roles: $2.nameList(), Members: $4.roleSpecList(), AdminOption: false}
@@ -7546,12 +8049,17 @@ grant_stmt:
 // %Text:
 // Revoke privileges:
 //   REVOKE {ALL | <privileges...> } ON <targets...> FROM <grantees...>
+// Revoke privileges on columns:
+//   REVOKE <privilege> ( <colnames...> ) [, ...] ON [TABLE] <tablename> [, ...] FROM <grantees...>
 // Revoke role membership:
 //   REVOKE [ADMIN OPTION FOR] <roles...> FROM <grantees...>
 //
 // Privileges:
 //   CREATE, DROP, GRANT, SELECT, INSERT, DELETE, UPDATE, USAGE, EXECUTE
 //
+// Column privileges:
+//   SELECT, INSERT, UPDATE
+//
 // Targets:
 //   DATABASE <databasename> [, <databasename>]...
 //   [TABLE] [<databasename> .] { <tablename> | * } [, ...]
@@ -7570,6 +8078,16 @@ revoke_stmt:
   {
     $$.val = &tree.Revoke{Privileges: $5.privilegeList(), Grantees: $9.roleSpecList(), Targets: $7.grantTargetList(), GrantOptionFor: true}
   }
+| REVOKE column_privileges ON grant_targets FROM role_spec_list
+  {
+    privs := $2.columnPrivileges()
+    $$.val = &tree.Revoke{Privileges: privs.privileges, ColumnPrivileges: privs.columns, Grantees: $6.roleSpecList(), Targets: $4.grantTargetList(), GrantOptionFor: false}
+  }
+| REVOKE GRANT OPTION FOR column_privileges ON grant_targets FROM role_spec_list
+  {
+    privs := $5.columnPrivileges()
+    $$.val = &tree.Revoke{Privileges: privs.privileges, ColumnPrivileges: privs.columns, Grantees: $9.roleSpecList(), Targets: $7.grantTargetList(), GrantOptionFor: true}
+  }
 | REVOKE privilege_list FROM role_spec_list
   {
     $$.val = &tree.RevokeRole{Roles: $2.nameList(), Members: $4.roleSpecList(), AdminOption: false }
@@ -7771,6 +8289,50 @@ privilege_list:
     $$.val = append($1.nameList(), tree.Name($3))
   }
 
+// column_privileges is a list of privileges in which at least one privilege
+// applies to specific columns, e.g. SELECT (a, b), UPDATE.
+column_privileges:
+  privilege '(' name_list ')'
+  {
+    colPriv, err := makeColumnPrivilege($1, $3.nameList())
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = &columnPrivileges{columns: tree.ColumnPrivilegeList{colPriv}}
+  }
+| privilege_list ',' privilege '(' name_list ')'
+  {
+    privList, err := privilege.ListFromStrings($1.nameList().ToStrings(), privilege.OriginFromUserInput)
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    colPriv, err := makeColumnPrivilege($3, $5.nameList())
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = &columnPrivileges{privileges: privList, columns: tree.ColumnPrivilegeList{colPriv}}
+  }
+| column_privileges ',' privilege
+  {
+    privList, err := privilege.ListFromStrings([]string{$3}, privilege.OriginFromUserInput)
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    privs := $1.columnPrivileges()
+    privs.privileges = append(privs.privileges, privList...)
+    $$.val = privs
+  }
+| column_privileges ',' privilege '(' name_list ')'
+  {
+    colPriv, err := makeColumnPrivilege($3, $5.nameList())
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    privs := $1.columnPrivileges()
+    privs.columns = append(privs.columns, colPriv)
+    $$.val = privs
+  }
+
 // Privileges are parsed at execution time to avoid having to make them reserved.
 // Any privileges above `col_name_keyword` should be listed here.
 // The full list is in sql/privilege/privilege.go.
@@ -11491,6 +12053,17 @@ partition_by_inner:
       Range: $6.rangePartitions(),
     }
   }
+| HASH '(' name_list ')' PARTITIONS iconst32
+  {
+    if $6.int32() <= 0 {
+      sqllex.Error("the number of hash partitions must be positive")
+      return 1
+    }
+    $$.val = &tree.PartitionBy{
+      Fields: $3.nameList(),
+      HashPartitions: $6.int32(),
+    }
+  }
 | NOTHING
   {
     $$.val = (*tree.PartitionBy)(nil)
@@ -11691,6 +12264,14 @@ col_qualification_elem:
   {
     $$.val = &tree.GeneratedByDefAsIdentity{}
   }
+| ENCRYPTED WITH KEY SCONST
+  {
+    $$.val = &tree.ColumnEncryptionDef{KMSURI: tree.NewStrVal($4)}
+  }
+| ENCRYPTED WITH KEY SCONST FOR ROLES '(' role_spec_list ')'
+  {
+    $$.val = &tree.ColumnEncryptionDef{KMSURI: tree.NewStrVal($4), Roles: $8.roleSpecList()}
+  }
 
 opt_without_index:
   WITHOUT INDEX
@@ -12906,7 +13487,9 @@ opt_index_access_method:
         val = idxtype.FORWARD
       case "cspann", "hnsw":
         val = idxtype.VECTOR
-      case "hash", "spgist", "brin":
+      case "brin":
+        val = idxtype.BRIN
+      case "hash", "spgist":
         return unimplemented(sqllex, "index using " + $2)
       default:
         sqllex.Error("unrecognized access method: " + $2)
@@ -17321,6 +17904,66 @@ func_expr_common_subexpr:
   {
     $$.val = &tree.CoalesceExpr{Name: "COALESCE", Exprs: $3.exprs()}
   }
+| XMLELEMENT '(' IDENT unrestricted_name ')'
+  {
+    e, code := makeXMLElement(sqllex, $3, $4, nil, nil)
+    if code != 0 {
+      return code
+    }
+    $$.val = e
+  }
+| XMLELEMENT '(' IDENT unrestricted_name ',' xml_attributes ')'
+  {
+    e, code := makeXMLElement(sqllex, $3, $4, $6.selExprs(), nil)
+    if code != 0 {
+      return code
+    }
+    $$.val = e
+  }
+| XMLELEMENT '(' IDENT unrestricted_name ',' expr_list ')'
+  {
+    e, code := makeXMLElement(sqllex, $3, $4, nil, $6.exprs())
+    if code != 0 {
+      return code
+    }
+    $$.val = e
+  }
+| XMLELEMENT '(' IDENT unrestricted_name ',' xml_attributes ',' expr_list ')'
+  {
+    e, code := makeXMLElement(sqllex, $3, $4, $6.selExprs(), $8.exprs())
+    if code != 0 {
+      return code
+    }
+    $$.val = e
+  }
+| XMLEXISTS '(' c_expr xmlexists_argument ')'
+  {
+    $$.val = &tree.FuncExpr{Func: tree.WrapFunction("xmlexists"), Exprs: tree.Exprs{$3.expr(), $4.expr()}}
+  }
+// The function call form is accepted so that the expression above can be
+// formatted and parsed back.
+| XMLEXISTS '(' a_expr ',' a_expr ')'
+  {
+    $$.val = &tree.FuncExpr{Func: tree.WrapFunction("xmlexists"), Exprs: tree.Exprs{$3.expr(), $5.expr()}}
+  }
+| XMLPARSE '(' document_or_content a_expr ')'
+  {
+    $$.val = &tree.FuncExpr{
+      Func: tree.WrapFunction("crdb_internal.xmlparse"),
+      Exprs: tree.Exprs{tree.NewStrVal($3), $4.expr()},
+    }
+  }
+| XMLSERIALIZE '(' document_or_content a_expr AS simple_typename ')'
+  {
+    $$.val = &tree.CastExpr{
+      Expr: &tree.FuncExpr{
+        Func: tree.WrapFunction("crdb_internal.xmlserialize"),
+        Exprs: tree.Exprs{tree.NewStrVal($3), $4.expr()},
+      },
+      Type: $6.typeReference(),
+      SyntaxMode: tree.CastExplicit,
+    }
+  }
 | special_function
 
 special_function:
@@ -18148,6 +18791,60 @@ bare_col_label:
   IDENT
 | bare_label_keywords
 
+document_or_content:
+  DOCUMENT { $$ = "document" }
+| CONTENT { $$ = "content" }
+
+xml_attributes:
+  XMLATTRIBUTES '(' xml_attribute_list ')'
+  {
+    $$.val = $3.selExprs()
+  }
+
+xml_attribute_list:
+  xml_attribute_el
+  {
+    $$.val = tree.SelectExprs{$1.selExpr()}
+  }
+| xml_attribute_list ',' xml_attribute_el
+  {
+    $$.val = append($1.selExprs(), $3.selExpr())
+  }
+
+xml_attribute_el:
+  a_expr AS unrestricted_name
+  {
+    $$.val = tree.SelectExpr{Expr: $1.expr(), As: tree.UnrestrictedName($3)}
+  }
+| a_expr
+  {
+    $$.val = tree.SelectExpr{Expr: $1.expr()}
+  }
+
+// The passing mechanism is accepted for compatibility and ignored, since XML
+// values are always passed by value.
+xmlexists_argument:
+  PASSING c_expr
+  {
+    $$.val = $2.expr()
+  }
+| PASSING c_expr xml_passing_mech
+  {
+    $$.val = $2.expr()
+  }
+| PASSING xml_passing_mech c_expr
+  {
+    $$.val = $3.expr()
+  }
+| PASSING xml_passing_mech c_expr xml_passing_mech
+  {
+    $$.val = $3.expr()
+  }
+
+xml_passing_mech:
+  BY REF {}
+| BY VALUE {}
+
 // Names and constants.
 
 table_index_name_list:
@@ -18743,6 +19440,7 @@ unreserved_keyword:
 | CONNECTION
 | CONNECTIONS
 | CONSTRAINTS
+| CONTENT
 | CONTROLCHANGEFEED
 | CONTROLJOB
 | CONVERSION
@@ -18774,8 +19472,10 @@ unreserved_keyword:
 | DESTINATION
 | DETACHED
 | DETAILS
+| DICTIONARY
 | DISABLE
 | DISCARD
+| DOCUMENT
 | DOMAIN
 | DOUBLE
 | DROP
@@ -18783,6 +19483,7 @@ unreserved_keyword:
 | ENABLE
 | ENCODING
 | ENCRYPTED
+| ENCRYPTION
 | ENCRYPTION_PASSPHRASE
 | ENUM
 | ENUMS
@@ -18896,6 +19597,8 @@ unreserved_keyword:
 | LOGGED
 | LOOKUP
 | LOW
+| MAPPING
+| MASKING
 | MATCH
 | MATERIALIZED
 | MAXVALUE
@@ -18973,6 +19676,7 @@ unreserved_keyword:
 | PARTIAL
 | PARTITION
 | PARTITIONS
+| PASSING
 | PASSWORD
 | PAUSE
 | PAUSED
@@ -19048,6 +19752,7 @@ unreserved_keyword:
 | ROLES
 | ROLLBACK
 | ROLLUP
+| ROTATE
 | ROUTINES
 | ROWS
 | RULE
@@ -19270,6 +19975,7 @@ bare_label_keywords:
 | CONNECTIONS
 | CONSTRAINT
 | CONSTRAINTS
+| CONTENT
 | CONTROLCHANGEFEED
 | CONTROLJOB
 | CONVERSION
@@ -19313,10 +20019,12 @@ bare_label_keywords:
 | DESTINATION
 | DETACHED
 | DETAILS
+| DICTIONARY
 | DISABLE
 | DISCARD
 | DISTINCT
 | DO
+| DOCUMENT
 | DOMAIN
 | DOUBLE
 | DROP
@@ -19325,6 +20033,7 @@ bare_label_keywords:
 | ENABLE
 | ENCODING
 | ENCRYPTED
+| ENCRYPTION
 | ENCRYPTION_PASSPHRASE
 | END
 | ENUM
@@ -19475,6 +20184,8 @@ bare_label_keywords:
 | LOGIN
 | LOOKUP
 | LOW
+| MAPPING
+| MASKING
 | MATCH
 | MATERIALIZED
 | MAXVALUE
@@ -19559,6 +20270,7 @@ bare_label_keywords:
 | PARTIAL
 | PARTITION
 | PARTITIONS
+| PASSING
 | PASSWORD
 | PAUSE
 | PAUSED
@@ -19642,6 +20354,7 @@ bare_label_keywords:
 | ROLES
 | ROLLBACK
 | ROLLUP
+| ROTATE
 | ROUTINES
 | ROW
 | ROWS
@@ -19802,6 +20515,11 @@ bare_label_keywords:
 | WHEN
 | WORK
 | WRITE
+| XMLATTRIBUTES
+| XMLELEMENT
+| XMLEXISTS
+| XMLPARSE
+| XMLSERIALIZE
 | ZONE
 
 
@@ -19873,6 +20591,11 @@ col_name_keyword:
 | VECTOR
 | VIRTUAL
 | WORK
+| XMLATTRIBUTES
+| XMLELEMENT
+| XMLEXISTS
+| XMLPARSE
+| XMLSERIALIZE
 
 // type_func_name_keyword contains both the standard set of
 // type_func_name_keyword's along with the set of CRDB extensions.
@@ -35,6 +35,9 @@ func partitionByFromTableDescImpl(
 	if part.NumColumns() == 0 {
 		return nil, nil
 	}
+	if partitionBy := tabledesc.HashPartitionByFromPartitioning(part); partitionBy != nil {
+		return partitionBy, nil
+	}
 
 	// We don't need real prefixes in the DecodePartitionTuple calls because we
 	// only use the tree.Datums part of the output.
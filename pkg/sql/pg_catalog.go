@@ -12,12 +12,14 @@ import (
 	"fmt"
 	"hash"
 	"hash/fnv"
+	"net"
 	"strings"
 	"time"
 	"unicode"
 
 	"github.com/cockroachdb/cockroach/pkg/keys"
 	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/settings/rulebasedscanner"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catenumpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catformat"
@@ -31,6 +33,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/oidext"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/hba"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/prep"
@@ -72,6 +75,7 @@ var (
 const (
 	indexTypeForwardIndex  = "prefix"
 	indexTypeInvertedIndex = "inverted"
+	indexTypeBrinIndex     = "brin"
 )
 
 // Bitmasks for pg_index.indoption. Each column in the index has a bitfield
@@ -116,6 +120,7 @@ const (
 
 var forwardIndexOid = stringOid(indexTypeForwardIndex)
 var invertedIndexOid = stringOid(indexTypeInvertedIndex)
+var brinIndexOid = stringOid(indexTypeBrinIndex)
 
 // pgCatalog contains a set of system tables mirroring PostgreSQL's pg_catalog schema.
 // This code attempts to comply as closely as possible to the system catalogs documented
@@ -353,6 +358,45 @@ https://www.postgresql.org/docs/9.5/catalog-pg-am.html`,
 		); err != nil {
 			return err
 		}
+
+		// add row for BRIN indexes
+		if err := addRow(
+			brinIndexOid,                      // oid - all versions
+			tree.NewDName(indexTypeBrinIndex), // amname - all versions
+			zeroVal,                           // amstrategies - < v9.6
+			zeroVal,                           // amsupport - < v9.6
+			tree.DBoolFalse,                   // amcanorder - < v9.6
+			tree.DBoolFalse,                   // amcanorderbyop - < v9.6
+			tree.DBoolFalse,                   // amcanbackward - < v9.6
+			tree.DBoolFalse,                   // amcanunique - < v9.6
+			tree.DBoolTrue,                    // amcanmulticol - < v9.6
+			tree.DBoolTrue,                    // amoptionalkey - < v9.6
+			tree.DBoolFalse,                   // amsearcharray - < v9.6
+			tree.DBoolTrue,                    // amsearchnulls - < v9.6
+			tree.DBoolTrue,                    // amstorage - < v9.6
+			tree.DBoolFalse,                   // amclusterable - < v9.6
+			tree.DBoolFalse,                   // ampredlocks - < v9.6
+			oidZero,                           // amkeytype - < v9.6
+			tree.DNull,                        // aminsert - < v9.6
+			tree.DNull,                        // ambeginscan - < v9.6
+			oidZero,                           // amgettuple - < v9.6
+			oidZero,                           // amgetbitmap - < v9.6
+			tree.DNull,                        // amrescan - < v9.6
+			tree.DNull,                        // amendscan - < v9.6
+			tree.DNull,                        // ammarkpos - < v9.6
+			tree.DNull,                        // amrestrpos - < v9.6
+			tree.DNull,                        // ambuild - < v9.6
+			tree.DNull,                        // ambuildempty - < v9.6
+			tree.DNull,                        // ambulkdelete - < v9.6
+			tree.DNull,                        // amvacuumcleanup - < v9.6
+			tree.DNull,                        // amcanreturn - < v9.6
+			tree.DNull,                        // amcostestimate - < v9.6
+			tree.DNull,                        // amoptions - < v9.6
+			tree.DNull,                        // amhandler - > v9.6
+			tree.NewDString("i"),              // amtype - > v9.6
+		); err != nil {
+			return err
+		}
 		return nil
 	},
 }
@@ -575,7 +619,7 @@ var pgCatalogCastTable = virtualSchemaTable{
 	comment: `casts (empty - needs filling out)
 https://www.postgresql.org/docs/9.6/catalog-pg-cast.html`,
 	schema: vtable.PGCatalogCast,
-	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+	populate: func(ctx context.Context, p *planner, dbContext catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
 		h := makeOidHasher()
 		cast.ForEachCast(func(src, tgt oid.Oid, cCtx cast.Context, ctxOrigin cast.ContextOrigin, _ volatility.V) {
 			if ctxOrigin == cast.ContextOriginPgCast {
@@ -597,10 +641,31 @@ https://www.postgresql.org/docs/9.6/catalog-pg-cast.html`,
 				)
 			}
 		})
-		return nil
+		// Casts created with CREATE CAST are explicit and call a function.
+		return forEachDatabaseDesc(ctx, p, dbContext, false /* requiresPrivileges */, func(ctx context.Context, db catalog.DatabaseDescriptor) error {
+			return forEachSchema(ctx, p, db, false /* requiresPrivileges */, false /* includeMetadata */, func(ctx context.Context, sc catalog.SchemaDescriptor) error {
+				return sc.ForEachCast(func(c descpb.SchemaDescriptor_Cast) error {
+					src, tgt := c.SourceType.Oid(), c.TargetType.Oid()
+					castFunc := tree.NewDOid(catid.FuncIDToOID(c.FunctionID))
+					return addRow(
+						h.CastOid(src, tgt), // oid
+						tree.NewDOid(src),   // cast source
+						tree.NewDOid(tgt),   // casttarget
+						castFunc,            // castfunc
+						castContextExplicit, // castcontext
+						castMethodFunction,  // castmethod
+					)
+				})
+			})
+		})
 	},
 }
 
+var (
+	castContextExplicit = tree.NewDString("e")
+	castMethodFunction  = tree.NewDString("f")
+)
+
 func userIsSuper(
 	ctx context.Context, p *planner, userName username.SQLUsername,
 ) (tree.DBool, error) {
@@ -887,8 +952,11 @@ https://www.postgresql.org/docs/9.5/catalog-pg-class.html`,
 		// Indexes.
 		return catalog.ForEachIndex(table, catalog.IndexOpts{}, func(index catalog.Index) error {
 			indexType := forwardIndexOid
-			if index.GetType() == idxtype.INVERTED {
+			switch index.GetType() {
+			case idxtype.INVERTED:
 				indexType = invertedIndexOid
+			case idxtype.BRIN:
+				indexType = brinIndexOid
 			}
 			ownerOid, err := getOwnerOID(ctx, p, table)
 			if err != nil {
@@ -968,11 +1036,32 @@ https://www.postgresql.org/docs/9.5/catalog-pg-collation.html`,
 					return err
 				}
 			}
-			return nil
+			// Collations created with CREATE COLLATION use ICU locale tags, which
+			// are shown in collcollate and collctype like in Postgres before
+			// version 15.
+			return forEachSchema(ctx, p, db, false /* requiresPrivileges */, false /* includeMetadata */, func(ctx context.Context, sc catalog.SchemaDescriptor) error {
+				return sc.ForEachCollation(func(coll descpb.SchemaDescriptor_Collation) error {
+					locale := tree.NewDString(coll.Locale)
+					return addRow(
+						h.UserDefinedCollationOid(sc.GetID(), coll.Name), // oid
+						tree.NewDString(coll.Name),                       // collname
+						schemaOid(sc.GetID()),                            // collnamespace
+						tree.DNull,                                       // collowner
+						builtins.DatEncodingUTFId,                        // collencoding
+						locale,                                           // collcollate
+						locale,                                           // collctype
+						collProviderICU,                                  // collprovider
+						tree.DNull,                                       // collversion
+						tree.MakeDBool(tree.DBool(coll.Deterministic)), // collisdeterministic
+					)
+				})
+			})
 		})
 	},
 }
 
+var collProviderICU = tree.NewDString("i")
+
 var (
 	conTypeCheck     = tree.NewDString("c")
 	conTypeFK        = tree.NewDString("f")
@@ -1437,6 +1526,10 @@ https://www.postgresql.org/docs/9.5/catalog-pg-database.html`,
 				if err != nil {
 					return err
 				}
+				datCollate := builtins.DatEncodingEnUTF8
+				if locale := db.GetDefaultCollation(); locale != "" {
+					datCollate = tree.NewDString(locale)
+				}
 				return addRow(
 					dbOid(db.GetID()),           // oid
 					tree.NewDName(db.GetName()), // datname
@@ -1444,7 +1537,7 @@ https://www.postgresql.org/docs/9.5/catalog-pg-database.html`,
 					// If there is a change in encoding value for the database we must update
 					// the definitions of getdatabaseencoding within pg_builtin.
 					builtins.DatEncodingUTFId,  // encoding
-					builtins.DatEncodingEnUTF8, // datcollate
+					datCollate,                 // datcollate
 					builtins.DatEncodingEnUTF8, // datctype
 					tree.DBoolFalse,            // datistemplate
 					tree.DBoolTrue,             // datallowconn
@@ -2525,7 +2618,39 @@ https://www.postgresql.org/docs/9.5/catalog-pg-operator.html`,
 				return err
 			}
 		}
-		return nil
+		// Operators created with CREATE OPERATOR belong to a schema and call a
+		// function, whose return type is the result type of the operator.
+		return forEachSchema(ctx, p, db, false /* requiresPrivileges */, false /* includeMetadata */, func(ctx context.Context, sc catalog.SchemaDescriptor) error {
+			return sc.ForEachOperator(func(op descpb.SchemaDescriptor_Operator) error {
+				fnOID := catid.FuncIDToOID(op.FunctionID)
+				returnType := oidZero
+				if _, overload, err := p.ResolveFunctionByOID(ctx, fnOID); err == nil {
+					returnType = tree.NewDOid(overload.ReturnType(nil).Oid())
+				} else if !errors.Is(err, catalog.ErrDescriptorNotFound) &&
+					!errors.Is(err, catalog.ErrDescriptorDropped) {
+					return err
+				}
+				leftType, rightType := tree.NewDOid(op.LeftType.Oid()), tree.NewDOid(op.RightType.Oid())
+				return addRow(
+					h.UserDefinedOperatorOid(sc.GetID(), op.Name, leftType, rightType), // oid
+
+					tree.NewDString(op.Name), // oprname
+					schemaOid(sc.GetID()),    // oprnamespace
+					tree.DNull,               // oprowner
+					infixKind,                // oprkind
+					tree.DBoolFalse,          // oprcanmerge
+					tree.DBoolFalse,          // oprcanhash
+					leftType,                 // oprleft
+					rightType,                // oprright
+					returnType,               // oprresult
+					tree.DNull,               // oprcom
+					tree.DNull,               // oprnegate
+					tree.NewDOid(fnOID),      // oprcode
+					tree.DNull,               // oprrest
+					tree.DNull,               // oprjoin
+				)
+			})
+		})
 	},
 }
 
@@ -3139,7 +3264,7 @@ https://www.postgresql.org/docs/9.5/catalog-pg-sequence.html`,
 					tree.NewDInt(tree.DInt(opts.MaxValue)),  // seqmax
 					tree.NewDInt(tree.DInt(opts.MinValue)),  // seqmin
 					tree.NewDInt(1),                         // seqcache
-					tree.DBoolFalse,                         // seqcycle
+					tree.MakeDBool(tree.DBool(opts.Cycle)),  // seqcycle
 				)
 			})
 	},
@@ -3488,6 +3613,12 @@ https://www.postgresql.org/docs/16/catalog-pg-trigger.html`,
 						newTableName = tree.NewDName(trigger.NewTransitionAlias)
 					}
 
+					// tgfoid: builtin trigger functions have no descriptor.
+					tgfoid := tree.NewDOid(catid.FuncIDToOID(trigger.FuncID))
+					if def, ok := tree.FunDefs[trigger.BuiltinFuncName]; ok {
+						tgfoid = tree.NewDOid(def.Definition[0].Oid)
+					}
+
 					// tgqual: WHEN condition expression (internal format).
 					var tgqual tree.Datum = tree.DNull
 					if trigger.WhenExpr != "" {
@@ -3495,19 +3626,19 @@ https://www.postgresql.org/docs/16/catalog-pg-trigger.html`,
 					}
 
 					if err := addRow(
-						triggerOid,                  // oid
-						tableOid,                    // tgrelid
-						oidZero,                     // tgparentid (partitioning not supported)
-						tree.NewDName(trigger.Name), // tgname
-						tree.NewDOid(catid.FuncIDToOID(trigger.FuncID)), // tgfoid
-						tree.NewDInt(tree.DInt(tgtype)),                 // tgtype
-						tgenabled,                                       // tgenabled
-						tree.DBoolFalse,                                 // tgisinternal
-						oidZero,                                         // tgconstrrelid (foreign key table)
-						oidZero,                                         // tgconstrindid (constraint index)
-						oidZero,                                         // tgconstraint (constraint oid)
-						tree.DBoolFalse,                                 // tgdeferrable
-						tree.DBoolFalse,                                 // tginitdeferred
+						triggerOid,                      // oid
+						tableOid,                        // tgrelid
+						oidZero,                         // tgparentid (partitioning not supported)
+						tree.NewDName(trigger.Name),     // tgname
+						tgfoid,                          // tgfoid
+						tree.NewDInt(tree.DInt(tgtype)), // tgtype
+						tgenabled,                       // tgenabled
+						tree.DBoolFalse,                 // tgisinternal
+						oidZero,                         // tgconstrrelid (foreign key table)
+						oidZero,                         // tgconstrindid (constraint index)
+						oidZero,                         // tgconstraint (constraint oid)
+						tree.DBoolFalse,                 // tgdeferrable
+						tree.DBoolFalse,                 // tginitdeferred
 						tree.NewDInt(tree.DInt(len(trigger.FuncArgs))), // tgnargs
 						tgattr,                              // tgattr
 						tree.NewDBytes(tree.DBytes(tgargs)), // tgargs
@@ -4285,7 +4416,7 @@ https://www.postgresql.org/docs/13/view-pg-sequences.html
 					tree.NewDInt(tree.DInt(opts.MinValue)),             // min_value
 					tree.NewDInt(tree.DInt(opts.MaxValue)),             // max_value
 					tree.NewDInt(tree.DInt(opts.Increment)),            // increment_by
-					tree.DBoolFalse,                                    // cycle
+					tree.MakeDBool(tree.DBool(opts.Cycle)),             // cycle
 					tree.NewDInt(tree.DInt(opts.EffectiveCacheSize())), // cache_size
 					lastValue, // last_value
 				)
@@ -4703,12 +4834,81 @@ var pgCatalogStatXactAllTablesTable = virtualSchemaTable{
 }
 
 var pgCatalogHbaFileRulesTable = virtualSchemaTable{
-	comment: "pg_hba_file_rules was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogHbaFileRules,
+	comment: `host-based authentication rules currently in effect on this node
+(configured via server.host_based_authentication.configuration)
+https://www.postgresql.org/docs/16/view-pg-hba-file-rules.html`,
+	schema: vtable.PgCatalogHbaFileRules,
 	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		if isAdmin, err := p.HasAdminRole(ctx); err != nil || !isAdmin {
+			return err
+		}
+		getConf := p.ExecCfg().HBAConfiguration
+		if getConf == nil {
+			return nil
+		}
+		conf := getConf()
+		if conf == nil {
+			return nil
+		}
+		// hbaNames renders a database or user list; an empty list means
+		// that the rule applies to all names.
+		hbaNames := func(names []rulebasedscanner.String) (tree.Datum, error) {
+			arr := tree.NewDArray(types.String)
+			if len(names) == 0 {
+				return arr, arr.Append(tree.NewDString("all"))
+			}
+			for _, n := range names {
+				if err := arr.Append(tree.NewDString(n.Value)); err != nil {
+					return nil, err
+				}
+			}
+			return arr, nil
+		}
+		for _, entry := range conf.Entries {
+			dbs, err := hbaNames(entry.Database)
+			if err != nil {
+				return err
+			}
+			users, err := hbaNames(entry.User)
+			if err != nil {
+				return err
+			}
+			address, netmask := tree.DNull, tree.DNull
+			switch a := entry.Address.(type) {
+			case hba.AnyAddr:
+				address = tree.NewDString(a.String())
+			case *net.IPNet:
+				address = tree.NewDString(a.IP.String())
+				netmask = tree.NewDString(net.IP(a.Mask).String())
+			case rulebasedscanner.String:
+				address = tree.NewDString(a.Value)
+			}
+			options := tree.DNull
+			if len(entry.Options) > 0 {
+				arr := tree.NewDArray(types.String)
+				for _, opt := range entry.Options {
+					if err := arr.Append(tree.NewDString(opt[0] + "=" + opt[1])); err != nil {
+						return err
+					}
+				}
+				options = arr
+			}
+			if err := addRow(
+				tree.DNull, // line_number
+				tree.NewDString(entry.ConnType.String()),
+				dbs,
+				users,
+				address,
+				netmask,
+				tree.NewDString(entry.Method.Value),
+				options,
+				tree.DNull, // error
+			); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogCursorsTable = virtualSchemaTable{
@@ -4854,12 +5054,38 @@ var pgCatalogGroupTable = virtualSchemaTable{
 }
 
 var pgCatalogLargeobjectMetadataTable = virtualSchemaTable{
-	comment: "pg_largeobject_metadata was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogLargeobjectMetadata,
+	comment: `large objects and their owners
+https://www.postgresql.org/docs/current/catalog-pg-largeobject-metadata.html`,
+	schema: vtable.PgCatalogLargeobjectMetadata,
 	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		rows, err := p.InternalSQLTxn().QueryBufferedEx(
+			ctx,
+			"select-large-objects",
+			p.Txn(),
+			sessiondata.NodeUserSessionDataOverride,
+			`SELECT lo.loid, u.username FROM system.public.large_objects AS lo
+LEFT JOIN system.public.users AS u ON lo.owner_id = u.user_id
+ORDER BY lo.loid`,
+		)
+		if err != nil {
+			return err
+		}
+		h := makeOidHasher()
+		for _, row := range rows {
+			owner := oidZero
+			if row[1] != tree.DNull {
+				owner = h.UserOid(username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[1]))))
+			}
+			if err := addRow(
+				row[0],     // oid
+				owner,      // lomowner
+				tree.DNull, // lomacl
+			); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogReplicationSlotsTable = virtualSchemaTable{
@@ -5031,12 +5257,39 @@ var pgCatalogStatSubscriptionTable = virtualSchemaTable{
 }
 
 var pgCatalogLargeobjectTable = virtualSchemaTable{
-	comment: "pg_largeobject was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogLargeobject,
-	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
-		return nil
+	comment: `pages of the data making up large objects; only visible to admins
+https://www.postgresql.org/docs/current/catalog-pg-largeobject.html`,
+	schema: vtable.PgCatalogLargeobject,
+	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) (retErr error) {
+		// Like in Postgres, the contents of large objects can only be read
+		// through this table by privileged users.
+		if isAdmin, err := p.HasAdminRole(ctx); err != nil || !isAdmin {
+			return err
+		}
+		it, err := p.InternalSQLTxn().QueryIteratorEx(
+			ctx,
+			"select-large-object-pages",
+			p.Txn(),
+			sessiondata.NodeUserSessionDataOverride,
+			`SELECT loid, pageno, data FROM system.public.large_object_pages ORDER BY loid, pageno`,
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { retErr = errors.CombineErrors(retErr, it.Close()) }()
+		var ok bool
+		for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+			row := it.Cur()
+			if err := addRow(
+				row[0], // loid
+				row[1], // pageno
+				row[2], // data
+			); err != nil {
+				return err
+			}
+		}
+		return err
 	},
-	unimplemented: true,
 }
 
 var pgCatalogReplicationOriginStatusTable = virtualSchemaTable{
@@ -5231,6 +5484,7 @@ var datumToTypeCategory = map[types.Family]*tree.DString{
 	types.VoidFamily:           typCategoryPseudo,
 	types.TriggerFamily:        typCategoryPseudo,
 	types.LTreeFamily:          typCategoryUserDefined,
+	types.XMLFamily:            typCategoryUserDefined,
 }
 
 func typCategory(typ *types.T) tree.Datum {
@@ -5587,6 +5841,15 @@ func (h oidHasher) CollationOid(collation string) *tree.DOid {
 	return h.getOid()
 }
 
+// UserDefinedCollationOid returns the OID of a collation created with CREATE
+// COLLATION. Unlike built-in collations, such collations belong to a schema.
+func (h oidHasher) UserDefinedCollationOid(scID descpb.ID, collation string) *tree.DOid {
+	h.writeTypeTag(collationTypeTag)
+	h.writeSchema(scID)
+	h.writeStr(collation)
+	return h.getOid()
+}
+
 func (h oidHasher) OperatorOid(name string, leftType, rightType, returnType *tree.DOid) *tree.DOid {
 	h.writeTypeTag(operatorTypeTag)
 	h.writeStr(name)
@@ -5596,6 +5859,19 @@ func (h oidHasher) OperatorOid(name string, leftType, rightType, returnType *tre
 	return h.getOid()
 }
 
+// UserDefinedOperatorOid returns the OID of an operator created with CREATE
+// OPERATOR. Unlike built-in operators, such operators belong to a schema.
+func (h oidHasher) UserDefinedOperatorOid(
+	scID descpb.ID, name string, leftType, rightType *tree.DOid,
+) *tree.DOid {
+	h.writeTypeTag(operatorTypeTag)
+	h.writeSchema(scID)
+	h.writeStr(name)
+	h.writeOID(leftType)
+	h.writeOID(rightType)
+	return h.getOid()
+}
+
 func (h oidHasher) EnumEntryOid(typOID *tree.DOid, physicalRep []byte) *tree.DOid {
 	h.writeTypeTag(enumEntryTypeTag)
 	h.writeOID(typOID)
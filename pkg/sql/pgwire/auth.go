@@ -69,6 +69,10 @@ type authOptions struct {
 	// allow system usernames (e.g. GSSAPI principals or X.509 CN's) to
 	// be dynamically mapped to database usernames.
 	identMap *identmap.Conf
+	// tlsServerCert is the certificate the server presented during the
+	// TLS handshake, or nil if the connection does not use TLS. It is
+	// used for SCRAM channel binding.
+	tlsServerCert *tls.Certificate
 
 	// The following fields are only used by tests.
 
@@ -105,7 +109,7 @@ func (c *conn) handleAuthentication(
 	authStartTime := timeutil.Now()
 
 	// Retrieve the authentication method.
-	tlsState, hbaEntry, authMethod, err := c.findAuthenticationMethod(authOpt)
+	tlsState, hbaEntry, authMethod, err := c.findAuthenticationMethod(ctx, authOpt)
 	if err != nil {
 		ac.LogAuthFailed(ctx, eventpb.AuthFailReason_METHOD_NOT_FOUND, err)
 		return nil, c.sendError(ctx, pgerror.WithCandidateCode(err, pgcode.InvalidAuthorizationSpecification))
@@ -208,6 +212,21 @@ func (c *conn) handleAuthentication(
 		return connClose, c.sendError(ctx, pgerror.Newf(pgcode.InvalidAuthorizationSpecification, "%s does not have login privilege", dbUser))
 	}
 
+	// Refuse the login if the user was locked out after too many failed
+	// attempts. As for users that do not exist, we show the same error as
+	// for invalid passwords, so as to not reveal the state of the account.
+	failedAttempts, lockedUntil, err := sql.GetUserLockout(ctx, execCfg, dbUser)
+	if err != nil {
+		log.Dev.Warningf(ctx, "lockout retrieval failed for user=%q: %+v", dbUser, err)
+		ac.LogAuthFailed(ctx, eventpb.AuthFailReason_USER_RETRIEVAL_ERROR, err)
+		return connClose, c.sendError(ctx, pgerror.WithCandidateCode(err, pgcode.InvalidAuthorizationSpecification))
+	}
+	if !lockedUntil.IsZero() {
+		ac.LogAuthFailed(ctx, eventpb.AuthFailReason_ACCOUNT_LOCKED,
+			errors.Newf("user is locked out until %s", lockedUntil))
+		return connClose, c.sendError(ctx, pgerror.WithCandidateCode(security.NewErrPasswordUserAuthFailed(dbUser), pgcode.InvalidPassword))
+	}
+
 	// At this point, we know that the requested user exists and is allowed to log
 	// in. Now we can delegate to the selected AuthMethod implementation to
 	// complete the authentication. We must pass in the systemIdentity here,
@@ -216,12 +235,20 @@ func (c *conn) handleAuthentication(
 	if err := behaviors.Authenticate(ctx, systemIdentity, true /* public */, pwRetrievalFn, roleSubject); err != nil {
 		ac.LogAuthFailed(ctx, eventpb.AuthFailReason_UNKNOWN, err)
 		if pErr := (*security.PasswordUserAuthError)(nil); errors.As(err, &pErr) {
+			if _, lockErr := sql.RecordFailedLogin(ctx, execCfg, dbUser); lockErr != nil {
+				log.Dev.Warningf(ctx, "recording the failed login for user=%q failed: %+v", dbUser, lockErr)
+			}
 			err = pgerror.WithCandidateCode(err, pgcode.InvalidPassword)
 		} else {
 			err = pgerror.WithCandidateCode(err, pgcode.InvalidAuthorizationSpecification)
 		}
 		return connClose, c.sendError(ctx, err)
 	}
+	if failedAttempts > 0 {
+		if err := sql.ResetFailedLogins(ctx, execCfg, dbUser); err != nil {
+			log.Dev.Warningf(ctx, "resetting the failed logins for user=%q failed: %+v", dbUser, err)
+		}
+	}
 
 	// Since authentication was successful for the user session, we try to perform
 	// additional authorization for the user. This delegates to the selected
@@ -364,7 +391,7 @@ func (c *conn) checkClientUsernameMatchesMapping(
 }
 
 func (c *conn) findAuthenticationMethod(
-	authOpt authOptions,
+	ctx context.Context, authOpt authOptions,
 ) (tlsState tls.ConnectionState, hbaEntry *hba.Entry, methodFn AuthMethod, err error) {
 	if authOpt.insecure {
 		// Insecure connections always use "trust" no matter what, and the
@@ -388,7 +415,7 @@ func (c *conn) findAuthenticationMethod(
 
 	// Look up the method from the HBA configuration.
 	var mi methodInfo
-	mi, hbaEntry, err = c.lookupAuthenticationMethodUsingRules(authOpt.connType, authOpt.auth)
+	mi, hbaEntry, err = c.lookupAuthenticationMethodUsingRules(ctx, authOpt.connType, authOpt.auth)
 	if err != nil {
 		return
 	}
@@ -416,7 +443,7 @@ func (c *conn) findAuthenticationMethod(
 }
 
 func (c *conn) lookupAuthenticationMethodUsingRules(
-	connType hba.ConnType, auth *hba.Conf,
+	ctx context.Context, connType hba.ConnType, auth *hba.Conf,
 ) (mi methodInfo, entry *hba.Entry, err error) {
 	var ip net.IP
 	if connType != hba.ConnLocal && connType != hba.ConnInternalLoopback {
@@ -428,12 +455,14 @@ func (c *conn) lookupAuthenticationMethodUsingRules(
 		}
 		ip = tcpAddr.IP
 	}
+	client := &hba.ClientAddr{IP: ip, Resolver: net.DefaultResolver}
+	dbName := c.sessionArgs.SessionDefaults["database"]
 
 	// Look up the method.
 	for i := range auth.Entries {
 		entry = &auth.Entries[i]
 		var connMatch bool
-		connMatch, err = entry.ConnMatches(connType, ip)
+		connMatch, err = entry.ConnMatches(ctx, connType, client)
 		if err != nil {
 			// TODO(knz): Determine if an error should be reported
 			// upon unknown address formats.
@@ -448,11 +477,16 @@ func (c *conn) lookupAuthenticationMethodUsingRules(
 			// The user does not match.
 			continue
 		}
+		if !entry.DatabaseMatches(dbName, c.sessionArgs.User) {
+			// The database does not match.
+			continue
+		}
 		return entry.MethodFn.(methodInfo), entry, nil
 	}
 
 	// No match.
-	err = errors.Errorf("no %s entry for host %q, user %q", serverHBAConfSetting, ip, c.sessionArgs.User)
+	err = errors.Errorf("no %s entry for host %q, user %q, database %q",
+		serverHBAConfSetting, ip, c.sessionArgs.User, dbName)
 	return
 }
 
@@ -496,6 +530,11 @@ type AuthConn interface {
 	// to the client connection.
 	AuthFail(err error)
 
+	// ChannelBindingData returns the tls-server-end-point channel
+	// binding data (RFC 5929) for the connection. An error is returned
+	// if the connection does not use TLS.
+	ChannelBindingData() ([]byte, error)
+
 	// SetAuthMethod sets the authentication method for subsequent
 	// logging messages.
 	SetAuthMethod(method redact.SafeString)
@@ -527,9 +566,10 @@ type authPipe struct {
 	log           bool
 	loggedFailure bool
 
-	connDetails eventpb.CommonConnectionDetails
-	authDetails eventpb.CommonSessionDetails
-	authMethod  redact.SafeString
+	connDetails   eventpb.CommonConnectionDetails
+	authDetails   eventpb.CommonSessionDetails
+	authMethod    redact.SafeString
+	tlsServerCert *tls.Certificate
 
 	ch chan []byte
 
@@ -554,9 +594,10 @@ func newAuthPipe(c *conn, logAuthn bool, authOpt authOptions, systemIdentity str
 			SystemIdentity: systemIdentity,
 			Transport:      authOpt.connType.String(),
 		},
-		ch:         make(chan []byte),
-		writerDone: make(chan struct{}),
-		readerDone: make(chan authRes, 1),
+		tlsServerCert: authOpt.tlsServerCert,
+		ch:            make(chan []byte),
+		writerDone:    make(chan struct{}),
+		readerDone:    make(chan authRes, 1),
 	}
 	return ap
 }
@@ -685,6 +726,14 @@ func (p *authPipe) SendAuthRequest(authType int32, data []byte) error {
 	return c.msgBuilder.finishMsg(c.conn)
 }
 
+// ChannelBindingData is part of the AuthConn interface.
+func (p *authPipe) ChannelBindingData() ([]byte, error) {
+	if p.tlsServerCert == nil {
+		return nil, errors.New("connection does not use TLS")
+	}
+	return tlsServerEndPoint(p.tlsServerCert)
+}
+
 // GetTenantSpecificMetrics is part of the AuthConn interface.
 func (p *authPipe) GetTenantSpecificMetrics() *tenantSpecificMetrics {
 	return p.c.metrics
@@ -28,8 +28,6 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/identmap"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgwirebase"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
-	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
-	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
@@ -72,11 +70,17 @@ func loadDefaultMethods() {
 	// The "scram-sha-256" authentication method uses the 5-way SCRAM
 	// handshake to negotiate password authn with the client. It hides
 	// the password from the network connection and is non-replayable.
-	RegisterAuthMethod("scram-sha-256", authScram, hba.ConnAny, NoOptionsAllowed)
+	//
+	// The channel_binding option controls whether SCRAM-SHA-256-PLUS,
+	// which binds the handshake to the TLS connection, is offered
+	// ("prefer", the default), required ("require") or never offered
+	// ("disable").
+	RegisterAuthMethod("scram-sha-256", authScram, hba.ConnAny, checkChannelBindingOption)
 
 	// The "cert-scram-sha-256" method is alike to "cert-password":
 	// it allows either a client certificate, or a valid 5-way SCRAM handshake.
-	RegisterAuthMethod("cert-scram-sha-256", authCertScram, hba.ConnAny, NoOptionsAllowed)
+	// It accepts the same channel_binding option as "scram-sha-256".
+	RegisterAuthMethod("cert-scram-sha-256", authCertScram, hba.ConnAny, checkChannelBindingOption)
 
 	// The "reject" method rejects any connection attempt that matches
 	// the current rule.
@@ -243,10 +247,11 @@ func authScram(
 	c AuthConn,
 	user username.SQLUsername,
 	_ tls.ConnectionState,
-	execCfg *sql.ExecutorConfig,
-	_ *hba.Entry,
+	_ *sql.ExecutorConfig,
+	entry *hba.Entry,
 	_ *identmap.Conf,
 ) (*AuthBehaviors, error) {
+	cbindMode := getChannelBindingMode(entry)
 	b := &AuthBehaviors{}
 	b.SetRoleMapper(UseProvidedIdentity)
 	b.SetAuthenticator(func(
@@ -256,7 +261,7 @@ func authScram(
 		pwRetrieveFn PasswordRetrievalFn,
 		_ *ldap.DN,
 	) error {
-		return scramAuthenticator(ctx, user, clientConnection, pwRetrieveFn, c, execCfg)
+		return scramAuthenticator(ctx, user, clientConnection, pwRetrieveFn, c, cbindMode)
 	})
 	return b, nil
 }
@@ -269,18 +274,40 @@ func scramAuthenticator(
 	clientConnection bool,
 	pwRetrieveFn PasswordRetrievalFn,
 	c AuthConn,
-	execCfg *sql.ExecutorConfig,
+	cbindMode channelBindingMode,
 ) error {
+	// Channel binding is only possible over TLS connections. When the
+	// server certificate cannot be determined, we cannot offer it either.
+	var cbindData []byte
+	if cbindMode != channelBindingDisable {
+		var err error
+		cbindData, err = c.ChannelBindingData()
+		if err != nil {
+			if cbindMode == channelBindingRequire {
+				err = errors.Wrap(err, "channel binding is required")
+				c.LogAuthFailed(ctx, eventpb.AuthFailReason_PRE_HOOK_ERROR, err)
+				return err
+			}
+			c.LogAuthInfof(ctx, redact.Sprintf("channel binding not available: %v", err))
+		}
+	}
+	offerPlus := cbindData != nil
+
 	// First step: send a SCRAM authentication request to the client.
 	// We do this with an auth request with the request type SASL,
 	// and a payload containing the list of supported SCRAM methods.
 	//
-	// NB: SCRAM-SHA-256-PLUS is not supported, see
-	// https://github.com/cockroachdb/cockroach/issues/74300
-	// There is one nul byte to terminate the first string,
-	// then another nul byte to terminate the list.
-	const supportedMethods = "SCRAM-SHA-256\x00\x00"
-	if err := c.SendAuthRequest(authReqSASL, []byte(supportedMethods)); err != nil {
+	// Each method name is terminated by a nul byte, then another nul
+	// byte terminates the list.
+	var supportedMethods []byte
+	if offerPlus {
+		supportedMethods = append(supportedMethods, scramSHA256Plus+"\x00"...)
+	}
+	if cbindMode != channelBindingRequire {
+		supportedMethods = append(supportedMethods, scramSHA256+"\x00"...)
+	}
+	supportedMethods = append(supportedMethods, 0)
+	if err := c.SendAuthRequest(authReqSASL, supportedMethods); err != nil {
 		return err
 	}
 
@@ -291,7 +318,7 @@ func scramAuthenticator(
 	// will be handled below.
 	expired, hashedPassword, pwRetrievalErr := pwRetrieveFn(ctx)
 
-	scramServer, _ := scram.SHA256.NewServer(func(user string) (creds scram.StoredCredentials, err error) {
+	credentialsLookup := func(user string) (creds scram.StoredCredentials, err error) {
 		// NB: the username passed in the SCRAM exchange (the user
 		// parameter in this callback) is ignored by PostgreSQL servers;
 		// see auth-scram.c, read_client_first_message().
@@ -318,16 +345,11 @@ func scramAuthenticator(
 			return creds, errors.AssertionFailedf("programming error: hash method is SCRAM but no stored credentials")
 		}
 		return creds, nil
-	})
-
-	handshake := scramServer.NewConversation()
-
-	initial := true
-	for {
-		if handshake.Done() {
-			break
-		}
+	}
 
+	// The conversation is selected once the client has chosen a method.
+	var handshake scramConversation
+	for handshake == nil || !handshake.Done() {
 		// Receive a response from the client.
 		resp, err := c.GetPwdData()
 		if err != nil {
@@ -344,7 +366,7 @@ func scramAuthenticator(
 		}
 
 		var input []byte
-		if initial {
+		if handshake == nil {
 			// Quoth postgres, backend/auth.go:
 			//
 			// The first SASLInitialResponse message is different from the others.
@@ -358,15 +380,6 @@ func scramAuthenticator(
 				c.LogAuthFailed(ctx, eventpb.AuthFailReason_PRE_HOOK_ERROR, err)
 				return err
 			}
-			if reqMethod != "SCRAM-SHA-256" {
-				c.LogAuthInfof(ctx, redact.Sprintf("client requests unknown scram method %q", redact.SafeString(reqMethod)))
-				err := unimplemented.NewWithIssue(74300, "channel binding not supported")
-				// We need to manually report the unimplemented error because it is not
-				// passed through to the client as-is (authn errors are hidden behind
-				// a generic "authn failed" error).
-				sqltelemetry.RecordError(ctx, err, &execCfg.Settings.SV)
-				return err
-			}
 			inputLen, err := rb.GetUint32()
 			if err != nil {
 				c.LogAuthFailed(ctx, eventpb.AuthFailReason_PRE_HOOK_ERROR, err)
@@ -381,7 +394,33 @@ func scramAuthenticator(
 					return err
 				}
 			}
-			initial = false
+
+			switch {
+			case reqMethod == scramSHA256Plus && offerPlus:
+				handshake = newScramPlusConversation(credentialsLookup, cbindData)
+
+			case reqMethod == scramSHA256 && cbindMode != channelBindingRequire:
+				// A client which supports channel binding signals with the
+				// "y" flag that it believes the server does not. If we
+				// offered channel binding, this indicates that the list of
+				// methods was tampered with (RFC 5802, section 6).
+				if offerPlus && bytes.HasPrefix(input, []byte("y")) {
+					err := errors.New("SCRAM channel binding negotiation error")
+					c.LogAuthFailed(ctx, eventpb.AuthFailReason_PRE_HOOK_ERROR, err)
+					return err
+				}
+				scramServer, _ := scram.SHA256.NewServer(credentialsLookup)
+				handshake = scramServer.NewConversation()
+
+			default:
+				c.LogAuthInfof(ctx, redact.Sprintf("client requests unsupported scram method %q", redact.SafeString(reqMethod)))
+				err := errors.Newf("unsupported SASL authentication mechanism %q", reqMethod)
+				if cbindMode == channelBindingRequire {
+					err = errors.WithHintf(err, "The server requires %s.", scramSHA256Plus)
+				}
+				c.LogAuthFailed(ctx, eventpb.AuthFailReason_PRE_HOOK_ERROR, err)
+				return err
+			}
 		} else {
 			input = resp
 		}
@@ -626,7 +665,7 @@ func authAutoSelectPasswordProtocol(
 		// error, we don't want the fallback to force the client to
 		// transmit a password in clear.
 		c.LogAuthInfof(ctx, "no crdb-bcrypt credentials found; proceeding with SCRAM-SHA-256")
-		return scramAuthenticator(ctx, user, clientConnection, newpwfn, c, execCfg)
+		return scramAuthenticator(ctx, user, clientConnection, newpwfn, c, channelBindingPrefer)
 	})
 	return b, nil
 }
@@ -36,6 +36,10 @@ const (
 	emptyQueryResponse
 	readyForQuery
 	flush
+	// functionCallResponse is used for fastpath function calls. The single
+	// value produced by the function is sent as a FunctionCallResponse message
+	// instead of a DataRow, and no CommandComplete message follows.
+	functionCallResponse
 	// Some commands, like Describe, don't need a completion message.
 	noCompletionMsg
 )
@@ -167,6 +171,12 @@ func (r *commandResult) Close(ctx context.Context, t sql.TransactionStatusIndica
 		// The error is saved on conn.err.
 		_ /* err */ = r.conn.Flush(r.pos)
 		r.conn.maybeReallocate()
+	case functionCallResponse:
+		// A function always produces a value, so if no row was added the
+		// function returned NULL.
+		if r.rowsAffected == 0 {
+			_ /* err */ = r.conn.bufferFunctionCallResponse(ctx, tree.DNull, types.Unknown, r)
+		}
 	case noCompletionMsg:
 		// nothing to do
 	default:
@@ -265,6 +275,12 @@ func (r *commandResult) AddRow(ctx context.Context, row tree.Datums) error {
 	default:
 		r.rowsAffected++
 	}
+	if r.typ == functionCallResponse {
+		if r.rowsAffected > 1 || len(row) != 1 {
+			return errors.AssertionFailedf("function call produced more than one value")
+		}
+		return r.conn.bufferFunctionCallResponse(ctx, row[0], r.types[0], r)
+	}
 	return r.conn.bufferRow(ctx, row, r)
 }
 
@@ -284,7 +300,8 @@ func (r *commandResult) AddBatch(ctx context.Context, batch coldata.Batch) error
 
 // SupportsAddBatch is part of the sql.RestrictedCommandResult interface.
 func (r *commandResult) SupportsAddBatch() bool {
-	return true
+	// Function call results are written value by value in AddRow.
+	return r.typ != functionCallResponse
 }
 
 // BufferedResultsLen is part of the sql.RestrictedCommandResult interface.
@@ -516,6 +533,27 @@ func (c *conn) newMiscResult(pos sql.CmdPos, typ completionMsgType) *commandResu
 	return r
 }
 
+func (c *conn) newFunctionCallResult(
+	pos sql.CmdPos,
+	resultFormat pgwirebase.FormatCode,
+	conv sessiondatapb.DataConversionConfig,
+	location *time.Location,
+) *commandResult {
+	r := c.allocCommandResult()
+	*r = commandResult{
+		conn:           c,
+		conv:           conv,
+		location:       location,
+		pos:            pos,
+		typ:            functionCallResponse,
+		cmdCompleteTag: "SELECT",
+		stmtType:       tree.Rows,
+		descOpt:        sql.DontNeedRowDesc,
+		formatCodes:    []pgwirebase.FormatCode{resultFormat},
+	}
+	return r
+}
+
 // limitedCommandResult is a commandResult that has a limit, after which calls
 // to AddRow will block until the associated client connection asks for more
 // rows. It essentially implements the "execute portal with limit" part of the
@@ -793,6 +793,63 @@ func (c *conn) handleExecute(
 	})
 }
 
+// handleFunctionCall reads a FunctionCall message, as used by the "fastpath"
+// function call protocol.
+//
+// An error is returned iff the statement buffer has been closed. In that case,
+// the connection should be considered toast.
+func (c *conn) handleFunctionCall(ctx context.Context, timeReceived crtime.Mono) error {
+	telemetry.Inc(sqltelemetry.FunctionCallRequestCounter)
+	funcOID, err := c.readBuf.GetUint32()
+	if err != nil {
+		return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
+	}
+	// The argument format codes follow the same rules as in a Bind message.
+	numArgFormatCodes, err := c.readBuf.GetUint16()
+	if err != nil {
+		return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
+	}
+	argFormatCodes := make([]pgwirebase.FormatCode, numArgFormatCodes)
+	for i := range argFormatCodes {
+		ch, err := c.readBuf.GetUint16()
+		if err != nil {
+			return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
+		}
+		argFormatCodes[i] = pgwirebase.FormatCode(ch)
+	}
+	numArgs, err := c.readBuf.GetUint16()
+	if err != nil {
+		return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
+	}
+	args := make([][]byte, numArgs)
+	for i := range args {
+		plen, err := c.readBuf.GetUint32()
+		if err != nil {
+			return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
+		}
+		if int32(plen) == -1 {
+			// The argument is a NULL value.
+			continue
+		}
+		b, err := c.readBuf.GetBytes(int(plen))
+		if err != nil {
+			return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
+		}
+		args[i] = b
+	}
+	resultFormat, err := c.readBuf.GetUint16()
+	if err != nil {
+		return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
+	}
+	return c.stmtBuf.Push(ctx, sql.FunctionCall{
+		FuncOID:        oid.Oid(funcOID),
+		Args:           args,
+		ArgFormatCodes: argFormatCodes,
+		ResultFormat:   pgwirebase.FormatCode(resultFormat),
+		TimeReceived:   timeReceived,
+	})
+}
+
 func (c *conn) handleFlush(ctx context.Context) error {
 	telemetry.Inc(sqltelemetry.FlushRequestCounter)
 	return c.stmtBuf.Push(ctx, sql.Flush{})
@@ -910,6 +967,27 @@ func (c *conn) bufferRow(ctx context.Context, row tree.Datums, r *commandResult)
 	return nil
 }
 
+// bufferFunctionCallResponse serializes the result of a fastpath function call
+// and adds it to the buffer. The value is encoded like a single DataRow column.
+func (c *conn) bufferFunctionCallResponse(
+	ctx context.Context, d tree.Datum, typ *types.T, r *commandResult,
+) error {
+	c.msgBuilder.initMsg(pgwirebase.ServerMsgFunctionCallResponse)
+	fmtCode, err := r.GetFormatCode(0)
+	if err != nil {
+		return err
+	}
+	switch fmtCode {
+	case pgwirebase.FormatText:
+		c.msgBuilder.writeTextDatum(ctx, d, r.conv, r.location, typ)
+	case pgwirebase.FormatBinary:
+		c.msgBuilder.writeBinaryDatum(ctx, d, r.location, typ)
+	default:
+		c.msgBuilder.setError(errors.Errorf("unsupported format code %s", fmtCode))
+	}
+	return c.msgBuilder.finishMsg(&c.writerState.buf)
+}
+
 // bufferBatch serializes a batch and adds all the rows from it to the buffer.
 // It is a noop for zero-length batch. Depending on the buffer size limit,
 // bufferBatch may flush the buffered data to the connection.
@@ -1380,6 +1458,16 @@ func (c *conn) CreateDrainResult(pos sql.CmdPos) sql.DrainResult {
 	return c.newMiscResult(pos, noCompletionMsg)
 }
 
+// CreateFunctionCallResult is part of the sql.ClientComm interface.
+func (c *conn) CreateFunctionCallResult(
+	pos sql.CmdPos,
+	resultFormat pgwirebase.FormatCode,
+	conv sessiondatapb.DataConversionConfig,
+	location *time.Location,
+) sql.CommandResult {
+	return c.newFunctionCallResult(pos, resultFormat, conv, location)
+}
+
 // CreateBindResult is part of the sql.ClientComm interface.
 func (c *conn) CreateBindResult(pos sql.CmdPos) sql.BindResult {
 	return c.newMiscResult(pos, bindComplete)
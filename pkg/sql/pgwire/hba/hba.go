@@ -14,13 +14,18 @@ package hba
 // on all systems.
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/settings/rulebasedscanner"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/errors"
 	"github.com/olekukonko/tablewriter"
@@ -41,7 +46,8 @@ type Entry struct {
 	// User is the list of users to match. An empty list means "match
 	// any user".
 	User []rulebasedscanner.String
-	// Address is either AnyAddr, *net.IPNet or (unsupported) String for a hostname.
+	// Address is either AnyAddr, *net.IPNet or String for a hostname. A
+	// hostname starting with a dot matches any name with that suffix.
 	Address interface{}
 	Method  rulebasedscanner.String
 	// MethodFn is populated during name resolution of Method.
@@ -197,14 +203,67 @@ func (h Entry) ConnTypeMatches(clientConn ConnType) bool {
 	}
 }
 
+// HostResolver performs the DNS lookups needed to match client
+// connections against hostname-based rules. It is implemented by
+// *net.Resolver.
+type HostResolver interface {
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// ClientAddr is the network address of a connecting client. Its hostname
+// is looked up at most once, the first time a hostname-based rule is
+// evaluated.
+type ClientAddr struct {
+	IP       net.IP
+	Resolver HostResolver
+
+	hostname struct {
+		resolved bool
+		name     string
+	}
+}
+
+// Hostname returns the hostname of the client, or the empty string if it
+// cannot be determined. As in PostgreSQL, the name obtained by a reverse
+// lookup of the client IP is only used if a forward lookup of that name
+// yields the client IP again.
+func (c *ClientAddr) Hostname(ctx context.Context) string {
+	if c.hostname.resolved {
+		return c.hostname.name
+	}
+	c.hostname.resolved = true
+	if c.IP == nil || c.Resolver == nil {
+		return ""
+	}
+	names, err := c.Resolver.LookupAddr(ctx, c.IP.String())
+	if err != nil || len(names) == 0 {
+		return ""
+	}
+	name := strings.TrimSuffix(strings.ToLower(names[0]), ".")
+	addrs, err := c.Resolver.LookupIPAddr(ctx, name)
+	if err != nil {
+		return ""
+	}
+	for _, addr := range addrs {
+		if addr.IP.Equal(c.IP) {
+			c.hostname.name = name
+			break
+		}
+	}
+	return c.hostname.name
+}
+
 // ConnMatches returns true iff the provided client connection
 // type and address matches the entry spec.
-func (h Entry) ConnMatches(clientConn ConnType, ip net.IP) (bool, error) {
+func (h Entry) ConnMatches(
+	ctx context.Context, clientConn ConnType, client *ClientAddr,
+) (bool, error) {
 	if !h.ConnTypeMatches(clientConn) {
 		return false, nil
 	}
 	if clientConn != ConnLocal {
-		return h.AddressMatches(ip)
+		return h.AddressMatches(ctx, client)
 	}
 	return true, nil
 }
@@ -228,19 +287,85 @@ func (h Entry) UserMatches(userName username.SQLUsername) bool {
 	return false
 }
 
+// DatabaseMatches returns true iff the provided database name matches
+// an entry in the Database list or if the database list is empty (the
+// entry matches all). The keyword "sameuser" matches the database
+// with the same name as the connecting user.
+//
+// The function assumes the entry was normalized already.
+// See ParseAndNormalize(). As in PostgreSQL, the database name is
+// compared exactly as requested by the client, which is also how the
+// session uses it: a rule listing the unquoted name reporting does not
+// match a connection to the database "Reporting".
+func (h Entry) DatabaseMatches(dbName string, userName username.SQLUsername) bool {
+	if h.Database == nil {
+		return true
+	}
+	for _, db := range h.Database {
+		if db.IsKeyword("sameuser") {
+			if dbName == userName.Normalized() {
+				return true
+			}
+			continue
+		}
+		if !db.Quoted && unsupportedDatabaseKeywords[db.Value] {
+			// These are rejected when the configuration is validated.
+			continue
+		}
+		if db.Value == dbName {
+			return true
+		}
+	}
+	return false
+}
+
+// unsupportedDatabaseKeywords are the special values of the database field
+// recognized by PostgreSQL which CockroachDB does not support.
+var unsupportedDatabaseKeywords = map[string]bool{
+	"samerole":    true,
+	"samegroup":   true,
+	"replication": true,
+}
+
+// UnsupportedDatabaseKeyword returns the first unquoted keyword in the
+// database field which is not supported, if any.
+func (h Entry) UnsupportedDatabaseKeyword() (string, bool) {
+	for _, db := range h.Database {
+		if !db.Quoted && unsupportedDatabaseKeywords[db.Value] {
+			return db.Value, true
+		}
+	}
+	return "", false
+}
+
 // AddressMatches returns true iff the provided address matches the
 // entry. The function assumes the entry was normalized already.
 // See ParseAndNormalize.
-func (h Entry) AddressMatches(addr net.IP) (bool, error) {
+func (h Entry) AddressMatches(ctx context.Context, client *ClientAddr) (bool, error) {
 	switch a := h.Address.(type) {
 	case AnyAddr:
 		return true, nil
 	case *net.IPNet:
-		return a.Contains(addr), nil
+		return a.Contains(client.IP), nil
+	case rulebasedscanner.String:
+		return hostnameMatches(a.Value, client.Hostname(ctx)), nil
 	default:
-		// This is where name-based validation can occur later.
-		return false, errors.Newf("unknown address type: %T", addr)
+		return false, errors.Newf("unknown address type: %T", h.Address)
+	}
+}
+
+// hostnameMatches returns true iff the given client hostname matches the
+// hostname of a rule. A rule hostname starting with a dot, or with "*.",
+// matches every hostname with that suffix.
+func hostnameMatches(pattern, hostname string) bool {
+	if hostname == "" {
+		return false
+	}
+	pattern = strings.TrimPrefix(pattern, "*")
+	if strings.HasPrefix(pattern, ".") {
+		return strings.HasSuffix(hostname, pattern)
 	}
+	return hostname == pattern
 }
 
 // DatabaseString returns a string that describes the database field.
@@ -308,7 +433,20 @@ func (h Entry) String() string {
 //
 //   - it ensures there is one entry per username. This simplifies
 //     the code in the authentication logic.
+//
+// Files referenced with the @file syntax in the database and user fields
+// are rejected; see ParseAndNormalizeWithIncludeDir.
 func ParseAndNormalize(val string) (*Conf, error) {
+	return ParseAndNormalizeWithIncludeDir(val, "" /* includeDir */)
+}
+
+// ParseAndNormalizeWithIncludeDir is like ParseAndNormalize, but also
+// replaces the @file references in the database and user fields by the
+// names listed in the referenced files. The files are looked up in
+// includeDir, which must be a directory controlled by the operator of the
+// node, and cannot be outside of it. If includeDir is empty, @file
+// references are rejected.
+func ParseAndNormalizeWithIncludeDir(val, includeDir string) (*Conf, error) {
 	conf, err := Parse(val)
 	if err != nil {
 		return nil, err
@@ -320,12 +458,25 @@ outer:
 	for i := range conf.Entries {
 		entry := conf.Entries[i]
 
-		// The database field is not supported yet in CockroachDB.
-		entry.Database = nil
+		// Replace the @file references by the names listed in the files.
+		if entry.Database, err = expandIncludedFiles(entry.Database, includeDir); err != nil {
+			return nil, pgerror.WithCandidateCode(err, pgcode.ConfigFile)
+		}
+		if entry.User, err = expandIncludedFiles(entry.User, includeDir); err != nil {
+			return nil, pgerror.WithCandidateCode(err, pgcode.ConfigFile)
+		}
+
+		entry.Database = normalizeDatabases(entry.Database)
 
-		// Normalize the 'all' keyword into AnyAddr.
-		if addr, ok := entry.Address.(rulebasedscanner.String); ok && addr.IsKeyword("all") {
-			entry.Address = AnyAddr{}
+		if addr, ok := entry.Address.(rulebasedscanner.String); ok {
+			if addr.IsKeyword("all") {
+				// Normalize the 'all' keyword into AnyAddr.
+				entry.Address = AnyAddr{}
+			} else {
+				// Hostnames are matched case-insensitively.
+				addr.Value = strings.ToLower(addr.Value)
+				entry.Address = addr
+			}
 		}
 
 		// If we're observing an "any" entry, just keep that and move
@@ -358,3 +509,118 @@ outer:
 	conf.Entries = entries
 	return conf, nil
 }
+
+// normalizeDatabases normalizes the database field of an entry. A field
+// containing the keyword "all" is replaced by nil, and the unquoted
+// database names are normalized like SQL identifiers.
+func normalizeDatabases(dbs []rulebasedscanner.String) []rulebasedscanner.String {
+	for _, db := range dbs {
+		if db.IsKeyword("all") {
+			return nil
+		}
+	}
+	res := make([]rulebasedscanner.String, len(dbs))
+	for i, db := range dbs {
+		res[i] = db
+		if !db.Quoted && !db.IsKeyword("sameuser") && !unsupportedDatabaseKeywords[db.Value] {
+			res[i].Value = tree.Name(db.Value).Normalize()
+		}
+	}
+	return res
+}
+
+// IncludedFiles returns the names of the files referenced with the @file
+// syntax in the database and user fields of the configuration.
+func (c *Conf) IncludedFiles() []string {
+	var res []string
+	for _, entry := range c.Entries {
+		for _, field := range [][]rulebasedscanner.String{entry.Database, entry.User} {
+			for _, s := range field {
+				if !s.Quoted && strings.HasPrefix(s.Value, "@") {
+					res = append(res, s.Value[1:])
+				}
+			}
+		}
+	}
+	return res
+}
+
+// CheckIncludedFileName checks that the name of a file referenced with the
+// @file syntax is a relative path that stays within the directory it is
+// looked up in.
+func CheckIncludedFileName(name string) error {
+	if !filepath.IsLocal(name) {
+		return errors.WithHint(
+			errors.Newf("included file %q must be specified as a relative path", name),
+			"Included files are looked up in the hba subdirectory of the certificates directory of each node.")
+	}
+	return nil
+}
+
+// expandIncludedFiles replaces the unquoted names starting with @ in the
+// given database or user field by the names listed in the file they
+// reference in includeDir. As in PostgreSQL, the names in the file can be
+// separated by commas or whitespace, and comments are allowed.
+func expandIncludedFiles(
+	field []rulebasedscanner.String, includeDir string,
+) ([]rulebasedscanner.String, error) {
+	var res []rulebasedscanner.String
+	for i, s := range field {
+		if s.Quoted || !strings.HasPrefix(s.Value, "@") {
+			if res != nil {
+				res = append(res, s)
+			}
+			continue
+		}
+		if res == nil {
+			res = append([]rulebasedscanner.String(nil), field[:i]...)
+		}
+		names, err := readIncludedFile(includeDir, s.Value[1:])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, names...)
+	}
+	if res == nil {
+		return field, nil
+	}
+	return res, nil
+}
+
+// readIncludedFile returns the names listed in the file with the given
+// name in includeDir.
+func readIncludedFile(includeDir, name string) ([]rulebasedscanner.String, error) {
+	if err := CheckIncludedFileName(name); err != nil {
+		return nil, err
+	}
+	if includeDir == "" {
+		return nil, errors.WithHint(
+			errors.Newf("included file %q cannot be used: included files are not supported on this server", name),
+			"Included files are only supported on the system interface of nodes started with a certificates directory.")
+	}
+	path := filepath.Join(includeDir, name)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read included file %q", name)
+	}
+	tokens, err := rulebasedscanner.Tokenize(string(contents))
+	if err != nil {
+		return nil, errors.Wrapf(err, "included file %q", name)
+	}
+	var names []rulebasedscanner.String
+	for _, line := range tokens.Lines {
+		for _, field := range line.Tokens {
+			for _, name := range field {
+				if !name.Quoted && strings.HasPrefix(name.Value, "@") {
+					return nil, errors.Newf("included file %q cannot include other files", name)
+				}
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		// An empty list would otherwise be interpreted as "all".
+		return nil, errors.Newf("included file %q does not list any names", name)
+	}
+	return names, nil
+}
@@ -6,13 +6,20 @@
 package hba
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/settings/rulebasedscanner"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils/datapathutils"
 	"github.com/cockroachdb/datadriven"
+	"github.com/cockroachdb/errors"
 	"github.com/kr/pretty"
 )
 
@@ -94,6 +101,168 @@ func TestMatchConnType(t *testing.T) {
 	}
 }
 
+func TestDatabaseMatches(t *testing.T) {
+	testCases := []struct {
+		conf  string
+		db    string
+		user  string
+		match bool
+	}{
+		{"all", "defaultdb", "foo", true},
+		{"reporting", "reporting", "foo", true},
+		{"reporting", "defaultdb", "foo", false},
+		{"Reporting", "reporting", "foo", true},
+		{"Reporting", "Reporting", "foo", false},
+		{"reporting", "REPORTING", "foo", false},
+		{`"Reporting"`, "REPORTING", "foo", false},
+		{`"Reporting"`, "reporting", "foo", false},
+		{`"Reporting"`, "Reporting", "foo", true},
+		{"a,b,c", "b", "foo", true},
+		{"a,b,c", "d", "foo", false},
+		{`"all"`, "all", "foo", true},
+		{`"all"`, "defaultdb", "foo", false},
+		{"sameuser", "foo", "foo", true},
+		{"sameuser", "bar", "foo", false},
+		{"sameuser", "Foo", "foo", false},
+		{`"sameuser"`, "foo", "foo", false},
+		{`"sameuser"`, "sameuser", "foo", true},
+	}
+	for _, tc := range testCases {
+		conf, err := ParseAndNormalize(fmt.Sprintf("host %s all all trust", tc.conf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry := conf.Entries[0]
+		user := username.MakeSQLUsernameFromPreNormalizedString(tc.user)
+		if m := entry.DatabaseMatches(tc.db, user); m != tc.match {
+			t.Errorf("%s vs %s (user %s): expected %v, got %v", tc.conf, tc.db, tc.user, tc.match, m)
+		}
+	}
+}
+
+func TestIncludedFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, contents string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("dbs", "reporting, Analytics\n# comment\n\"Mixed\"\n")
+	writeFile("users", "app_ro app_rw\n")
+	writeFile("empty", "# nothing here\n")
+	writeFile("nested", "@dbs\n")
+
+	conf, err := ParseAndNormalizeWithIncludeDir("host @dbs,other @users all cert", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d:\n%s", len(conf.Entries), conf)
+	}
+	if expected, actual := `reporting,analytics,"Mixed",other`, conf.Entries[0].DatabaseString(); expected != actual {
+		t.Errorf("expected databases %s, got %s", expected, actual)
+	}
+	for i, expected := range []string{"app_ro", "app_rw"} {
+		if actual := conf.Entries[i].UserString(); expected != actual {
+			t.Errorf("entry %d: expected user %s, got %s", i, expected, actual)
+		}
+	}
+
+	// A quoted @ is not a file reference.
+	conf, err = ParseAndNormalize(`host "@dbs" all all cert`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, actual := `"@dbs"`, conf.Entries[0].DatabaseString(); expected != actual {
+		t.Errorf("expected databases %s, got %s", expected, actual)
+	}
+
+	errCases := []struct {
+		conf       string
+		includeDir string
+		err        string
+	}{
+		{"host @dbs all all cert", "", "included files are not supported on this server"},
+		{fmt.Sprintf("host @%s all all cert", filepath.Join(dir, "dbs")), dir, "must be specified as a relative path"},
+		{"host @../dbs all all cert", dir, "must be specified as a relative path"},
+		{"host @missing all all cert", dir, "could not read included file"},
+		{"host @empty all all cert", dir, "does not list any names"},
+		{"host all @nested all cert", dir, "cannot include other files"},
+	}
+	for _, tc := range errCases {
+		if _, err := ParseAndNormalizeWithIncludeDir(tc.conf, tc.includeDir); !testutils.IsError(err, tc.err) {
+			t.Errorf("%s: expected error %q, got %v", tc.conf, tc.err, err)
+		}
+	}
+}
+
+type fakeResolver struct {
+	names map[string][]string
+	addrs map[string][]net.IPAddr
+}
+
+func (r fakeResolver) LookupAddr(_ context.Context, addr string) ([]string, error) {
+	if names, ok := r.names[addr]; ok {
+		return names, nil
+	}
+	return nil, errors.Newf("no name for %s", addr)
+}
+
+func (r fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	if addrs, ok := r.addrs[host]; ok {
+		return addrs, nil
+	}
+	return nil, errors.Newf("no address for %s", host)
+}
+
+func TestHostnameMatches(t *testing.T) {
+	resolver := fakeResolver{
+		names: map[string][]string{
+			"10.0.0.1": {"App1.BI.internal."},
+			"10.0.0.2": {"spoofed.bi.internal."},
+			"10.0.0.3": {"bi.internal."},
+		},
+		addrs: map[string][]net.IPAddr{
+			"app1.bi.internal": {{IP: net.ParseIP("10.0.0.1")}},
+			// The forward lookup does not confirm the reverse lookup.
+			"spoofed.bi.internal": {{IP: net.ParseIP("192.168.0.1")}},
+			"bi.internal":         {{IP: net.ParseIP("10.0.0.3")}},
+		},
+	}
+	testCases := []struct {
+		conf  string
+		ip    string
+		match bool
+	}{
+		{"app1.bi.internal", "10.0.0.1", true},
+		{"App1.bi.Internal", "10.0.0.1", true},
+		{"app2.bi.internal", "10.0.0.1", false},
+		{".bi.internal", "10.0.0.1", true},
+		{"*.bi.internal", "10.0.0.1", true},
+		{".internal", "10.0.0.1", true},
+		{".bi.internal", "10.0.0.3", false},
+		{"bi.internal", "10.0.0.3", true},
+		{".bi.internal", "10.0.0.2", false},
+		{"spoofed.bi.internal", "10.0.0.2", false},
+		{".bi.internal", "10.0.0.4", false},
+	}
+	ctx := context.Background()
+	for _, tc := range testCases {
+		conf, err := ParseAndNormalize(fmt.Sprintf("host all all %s trust", tc.conf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := &ClientAddr{IP: net.ParseIP(tc.ip), Resolver: resolver}
+		m, err := conf.Entries[0].ConnMatches(ctx, ConnHostSSL, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m != tc.match {
+			t.Errorf("%s vs %s: expected %v, got %v", tc.conf, tc.ip, tc.match, m)
+		}
+	}
+}
+
 // TODO(mjibson): these are untested outside ccl +gss builds.
 var _ = Entry.GetOption
 var _ = Entry.GetOptions
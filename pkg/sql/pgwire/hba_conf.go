@@ -9,6 +9,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"path/filepath"
 	"sort"
 	"strings"
 
@@ -53,14 +54,30 @@ import (
 //
 // For now, CockroachDB only supports the following syntax:
 //
-//     host  all  <user[,user]...>  <IP-address/mask-length>  <auth-method>
+//     host  <db[,db]...>  <user[,user]...>  <address>  <auth-method>
+//
+// where <address> is either an IP address range in CIDR notation, or
+// a hostname.
 //
 // The matching rules are as follows:
+// - A rule matches if the requested database matches either of the
+//   databases listed in the rule, or if the pseudo-database 'all' is
+//   present in the database column. The keyword 'sameuser' matches
+//   the database with the same name as the connecting user.
 // - A rule matches if the connecting username matches either of the
 //   usernames listed in the rule, or if the pseudo-user 'all' is
 //   present in the user column.
 // - A rule matches if the connecting client's IP address is included
-//   in the network address specified in the CIDR notation.
+//   in the network address specified in the CIDR notation, or if the
+//   hostname of the client matches the hostname in the rule. A hostname
+//   starting with a dot matches all the hostnames with that suffix.
+//   The hostname of the client is determined with a reverse DNS lookup
+//   of its IP address, which is verified with a forward lookup.
+// - In the database and user columns, @file is replaced by the names
+//   listed in the file with the given relative path in the hba
+//   subdirectory of the certificates directory of the node. This is only
+//   supported on the system interface, since the administrators of a
+//   virtual cluster do not control the files of the nodes.
 //
 
 // chainOptions and requireClusterVersion will be used in an upcoming PR.
@@ -99,7 +116,7 @@ func loadLocalHBAConfigUponRemoteSettingChange(
 	hbaConfig := DefaultHBAConfig
 	if val != "" {
 		var err error
-		hbaConfig, err = ParseAndNormalize(val)
+		hbaConfig, err = parseAndNormalize(val, server.hbaIncludeDir())
 		if err != nil {
 			// The default is also used if the node is unable to load the
 			// config from the cluster setting.
@@ -147,32 +164,11 @@ func checkHBASyntaxBeforeUpdatingSetting(values *settings.Values, s string) erro
 			return unimplemented.Newf("hba-type-"+entry.ConnType.String(),
 				"unsupported connection type: %s", entry.ConnType)
 		}
-		for _, db := range entry.Database {
-			if !db.IsKeyword("all") {
-				return errors.WithHint(
-					unimplemented.New("hba-per-db", "per-database HBA rules are not supported"),
-					"Use the special value 'all' (without quotes) to match all databases.")
-			}
-		}
-
-		if entry.ConnType != hba.ConnLocal {
-			// Verify the user is not requesting hostname-based validation,
-			// which is not yet implemented.
-			addrOk := true
-			switch t := entry.Address.(type) {
-			case *net.IPNet:
-			case hba.AnyAddr:
-			case rulebasedscanner.String:
-				addrOk = t.IsKeyword("all")
-			default:
-				addrOk = false
-			}
-			if !addrOk {
-				return errors.WithHint(
-					unimplemented.New("hba-hostnames", "hostname-based HBA rules are not supported"),
-					"List the numeric CIDR notation instead, for example: 127.0.0.1/8.\n"+
-						"Alternatively, use 'all' (without quotes) for any IPv4/IPv6 address.")
-			}
+		if kw, ok := entry.UnsupportedDatabaseKeyword(); ok {
+			return errors.WithHint(
+				unimplemented.Newf("hba-db-"+kw, "database keyword %s is not supported", kw),
+				"List the database names instead, or use 'sameuser' (without quotes) "+
+					"to match the database named after the user.")
 		}
 
 		// Verify that the auth method is supported.
@@ -189,9 +185,37 @@ func checkHBASyntaxBeforeUpdatingSetting(values *settings.Values, s string) erro
 			}
 		}
 	}
+
+	if files := conf.IncludedFiles(); len(files) > 0 {
+		for _, name := range files {
+			if err := hba.CheckIncludedFileName(name); err != nil {
+				return pgerror.WithCandidateCode(err, pgcode.ConfigFile)
+			}
+		}
+		if values.SpecializedToVirtualCluster() {
+			return pgerror.WithCandidateCode(errors.WithHint(
+				errors.New("included files are not supported by virtual clusters"),
+				"List the names in the configuration instead."), pgcode.ConfigFile)
+		}
+	}
 	return nil
 }
 
+// hbaIncludeSubdir is the subdirectory of the certificates directory in
+// which the files referenced with the @file syntax in the HBA
+// configuration are looked up.
+const hbaIncludeSubdir = "hba"
+
+// hbaIncludeDir returns the directory in which the files referenced with
+// the @file syntax in the HBA configuration are looked up, or the empty
+// string if they are not supported by this server.
+func (s *Server) hbaIncludeDir() string {
+	if s.cfg == nil || s.cfg.SSLCertsDir == "" || !s.execCfg.Codec.ForSystemTenant() {
+		return ""
+	}
+	return filepath.Join(s.cfg.SSLCertsDir, hbaIncludeSubdir)
+}
+
 // ParseAndNormalize calls hba.ParseAndNormalize and also ensures the
 // configuration starts with a rule that authenticates the root user
 // with client certificates.
@@ -200,7 +224,13 @@ func checkHBASyntaxBeforeUpdatingSetting(values *settings.Values, s string) erro
 // root not able to login, thus disallowing anyone from fixing the HBA
 // configuration.
 func ParseAndNormalize(val string) (*hba.Conf, error) {
-	conf, err := hba.ParseAndNormalize(val)
+	return parseAndNormalize(val, "" /* includeDir */)
+}
+
+// parseAndNormalize is like ParseAndNormalize, but also reads the files
+// referenced with the @file syntax from includeDir.
+func parseAndNormalize(val, includeDir string) (*hba.Conf, error) {
+	conf, err := hba.ParseAndNormalizeWithIncludeDir(val, includeDir)
 	if err != nil {
 		return conf, err
 	}
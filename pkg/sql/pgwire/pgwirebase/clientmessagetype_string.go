@@ -21,6 +21,7 @@ func _() {
 	_ = x[ClientMsgDescribe-68]
 	_ = x[ClientMsgExecute-69]
 	_ = x[ClientMsgFlush-72]
+	_ = x[ClientMsgFunctionCall-70]
 	_ = x[ClientMsgParse-80]
 	_ = x[ClientMsgPassword-112]
 	_ = x[ClientMsgSimpleQuery-81]
@@ -46,6 +47,8 @@ func (i ClientMessageType) String() string {
 		return "ClientMsgExecute"
 	case ClientMsgFlush:
 		return "ClientMsgFlush"
+	case ClientMsgFunctionCall:
+		return "ClientMsgFunctionCall"
 	case ClientMsgParse:
 		return "ClientMsgParse"
 	case ClientMsgPassword:
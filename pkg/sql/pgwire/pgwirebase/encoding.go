@@ -938,6 +938,11 @@ func DecodeDatum(
 			return nil, err
 		}
 		return da.NewDRefCursor(tree.DString(bs)), nil
+	case types.XMLFamily:
+		if err := validateStringBytes(b); err != nil {
+			return nil, err
+		}
+		return tree.ParseDXML(string(b))
 	}
 	switch id {
 	case oid.T_text, oid.T_varchar, oid.T_unknown:
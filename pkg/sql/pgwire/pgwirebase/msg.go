@@ -19,19 +19,20 @@ type ServerMessageType byte
 
 // http://www.postgresql.org/docs/9.4/static/protocol-message-formats.html
 const (
-	ClientMsgBind        ClientMessageType = 'B'
-	ClientMsgClose       ClientMessageType = 'C'
-	ClientMsgCopyData    ClientMessageType = 'd'
-	ClientMsgCopyDone    ClientMessageType = 'c'
-	ClientMsgCopyFail    ClientMessageType = 'f'
-	ClientMsgDescribe    ClientMessageType = 'D'
-	ClientMsgExecute     ClientMessageType = 'E'
-	ClientMsgFlush       ClientMessageType = 'H'
-	ClientMsgParse       ClientMessageType = 'P'
-	ClientMsgPassword    ClientMessageType = 'p'
-	ClientMsgSimpleQuery ClientMessageType = 'Q'
-	ClientMsgSync        ClientMessageType = 'S'
-	ClientMsgTerminate   ClientMessageType = 'X'
+	ClientMsgBind         ClientMessageType = 'B'
+	ClientMsgClose        ClientMessageType = 'C'
+	ClientMsgCopyData     ClientMessageType = 'd'
+	ClientMsgCopyDone     ClientMessageType = 'c'
+	ClientMsgCopyFail     ClientMessageType = 'f'
+	ClientMsgDescribe     ClientMessageType = 'D'
+	ClientMsgExecute      ClientMessageType = 'E'
+	ClientMsgFlush        ClientMessageType = 'H'
+	ClientMsgFunctionCall ClientMessageType = 'F'
+	ClientMsgParse        ClientMessageType = 'P'
+	ClientMsgPassword     ClientMessageType = 'p'
+	ClientMsgSimpleQuery  ClientMessageType = 'Q'
+	ClientMsgSync         ClientMessageType = 'S'
+	ClientMsgTerminate    ClientMessageType = 'X'
 
 	ServerMsgAuth                     ServerMessageType = 'R'
 	ServerMsgBackendKeyData           ServerMessageType = 'K'
@@ -45,6 +46,7 @@ const (
 	ServerMsgDataRow                  ServerMessageType = 'D'
 	ServerMsgEmptyQuery               ServerMessageType = 'I'
 	ServerMsgErrorResponse            ServerMessageType = 'E'
+	ServerMsgFunctionCallResponse     ServerMessageType = 'V'
 	ServerMsgNoticeResponse           ServerMessageType = 'N'
 	ServerMsgNoData                   ServerMessageType = 'n'
 	ServerMsgNegotiateProtocolVersion ServerMessageType = 'v'
@@ -25,6 +25,7 @@ func _() {
 	_ = x[ServerMsgDataRow-68]
 	_ = x[ServerMsgEmptyQuery-73]
 	_ = x[ServerMsgErrorResponse-69]
+	_ = x[ServerMsgFunctionCallResponse-86]
 	_ = x[ServerMsgNoticeResponse-78]
 	_ = x[ServerMsgNoData-110]
 	_ = x[ServerMsgNegotiateProtocolVersion-118]
@@ -62,6 +63,8 @@ func (i ServerMessageType) String() string {
 		return "ServerMsgEmptyQuery"
 	case ServerMsgErrorResponse:
 		return "ServerMsgErrorResponse"
+	case ServerMsgFunctionCallResponse:
+		return "ServerMsgFunctionCallResponse"
 	case ServerMsgNoticeResponse:
 		return "ServerMsgNoticeResponse"
 	case ServerMsgNoData:
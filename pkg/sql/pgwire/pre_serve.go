@@ -311,6 +311,10 @@ type PreServeStatus struct {
 
 	// clientParameters is the set of client-provided status parameters.
 	clientParameters tenantIndependentClientParameters
+
+	// tlsServerCert is the certificate presented by the server during
+	// the TLS handshake, if the connection was upgraded to TLS.
+	tlsServerCert *tls.Certificate
 }
 
 // GetTenantName retrieves the selected tenant name.
@@ -388,13 +392,17 @@ func (s *PreServeConnHandler) PreServe(
 
 	// If the client requests SSL, upgrade the connection to use TLS.
 	var clientErr error
-	conn, st.ConnType, version, clientErr, err = s.maybeUpgradeToSecureConn(ctx, conn, st.ConnType, version, &buf)
+	var serverCert serverCertRecorder
+	conn, st.ConnType, version, clientErr, err = s.maybeUpgradeToSecureConn(ctx, conn, st.ConnType, version, &buf, &serverCert)
 	if err != nil {
 		return conn, st, err
 	}
 	if clientErr != nil {
 		return conn, st, s.sendErr(ctx, s.st, conn, clientErr)
 	}
+	// The handshake has completed by now. Remember which certificate was
+	// presented, for SCRAM channel binding.
+	st.tlsServerCert = serverCert.cert
 
 	// What does the client want to do?
 	needsNegotiation := false
@@ -473,12 +481,14 @@ func (s *PreServeConnHandler) PreServe(
 
 // maybeUpgradeToSecureConn upgrades the connection to TLS/SSL if
 // requested by the client, and available in the server configuration.
+// The certificate presented to the client is recorded in serverCert.
 func (s *PreServeConnHandler) maybeUpgradeToSecureConn(
 	ctx context.Context,
 	conn net.Conn,
 	connType hba.ConnType,
 	version uint32,
 	buf *pgwirebase.ReadBuffer,
+	serverCert *serverCertRecorder,
 ) (newConn net.Conn, newConnType hba.ConnType, newVersion uint32, clientErr, serverErr error) {
 	// By default, this is a no-op.
 	newConn = conn
@@ -542,7 +552,7 @@ func (s *PreServeConnHandler) maybeUpgradeToSecureConn(
 		if serverErr != nil {
 			return
 		}
-		newConn = tls.Server(conn, tlsConfig)
+		newConn = tls.Server(conn, serverCert.wrap(tlsConfig))
 		// Conditionally perform handshake connection and determine additional restrictions.
 		if err := security.TLSCipherRestrict(newConn); err != nil {
 			clientErr = pgerror.Wrapf(err, pgcode.SQLserverRejectedEstablishmentOfSQLconnection, "cannot use SSL/TLS with the requested ciphers")
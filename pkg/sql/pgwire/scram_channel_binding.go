@@ -0,0 +1,341 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package pgwire
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/hba"
+	"github.com/cockroachdb/errors"
+	"github.com/xdg-go/scram"
+)
+
+// This file implements the SCRAM-SHA-256-PLUS SASL mechanism, that is
+// SCRAM-SHA-256 (RFC 5802, RFC 7677) with channel binding. The only
+// channel binding type supported is tls-server-end-point (RFC 5929),
+// which is also the only one supported by PostgreSQL.
+//
+// With channel binding, the client proves that the TLS connection it
+// is using terminates at the server which owns the certificate it
+// received; this defeats a MITM that would relay the SCRAM exchange
+// over two separate TLS connections.
+
+const (
+	scramSHA256     = "SCRAM-SHA-256"
+	scramSHA256Plus = "SCRAM-SHA-256-PLUS"
+
+	cbindTypeTLSServerEndPoint = "tls-server-end-point"
+)
+
+// channelBindingMode configures whether SCRAM channel binding is
+// offered to, or required from, the client. It is set using the
+// channel_binding option of the HBA methods that use SCRAM.
+type channelBindingMode int
+
+const (
+	// channelBindingPrefer advertises SCRAM-SHA-256-PLUS alongside
+	// SCRAM-SHA-256 on TLS connections, and lets the client choose.
+	channelBindingPrefer channelBindingMode = iota
+	// channelBindingRequire only advertises SCRAM-SHA-256-PLUS, and
+	// rejects non-TLS connections.
+	channelBindingRequire
+	// channelBindingDisable never advertises SCRAM-SHA-256-PLUS.
+	channelBindingDisable
+)
+
+const channelBindingOption = "channel_binding"
+
+var channelBindingModes = map[string]channelBindingMode{
+	"prefer":  channelBindingPrefer,
+	"require": channelBindingRequire,
+	"disable": channelBindingDisable,
+}
+
+// getChannelBindingMode returns the channel binding mode configured
+// for the given HBA entry. The option was validated by
+// checkChannelBindingOption when the configuration was set.
+func getChannelBindingMode(entry *hba.Entry) channelBindingMode {
+	if entry == nil {
+		return channelBindingPrefer
+	}
+	return channelBindingModes[entry.GetOption(channelBindingOption)]
+}
+
+// checkChannelBindingOption is the CheckHBAEntry for the HBA methods
+// that use SCRAM. Only the channel_binding option is accepted.
+var checkChannelBindingOption CheckHBAEntry = func(_ *settings.Values, e hba.Entry) error {
+	for _, opt := range e.Options {
+		if opt[0] != channelBindingOption {
+			return errors.Newf("the HBA method %q does not accept option %q", e.Method, opt[0])
+		}
+	}
+	if vals := e.GetOptions(channelBindingOption); len(vals) > 1 {
+		return errors.Newf("the %s option can only be specified once", channelBindingOption)
+	} else if len(vals) == 1 {
+		if _, ok := channelBindingModes[vals[0]]; !ok {
+			return errors.WithHint(
+				errors.Newf("invalid value for option %s: %q", channelBindingOption, vals[0]),
+				"Supported values: prefer, require, disable.")
+		}
+	}
+	return nil
+}
+
+// serverCertRecorder records the certificate that the server presents
+// to the client during a TLS handshake.
+type serverCertRecorder struct {
+	cert *tls.Certificate
+}
+
+// wrap returns a copy of cfg which records the certificate presented
+// during the handshake in r. The copy must only be used for a single
+// connection.
+func (r *serverCertRecorder) wrap(cfg *tls.Config) *tls.Config {
+	cfg = cfg.Clone()
+	if getConfigForClient := cfg.GetConfigForClient; getConfigForClient != nil {
+		cfg.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			clientCfg, err := getConfigForClient(hello)
+			if err != nil || clientCfg == nil {
+				return clientCfg, err
+			}
+			clientCfg = clientCfg.Clone()
+			r.wrapGetCertificate(clientCfg)
+			return clientCfg, nil
+		}
+	}
+	r.wrapGetCertificate(cfg)
+	return cfg
+}
+
+// wrapGetCertificate makes the TLS stack obtain the certificate of cfg
+// from a GetCertificate callback which records it.
+func (r *serverCertRecorder) wrapGetCertificate(cfg *tls.Config) {
+	getCertificate, certs := cfg.GetCertificate, cfg.Certificates
+	// Without static certificates, GetCertificate is always called.
+	cfg.Certificates = nil
+	cfg.GetCertificate = func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		cert, err := selectServerCertificate(getCertificate, certs, hello)
+		if err != nil {
+			return nil, err
+		}
+		r.cert = cert
+		return cert, nil
+	}
+}
+
+// selectServerCertificate picks the certificate to present to a client
+// the same way crypto/tls does for a config with the given
+// GetCertificate callback and static certificates.
+func selectServerCertificate(
+	getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error),
+	certs []tls.Certificate,
+	hello *tls.ClientHelloInfo,
+) (*tls.Certificate, error) {
+	if getCertificate != nil && (len(certs) == 0 || hello.ServerName != "") {
+		if cert, err := getCertificate(hello); cert != nil || err != nil {
+			return cert, err
+		}
+	}
+	switch len(certs) {
+	case 0:
+		return nil, errors.New("no certificates configured")
+	case 1:
+		return &certs[0], nil
+	}
+	for i := range certs {
+		if hello.SupportsCertificate(&certs[i]) == nil {
+			return &certs[i], nil
+		}
+	}
+	return &certs[0], nil
+}
+
+// tlsServerEndPoint computes the channel binding data for the
+// tls-server-end-point type: the hash of the DER encoding of the server
+// certificate, using the hash function of the certificate's signature
+// algorithm, or SHA-256 if that is MD5 or SHA-1 (RFC 5929, section 4.1).
+func tlsServerEndPoint(cert *tls.Certificate) ([]byte, error) {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return nil, errors.New("no server certificate")
+	}
+	leaf := cert.Leaf
+	if leaf == nil {
+		var err error
+		if leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return nil, err
+		}
+	}
+	var h crypto.Hash
+	switch leaf.SignatureAlgorithm {
+	case x509.MD5WithRSA, x509.SHA1WithRSA, x509.ECDSAWithSHA1, x509.DSAWithSHA1,
+		x509.SHA256WithRSA, x509.SHA256WithRSAPSS, x509.ECDSAWithSHA256, x509.DSAWithSHA256:
+		h = crypto.SHA256
+	case x509.SHA384WithRSA, x509.SHA384WithRSAPSS, x509.ECDSAWithSHA384:
+		h = crypto.SHA384
+	case x509.SHA512WithRSA, x509.SHA512WithRSAPSS, x509.ECDSAWithSHA512:
+		h = crypto.SHA512
+	default:
+		return nil, errors.Newf("cannot determine the hash function for server certificate signature algorithm %s",
+			leaf.SignatureAlgorithm)
+	}
+	hasher := h.New()
+	hasher.Write(leaf.Raw)
+	return hasher.Sum(nil), nil
+}
+
+// scramConversation is the server side of a SCRAM exchange. It is
+// implemented by *scram.ServerConversation and *scramPlusConversation.
+type scramConversation interface {
+	Step(string) (string, error)
+	Done() bool
+	Valid() bool
+}
+
+var _ scramConversation = (*scram.ServerConversation)(nil)
+var _ scramConversation = (*scramPlusConversation)(nil)
+
+// scramPlusConversation is the server side of a SCRAM-SHA-256-PLUS
+// exchange. The scram package does not support channel binding, so the
+// message processing is done here.
+type scramPlusConversation struct {
+	credentialsLookup scram.CredentialLookup
+	cbindData         []byte
+
+	step            int
+	valid           bool
+	gs2Header       string
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+	creds           scram.StoredCredentials
+}
+
+func newScramPlusConversation(
+	credentialsLookup scram.CredentialLookup, cbindData []byte,
+) *scramPlusConversation {
+	return &scramPlusConversation{credentialsLookup: credentialsLookup, cbindData: cbindData}
+}
+
+// Done returns true when the exchange is complete, successfully or not.
+func (sc *scramPlusConversation) Done() bool { return sc.step >= 2 }
+
+// Valid returns true if the client was authenticated.
+func (sc *scramPlusConversation) Valid() bool { return sc.valid }
+
+// Step processes a message from the client and returns the response.
+func (sc *scramPlusConversation) Step(in string) (string, error) {
+	switch sc.step {
+	case 0:
+		sc.step++
+		return sc.clientFirst(in)
+	case 1:
+		sc.step++
+		return sc.clientFinal(in)
+	default:
+		return "", errors.New("conversation already completed")
+	}
+}
+
+func (sc *scramPlusConversation) clientFirst(in string) (string, error) {
+	// client-first-message = gs2-header client-first-message-bare
+	// gs2-header = gs2-cbind-flag "," [ authzid ] ","
+	parts := strings.SplitN(in, ",", 3)
+	if len(parts) != 3 {
+		return "", errors.New("malformed SCRAM client-first-message")
+	}
+	if cbind, ok := strings.CutPrefix(parts[0], "p="); !ok {
+		return "", errors.Newf("client selected %s without channel binding", scramSHA256Plus)
+	} else if cbind != cbindTypeTLSServerEndPoint {
+		return "", errors.Newf("unsupported SCRAM channel binding type %q", cbind)
+	}
+	sc.gs2Header = parts[0] + "," + parts[1] + ","
+	sc.clientFirstBare = parts[2]
+
+	// client-first-message-bare = [reserved-mext ","] username "," nonce
+	// ["," extensions]
+	fields := strings.Split(sc.clientFirstBare, ",")
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "n=") {
+		return "", errors.New("malformed SCRAM client-first-message")
+	}
+	clientNonce, ok := strings.CutPrefix(fields[1], "r=")
+	if !ok || clientNonce == "" {
+		return "", errors.New("malformed SCRAM client-first-message")
+	}
+
+	var err error
+	if sc.creds, err = sc.credentialsLookup(strings.TrimPrefix(fields[0], "n=")); err != nil {
+		return "", err
+	}
+
+	serverNonce := make([]byte, 24)
+	if _, err := rand.Read(serverNonce); err != nil {
+		return "", err
+	}
+	sc.nonce = clientNonce + base64.StdEncoding.EncodeToString(serverNonce)
+	sc.serverFirst = "r=" + sc.nonce +
+		",s=" + base64.StdEncoding.EncodeToString([]byte(sc.creds.Salt)) +
+		",i=" + strconv.Itoa(sc.creds.Iters)
+	return sc.serverFirst, nil
+}
+
+func (sc *scramPlusConversation) clientFinal(in string) (string, error) {
+	// client-final-message = channel-binding "," nonce ["," extensions]
+	// "," proof
+	proofIdx := strings.LastIndex(in, ",p=")
+	if proofIdx < 0 {
+		return "", errors.New("malformed SCRAM client-final-message")
+	}
+	withoutProof := in[:proofIdx]
+	fields := strings.Split(withoutProof, ",")
+	if len(fields) < 2 {
+		return "", errors.New("malformed SCRAM client-final-message")
+	}
+
+	// The channel binding data must match our end of the connection.
+	expectedCbind := base64.StdEncoding.EncodeToString(append([]byte(sc.gs2Header), sc.cbindData...))
+	if cbind, ok := strings.CutPrefix(fields[0], "c="); !ok ||
+		subtle.ConstantTimeCompare([]byte(cbind), []byte(expectedCbind)) != 1 {
+		return "e=channel-bindings-dont-match", errors.New("SCRAM channel binding check failed")
+	}
+	if nonce, ok := strings.CutPrefix(fields[1], "r="); !ok || nonce != sc.nonce {
+		return "e=other-error", errors.New("SCRAM nonce mismatch")
+	}
+	proof, err := base64.StdEncoding.DecodeString(in[proofIdx+len(",p="):])
+	if err != nil || len(proof) != sha256.Size {
+		return "e=invalid-proof", errors.New("malformed SCRAM client proof")
+	}
+
+	// ClientKey := ClientProof XOR HMAC(StoredKey, AuthMessage), and
+	// StoredKey must be H(ClientKey).
+	authMessage := []byte(sc.clientFirstBare + "," + sc.serverFirst + "," + withoutProof)
+	clientKey := computeHMAC(sc.creds.StoredKey, authMessage)
+	for i := range clientKey {
+		clientKey[i] ^= proof[i]
+	}
+	storedKey := sha256.Sum256(clientKey)
+	if subtle.ConstantTimeCompare(storedKey[:], sc.creds.StoredKey) != 1 {
+		return "e=invalid-proof", errors.New("invalid SCRAM client proof")
+	}
+
+	sc.valid = true
+	return "v=" + base64.StdEncoding.EncodeToString(computeHMAC(sc.creds.ServerKey, authMessage)), nil
+}
+
+func computeHMAC(key, msg []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(msg)
+	return mac.Sum(nil)
+}
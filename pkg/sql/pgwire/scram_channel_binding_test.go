@@ -0,0 +1,261 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package pgwire
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/hba"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/stretchr/testify/require"
+	"github.com/xdg-go/scram"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func TestTLSServerEndPoint(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	makeCert := func(curve elliptic.Curve, sigAlg x509.SignatureAlgorithm) *tls.Certificate {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		require.NoError(t, err)
+		tmpl := &x509.Certificate{
+			SerialNumber:       big.NewInt(1),
+			Subject:            pkix.Name{CommonName: "node"},
+			NotBefore:          time.Now().Add(-time.Hour),
+			NotAfter:           time.Now().Add(time.Hour),
+			SignatureAlgorithm: sigAlg,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+		require.NoError(t, err)
+		return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	}
+
+	t.Run("sha256", func(t *testing.T) {
+		cert := makeCert(elliptic.P256(), x509.ECDSAWithSHA256)
+		data, err := tlsServerEndPoint(cert)
+		require.NoError(t, err)
+		expected := sha256.Sum256(cert.Certificate[0])
+		require.Equal(t, expected[:], data)
+	})
+
+	t.Run("sha384", func(t *testing.T) {
+		cert := makeCert(elliptic.P384(), x509.ECDSAWithSHA384)
+		data, err := tlsServerEndPoint(cert)
+		require.NoError(t, err)
+		expected := sha512.Sum384(cert.Certificate[0])
+		require.Equal(t, expected[:], data)
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		_, err := tlsServerEndPoint(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("server certificate recorder", func(t *testing.T) {
+		// handshake runs a TLS handshake against a server using cfg, and
+		// returns the certificate it recorded and the one the client saw.
+		handshake := func(t *testing.T, cfg *tls.Config) (recorded, seen *tls.Certificate) {
+			serverConn, clientConn := net.Pipe()
+			defer serverConn.Close()
+			defer clientConn.Close()
+			var r serverCertRecorder
+			server := tls.Server(serverConn, r.wrap(cfg))
+			errCh := make(chan error, 1)
+			go func() { errCh <- server.Handshake() }()
+			client := tls.Client(clientConn, &tls.Config{InsecureSkipVerify: true})
+			require.NoError(t, client.Handshake())
+			require.NoError(t, <-errCh)
+			peerCerts := client.ConnectionState().PeerCertificates
+			require.NotEmpty(t, peerCerts)
+			return r.cert, &tls.Certificate{Certificate: [][]byte{peerCerts[0].Raw}}
+		}
+
+		first := makeCert(elliptic.P256(), x509.ECDSAWithSHA256)
+		second := makeCert(elliptic.P384(), x509.ECDSAWithSHA384)
+		for _, tc := range []struct {
+			name string
+			cfg  *tls.Config
+		}{
+			{
+				name: "static certificate",
+				cfg:  &tls.Config{Certificates: []tls.Certificate{*first}},
+			},
+			{
+				name: "get certificate",
+				cfg: &tls.Config{
+					GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) { return first, nil },
+				},
+			},
+			{
+				// The certificate of the config returned for the client is
+				// presented, not the one of the outer config.
+				name: "get config for client",
+				cfg: &tls.Config{
+					Certificates: []tls.Certificate{*second},
+					GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+						return &tls.Config{Certificates: []tls.Certificate{*first}}, nil
+					},
+				},
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				recorded, seen := handshake(t, tc.cfg)
+				require.NotNil(t, recorded)
+				require.Equal(t, seen.Certificate[0], recorded.Certificate[0])
+				recordedData, err := tlsServerEndPoint(recorded)
+				require.NoError(t, err)
+				seenData, err := tlsServerEndPoint(seen)
+				require.NoError(t, err)
+				require.Equal(t, seenData, recordedData)
+			})
+		}
+	})
+}
+
+func TestScramPlusConversation(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const user, pass = "abc", "s3cret"
+	kf := scram.KeyFactors{Salt: "0123456789abcdef", Iters: 4096}
+	client, err := scram.SHA256.NewClient(user, pass, "")
+	require.NoError(t, err)
+	creds := client.GetStoredCredentials(kf)
+	lookup := func(string) (scram.StoredCredentials, error) { return creds, nil }
+	cbindData := []byte("server end point")
+
+	hmacSHA256 := func(key []byte, msg string) []byte {
+		return computeHMAC(key, []byte(msg))
+	}
+
+	// exchange runs a SCRAM-SHA-256-PLUS exchange against the server
+	// with the given client-side inputs, and returns the server's final
+	// message and error.
+	exchange := func(
+		t *testing.T, gs2Header string, clientCbind []byte, clientPass string,
+	) (*scramPlusConversation, string, error) {
+		sc := newScramPlusConversation(lookup, cbindData)
+		clientFirstBare := "n=" + user + ",r=clientnonce"
+		serverFirst, err := sc.Step(gs2Header + clientFirstBare)
+		if err != nil {
+			return sc, "", err
+		}
+		require.False(t, sc.Done())
+
+		var nonce, salt string
+		var iters int
+		for _, f := range strings.Split(serverFirst, ",") {
+			switch f[:2] {
+			case "r=":
+				nonce = f[2:]
+			case "s=":
+				s, err := base64.StdEncoding.DecodeString(f[2:])
+				require.NoError(t, err)
+				salt = string(s)
+			case "i=":
+				iters, err = strconv.Atoi(f[2:])
+				require.NoError(t, err)
+			}
+		}
+		require.True(t, strings.HasPrefix(nonce, "clientnonce"))
+		require.Equal(t, kf.Salt, salt)
+		require.Equal(t, kf.Iters, iters)
+
+		withoutProof := "c=" + base64.StdEncoding.EncodeToString(append([]byte(gs2Header), clientCbind...)) +
+			",r=" + nonce
+		authMessage := clientFirstBare + "," + serverFirst + "," + withoutProof
+		saltedPassword := pbkdf2.Key([]byte(clientPass), []byte(salt), iters, sha256.Size, sha256.New)
+		clientKey := hmacSHA256(saltedPassword, "Client Key")
+		storedKey := sha256.Sum256(clientKey)
+		proof := hmacSHA256(storedKey[:], authMessage)
+		for i := range proof {
+			proof[i] ^= clientKey[i]
+		}
+		serverFinal, err := sc.Step(withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof))
+		require.True(t, sc.Done())
+		if err == nil {
+			serverKey := hmacSHA256(saltedPassword, "Server Key")
+			require.Equal(t, "v="+base64.StdEncoding.EncodeToString(hmacSHA256(serverKey, authMessage)), serverFinal)
+		}
+		return sc, serverFinal, err
+	}
+
+	const gs2Header = "p=tls-server-end-point,,"
+
+	t.Run("valid", func(t *testing.T) {
+		sc, _, err := exchange(t, gs2Header, cbindData, pass)
+		require.NoError(t, err)
+		require.True(t, sc.Valid())
+	})
+
+	t.Run("channel binding mismatch", func(t *testing.T) {
+		sc, final, err := exchange(t, gs2Header, []byte("mitm end point"), pass)
+		require.Error(t, err)
+		require.Equal(t, "e=channel-bindings-dont-match", final)
+		require.False(t, sc.Valid())
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		sc, final, err := exchange(t, gs2Header, cbindData, "wrong")
+		require.Error(t, err)
+		require.Equal(t, "e=invalid-proof", final)
+		require.False(t, sc.Valid())
+	})
+
+	t.Run("unsupported channel binding type", func(t *testing.T) {
+		_, _, err := exchange(t, "p=tls-unique,,", cbindData, pass)
+		require.ErrorContains(t, err, "unsupported SCRAM channel binding type")
+	})
+
+	t.Run("no channel binding", func(t *testing.T) {
+		_, _, err := exchange(t, "n,,", cbindData, pass)
+		require.ErrorContains(t, err, "without channel binding")
+	})
+}
+
+func TestChannelBindingOption(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		conf string
+		mode channelBindingMode
+		err  string
+	}{
+		{conf: "scram-sha-256", mode: channelBindingPrefer},
+		{conf: "scram-sha-256 channel_binding=prefer", mode: channelBindingPrefer},
+		{conf: "scram-sha-256 channel_binding=require", mode: channelBindingRequire},
+		{conf: "cert-scram-sha-256 channel_binding=disable", mode: channelBindingDisable},
+		{conf: "scram-sha-256 channel_binding=maybe", err: "invalid value for option channel_binding"},
+		{conf: "scram-sha-256 channel_binding=require channel_binding=disable", err: "can only be specified once"},
+		{conf: "scram-sha-256 map=foo", err: `does not accept option "map"`},
+	} {
+		t.Run(tc.conf, func(t *testing.T) {
+			conf, err := hba.ParseAndNormalize("host all all all " + tc.conf)
+			require.NoError(t, err)
+			entry := conf.Entries[0]
+			err = checkChannelBindingOption(nil, entry)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.mode, getChannelBindingMode(&entry))
+		})
+	}
+}
@@ -528,6 +528,10 @@ func MakeServer(
 	server.mu.Unlock()
 	server.connectionErrorLogEveryN = log.Every(10 * time.Second)
 	executorConfig.CidrLookup.SetOnChange(server.onCidrChange)
+	executorConfig.HBAConfiguration = func() *hba.Conf {
+		conf, _ := server.GetAuthenticationConfiguration()
+		return conf
+	}
 
 	connAuthConf.SetOnChange(&st.SV, func(ctx context.Context) {
 		loadLocalHBAConfigUponRemoteSettingChange(ctx, server, st)
@@ -1033,6 +1037,7 @@ func (s *Server) ServeConn(
 			insecure:        s.cfg.Insecure,
 			auth:            hbaConf,
 			identMap:        identMap,
+			tlsServerCert:   preServeStatus.tlsServerCert,
 			testingAuthHook: testingAuthHook,
 		},
 		sessionID,
@@ -1396,6 +1401,19 @@ func (s *Server) serveImpl(
 					ExplicitFromClient: false,
 				})
 
+			case pgwirebase.ClientMsgFunctionCall:
+				if err := c.prohibitUnderReplicationMode(ctx); err != nil {
+					return false, isSimpleQuery, err
+				}
+				if err = c.handleFunctionCall(ctx, timeReceived); err != nil {
+					return false, isSimpleQuery, err
+				}
+				// Like a simple query, a function call is its own transaction
+				// boundary and is always followed by ReadyForQuery.
+				return false, isSimpleQuery, c.stmtBuf.Push(ctx, sql.Sync{
+					ExplicitFromClient: false,
+				})
+
 			case pgwirebase.ClientMsgExecute:
 				if err := c.prohibitUnderReplicationMode(ctx); err != nil {
 					return false, isSimpleQuery, err
@@ -290,6 +290,9 @@ func writeTextDatumNotNull(
 		b.textFormatter.FormatNode(v)
 		b.writeFromFmtCtx(b.textFormatter)
 
+	case *tree.DXML:
+		b.writeLengthPrefixedString(v.Contents)
+
 	default:
 		b.setError(errors.Errorf("unsupported type %T", d))
 	}
@@ -887,6 +890,10 @@ func writeBinaryDatumNotNull(
 		b.textFormatter.FormatNode(v)
 		b.writeFromFmtCtxWithoutLength(b.textFormatter)
 
+	case *tree.DXML:
+		// The binary format of xml is the same as its text format.
+		b.writeLengthPrefixedString(v.Contents)
+
 	default:
 		b.setError(errors.AssertionFailedf("unsupported type %T", d))
 	}
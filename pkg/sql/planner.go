@@ -37,6 +37,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/hintpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/hints"
 	"github.com/cockroachdb/cockroach/pkg/sql/idxusage"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/prep"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
@@ -57,6 +58,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
 	"github.com/cockroachdb/cockroach/pkg/util/mon"
+	"github.com/cockroachdb/cockroach/pkg/util/tsearch"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/logtags"
 	"github.com/cockroachdb/redact"
@@ -258,6 +260,15 @@ type planner struct {
 
 	createdSequences createdSequences
 
+	// largeObjectDescs contains the large object descriptors opened in the
+	// current transaction.
+	largeObjectDescs *largeobject.Descriptors
+
+	// textSearchConfigs caches the user-defined text search configurations
+	// resolved during the current statement, keyed by the name they were
+	// resolved with, so that their dictionaries are only built once.
+	textSearchConfigs map[string]*tsearch.Config
+
 	// autoCommit indicates whether the plan is allowed (but not required) to
 	// commit the transaction along with other KV operations. Committing the txn
 	// might be beneficial because it may enable the 1PC optimization. Note that
@@ -503,6 +514,7 @@ func newInternalPlanner(
 	p.sqlCursors = emptySqlCursors{}
 	p.preparedStatements = emptyPreparedStatements{}
 	p.createdSequences = emptyCreatedSequences{}
+	p.largeObjectDescs = &largeobject.Descriptors{}
 
 	p.schemaResolver.descCollection = p.Descriptors()
 	p.schemaResolver.sessionDataStack = sds
@@ -1022,6 +1034,7 @@ func (p *planner) resetPlanner(
 	p.typeResolutionDbID = descpb.InvalidID
 	p.pausablePortal = nil
 	p.routineMetadataForwarder = nil
+	p.textSearchConfigs = nil
 	p.autoRetryCounter = 0
 	p.autoRetryStmtReason = nil
 	p.autoRetryStmtCounter = 0
@@ -65,6 +65,9 @@ const (
 	VirtualTable ObjectType = "virtual_table"
 	// ExternalConnection represents an external connection object.
 	ExternalConnection ObjectType = "external_connection"
+	// Column represents a column of a table. Column privileges are stored in
+	// the table descriptor.
+	Column ObjectType = "column"
 )
 
 var isDescriptorBacked = map[ObjectType]bool{
@@ -77,6 +80,7 @@ var isDescriptorBacked = map[ObjectType]bool{
 	Global:             false,
 	VirtualTable:       false,
 	ExternalConnection: false,
+	Column:             true,
 }
 
 // Predefined sets of privileges.
@@ -104,6 +108,10 @@ var (
 	}
 	VirtualTablePrivileges       = List{ALL, SELECT}
 	ExternalConnectionPrivileges = List{ALL, USAGE, DROP, UPDATE}
+	// ColumnPrivileges are the privileges that can be granted on individual
+	// columns of a table. They complement the privileges granted on the table
+	// itself.
+	ColumnPrivileges = List{SELECT, INSERT, UPDATE}
 )
 
 // Mask returns the bitmask for a given privilege.
@@ -346,6 +354,8 @@ func GetValidPrivilegesForObject(objectType ObjectType) (List, error) {
 		return VirtualTablePrivileges, nil
 	case ExternalConnection:
 		return ExternalConnectionPrivileges, nil
+	case Column:
+		return ColumnPrivileges, nil
 	default:
 		return nil, errors.AssertionFailedf("unknown object type %s", objectType)
 	}
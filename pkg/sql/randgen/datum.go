@@ -314,6 +314,9 @@ func RandDatumWithNullChance(
 		return tree.NewDOidWithType(oid.Oid(rng.Uint32()), typ)
 	case types.LTreeFamily:
 		return tree.NewDLTree(ltree.RandLTree(rng))
+	case types.XMLFamily:
+		name := fmt.Sprintf("e%d", rng.Intn(4))
+		return tree.NewDXML(fmt.Sprintf("<%s>%d</%s>", name, rng.Int63(), name))
 	case types.UnknownFamily:
 		return tree.DNull
 	case types.ArrayFamily:
@@ -915,6 +918,19 @@ func getRandInterestingDatums(typ types.Family) ([]tree.Datum, bool) {
 				}
 				return res
 			}(),
+			types.XMLFamily: func() []tree.Datum {
+				var res []tree.Datum
+				for _, s := range []string{
+					"",
+					"foo",
+					"<a/>",
+					`<a b="c">d</a>`,
+					"<a/><b/>",
+				} {
+					res = append(res, tree.NewDXML(s))
+				}
+				return res
+			}(),
 		}
 
 	})
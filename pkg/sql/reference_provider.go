@@ -11,14 +11,23 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/kv"
 	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/norm"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/optbuilder"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scbuild"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
+	"github.com/lib/pq/oid"
 )
 
 type tableDescReferences []descpb.TableDescriptor_Reference
@@ -30,6 +39,8 @@ type referenceProvider struct {
 	referencedSequences catalog.DescriptorIDSet
 	referencedTypes     catalog.DescriptorIDSet
 	allRelationIDs      catalog.DescriptorIDSet
+	viewQuery           string
+	viewColumns         colinfo.ResultColumns
 }
 
 func newReferenceProvider() *referenceProvider {
@@ -105,6 +116,16 @@ func (r *referenceProvider) ReferencedRoutines() catalog.DescriptorIDSet {
 	return r.referencedFunctions
 }
 
+// ViewQuery implements scbuildstmt.ReferenceProvider
+func (r *referenceProvider) ViewQuery() string {
+	return r.viewQuery
+}
+
+// ViewColumns implements scbuildstmt.ReferenceProvider
+func (r *referenceProvider) ViewColumns() colinfo.ResultColumns {
+	return r.viewColumns
+}
+
 type referenceProviderFactory struct {
 	p *planner
 }
@@ -121,12 +142,23 @@ func (f *referenceProviderFactory) NewReferenceProvider(
 		return nil, err
 	}
 	var (
-		err      error
-		planDeps planDependencies
-		typeDeps typeDependencies
-		funcDeps functionDependencies
+		err         error
+		planDeps    planDependencies
+		typeDeps    typeDependencies
+		funcDeps    functionDependencies
+		viewQuery   string
+		viewColumns colinfo.ResultColumns
 	)
 	switch t := optFactory.Memo().RootExpr().(type) {
+	case *memo.CreateViewExpr:
+		planDeps, typeDeps, funcDeps, err = toPlanDependencies(t.Deps, t.TypeDeps, t.FuncDeps)
+		md := optFactory.Memo().Metadata()
+		viewQuery = t.ViewQuery
+		viewColumns = make(colinfo.ResultColumns, len(t.Columns))
+		for i := range viewColumns {
+			viewColumns[i].Name = t.Columns[i].Alias
+			viewColumns[i].Typ = md.ColumnMeta(t.Columns[i].ID).Type
+		}
 	case *memo.CreateFunctionExpr:
 		planDeps, typeDeps, funcDeps, err = toPlanDependencies(t.Deps, t.TypeDeps, t.FuncDeps)
 	case *memo.CreateTriggerExpr:
@@ -139,6 +171,8 @@ func (f *referenceProviderFactory) NewReferenceProvider(
 	}
 
 	ret := newReferenceProvider()
+	ret.viewQuery = viewQuery
+	ret.viewColumns = viewColumns
 
 	for descID, refs := range planDeps {
 		ret.allRelationIDs.Add(descID)
@@ -170,6 +204,139 @@ func (f *referenceProviderFactory) NewReferenceProvider(
 	return ret, nil
 }
 
+// TypeCheckWithColumnType implements scbuild.ReferenceProviderFactory.
+func (f *referenceProviderFactory) TypeCheckWithColumnType(
+	ctx context.Context, dependentID, tableID descpb.ID, columnID descpb.ColumnID, typ *types.T,
+) error {
+	p := f.p
+	getter := p.Descriptors().ByIDWithoutLeased(p.txn).WithoutNonPublic().Get()
+	tbl, err := getter.Table(ctx, tableID)
+	if err != nil {
+		return err
+	}
+	dependent, err := getter.Desc(ctx, dependentID)
+	if err != nil {
+		return err
+	}
+	sc, err := getter.Schema(ctx, dependent.GetParentSchemaID())
+	if err != nil {
+		return err
+	}
+	prefix, err := p.getQualifiedSchemaName(ctx, sc)
+	if err != nil {
+		return err
+	}
+	var stmt tree.Statement
+	var viewDesc catalog.TableDescriptor
+	switch d := dependent.(type) {
+	case catalog.TableDescriptor:
+		parsed, err := parser.ParseOne(d.GetViewQuery())
+		if err != nil {
+			return err
+		}
+		sel, ok := parsed.AST.(*tree.Select)
+		if !ok {
+			return errors.AssertionFailedf("unexpected query for view %q: %s", d.GetName(), parsed.AST)
+		}
+		viewDesc = d
+		stmt = &tree.CreateView{
+			Name:        tree.MakeTableNameFromPrefix(*prefix, tree.Name(d.GetName())),
+			AsSource:    sel,
+			Persistence: tree.PersistencePermanent,
+		}
+	case catalog.FunctionDescriptor:
+		cf, err := d.ToCreateExpr()
+		if err != nil {
+			return err
+		}
+		cf.Name = tree.MakeRoutineNameFromPrefix(*prefix, tree.Name(d.GetName()))
+		stmt = cf
+		// Routines can only be planned in the current database, which need not
+		// be the one of the altered table.
+		if curDB := p.SessionData().Database; curDB != string(prefix.CatalogName) {
+			p.SessionData().Database = string(prefix.CatalogName)
+			defer func() { p.SessionData().Database = curDB }()
+		}
+	default:
+		return errors.AssertionFailedf("unexpected dependent %q of type %s", dependent.GetName(), dependent.DescriptorType())
+	}
+
+	// Shadow the table with a copy in which the column has its new type for the
+	// duration of the planning.
+	mut := tabledesc.NewBuilder(tbl.TableDesc()).BuildExistingMutableTable()
+	col, err := catalog.MustFindColumnByID(mut, columnID)
+	if err != nil {
+		return err
+	}
+	col.ColumnDesc().Type = typ
+	p.Descriptors().AddSyntheticDescriptor(mut)
+	defer p.Descriptors().RemoveSyntheticDescriptor(tableID)
+
+	// The dependent was already checked when it was created, and altering the
+	// type of a column does not require privileges on it.
+	ctlg := &privilegeSkippingOptCatalog{}
+	ctlg.init(p)
+	var optFactory norm.Factory
+	optFactory.Init(ctx, p.EvalContext(), ctlg)
+	optBld := optbuilder.New(ctx, p.SemaCtx(), p.EvalContext(), ctlg, &optFactory, stmt)
+	if err := optBld.Build(); err != nil {
+		return err
+	}
+	if viewDesc == nil {
+		return nil
+	}
+	cv, ok := optFactory.Memo().RootExpr().(*memo.CreateViewExpr)
+	if !ok {
+		return errors.AssertionFailedf("unexpected root expression: %s", optFactory.Memo().RootExpr().Op())
+	}
+	viewCols := viewDesc.VisibleColumns()
+	if len(cv.Columns) != len(viewCols) {
+		return errors.AssertionFailedf("view %q has %d columns, but its query returns %d",
+			viewDesc.GetName(), len(viewCols), len(cv.Columns))
+	}
+	md := optFactory.Memo().Metadata()
+	for i, viewCol := range viewCols {
+		newTyp := md.ColumnMeta(cv.Columns[i].ID).Type
+		if newTyp.Family() == types.UnknownFamily {
+			newTyp = types.String
+		}
+		if !newTyp.Identical(viewCol.GetType()) {
+			return pgerror.Newf(pgcode.DatatypeMismatch,
+				"column %q of view %q would change from type %s to %s",
+				viewCol.GetName(), viewDesc.GetName(), viewCol.GetType().SQLString(), newTyp.SQLString())
+		}
+	}
+	return nil
+}
+
+// privilegeSkippingOptCatalog is an optCatalog that does not check privileges.
+// It is used to re-plan existing views and routines, which are not executed
+// by the current user.
+type privilegeSkippingOptCatalog struct {
+	optCatalog
+}
+
+var _ cat.Catalog = (*privilegeSkippingOptCatalog)(nil)
+
+// CheckPrivilege is part of the cat.Catalog interface.
+func (oc *privilegeSkippingOptCatalog) CheckPrivilege(
+	context.Context, cat.Object, username.SQLUsername, privilege.Kind,
+) error {
+	return nil
+}
+
+// CheckAnyPrivilege is part of the cat.Catalog interface.
+func (oc *privilegeSkippingOptCatalog) CheckAnyPrivilege(context.Context, cat.Object) error {
+	return nil
+}
+
+// CheckExecutionPrivilege is part of the cat.Catalog interface.
+func (oc *privilegeSkippingOptCatalog) CheckExecutionPrivilege(
+	context.Context, oid.Oid, username.SQLUsername,
+) error {
+	return nil
+}
+
 // NewReferenceProviderFactory returns a new ReferenceProviderFactory.
 func NewReferenceProviderFactory(p *planner) scbuild.ReferenceProviderFactory {
 	return &referenceProviderFactory{p: p}
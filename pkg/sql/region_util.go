@@ -148,33 +148,7 @@ func TestingConvertRegionToZoneConfig(
 func zoneConfigForMultiRegionDatabase(
 	regionConfig multiregion.RegionConfig,
 ) (zonepb.ZoneConfig, error) {
-	numVoters, numReplicas := regions.GetNumVotersAndNumReplicas(regionConfig)
-
-	constraints, err := regions.SynthesizeReplicaConstraints(regionConfig.Regions(), regionConfig.Placement())
-	if err != nil {
-		return zonepb.ZoneConfig{}, err
-	}
-
-	voterConstraints, err := regions.SynthesizeVoterConstraints(regionConfig.PrimaryRegion(), regionConfig)
-	if err != nil {
-		return zonepb.ZoneConfig{}, err
-	}
-
-	leasePreferences := regions.SynthesizeLeasePreferences(regionConfig.PrimaryRegion(), regionConfig.SecondaryRegion())
-
-	zc := zonepb.ZoneConfig{
-		NumReplicas:                 &numReplicas,
-		NumVoters:                   &numVoters,
-		Constraints:                 constraints,
-		VoterConstraints:            voterConstraints,
-		LeasePreferences:            leasePreferences,
-		InheritedConstraints:        false,
-		NullVoterConstraintsIsEmpty: true,
-		InheritedLeasePreferences:   false,
-	}
-
-	// The validation of the extended zone config is done here.
-	return regionConfig.ExtendZoneConfigWithRegionalIn(zc, regionConfig.PrimaryRegion())
+	return regions.ZoneConfigForMultiRegionDatabase(regionConfig)
 }
 
 // applyZoneConfigForMultiRegionTableOption is an option that can be passed into
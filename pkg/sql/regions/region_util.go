@@ -130,6 +130,43 @@ func ZoneConfigForMultiRegionTable(
 	}
 }
 
+// ZoneConfigForMultiRegionDatabase generates a ZoneConfig stub for a
+// multi-region database such that at least one replica (voting or non-voting)
+// is constrained to each region defined within the given `regionConfig` and
+// some voting replicas are constrained to the primary region of the database
+// depending on its prescribed survivability goal.
+func ZoneConfigForMultiRegionDatabase(
+	regionConfig multiregion.RegionConfig,
+) (zonepb.ZoneConfig, error) {
+	numVoters, numReplicas := GetNumVotersAndNumReplicas(regionConfig)
+
+	constraints, err := SynthesizeReplicaConstraints(regionConfig.Regions(), regionConfig.Placement())
+	if err != nil {
+		return zonepb.ZoneConfig{}, err
+	}
+
+	voterConstraints, err := SynthesizeVoterConstraints(regionConfig.PrimaryRegion(), regionConfig)
+	if err != nil {
+		return zonepb.ZoneConfig{}, err
+	}
+
+	leasePreferences := SynthesizeLeasePreferences(regionConfig.PrimaryRegion(), regionConfig.SecondaryRegion())
+
+	zc := zonepb.ZoneConfig{
+		NumReplicas:                 &numReplicas,
+		NumVoters:                   &numVoters,
+		Constraints:                 constraints,
+		VoterConstraints:            voterConstraints,
+		LeasePreferences:            leasePreferences,
+		InheritedConstraints:        false,
+		NullVoterConstraintsIsEmpty: true,
+		InheritedLeasePreferences:   false,
+	}
+
+	// The validation of the extended zone config is done here.
+	return regionConfig.ExtendZoneConfigWithRegionalIn(zc, regionConfig.PrimaryRegion())
+}
+
 // ZoneConfigForMultiRegionPartition generates a ZoneConfig stub for a partition
 // that belongs to a regional by row table in a multi-region database.
 //
@@ -6,9 +6,9 @@
 package sql
 
 import (
-	"bytes"
 	"context"
 	"encoding/hex"
+	"io"
 	"sort"
 	"time"
 
@@ -799,7 +799,10 @@ func (p *planner) ExternalReadFile(ctx context.Context, uri string) ([]byte, err
 	return ioctx.ReadAll(ctx, file)
 }
 
-func (p *planner) ExternalWriteFile(ctx context.Context, uri string, content []byte) error {
+// ExternalStreamFile is part of the eval.Planner interface.
+func (p *planner) ExternalStreamFile(
+	ctx context.Context, uri string, fn func(io.Reader) error,
+) (retErr error) {
 	if err := p.CheckPrivilege(ctx, syntheticprivilege.GlobalPrivilegeObject, privilege.REPAIRCLUSTER); err != nil {
 		return err
 	}
@@ -808,7 +811,26 @@ func (p *planner) ExternalWriteFile(ctx context.Context, uri string, content []b
 	if err != nil {
 		return err
 	}
-	return cloud.WriteFile(ctx, conn, "", bytes.NewReader(content))
+	defer func() { retErr = errors.CombineErrors(retErr, conn.Close()) }()
+
+	file, _, err := conn.ReadFile(ctx, "", cloud.ReadOptions{NoFileSize: true})
+	if err != nil {
+		return err
+	}
+	defer func() { retErr = errors.CombineErrors(retErr, file.Close(ctx)) }()
+	return fn(ioctx.ReaderCtxAdapter(ctx, file))
+}
+
+func (p *planner) ExternalWriteFile(ctx context.Context, uri string, content io.Reader) error {
+	if err := p.CheckPrivilege(ctx, syntheticprivilege.GlobalPrivilegeObject, privilege.REPAIRCLUSTER); err != nil {
+		return err
+	}
+
+	conn, err := p.ExecCfg().DistSQLSrv.ExternalStorageFromURI(ctx, uri, p.User())
+	if err != nil {
+		return err
+	}
+	return cloud.WriteFile(ctx, conn, "", content)
 }
 
 // UpsertDroppedRelationGCTTL is part of the Planner interface.
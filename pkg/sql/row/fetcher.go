@@ -23,6 +23,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/colencryption"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc/keyside"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc/valueside"
@@ -180,6 +181,13 @@ type tableInfo struct {
 	// (into spec.FetchedColumns); -1 if we don't need the value for that column.
 	indexColIdx []int
 
+	// encryptionKeys holds the key rings of the fetched encrypted columns.
+	encryptionKeys colencryption.TableKeys
+
+	// missingValues holds the decoded missing values of the fetched columns,
+	// indexed like spec.FetchedColumns. It is nil if no column has one.
+	missingValues tree.Datums
+
 	// -- Fields updated during a scan --
 
 	keyVals    []rowenc.EncDatum
@@ -389,6 +397,26 @@ func (rf *Fetcher) Init(ctx context.Context, args FetcherInitArgs) error {
 		originTimestampOutputIdx: noOutputColumn,
 	}
 
+	var err error
+	if table.encryptionKeys, err = colencryption.KeysFromFetchSpec(args.Spec); err != nil {
+		return err
+	}
+
+	for idx := range args.Spec.FetchedColumns {
+		col := &args.Spec.FetchedColumns[idx]
+		if col.MissingValue == nil {
+			continue
+		}
+		if table.missingValues == nil {
+			table.missingValues = make(tree.Datums, len(args.Spec.FetchedColumns))
+		}
+		if table.missingValues[idx], _, err = valueside.Decode(
+			&tree.DatumAlloc{}, col.Type, col.MissingValue,
+		); err != nil {
+			return err
+		}
+	}
+
 	for idx := range args.Spec.FetchedColumns {
 		colID := args.Spec.FetchedColumns[idx].ColumnID
 		table.colIdxMap.Set(colID, idx)
@@ -1109,7 +1137,8 @@ func (rf *Fetcher) processKV(
 }
 
 // processValueSingle processes the given value (of column colID), setting
-// values in table.row accordingly. The key is only used for logging.
+// values in table.row accordingly. The key is used for logging and to decrypt
+// encrypted values.
 func (rf *Fetcher) processValueSingle(
 	table *tableInfo, colID descpb.ColumnID, kv roachpb.KeyValue, prettyKeyPrefix string,
 ) (prettyKey string, prettyValue string, err error) {
@@ -1128,12 +1157,20 @@ func (rf *Fetcher) processValueSingle(
 		return prettyKey, "", nil
 	}
 	typ := table.spec.FetchedColumns[idx].Type
+	rawValue := kv.Value
+	if table.spec.FetchedColumns[idx].IsEncrypted {
+		if rawValue, err = table.encryptionKeys.DecryptFetchedValue(
+			&table.spec.FetchedColumns[idx], kv.Key, rawValue,
+		); err != nil {
+			return "", "", err
+		}
+	}
 	// TODO(arjun): The value is a directly marshaled single value, so we
 	// unmarshal it eagerly here. This can potentially be optimized out,
 	// although that would require changing UnmarshalColumnValue to operate
 	// on bytes, and for Encode/DecodeTableValue to operate on marshaled
 	// single values.
-	value, err := valueside.UnmarshalLegacy(rf.args.Alloc, typ, kv.Value)
+	value, err := valueside.UnmarshalLegacy(rf.args.Alloc, typ, rawValue)
 	if err != nil {
 		return "", "", err
 	}
@@ -1350,7 +1387,8 @@ func (rf *Fetcher) finalizeRow() error {
 		}
 	}
 
-	// Fill in any missing values with NULLs
+	// Fill in any missing values with the missing value of the column if it
+	// has one, and NULLs otherwise.
 	for i := range table.spec.FetchedColumns {
 		col := &table.spec.FetchedColumns[i]
 		if rf.valueColsFound == table.neededValueCols {
@@ -1358,6 +1396,12 @@ func (rf *Fetcher) finalizeRow() error {
 			return nil
 		}
 		if table.row[i].IsUnset() {
+			if table.missingValues != nil && table.missingValues[i] != nil && !table.rowIsDeleted {
+				// The row was written before the column was added.
+				table.row[i] = rowenc.EncDatum{Datum: table.missingValues[i]}
+				rf.valueColsFound++
+				continue
+			}
 			// If the row was deleted, we'll be missing any non-primary key
 			// columns, including nullable ones, but this is expected. If the column
 			// is not yet active, we can also expect NULLs.
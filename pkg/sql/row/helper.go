@@ -20,6 +20,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/colencryption"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
@@ -87,6 +88,11 @@ type RowHelper struct {
 	// Secondary indexes.
 	Indexes []catalog.Index
 
+	// EncryptionKeys holds the key rings used to encrypt the values of the
+	// table's encrypted columns. It must be set before writing non-NULL values
+	// to encrypted columns.
+	EncryptionKeys colencryption.TableKeys
+
 	// Unique indexes that can be enforced with tombstones.
 	UniqueWithTombstoneIndexes intsets.Fast
 	indexEntries               map[catalog.Index][]rowenc.IndexEntry
@@ -142,6 +148,19 @@ func NewRowHelper(
 	}
 }
 
+// encryptValue encrypts the marshaled value of an encrypted column, to be
+// stored under the given KV key.
+func (rh *RowHelper) encryptValue(
+	col catalog.Column, key roachpb.Key, v roachpb.Value,
+) (roachpb.Value, error) {
+	r, ok := rh.EncryptionKeys[col.GetID()]
+	if !ok {
+		return roachpb.Value{}, pgerror.Newf(pgcode.FeatureNotSupported,
+			"writing to encrypted column %q is not supported by this operation", col.GetName())
+	}
+	return r.EncryptValue(v, key)
+}
+
 // lazyIndexDirs represents encoding directions of an index. Those directions
 // may not have been, and may never be computed. The value of -2 represents
 // empty encoding directions. The value of -1 represents the encoding directions
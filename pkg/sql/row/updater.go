@@ -16,6 +16,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/colencryption"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowinfra"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
@@ -664,6 +665,13 @@ func (ru *Updater) IsColumnOnlyUpdate() bool {
 	return !ru.primaryKeyColChange && ru.DeleteHelper == nil && len(ru.Helper.Indexes) == 0
 }
 
+// SetEncryptionKeys sets the key rings used to encrypt the values written to
+// the table's encrypted columns.
+func (ru *Updater) SetEncryptionKeys(keys colencryption.TableKeys) {
+	ru.Helper.EncryptionKeys = keys
+	ru.ri.Helper.EncryptionKeys = keys
+}
+
 func updateCPutFn(
 	ctx context.Context,
 	b Putter,
@@ -181,10 +181,22 @@ func prepareInsertOrUpdateBatch(
 				if err != nil {
 					return nil, err
 				}
+				if fetchedCols[idx].IsEncrypted() && marshaled.IsPresent() {
+					if marshaled, err = helper.encryptValue(fetchedCols[idx], *kvKey, marshaled); err != nil {
+						return nil, err
+					}
+				}
 			}
 
 			var oldVal []byte
 			if (oth.IsSet() || mustValidateOldPKValues) && len(oldValues) > 0 {
+				if fetchedCols[idx].IsEncrypted() {
+					// Encrypted values use a random nonce, so the expected previous
+					// value cannot be reproduced. Tables with encrypted columns have
+					// multiple column families, which rules out these code paths.
+					return nil, errors.AssertionFailedf(
+						"cannot validate the previous value of encrypted column %q", fetchedCols[idx].GetName())
+				}
 				// If the column could be composite, we only encode the old value if it
 				// was a composite value.
 				if !couldBeComposite || oldValues[idx].(tree.CompositeDatum).IsComposite() {
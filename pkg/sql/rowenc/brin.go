@@ -0,0 +1,338 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package rowenc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc/valueside"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
+	"github.com/cockroachdb/errors"
+)
+
+// BRIN indexes summarize contiguous blocks of rows of the primary index with
+// the minimum and maximum values of the indexed columns. The keyspace of a
+// BRIN index holds two kinds of entries:
+//
+//   - Block summaries, keyed by the index prefix, brinBlocksTag and the
+//     primary key of the first row of the block. Their value holds the primary
+//     key of the last row of the block, the number of rows in the block, and
+//     the minimum, maximum and presence of NULLs of each indexed column.
+//     Summaries are built by the index backfill and by
+//     crdb_internal.brin_summarize, which the sql.BrinSummarizer calls in the
+//     background once enough rows have been written to the table.
+//   - Pending entries, keyed by the index prefix, brinPendingTag and the
+//     primary key of a row written since its block was last summarized. Their
+//     value holds the indexed columns of the row. Pending entries are written
+//     and deleted with the rows like the entries of any secondary index, so
+//     that writers never read or lock the summaries. Summarizing the index
+//     folds them into the block summaries.
+//
+// A block can only be skipped by a scan if neither its summary nor the
+// pending entries within it can satisfy the filters of the scan.
+
+// DefaultBrinRowsPerBlock is the default number of rows in a block summarized
+// by a BRIN index.
+const DefaultBrinRowsPerBlock = 1024
+
+const (
+	brinBlocksTag  = 1
+	brinPendingTag = 2
+)
+
+// BrinIndex holds the information needed to read and write the entries of a
+// BRIN index.
+type BrinIndex struct {
+	Index catalog.Index
+	// ColIDs and Types are the IDs and types of the indexed columns.
+	ColIDs []descpb.ColumnID
+	Types  []*types.T
+
+	desc          catalog.TableDescriptor
+	blocksPrefix  roachpb.Key
+	pendingPrefix roachpb.Key
+}
+
+// MakeBrinIndex returns the BrinIndex for the given BRIN index of the table.
+func MakeBrinIndex(
+	codec keys.SQLCodec, desc catalog.TableDescriptor, index catalog.Index,
+) (BrinIndex, error) {
+	prefix := MakeIndexKeyPrefix(codec, desc.GetID(), index.GetID())
+	bi := BrinIndex{
+		Index:         index,
+		ColIDs:        make([]descpb.ColumnID, index.NumKeyColumns()),
+		Types:         make([]*types.T, index.NumKeyColumns()),
+		desc:          desc,
+		blocksPrefix:  encoding.EncodeUvarintAscending(slices.Clip(prefix), brinBlocksTag),
+		pendingPrefix: encoding.EncodeUvarintAscending(slices.Clip(prefix), brinPendingTag),
+	}
+	for i := range bi.ColIDs {
+		col, err := catalog.MustFindColumnByID(desc, index.GetKeyColumnID(i))
+		if err != nil {
+			return BrinIndex{}, err
+		}
+		bi.ColIDs[i] = col.GetID()
+		bi.Types[i] = col.GetType()
+	}
+	return bi, nil
+}
+
+// BlocksSpan returns the span of the block summaries of the index.
+func (bi *BrinIndex) BlocksSpan() roachpb.Span {
+	return roachpb.Span{Key: bi.blocksPrefix, EndKey: bi.blocksPrefix.PrefixEnd()}
+}
+
+// PendingSpan returns the span of the pending entries of the index.
+func (bi *BrinIndex) PendingSpan() roachpb.Span {
+	return roachpb.Span{Key: bi.pendingPrefix, EndKey: bi.pendingPrefix.PrefixEnd()}
+}
+
+// BlockKey returns the key of the block starting at the row with the given
+// primary key suffix.
+func (bi *BrinIndex) BlockKey(start roachpb.Key) roachpb.Key {
+	return append(slices.Clip(bi.blocksPrefix), start...)
+}
+
+// PendingKey returns the key of the pending entry of the row with the given
+// primary key suffix.
+func (bi *BrinIndex) PendingKey(row roachpb.Key) roachpb.Key {
+	return append(slices.Clip(bi.pendingPrefix), row...)
+}
+
+// EncodeRow returns the primary key suffix of a row, encoded with the given
+// primary index, and the values of its indexed columns. colMap maps column IDs
+// to positions in values; missing columns are treated as NULLs.
+func (bi *BrinIndex) EncodeRow(
+	primary catalog.Index, colMap catalog.TableColMap, values []tree.Datum,
+) (row roachpb.Key, vals tree.Datums, _ error) {
+	key, _, err := EncodeIndexKey(bi.desc, primary, colMap, values, nil /* keyPrefix */)
+	if err != nil {
+		return nil, nil, err
+	}
+	vals = make(tree.Datums, len(bi.ColIDs))
+	for i, colID := range bi.ColIDs {
+		vals[i] = tree.DNull
+		if ord, ok := colMap.Get(colID); ok {
+			vals[i] = values[ord]
+		}
+	}
+	return key, vals, nil
+}
+
+// encodeBrinPendingEntry encodes the pending entry of a row written to a table
+// with a BRIN index.
+func encodeBrinPendingEntry(
+	tableDesc catalog.TableDescriptor,
+	index catalog.Index,
+	keyPrefix []byte,
+	colMap catalog.TableColMap,
+	values []tree.Datum,
+) (IndexEntry, error) {
+	key := encoding.EncodeUvarintAscending(slices.Clip(keyPrefix), brinPendingTag)
+	key, _, err := EncodeIndexKey(tableDesc, tableDesc.GetPrimaryIndex(), colMap, values, key)
+	if err != nil {
+		return IndexEntry{}, err
+	}
+	var buf []byte
+	for i := 0; i < index.NumKeyColumns(); i++ {
+		val := tree.Datum(tree.DNull)
+		if ord, ok := colMap.Get(index.GetKeyColumnID(i)); ok {
+			val = values[ord]
+		}
+		if buf, err = valueside.Encode(buf, valueside.NoColumnID, val); err != nil {
+			return IndexEntry{}, err
+		}
+	}
+	entry := IndexEntry{Key: key}
+	entry.Value.SetBytes(buf)
+	return entry, nil
+}
+
+// DecodePending decodes a pending entry of the index into the primary key
+// suffix and the indexed values of its row.
+func (bi *BrinIndex) DecodePending(
+	a *tree.DatumAlloc, key roachpb.Key, value *roachpb.Value,
+) (row roachpb.Key, vals tree.Datums, _ error) {
+	if !key.HasPrefix(bi.pendingPrefix) {
+		return nil, nil, errors.AssertionFailedf("unexpected BRIN pending key %s", key)
+	}
+	buf, err := value.GetBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	vals = make(tree.Datums, len(bi.ColIDs))
+	for i := range vals {
+		if vals[i], buf, err = valueside.Decode(a, bi.Types[i], buf); err != nil {
+			return nil, nil, err
+		}
+	}
+	return key[len(bi.pendingPrefix):], vals, nil
+}
+
+// BrinBlock is the summary of a contiguous block of rows of the primary index.
+// Start and End are the primary index keys, without the index prefix, of the
+// first and last rows of the block.
+type BrinBlock struct {
+	Start, End roachpb.Key
+	Count      int64
+	Min, Max   tree.Datums
+	HasNulls   []bool
+}
+
+// NewBlock returns an empty block starting at the row with the given primary
+// key suffix.
+func (bi *BrinIndex) NewBlock(start roachpb.Key) *BrinBlock {
+	b := &BrinBlock{
+		Start:    start,
+		End:      start,
+		Min:      make(tree.Datums, len(bi.ColIDs)),
+		Max:      make(tree.Datums, len(bi.ColIDs)),
+		HasNulls: make([]bool, len(bi.ColIDs)),
+	}
+	for i := range b.Min {
+		b.Min[i], b.Max[i] = tree.DNull, tree.DNull
+	}
+	return b
+}
+
+// Widen extends the summary of the block with the values of the indexed
+// columns of a row.
+func (b *BrinBlock) Widen(ctx context.Context, cmpCtx tree.CompareContext, vals tree.Datums) error {
+	for i, d := range vals {
+		if d == tree.DNull {
+			b.HasNulls[i] = true
+			continue
+		}
+		if b.Min[i] == tree.DNull {
+			b.Min[i], b.Max[i] = d, d
+			continue
+		}
+		if c, err := d.Compare(ctx, cmpCtx, b.Min[i]); err != nil {
+			return err
+		} else if c < 0 {
+			b.Min[i] = d
+		}
+		if c, err := d.Compare(ctx, cmpCtx, b.Max[i]); err != nil {
+			return err
+		} else if c > 0 {
+			b.Max[i] = d
+		}
+	}
+	b.Count++
+	return nil
+}
+
+// EncodeBlock encodes the summary of a block into an index entry.
+func (bi *BrinIndex) EncodeBlock(b *BrinBlock) (_ IndexEntry, err error) {
+	var buf []byte
+	if buf, err = valueside.Encode(buf, valueside.NoColumnID, tree.NewDBytes(tree.DBytes(b.End))); err != nil {
+		return IndexEntry{}, err
+	}
+	if buf, err = valueside.Encode(buf, valueside.NoColumnID, tree.NewDInt(tree.DInt(b.Count))); err != nil {
+		return IndexEntry{}, err
+	}
+	for i := range bi.ColIDs {
+		if buf, err = valueside.Encode(buf, valueside.NoColumnID, b.Min[i]); err != nil {
+			return IndexEntry{}, err
+		}
+		if buf, err = valueside.Encode(buf, valueside.NoColumnID, b.Max[i]); err != nil {
+			return IndexEntry{}, err
+		}
+		if buf, err = valueside.Encode(buf, valueside.NoColumnID, tree.MakeDBool(tree.DBool(b.HasNulls[i]))); err != nil {
+			return IndexEntry{}, err
+		}
+	}
+	entry := IndexEntry{Key: bi.BlockKey(b.Start)}
+	entry.Value.SetBytes(buf)
+	return entry, nil
+}
+
+// DecodeBlock decodes the summary of a block of the index.
+func (bi *BrinIndex) DecodeBlock(
+	a *tree.DatumAlloc, key roachpb.Key, value *roachpb.Value,
+) (*BrinBlock, error) {
+	if !key.HasPrefix(bi.blocksPrefix) {
+		return nil, errors.AssertionFailedf("unexpected BRIN block key %s", key)
+	}
+	b := bi.NewBlock(key[len(bi.blocksPrefix):])
+	buf, err := value.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+	var d tree.Datum
+	if d, buf, err = valueside.Decode(a, types.Bytes, buf); err != nil {
+		return nil, err
+	}
+	b.End = roachpb.Key(tree.MustBeDBytes(d))
+	if d, buf, err = valueside.Decode(a, types.Int, buf); err != nil {
+		return nil, err
+	}
+	b.Count = int64(tree.MustBeDInt(d))
+	for i := range bi.ColIDs {
+		if b.Min[i], buf, err = valueside.Decode(a, bi.Types[i], buf); err != nil {
+			return nil, err
+		}
+		if b.Max[i], buf, err = valueside.Decode(a, bi.Types[i], buf); err != nil {
+			return nil, err
+		}
+		if d, buf, err = valueside.Decode(a, types.Bool, buf); err != nil {
+			return nil, err
+		}
+		b.HasNulls[i] = bool(tree.MustBeDBool(d))
+	}
+	return b, nil
+}
+
+// BrinBlockBuilder builds the summaries of consecutive blocks from rows added
+// in primary key order.
+type BrinBlockBuilder struct {
+	bi           *BrinIndex
+	rowsPerBlock int64
+	cur          *BrinBlock
+}
+
+// NewBlockBuilder returns a builder of blocks of the given number of rows.
+func (bi *BrinIndex) NewBlockBuilder(rowsPerBlock int64) *BrinBlockBuilder {
+	return &BrinBlockBuilder{bi: bi, rowsPerBlock: rowsPerBlock}
+}
+
+// Add adds a row to the current block. If the row starts a new block, the
+// entry of the previous block is returned.
+func (bb *BrinBlockBuilder) Add(
+	ctx context.Context, cmpCtx tree.CompareContext, row roachpb.Key, vals tree.Datums,
+) (entry IndexEntry, ok bool, err error) {
+	if bb.cur != nil && bb.cur.Count >= bb.rowsPerBlock {
+		if entry, ok, err = bb.Finish(); err != nil {
+			return IndexEntry{}, false, err
+		}
+	}
+	if bb.cur == nil {
+		bb.cur = bb.bi.NewBlock(row)
+	}
+	bb.cur.End = row
+	if err := bb.cur.Widen(ctx, cmpCtx, vals); err != nil {
+		return IndexEntry{}, false, err
+	}
+	return entry, ok, nil
+}
+
+// Finish returns the entry of the current block, if any, and resets the
+// builder so that the next row starts a new block.
+func (bb *BrinBlockBuilder) Finish() (entry IndexEntry, ok bool, err error) {
+	if bb.cur == nil {
+		return IndexEntry{}, false, nil
+	}
+	entry, err = bb.bi.EncodeBlock(bb.cur)
+	bb.cur = nil
+	return entry, err == nil, err
+}
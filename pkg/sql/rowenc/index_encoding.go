@@ -1218,7 +1218,13 @@ func EncodePrimaryIndexWithKeyPrefix(
 				if err != nil {
 					return err
 				}
-				value, err := valueside.MarshalLegacy(col.GetType(), datum)
+				typ := col.GetType()
+				if col.IsEncrypted() {
+					// The values of encrypted columns are passed through as their
+					// ciphertext; see PassThroughEncryptedColumns.
+					typ = types.Bytes
+				}
+				value, err := valueside.MarshalLegacy(typ, datum)
 				if err != nil {
 					return err
 				}
@@ -1412,6 +1418,22 @@ func encodeSecondaryIndexWithKeyPrefix(
 	includeEmpty bool,
 	vh VectorIndexEncodingHelper,
 ) ([]IndexEntry, error) {
+	// Summary indexes hold a pending entry for each row until the row is
+	// summarized, see brin.go.
+	if secondaryIndex.GetType().IsSummary() {
+		entry, err := encodeBrinPendingEntry(tableDesc, secondaryIndex, keyPrefix, colMap, values)
+		if err != nil {
+			return []IndexEntry{}, err
+		}
+		entries := []IndexEntry{entry}
+		if secondaryIndex.UseDeletePreservingEncoding() {
+			if err := wrapIndexEntries(entries); err != nil {
+				return nil, err
+			}
+		}
+		return entries, nil
+	}
+
 	// Use the primary key encoding for covering indexes.
 	if secondaryIndex.GetEncodingType() == catenumpb.PrimaryIndexEncoding {
 		return EncodePrimaryIndexWithKeyPrefix(tableDesc, secondaryIndex, keyPrefix, colMap, values,
@@ -11,6 +11,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/buildutil"
 	"github.com/cockroachdb/cockroach/pkg/util/encoding"
 	"github.com/cockroachdb/errors"
@@ -95,6 +96,8 @@ func InitIndexFetchSpec(
 			ColumnID:      colID,
 			Type:          typ,
 			IsNonNullable: !col.IsNullable() && col.Public(),
+			IsEncrypted:   col.IsEncrypted(),
+			MissingValue:  col.GetMissingValue(),
 		}
 	}
 
@@ -113,3 +116,17 @@ func InitIndexFetchSpec(
 
 	return nil
 }
+
+// PassThroughEncryptedColumns changes the fetched encrypted columns of the
+// given spec so that their values are fetched as BYTES datums holding the
+// ciphertext, rather than being decrypted. This allows operations that copy
+// rows, like backfills, to preserve encrypted values without having access to
+// their data keys. EncodePrimaryIndex writes such values back unchanged.
+func PassThroughEncryptedColumns(s *fetchpb.IndexFetchSpec) {
+	for i := range s.FetchedColumns {
+		if col := &s.FetchedColumns[i]; col.IsEncrypted {
+			col.Type = types.Bytes
+			col.IsEncrypted = false
+		}
+	}
+}
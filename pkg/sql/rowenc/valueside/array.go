@@ -210,7 +210,7 @@ func DatumTypeToArrayElementEncodingType(t *types.T) (encoding.Type, error) {
 	case types.DecimalFamily:
 		return encoding.Decimal, nil
 	case types.BytesFamily, types.StringFamily, types.CollatedStringFamily,
-		types.EnumFamily, types.RefCursorFamily:
+		types.EnumFamily, types.RefCursorFamily, types.XMLFamily:
 		return encoding.Bytes, nil
 	case types.TimestampFamily, types.TimestampTZFamily:
 		return encoding.Time, nil
@@ -357,6 +357,8 @@ func encodeArrayElement(b []byte, d tree.Datum) ([]byte, error) {
 		return encoding.EncodeUntaggedBytesValue(b, encoded), nil
 	case *tree.DLTree:
 		return encoding.EncodeUntaggedLTreeValue(b, t.LTree), nil
+	case *tree.DXML:
+		return encoding.EncodeUntaggedBytesValue(b, []byte(t.Contents)), nil
 	default:
 		return nil, errors.Errorf("don't know how to encode %s (%T)", d, d)
 	}
@@ -250,6 +250,12 @@ func DecodeUntaggedDatum(
 	case types.LTreeFamily:
 		b, l, err := encoding.DecodeUntaggedLTreeValue(buf)
 		return tree.NewDLTree(l), b, err
+	case types.XMLFamily:
+		b, data, err := encoding.DecodeUntaggedBytesValue(buf)
+		if err != nil {
+			return nil, b, err
+		}
+		return tree.NewDXML(string(data)), b, nil
 	case types.ArrayFamily:
 		// Skip the encoded data length.
 		b, _, _, err := encoding.DecodeNonsortingUvarint(buf)
@@ -124,6 +124,8 @@ func EncodeWithScratch(
 		return encoding.EncodeIntValue(appendTo, uint32(colID), int64(t.Oid)), scratch, nil
 	case *tree.DLTree:
 		return encoding.EncodeLTreeValue(appendTo, uint32(colID), t.LTree), scratch, nil
+	case *tree.DXML:
+		return encoding.EncodeBytesValue(appendTo, uint32(colID), []byte(t.Contents)), scratch, nil
 	case *tree.DEnum:
 		return encoding.EncodeBytesValue(appendTo, uint32(colID), t.PhysicalRep), scratch, nil
 	case *tree.DVoid:
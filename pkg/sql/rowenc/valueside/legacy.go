@@ -178,6 +178,11 @@ func MarshalLegacy(colType *types.T, val tree.Datum) (roachpb.Value, error) {
 			r.SetBytes(data)
 			return r, nil
 		}
+	case types.XMLFamily:
+		if v, ok := val.(*tree.DXML); ok {
+			r.SetString(v.Contents)
+			return r, nil
+		}
 	case types.ArrayFamily:
 		if v, ok := val.(*tree.DArray); ok {
 			if err := checkElementType(v.ParamTyp, colType.ArrayContents()); err != nil {
@@ -400,6 +405,12 @@ func UnmarshalLegacy(a *tree.DatumAlloc, typ *types.T, value roachpb.Value) (tre
 			return nil, err
 		}
 		return tree.NewDLTree(l), nil
+	case types.XMLFamily:
+		v, err := value.GetBytes()
+		if err != nil {
+			return nil, err
+		}
+		return tree.NewDXML(string(v)), nil
 	case types.ArrayFamily:
 		v, err := value.GetBytes()
 		if err != nil {
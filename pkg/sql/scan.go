@@ -209,7 +209,7 @@ func (n *scanNode) initDescSpecificCol(colCfg scanColumnsConfig, prefixCol catal
 	// table where prefixCol is the key column.
 	foundIndex := false
 	for _, idx := range indexes {
-		if idx.GetType().AllowsPrefixColumns() || idx.IsPartial() {
+		if idx.GetType().AllowsPrefixColumns() || idx.GetType().IsSummary() || idx.IsPartial() {
 			continue
 		}
 		columns := n.desc.IndexKeyColumns(idx)
@@ -24,11 +24,13 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scbuild"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/iterutil"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq/oid"
 )
@@ -359,6 +361,140 @@ func (sr *schemaResolver) ResolveType(
 	return typedesc.HydratedTFromDesc(ctx, &tn, tdesc, sr)
 }
 
+// ResolveCollation implements the tree.CollationResolver interface. The
+// collation is looked up in the schemas of the current database that are on
+// the search path.
+func (sr *schemaResolver) ResolveCollation(
+	ctx context.Context, name string,
+) (locale string, found bool, _ error) {
+	dbName := sr.CurrentDatabase()
+	if dbName == "" {
+		return "", false, nil
+	}
+	g := sr.byNameGetterBuilder().MaybeGet()
+	db, err := g.Database(ctx, dbName)
+	if err != nil || db == nil {
+		return "", false, err
+	}
+	iter := sr.CurrentSearchPath().Iter()
+	for scName, ok := iter.Next(); ok; scName, ok = iter.Next() {
+		sc, err := g.Schema(ctx, db, scName)
+		if err != nil {
+			return "", false, err
+		}
+		if sc == nil || sc.SchemaKind() == catalog.SchemaVirtual {
+			continue
+		}
+		if coll, ok := sc.GetCollation(name); ok {
+			return coll.Locale, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// ResolveCast implements the tree.CastAndOperatorResolver interface. Like
+// operators, casts are only looked up in the schemas of the current database
+// that are on the search path, so that a user who can create objects in a
+// schema cannot change how the expressions of sessions that do not trust the
+// schema are evaluated.
+func (sr *schemaResolver) ResolveCast(
+	ctx context.Context, source, target *types.T,
+) (funcOID oid.Oid, found bool, _ error) {
+	return sr.lookupCast(ctx, source, target, true /* onSearchPath */)
+}
+
+// lookupCast looks up a user-defined cast in the schemas of the current
+// database, either all of them or only those on the search path. Casts are
+// not scoped to a schema, so CREATE CAST and DROP CAST consider all of them.
+func (sr *schemaResolver) lookupCast(
+	ctx context.Context, source, target *types.T, onSearchPath bool,
+) (funcOID oid.Oid, found bool, _ error) {
+	dbName := sr.CurrentDatabase()
+	if dbName == "" {
+		return 0, false, nil
+	}
+	g := sr.byNameGetterBuilder().MaybeGet()
+	db, err := g.Database(ctx, dbName)
+	if err != nil || db == nil {
+		return 0, false, err
+	}
+	var scNames []string
+	if onSearchPath {
+		iter := sr.CurrentSearchPath().Iter()
+		for scName, ok := iter.Next(); ok; scName, ok = iter.Next() {
+			scNames = append(scNames, scName)
+		}
+	} else if err := db.ForEachSchema(func(_ descpb.ID, scName string) error {
+		scNames = append(scNames, scName)
+		return nil
+	}); err != nil {
+		return 0, false, err
+	}
+	for _, scName := range scNames {
+		sc, err := g.Schema(ctx, db, scName)
+		if err != nil {
+			return 0, false, err
+		}
+		if sc == nil || sc.SchemaKind() == catalog.SchemaVirtual {
+			continue
+		}
+		if err := sc.ForEachCast(func(cast descpb.SchemaDescriptor_Cast) error {
+			if cast.SourceType.Oid() == source.Oid() && cast.TargetType.Oid() == target.Oid() {
+				funcOID, found = catid.FuncIDToOID(cast.FunctionID), true
+				return iterutil.StopIteration()
+			}
+			return nil
+		}); err != nil {
+			return 0, false, err
+		}
+		if found {
+			return funcOID, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// ResolveOperators implements the tree.CastAndOperatorResolver interface.
+// The operators are looked up in the schemas of the current database that
+// are on the search path.
+func (sr *schemaResolver) ResolveOperators(
+	ctx context.Context, name string,
+) ([]tree.UserDefinedOperator, error) {
+	dbName := sr.CurrentDatabase()
+	if dbName == "" {
+		return nil, nil
+	}
+	g := sr.byNameGetterBuilder().MaybeGet()
+	db, err := g.Database(ctx, dbName)
+	if err != nil || db == nil {
+		return nil, err
+	}
+	var ret []tree.UserDefinedOperator
+	iter := sr.CurrentSearchPath().Iter()
+	for scName, ok := iter.Next(); ok; scName, ok = iter.Next() {
+		sc, err := g.Schema(ctx, db, scName)
+		if err != nil {
+			return nil, err
+		}
+		if sc == nil || sc.SchemaKind() == catalog.SchemaVirtual {
+			continue
+		}
+		if err := sc.ForEachOperator(func(op descpb.SchemaDescriptor_Operator) error {
+			if op.Name == name {
+				ret = append(ret, tree.UserDefinedOperator{
+					LeftType:  op.LeftType,
+					RightType: op.RightType,
+					FuncOID:   catid.FuncIDToOID(op.FunctionID),
+				})
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
 // ResolveTypeByOID implements the tree.TypeReferenceResolver interface.
 // Note: Type resolution only works for OIDs of user-defined types. Builtin
 // types do not need to be hydrated.
@@ -313,6 +313,7 @@ func ValidateAlterColumnTypeChecks(
 	ctx context.Context,
 	t *tree.AlterTableAlterColumnType,
 	settions *cluster.Settings,
+	typeResolver tree.TypeReferenceResolver,
 	origTyp *types.T,
 	isGeneratedAsIdentity bool,
 	isVirtual bool,
@@ -321,7 +322,11 @@ func ValidateAlterColumnTypeChecks(
 	// Special handling for STRING COLLATE xy to verify that we recognize the language.
 	if t.Collation != "" {
 		if types.IsStringType(typ) {
-			typ = types.MakeCollatedString(typ, t.Collation)
+			locale, err := tree.ResolveCollationLocale(ctx, t.Collation, typeResolver)
+			if err != nil {
+				return typ, err
+			}
+			typ = types.MakeCollatedString(typ, locale)
 		} else {
 			return typ, pgerror.New(pgcode.Syntax, "COLLATE can only be used with string types")
 		}
@@ -119,6 +119,19 @@ func (b *builderState) Ensure(e scpb.Element, target scpb.TargetStatus, meta scp
 		))
 	}
 
+	if t, ok := e.(*scpb.PrimaryIndex); ok && target == scpb.ToPublic {
+		b.checkTableHasNoEncryptedColumns(t.TableID)
+	}
+	if target == scpb.ToAbsent {
+		switch t := e.(type) {
+		case *scpb.Column:
+			b.checkColumnHasNoMaskingPolicy(t.TableID, t.ColumnID)
+		case *scpb.ColumnType:
+			b.checkColumnHasNoMaskingPolicy(t.TableID, t.ColumnID)
+			b.checkColumnIsNotEncrypted(t.TableID, t.ColumnID)
+		}
+	}
+
 	// Henceforth all possibilities lead to the target and metadata being
 	// overwritten. See below for explanations as to why this is legal.
 	oldTarget, oldStatementID := dst.target, dst.metadata.StatementID
@@ -181,6 +194,55 @@ func (b *builderState) Ensure(e scpb.Element, target scpb.TargetStatus, meta scp
 	panic(errors.AssertionFailedf("unsupported incumbent target %s", oldTarget.Status()))
 }
 
+// checkColumnHasNoMaskingPolicy forces a fallback to the legacy schema changer
+// when a column with a masking policy is dropped or has its type changed.
+// Masking policies are not modeled as elements, so only the legacy schema
+// changer maintains the references to their masking functions.
+func (b *builderState) checkColumnHasNoMaskingPolicy(tableID catid.DescID, columnID catid.ColumnID) {
+	b.ensureDescriptor(tableID)
+	tbl, ok := b.descCache[tableID].desc.(catalog.TableDescriptor)
+	if !ok {
+		return
+	}
+	if col := catalog.FindColumnByID(tbl, columnID); col != nil && col.ColumnDesc().MaskingPolicy != nil {
+		panic(scerrors.NotImplementedErrorf(nil, /* n */
+			redact.Sprintf("altering column %q with a masking policy", col.GetName())))
+	}
+}
+
+// checkColumnIsNotEncrypted forces a fallback to the legacy schema changer,
+// which rejects the change, when the type of an encrypted column is altered.
+func (b *builderState) checkColumnIsNotEncrypted(tableID catid.DescID, columnID catid.ColumnID) {
+	b.ensureDescriptor(tableID)
+	tbl, ok := b.descCache[tableID].desc.(catalog.TableDescriptor)
+	if !ok {
+		return
+	}
+	if col := catalog.FindColumnByID(tbl, columnID); col != nil && col.IsEncrypted() {
+		panic(scerrors.NotImplementedErrorf(nil, /* n */
+			redact.Sprintf("altering the type of encrypted column %q", col.GetName())))
+	}
+}
+
+// checkTableHasNoEncryptedColumns forces a fallback to the legacy schema
+// changer when a new primary index is built for a table with encrypted
+// columns. The index backfiller has no access to the column encryption keys,
+// so only the legacy schema changer, which rejects such rebuilds or avoids
+// them altogether, may change these tables.
+func (b *builderState) checkTableHasNoEncryptedColumns(tableID catid.DescID) {
+	b.ensureDescriptor(tableID)
+	tbl, ok := b.descCache[tableID].desc.(catalog.TableDescriptor)
+	if !ok {
+		return
+	}
+	for _, col := range tbl.DeletableColumns() {
+		if col.IsEncrypted() {
+			panic(scerrors.NotImplementedErrorf(nil, /* n */
+				redact.Sprintf("rebuilding the primary index of a table with encrypted column %q", col.GetName())))
+		}
+	}
+}
+
 func (b *builderState) checkForConcurrentSchemaChanges(
 	e scpb.Element, targetStatus scpb.TargetStatus,
 ) *elementState {
@@ -188,7 +250,8 @@ func (b *builderState) checkForConcurrentSchemaChanges(
 	// Check that there are no descriptors which are undergoing a concurrent
 	// schema change which might interfere with this one.
 	checkID := func(id descpb.ID) {
-		if c := b.descCache[id]; c != nil && c.desc != nil && c.desc.HasConcurrentSchemaChanges() {
+		if c := b.descCache[id]; c != nil && c.desc != nil && c.desc.HasConcurrentSchemaChanges() &&
+			!b.enumValuesAddedInTxn(c) {
 			panic(scerrors.ConcurrentSchemaChangeError(c.desc))
 		}
 	}
@@ -209,6 +272,36 @@ func (b *builderState) checkForConcurrentSchemaChanges(
 	return b.getExistingElementState(e)
 }
 
+// enumValuesAddedInTxn returns true if the descriptor is a type whose only
+// pending schema changes are enum values added by an earlier statement in the
+// current transaction. These have not yet been assigned a job.
+func (b *builderState) enumValuesAddedInTxn(c *cachedDesc) bool {
+	typ, ok := c.desc.(catalog.TypeDescriptor)
+	if !ok {
+		return false
+	}
+	if state := typ.GetDeclarativeSchemaChangerState(); state != nil && state.JobID != catpb.InvalidJobID {
+		return false
+	}
+	added := make(map[string]struct{})
+	for _, i := range c.outputIndexes {
+		es := &b.output[i]
+		if v, ok := es.element.(*scpb.EnumTypeValue); ok &&
+			es.initial == scpb.Status_ABSENT && es.target == scpb.ToPublic {
+			added[v.LogicalRepresentation] = struct{}{}
+		}
+	}
+	for i := 0; i < typ.NumEnumMembers(); i++ {
+		if !typ.IsMemberReadOnly(i) {
+			continue
+		}
+		if _, ok := added[typ.GetMemberLogicalRepresentation(i)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ensureDescriptors ensures the presence of all elements for all
 // descriptors referenced inside the element.
 //
@@ -412,7 +505,10 @@ var _ scbuildstmt.TableHelpers = (*builderState)(nil)
 
 // NextTableColumnID implements the scbuildstmt.TableHelpers interface.
 func (b *builderState) NextTableColumnID(table *scpb.Table) (ret catid.ColumnID) {
-	{
+	if b.newDescriptors.Contains(table.TableID) {
+		// Tables created by this schema change have no descriptor to consult.
+		ret = 1
+	} else {
 		b.ensureDescriptor(table.TableID)
 		desc := b.descCache[table.TableID].desc
 		tbl, ok := desc.(catalog.TableDescriptor)
@@ -438,7 +534,7 @@ func (b *builderState) NextTableColumnID(table *scpb.Table) (ret catid.ColumnID)
 
 // NextColumnFamilyID implements the scbuildstmt.TableHelpers interface.
 func (b *builderState) NextColumnFamilyID(table *scpb.Table) (ret catid.FamilyID) {
-	{
+	if !b.newDescriptors.Contains(table.TableID) {
 		b.ensureDescriptor(table.TableID)
 		desc := b.descCache[table.TableID].desc
 		tbl, ok := desc.(catalog.TableDescriptor)
@@ -474,7 +570,9 @@ func (b *builderState) NextViewIndexID(view *scpb.View) (ret catid.IndexID) {
 
 // NextTableConstraintID implements the scbuildstmt.TableHelpers interface.
 func (b *builderState) NextTableConstraintID(tableID catid.DescID) (ret catid.ConstraintID) {
-	{
+	if b.newDescriptors.Contains(tableID) {
+		ret = 1
+	} else {
 		b.ensureDescriptor(tableID)
 		desc := b.descCache[tableID].desc
 		tbl, ok := desc.(catalog.TableDescriptor)
@@ -605,6 +703,19 @@ func (b *builderState) IsTableEmpty(table *scpb.Table) bool {
 	return b.tr.IsTableEmpty(b.ctx, table.TableID, index.IndexID)
 }
 
+func (b *builderState) DatabaseDefaultCollation(dbID catid.DescID) string {
+	// Databases with a default collation are only created by the legacy schema
+	// changer, so databases created in this transaction don't have one.
+	if b.newDescriptors.Contains(dbID) {
+		return ""
+	}
+	db, ok := b.readDescriptor(dbID).(catalog.DatabaseDescriptor)
+	if !ok {
+		panic(errors.AssertionFailedf("descriptor %d is not a database", dbID))
+	}
+	return db.GetDefaultCollation()
+}
+
 // TTLExpirationExpression returns a validated TTL expiration expression for
 // a table's row-level TTL configuration. It verifies that the expression:
 // - type-checks as a TIMESTAMPTZ
@@ -642,7 +753,9 @@ func (b *builderState) TTLExpirationExpression(
 }
 
 func (b *builderState) nextIndexID(id catid.DescID) (ret catid.IndexID) {
-	{
+	if b.newDescriptors.Contains(id) {
+		ret = 1
+	} else {
 		b.ensureDescriptor(id)
 		desc := b.descCache[id].desc
 		tbl, ok := desc.(catalog.TableDescriptor)
@@ -884,6 +997,9 @@ func (b *builderState) ComputedColumnExpression(
 	_, _, ns := scpb.FindNamespace(b.QueryByID(tbl.TableID))
 	tn := tree.MakeTableNameFromPrefix(b.NamePrefix(tbl), tree.Name(ns.Name))
 	b.ensureDescriptor(tbl.TableID)
+	// Tables created by this schema change have no descriptor yet, in which
+	// case tblDesc is nil and only the lookup functions are consulted.
+	tblDesc, _ := b.descCache[tbl.TableID].desc.(catalog.TableDescriptor)
 
 	// In versions before 26.1, computed columns referencing newly added columns
 	// are not supported in the declarative schema changer. Fall back to the
@@ -892,7 +1008,7 @@ func (b *builderState) ComputedColumnExpression(
 		// Use the old validation logic that doesn't support newly added columns.
 		expr, typ, err := schemaexpr.ValidateComputedColumnExpression(
 			b.ctx,
-			b.descCache[tbl.TableID].desc.(catalog.TableDescriptor),
+			tblDesc,
 			d,
 			&tn,
 			exprContext,
@@ -919,7 +1035,7 @@ func (b *builderState) ComputedColumnExpression(
 	// columns by using the lookup functions to query the builder state.
 	expr, typ, err := schemaexpr.ValidateComputedColumnExpressionWithLookup(
 		b.ctx,
-		b.descCache[tbl.TableID].desc.(catalog.TableDescriptor),
+		tblDesc,
 		d,
 		&tn,
 		exprContext,
@@ -1030,9 +1146,33 @@ var _ scbuildstmt.NameResolver = (*builderState)(nil)
 func (b *builderState) NamePrefix(e scpb.Element) tree.ObjectNamePrefix {
 	id := screl.GetDescID(e)
 	b.ensureDescriptor(id)
+	if b.newDescriptors.Contains(id) {
+		return b.newDescriptorNamePrefix(id)
+	}
 	return b.descCache[id].prefix
 }
 
+// newDescriptorNamePrefix derives the name prefix of a descriptor created by
+// this schema change from its namespace element, since there is no descriptor
+// in the catalog to derive it from.
+func (b *builderState) newDescriptorNamePrefix(id catid.DescID) (ret tree.ObjectNamePrefix) {
+	ns := b.QueryByID(id).FilterNamespace().NotToAbsent().MustGetZeroOrOneElement()
+	if ns == nil || ns.DatabaseID == catid.InvalidDescID {
+		return ret
+	}
+	nameOf := func(id catid.DescID) tree.Name {
+		parentNS := b.QueryByID(id).FilterNamespace().NotToAbsent().MustGetOneElement()
+		return tree.Name(parentNS.Name)
+	}
+	ret.CatalogName = nameOf(ns.DatabaseID)
+	ret.ExplicitCatalog = true
+	if ns.SchemaID != catid.InvalidDescID {
+		ret.SchemaName = nameOf(ns.SchemaID)
+		ret.ExplicitSchema = true
+	}
+	return ret
+}
+
 // ResolveDatabase implements the scbuildstmt.NameResolver interface.
 func (b *builderState) ResolveDatabase(
 	name tree.Name, p scbuildstmt.ResolveParams,
@@ -1173,6 +1313,35 @@ func (b *builderState) ResolveUserDefinedTypeType(
 	return b.QueryByID(typ.GetID())
 }
 
+// ResolveAlterableType implements the scbuildstmt.NameResolver interface.
+func (b *builderState) ResolveAlterableType(
+	name *tree.UnresolvedObjectName,
+) scbuildstmt.ElementResultSet {
+	prefix, typ := b.cr.MayResolveType(b.ctx, *name)
+	if typ == nil {
+		panic(sqlerrors.NewUndefinedTypeError(name))
+	}
+	typeName := tree.MakeTypeNameWithPrefix(prefix.NamePrefix(), typ.GetName())
+	switch typ.GetKind() {
+	case descpb.TypeDescriptor_ALIAS:
+		// The implicit array types are not modifiable.
+		panic(pgerror.Newf(pgcode.WrongObjectType,
+			"%q is an implicit array type and cannot be modified", typeName.FQString()))
+	case descpb.TypeDescriptor_MULTIREGION_ENUM:
+		// Multi-region enums can only be modified using ALTER DATABASE.
+		panic(errors.WithHint(pgerror.Newf(pgcode.WrongObjectType,
+			"%q is a multi-region enum and can't be modified using the alter type command",
+			typeName.FQString()),
+			"try adding/removing the region using ALTER DATABASE"))
+	case descpb.TypeDescriptor_TABLE_IMPLICIT_RECORD_TYPE:
+		panic(pgerror.Newf(pgcode.WrongObjectType,
+			"%q is a table's record type and cannot be modified", typeName.FQString()))
+	}
+	b.ensureDescriptor(typ.GetID())
+	b.mustOwn(typ.GetID())
+	return b.QueryByID(typ.GetID())
+}
+
 // ResolveRelation implements the scbuildstmt.NameResolver interface.
 func (b *builderState) ResolveRelation(
 	name *tree.UnresolvedObjectName, p scbuildstmt.ResolveParams,
@@ -1782,6 +1951,12 @@ func (b *builderState) BuildReferenceProvider(stmt tree.Statement) scbuildstmt.R
 	return provider
 }
 
+func (b *builderState) TypeCheckWithColumnType(
+	dependentID, tableID descpb.ID, columnID descpb.ColumnID, typ *types.T,
+) error {
+	return b.referenceProviderFactory.TypeCheckWithColumnType(b.ctx, dependentID, tableID, columnID, typ)
+}
+
 func (b *builderState) BuildUserPrivilegesFromDefaultPrivileges(
 	db *scpb.Database,
 	sc *scpb.Schema,
@@ -1838,6 +2013,39 @@ func (b *builderState) BuildUserPrivilegesFromDefaultPrivileges(
 	return ownerElem, upsElems
 }
 
+// CastOrOperatorUsingFunction implements the scbuildstmt.FunctionHelpers
+// interface.
+func (b *builderState) CastOrOperatorUsingFunction(fnID descpb.ID) string {
+	fn, ok := b.readDescriptor(fnID).(catalog.FunctionDescriptor)
+	if !ok {
+		panic(errors.AssertionFailedf("descriptor %d is not a function", fnID))
+	}
+	db, ok := b.readDescriptor(fn.GetParentID()).(catalog.DatabaseDescriptor)
+	if !ok {
+		panic(errors.AssertionFailedf("descriptor %d is not a database", fn.GetParentID()))
+	}
+	dependent, err := catalog.FindCastOrOperatorUsingFunction(db, fnID, func(
+		id descpb.ID,
+	) (catalog.SchemaDescriptor, error) {
+		sc, ok := b.readDescriptor(id).(catalog.SchemaDescriptor)
+		if !ok {
+			return nil, errors.AssertionFailedf("descriptor %d is not a schema", id)
+		}
+		return sc, nil
+	}, func(t *types.T) string {
+		if t.UserDefined() {
+			if typ, err := b.cr.ResolveTypeByOID(b.ctx, t.Oid()); err == nil {
+				return typ.SQLString()
+			}
+		}
+		return t.SQLString()
+	})
+	if err != nil {
+		panic(err)
+	}
+	return dependent
+}
+
 func (b *builderState) WrapFunctionBody(
 	fnID descpb.ID,
 	bodyStr string,
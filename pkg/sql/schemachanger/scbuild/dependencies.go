@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
 	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
 	"github.com/cockroachdb/cockroach/pkg/util/uuid"
@@ -267,6 +268,14 @@ type ReferenceProvider interface {
 // provide all dependencies required by the statement.
 type ReferenceProviderFactory interface {
 	NewReferenceProvider(ctx context.Context, stmt tree.Statement) (ReferenceProvider, error)
+
+	// TypeCheckWithColumnType re-plans the query of a view or the body of a
+	// routine as if a column of a table it depends on had the given type. An
+	// error is returned if the query or body no longer type-checks, or if the
+	// view would produce columns of different types than the ones it stores.
+	TypeCheckWithColumnType(
+		ctx context.Context, dependentID, tableID descpb.ID, columnID descpb.ColumnID, typ *types.T,
+	) error
 }
 
 // Export dependency interfaces.
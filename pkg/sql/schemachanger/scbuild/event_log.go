@@ -368,7 +368,9 @@ func (pb payloadBuilder) build(b buildCtx) logpb.EventPayload {
 		}
 	case *scpb.Table:
 		if pb.TargetStatus == scpb.Status_PUBLIC {
-			return nil
+			return &eventpb.CreateTable{
+				TableName: fullyQualifiedName(b, e),
+			}
 		} else {
 			return &eventpb.DropTable{
 				TableName:           fullyQualifiedName(b, e),
@@ -377,7 +379,10 @@ func (pb payloadBuilder) build(b buildCtx) logpb.EventPayload {
 		}
 	case *scpb.View:
 		if pb.TargetStatus == scpb.Status_PUBLIC {
-			return nil
+			return &eventpb.CreateView{
+				ViewName:  fullyQualifiedName(b, e),
+				ViewQuery: e.ViewQuery,
+			}
 		} else {
 			return &eventpb.DropView{
 				ViewName:            fullyQualifiedName(b, e),
@@ -396,7 +401,9 @@ func (pb payloadBuilder) build(b buildCtx) logpb.EventPayload {
 		}
 	case *scpb.EnumType:
 		if pb.TargetStatus == scpb.Status_PUBLIC {
-			return nil
+			return &eventpb.CreateType{
+				TypeName: fullyQualifiedName(b, e),
+			}
 		} else {
 			return &eventpb.DropType{
 				TypeName: fullyQualifiedName(b, e),
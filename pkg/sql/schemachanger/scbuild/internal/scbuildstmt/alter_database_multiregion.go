@@ -0,0 +1,334 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/config/zonepb"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/multiregion"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/regions"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
+	"github.com/cockroachdb/errors"
+)
+
+// AlterDatabaseSurvivalGoal implements ALTER DATABASE ... SURVIVE.
+func AlterDatabaseSurvivalGoal(b BuildCtx, n *tree.AlterDatabaseSurvivalGoal) {
+	elts := resolveMultiRegionDatabase(b, n, n.Name)
+	regionConfig := elts.FilterDatabaseRegionConfig().NotToAbsent().MustGetZeroOrOneElement()
+	if regionConfig == nil {
+		panic(errors.WithHintf(
+			pgerror.New(pgcode.InvalidName,
+				"database must have associated regions before a survival goal can be set",
+			),
+			"you must first add a primary region to the database using "+
+				"ALTER DATABASE %s PRIMARY REGION <region_name>",
+			n.Name.String(),
+		))
+	}
+	survivalGoal := translateSurvivalGoal(n.SurvivalGoal)
+	if survivalGoal == descpb.SurvivalGoal_REGION_FAILURE &&
+		descpb.DataPlacement(regionConfig.Placement) == descpb.DataPlacement_RESTRICTED {
+		panic(errors.WithDetailf(
+			pgerror.New(pgcode.InvalidParameterValue,
+				"a region-survivable database cannot also have a restricted placement policy"),
+			"PLACEMENT RESTRICTED may only be used with SURVIVE ZONE FAILURE",
+		))
+	}
+	current, err := b.SynthesizeRegionConfig(b, regionConfig.DatabaseID)
+	if err != nil {
+		panic(err)
+	}
+	if survivalGoal == descpb.SurvivalGoal_REGION_FAILURE {
+		for _, sr := range current.SuperRegions() {
+			if err := multiregion.CanSatisfySurvivalGoal(survivalGoal, len(sr.Regions)); err != nil {
+				panic(errors.Wrapf(err, "super region %s only has %d region(s)", sr.SuperRegionName, len(sr.Regions)))
+			}
+		}
+	}
+	telemetry.Inc(sqltelemetry.AlterDatabaseSurvivalGoalCounter(n.SurvivalGoal.TelemetryName()))
+
+	newRegionConfig := *regionConfig
+	newRegionConfig.SurvivalGoal = uint32(survivalGoal)
+	// Replica placement of all tables depends on the survival goal.
+	updateDatabaseRegionConfig(b, regionConfig, &newRegionConfig,
+		current.WithSurvivalGoal(survivalGoal), false /* onlyGlobalTables */)
+	b.LogEventForExistingPayload(&newRegionConfig, &eventpb.AlterDatabaseSurvivalGoal{
+		DatabaseName: string(n.Name),
+		SurvivalGoal: survivalGoal.String(),
+	})
+}
+
+// AlterDatabasePlacement implements ALTER DATABASE ... PLACEMENT.
+func AlterDatabasePlacement(b BuildCtx, n *tree.AlterDatabasePlacement) {
+	if !b.SessionData().PlacementEnabled {
+		panic(errors.WithHint(pgerror.New(
+			pgcode.ExperimentalFeature,
+			"ALTER DATABASE PLACEMENT requires that the session setting "+
+				"enable_multiregion_placement_policy is enabled",
+		),
+			"to enable, enable the session setting or the cluster "+
+				"setting sql.defaults.multiregion_placement_policy.enabled",
+		))
+	}
+	elts := resolveMultiRegionDatabase(b, n, n.Name)
+	regionConfig := elts.FilterDatabaseRegionConfig().NotToAbsent().MustGetZeroOrOneElement()
+	if regionConfig == nil {
+		panic(errors.WithHintf(
+			pgerror.New(pgcode.InvalidName,
+				"database must have associated regions before a placement policy can be set",
+			),
+			"you must first add a primary region to the database using "+
+				"ALTER DATABASE %s PRIMARY REGION <region_name>",
+			n.Name.String(),
+		))
+	}
+	placement := translateDataPlacement(n.Placement)
+	if placement == descpb.DataPlacement_RESTRICTED &&
+		descpb.SurvivalGoal(regionConfig.SurvivalGoal) == descpb.SurvivalGoal_REGION_FAILURE {
+		panic(errors.WithDetailf(
+			pgerror.New(pgcode.InvalidParameterValue,
+				"a region-survivable database cannot also have a restricted placement policy"),
+			"PLACEMENT RESTRICTED may only be used with SURVIVE ZONE FAILURE",
+		))
+	}
+	current, err := b.SynthesizeRegionConfig(b, regionConfig.DatabaseID)
+	if err != nil {
+		panic(err)
+	}
+	telemetry.Inc(sqltelemetry.AlterDatabasePlacementCounter(n.Placement.TelemetryName()))
+
+	newRegionConfig := *regionConfig
+	newRegionConfig.Placement = uint32(placement)
+	// GLOBAL tables inherit the database's zone configuration under the DEFAULT
+	// placement policy, but need a bespoke one which keeps their non-voters
+	// under the RESTRICTED policy. Other tables are unaffected.
+	updateDatabaseRegionConfig(b, regionConfig, &newRegionConfig,
+		current.WithPlacement(placement), true /* onlyGlobalTables */)
+	b.LogEventForExistingPayload(&newRegionConfig, &eventpb.AlterDatabasePlacement{
+		DatabaseName: string(n.Name),
+		Placement:    placement.String(),
+	})
+}
+
+// resolveMultiRegionDatabase resolves the database targeted by a multi-region
+// ALTER DATABASE statement and checks that the user may modify it. The system
+// database is left to the legacy schema changer.
+func resolveMultiRegionDatabase(b BuildCtx, n tree.Statement, name tree.Name) ElementResultSet {
+	elts := b.ResolveDatabase(name, ResolveParams{})
+	db := elts.FilterDatabase().MustGetOneElement()
+	if db.DatabaseID == keys.SystemDatabaseID {
+		panic(scerrors.NotImplementedErrorf(n, "multi-region operations on the system database"))
+	}
+	if !b.CurrentUserHasAdminOrIsMemberOf(username.AdminRoleName()) &&
+		b.CheckPrivilege(db, privilege.CREATE) != nil {
+		panic(pgerror.Newf(pgcode.InsufficientPrivilege,
+			"user %s must be owner of %s or have %s privilege on database %s",
+			b.CurrentUser(), name, privilege.CREATE, name))
+	}
+	return elts
+}
+
+// updateDatabaseRegionConfig replaces the region config of a multi-region
+// database and rewrites the multi-region fields of the zone configurations of
+// the database and its tables to match regionConfig.
+func updateDatabaseRegionConfig(
+	b BuildCtx,
+	oldElem, newElem *scpb.DatabaseRegionConfig,
+	regionConfig multiregion.RegionConfig,
+	onlyGlobalTables bool,
+) {
+	dbID := oldElem.DatabaseID
+	currentZoneConfig, seqNum := mostRecentDatabaseZoneConfig(b, dbID)
+	panicIfDatabaseZoneConfigModifiedByUser(b, dbID, currentZoneConfig)
+
+	newElem.SeqNum = oldElem.SeqNum + 1
+	b.Drop(oldElem)
+	b.Add(newElem)
+
+	dbZoneConfig, err := regions.ZoneConfigForMultiRegionDatabase(regionConfig)
+	if err != nil {
+		panic(err)
+	}
+	if err := dbZoneConfig.Validate(); err != nil {
+		panic(pgerror.Wrap(err, pgcode.CheckViolation, "could not validate zone config"))
+	}
+	if err := zonepb.ValidateNoRepeatKeysInZone(&dbZoneConfig); err != nil {
+		panic(err)
+	}
+	newZoneConfig := protoutil.Clone(currentZoneConfig).(*zonepb.ZoneConfig)
+	newZoneConfig.CopyFromZone(dbZoneConfig, zonepb.MultiRegionZoneConfigFields)
+	b.Add(&scpb.DatabaseZoneConfig{
+		DatabaseID: dbID,
+		ZoneConfig: newZoneConfig,
+		SeqNum:     seqNum + 1,
+	})
+
+	forEachTableInDatabase(b, dbID, func(tableID catid.DescID) {
+		localityConfig, ok := tableLocalityConfig(b, tableID)
+		if !ok || (onlyGlobalTables && localityConfig.GetGlobal() == nil) {
+			return
+		}
+		var indexIDs []descpb.IndexID
+		tableElts := b.QueryByID(tableID)
+		tableElts.FilterPrimaryIndex().NotToAbsent().ForEach(func(
+			_ scpb.Status, _ scpb.TargetStatus, e *scpb.PrimaryIndex,
+		) {
+			indexIDs = append(indexIDs, e.IndexID)
+		})
+		tableElts.FilterSecondaryIndex().NotToAbsent().ForEach(func(
+			_ scpb.Status, _ scpb.TargetStatus, e *scpb.SecondaryIndex,
+		) {
+			indexIDs = append(indexIDs, e.IndexID)
+		})
+		if err := applyZoneConfigForMultiRegionTable(b, regionConfig, tableID,
+			applyZoneConfigForMultiRegionTableOptionTableAndIndexes(localityConfig, indexIDs...),
+		); err != nil {
+			panic(err)
+		}
+	})
+}
+
+// mostRecentDatabaseZoneConfig returns the zone configuration of the database
+// and its sequence number. An empty zone configuration is returned if none is
+// set.
+func mostRecentDatabaseZoneConfig(b BuildCtx, dbID catid.DescID) (*zonepb.ZoneConfig, uint32) {
+	var mostRecent *scpb.DatabaseZoneConfig
+	b.QueryByID(dbID).FilterDatabaseZoneConfig().NotToAbsent().ForEach(func(
+		_ scpb.Status, _ scpb.TargetStatus, e *scpb.DatabaseZoneConfig,
+	) {
+		if mostRecent == nil || mostRecent.SeqNum <= e.SeqNum {
+			mostRecent = e
+		}
+	})
+	if mostRecent == nil || mostRecent.ZoneConfig == nil {
+		return zonepb.NewZoneConfig(), 0
+	}
+	return mostRecent.ZoneConfig, mostRecent.SeqNum
+}
+
+// panicIfDatabaseZoneConfigModifiedByUser panics if the user modified the
+// multi-region fields of the zone configuration of the database, which would
+// be overwritten, unless override_multi_region_zone_config is set.
+func panicIfDatabaseZoneConfigModifiedByUser(
+	b BuildCtx, dbID catid.DescID, currentZoneConfig *zonepb.ZoneConfig,
+) {
+	if b.SessionData().OverrideMultiRegionZoneConfigEnabled {
+		telemetry.Inc(sqltelemetry.OverrideMultiRegionDatabaseZoneConfigurationSystem)
+		return
+	}
+	regionConfig, err := b.SynthesizeRegionConfig(b, dbID, multiregion.SynthesizeRegionConfigOptionForValidation)
+	if err != nil {
+		panic(err)
+	}
+	expected, err := regions.ZoneConfigForMultiRegionDatabase(regionConfig)
+	if err != nil {
+		panic(err)
+	}
+	same, mismatch, err := currentZoneConfig.DiffWithZone(expected, zonepb.MultiRegionZoneConfigFields)
+	if err != nil {
+		panic(err)
+	}
+	if same {
+		return
+	}
+	dbName := tree.Name(simpleName(b, dbID))
+	panic(errors.WithHint(
+		errors.WithDetail(
+			pgerror.Newf(pgcode.InvalidObjectDefinition,
+				"attempting to update zone configuration for database %s which contains modified field %q (expected=%s actual=%s)",
+				dbName.String(), mismatch.Field, mismatch.Expected, mismatch.Actual,
+			),
+			"the attempted operation will overwrite a user modified field",
+		),
+		"to proceed with the overwrite, SET override_multi_region_zone_config = true, "+
+			"and reissue the statement",
+	))
+}
+
+// forEachTableInDatabase calls fn for each table in the database which is not
+// being dropped.
+func forEachTableInDatabase(b BuildCtx, dbID catid.DescID, fn func(tableID catid.DescID)) {
+	b.BackReferences(dbID).FilterSchema().ForEach(func(
+		_ scpb.Status, _ scpb.TargetStatus, sc *scpb.Schema,
+	) {
+		b.BackReferences(sc.SchemaID).FilterTable().NotToAbsent().ForEach(func(
+			_ scpb.Status, _ scpb.TargetStatus, tbl *scpb.Table,
+		) {
+			fn(tbl.TableID)
+		})
+	})
+}
+
+// tableLocalityConfig returns the locality of a table, if it has one.
+func tableLocalityConfig(b BuildCtx, tableID catid.DescID) (catpb.LocalityConfig, bool) {
+	elts := b.QueryByID(tableID)
+	if elts.FilterTableLocalityGlobal().NotToAbsent().MustGetZeroOrOneElement() != nil {
+		return catpb.LocalityConfig{
+			Locality: &catpb.LocalityConfig_Global_{Global: &catpb.LocalityConfig_Global{}},
+		}, true
+	}
+	if e := elts.FilterTableLocalityRegionalByRow().NotToAbsent().MustGetZeroOrOneElement(); e != nil {
+		rbr := &catpb.LocalityConfig_RegionalByRow{}
+		if e.As != "" {
+			as := e.As
+			rbr.As = &as
+		}
+		return catpb.LocalityConfig{
+			Locality: &catpb.LocalityConfig_RegionalByRow_{RegionalByRow: rbr},
+		}, true
+	}
+	if e := elts.FilterTableLocalitySecondaryRegion().NotToAbsent().MustGetZeroOrOneElement(); e != nil {
+		region := e.RegionName
+		return catpb.LocalityConfig{
+			Locality: &catpb.LocalityConfig_RegionalByTable_{
+				RegionalByTable: &catpb.LocalityConfig_RegionalByTable{Region: &region},
+			},
+		}, true
+	}
+	if elts.FilterTableLocalityPrimaryRegion().NotToAbsent().MustGetZeroOrOneElement() != nil {
+		return catpb.LocalityConfig{
+			Locality: &catpb.LocalityConfig_RegionalByTable_{
+				RegionalByTable: &catpb.LocalityConfig_RegionalByTable{},
+			},
+		}, true
+	}
+	return catpb.LocalityConfig{}, false
+}
+
+func translateSurvivalGoal(g tree.SurvivalGoal) descpb.SurvivalGoal {
+	switch g {
+	case tree.SurvivalGoalDefault, tree.SurvivalGoalZoneFailure:
+		return descpb.SurvivalGoal_ZONE_FAILURE
+	case tree.SurvivalGoalRegionFailure:
+		return descpb.SurvivalGoal_REGION_FAILURE
+	default:
+		panic(errors.AssertionFailedf("unknown survival goal: %d", g))
+	}
+}
+
+func translateDataPlacement(p tree.DataPlacement) descpb.DataPlacement {
+	switch p {
+	case tree.DataPlacementUnspecified, tree.DataPlacementDefault:
+		return descpb.DataPlacement_DEFAULT
+	case tree.DataPlacementRestricted:
+		return descpb.DataPlacement_RESTRICTED
+	default:
+		panic(errors.AssertionFailedf("unknown data placement: %d", p))
+	}
+}
@@ -16,7 +16,6 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
-	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
 	"github.com/cockroachdb/errors"
 )
@@ -111,6 +110,11 @@ func AlterSequence(b BuildCtx, n *tree.AlterSequence) {
 				if err != nil {
 					panic(pgerror.Wrapf(err, pgcode.Internal, "invalid sequence option value for %q", name))
 				}
+			case tree.SeqOptCycle:
+				currentOpts.Cycle, err = strconv.ParseBool(e.Value)
+				if err != nil {
+					panic(pgerror.Wrapf(err, pgcode.Internal, "invalid sequence option value for %q", name))
+				}
 			default:
 				panic(pgerror.Newf(pgcode.Internal, "unexpected sequence option %q", name))
 			}
@@ -136,6 +140,9 @@ func AlterSequence(b BuildCtx, n *tree.AlterSequence) {
 	}
 
 	// Compute the updated sequence options.
+	if err := schemaexpr.CheckSequenceCycleSupported(b, b.ClusterSettings().Version, n.Options); err != nil {
+		panic(err)
+	}
 	updatedOpts := currentOpts
 	if err := schemaexpr.AssignSequenceOptions(
 		&updatedOpts,
@@ -202,10 +209,9 @@ func AlterSequence(b BuildCtx, n *tree.AlterSequence) {
 		switch name := opt.Name; name {
 		case tree.SeqOptAs:
 			_ = updateElement(name, updatedOpts.AsIntegerType, updatedOpts.AsIntegerType == defaultOpts.AsIntegerType)
-		case tree.SeqOptCycle:
-			panic(unimplemented.NewWithIssue(20961, "CYCLE option is not supported"))
-		case tree.SeqOptNoCycle:
-			// Noop for a default that can't be modified.
+		case tree.SeqOptCycle, tree.SeqOptNoCycle:
+			// Both options are stored under the CYCLE key.
+			_ = updateElement(tree.SeqOptCycle, fmtBool(updatedOpts.Cycle), updatedOpts.Cycle == defaultOpts.Cycle)
 		case tree.SeqOptCacheNode:
 			_ = updateElement(name, fmtInt(updatedOpts.NodeCacheSize), updatedOpts.NodeCacheSize == defaultOpts.NodeCacheSize)
 		case tree.SeqOptCacheSession:
@@ -52,6 +52,7 @@ var supportedAlterTableStatements = map[reflect.Type]supportedAlterTableCommand{
 	reflect.TypeOf((*tree.AlterTableSetStorageParams)(nil)):   {fn: AlterTableSetStorageParams, on: true, checks: isV261Active},
 	reflect.TypeOf((*tree.AlterTableResetStorageParams)(nil)): {fn: AlterTableResetStorageParams, on: true, checks: isV261Active},
 	reflect.TypeOf((*tree.AlterTableSetTrigger)(nil)):         {fn: alterTableSetTrigger, on: true, checks: isV262Active},
+	reflect.TypeOf((*tree.AlterTablePartitionByTable)(nil)):   {fn: alterTablePartitionByTable, on: true, checks: alterTablePartitionByTableChecks},
 }
 
 // alterTableSubcommandNames maps ALTER TABLE command types to their subcommand
@@ -82,6 +83,7 @@ var alterTableSubcommandNames = map[reflect.Type]string{
 	reflect.TypeOf((*tree.AlterTableSetStorageParams)(nil)):   "SET STORAGE PARAM",
 	reflect.TypeOf((*tree.AlterTableResetStorageParams)(nil)): "RESET STORAGE PARAM",
 	reflect.TypeOf((*tree.AlterTableSetTrigger)(nil)):         "SET TRIGGER",
+	reflect.TypeOf((*tree.AlterTablePartitionByTable)(nil)):   "PARTITION BY",
 }
 
 func init() {
@@ -26,6 +26,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scdecomp"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
@@ -67,6 +68,9 @@ func alterTableAddColumn(
 		}
 		panic(sqlerrors.NewColumnAlreadyExistsInRelationError(string(d.Name), tn.Object()))
 	}
+	if d.IsEncrypted() {
+		panic(scerrors.NotImplementedErrorf(d, "adding an encrypted column"))
+	}
 	var colSerialDefaultExpression *scpb.Expression
 	if d.IsSerial || d.GeneratedIdentity.IsGeneratedAsIdentity {
 		d, colSerialDefaultExpression = alterTableAddColumnSerialOrGeneratedIdentity(b, d, tn)
@@ -100,6 +104,8 @@ func alterTableAddColumn(
 	if d.IsComputed() {
 		d.Computed.Expr = schemaexpr.MaybeRewriteComputedColumn(d.Computed.Expr, b.SessionData())
 	}
+	_, _, tableNamespace := scpb.FindNamespace(b.QueryByID(tbl.TableID))
+	d = d.WithDatabaseCollation(b.DatabaseDefaultCollation(tableNamespace.DatabaseID))
 	cdd, err := tabledesc.MakeColumnDefDescs(b, d, b.SemaCtx(), b.EvalCtx(), tree.ColumnDefaultExprInAddColumn)
 	if err != nil {
 		panic(err)
@@ -149,7 +155,6 @@ func alterTableAddColumn(
 		ElementCreationMetadata: scdecomp.NewElementCreationMetadata(b.EvalCtx().Settings.Version.ActiveVersion(b)),
 	}
 
-	_, _, tableNamespace := scpb.FindNamespace(b.QueryByID(tbl.TableID))
 	spec.colType.TypeT = b.ResolveTypeRef(d.Type)
 	if spec.colType.TypeT.Type.UserDefined() {
 		typeID := typedesc.UserDefinedTypeOIDToID(spec.colType.TypeT.Type.Oid())
@@ -267,6 +272,14 @@ func alterTableAddColumn(
 			Expression: *colSerialDefaultExpression,
 		}
 		b.IncrementSchemaChangeAddColumnQualificationCounter("default_expr")
+		// Existing rows need not be backfilled if they can decode to a constant
+		// missing value instead.
+		spec.colType.MissingValue = schemaexpr.MakeMissingValue(
+			b, b.EvalCtx(), desc, cdd.DefaultExpr, b.EvalCtx().Settings.Version.ActiveVersion(b),
+		)
+		if spec.colType.MissingValue != nil {
+			b.IncrementSchemaChangeAddColumnQualificationCounter("missing_value")
+		}
 	}
 	// We're checking to see if a user is trying add a non-nullable column without a default to a
 	// non-empty table by scanning the primary index span with a limit of 1 to see if any key exists.
@@ -547,13 +560,16 @@ func addColumn(b BuildCtx, spec addColumnSpec, n tree.NodeFormatter) (backing *s
 		}
 
 		inflatedChain := getInflatedPrimaryIndexChain(b, spec.tbl.TableID)
-		if spec.def == nil && spec.compute == nil && spec.transientCompute == nil {
+		if (spec.def == nil || spec.colType.MissingValue != nil) &&
+			spec.compute == nil && spec.transientCompute == nil {
 			// Optimization opportunity: if we were to add a new column without default
 			// value nor computed expression, then we can just add the column to existing
 			// non-nil primary indexes without actually backfilling any data. This is
 			// achieved by inflating the chain of primary indexes and add this column
 			// to *all* four primary indexes. Later, in the de-duplication step, we'd
-			// recognize this and drop redundant primary indexes appropriately.
+			// recognize this and drop redundant primary indexes appropriately. The
+			// same goes for columns with a missing value, which existing rows decode
+			// to in place of their default value.
 			addStoredColumnToPrimaryIndexTargeting(b, spec.tbl.TableID, inflatedChain.oldSpec.primary, spec.col, scpb.ToPublic)
 		}
 		addStoredColumnToPrimaryIndexTargeting(b, spec.tbl.TableID, inflatedChain.inter1Spec.primary, spec.col, scpb.TransientAbsent)
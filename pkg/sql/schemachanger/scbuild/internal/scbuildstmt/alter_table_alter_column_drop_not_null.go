@@ -10,6 +10,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
 )
 
 func alterTableDropNotNull(
@@ -41,6 +42,10 @@ func alterTableDropNotNull(
 		panic(pgerror.Newf(pgcode.Syntax,
 			`column "%s" of relation "%s" is an identity column`, colName.Name, tn.ObjectName))
 	}
+	if retrieveColumnTypeElem(b, tbl.TableID, columnID).MissingValue != nil {
+		colName := mustRetrieveColumnName(b, tbl.TableID, columnID)
+		panic(sqlerrors.NewDropNotNullOnColumnWithMissingValueError(colName.Name))
+	}
 	columNotNull := b.QueryByID(tbl.TableID).FilterColumnNotNull().Filter(func(current scpb.Status, target scpb.TargetStatus, e *scpb.ColumnNotNull) bool {
 		return e.ColumnID == columnID
 	}).MustGetZeroOrOneElement()
@@ -17,7 +17,6 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
-	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/errors"
 )
 
@@ -99,12 +98,20 @@ func alterTableAlterColumnIdentity(
 			if err != nil {
 				panic(pgerror.Wrapf(err, pgcode.Internal, "invalid sequence option value for %q", name))
 			}
+		case tree.SeqOptCycle:
+			currentOpts.Cycle, err = strconv.ParseBool(e.Value)
+			if err != nil {
+				panic(pgerror.Wrapf(err, pgcode.Internal, "invalid sequence option value for %q", name))
+			}
 		default:
 			panic(pgerror.Newf(pgcode.Internal, "unexpected sequence option %q", name))
 		}
 	})
 
 	// And the final state for the sequence options.
+	if err := schemaexpr.CheckSequenceCycleSupported(b, b.ClusterSettings().Version, t.SeqOptions); err != nil {
+		panic(err)
+	}
 	updatedOpts := currentOpts
 	if err := schemaexpr.AssignSequenceOptions(&updatedOpts,
 		t.SeqOptions,
@@ -157,10 +164,9 @@ func alterTableAlterColumnIdentity(
 		switch name := opt.Name; name {
 		case tree.SeqOptAs:
 			_ = updateElement(name, defaultOpts.AsIntegerType, updatedOpts.AsIntegerType)
-		case tree.SeqOptCycle:
-			panic(unimplemented.NewWithIssue(20961, "CYCLE option is not supported"))
-		case tree.SeqOptNoCycle:
-			// TODO(20961): Noop for a default that can't be modified.
+		case tree.SeqOptCycle, tree.SeqOptNoCycle:
+			// Both options are stored under the CYCLE key.
+			_ = updateElement(tree.SeqOptCycle, defaultOpts.Cycle, updatedOpts.Cycle)
 		case tree.SeqOptCacheNode:
 			_ = updateElement(name, defaultOpts.NodeCacheSize, updatedOpts.NodeCacheSize)
 		case tree.SeqOptCacheSession:
@@ -231,6 +237,9 @@ func alterTableAlterColumnIdentity(
 	if updatedOpts.Start != defaultOpts.Start {
 		seqOptions = append(seqOptions, tree.SequenceOption{Name: tree.SeqOptStart, IntVal: &updatedOpts.Start})
 	}
+	if updatedOpts.Cycle != defaultOpts.Cycle {
+		seqOptions = append(seqOptions, tree.SequenceOption{Name: tree.SeqOptCycle})
+	}
 	if updatedOpts.Virtual != defaultOpts.Virtual {
 		seqOptions = append(seqOptions, tree.SequenceOption{Name: tree.SeqOptVirtual})
 	}
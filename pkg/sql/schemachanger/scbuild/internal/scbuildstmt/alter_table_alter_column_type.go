@@ -7,9 +7,10 @@ package scbuildstmt
 
 import (
 	"fmt"
+	"sort"
 
-	"github.com/cockroachdb/cockroach/pkg/build"
 	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
@@ -18,10 +19,11 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachange"
-	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/screl"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/cast"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
 	"github.com/cockroachdb/cockroach/pkg/sql/sessiondatapb"
@@ -50,6 +52,9 @@ func alterTableAlterColumnType(
 	newColType.TypeT = b.ResolveTypeRef(t.ToType)
 
 	// Check for elements depending on the column we are altering.
+	var hasViewOrRoutineDeps bool
+	var dependentViews, dependentRoutines []catid.DescID
+	canReplaceDeps := b.ClusterSettings().Version.IsActive(b, clusterversion.V26_2_AlterColumnTypeGeneral)
 	walkColumnDependencies(b, col, "alter type of", "column", func(e scpb.Element, op, objType string) {
 		switch e := e.(type) {
 		case *scpb.Column:
@@ -60,15 +65,31 @@ func alterTableAlterColumnType(
 			computedColName := elts.FilterColumnName().MustGetOneElement()
 			panic(sqlerrors.NewDependentBlocksOpError(op, objType, t.Column.String(), "computed column", computedColName.Name))
 		case *scpb.View:
-			ns := b.QueryByID(col.TableID).FilterNamespace().MustGetOneElement()
-			nsDep := b.QueryByID(e.ViewID).FilterNamespace().MustGetOneElement()
-			if nsDep.DatabaseID != ns.DatabaseID || nsDep.SchemaID != ns.SchemaID {
-				panic(sqlerrors.NewDependentBlocksOpError(op, objType, t.Column.String(), "view", qualifiedName(b, e.ViewID)))
+			// Materialized views store the results of their query, which would no
+			// longer match the type of the column.
+			if e.IsMaterialized || !canReplaceDeps {
+				ns := b.QueryByID(col.TableID).FilterNamespace().MustGetOneElement()
+				nsDep := b.QueryByID(e.ViewID).FilterNamespace().MustGetOneElement()
+				if nsDep.DatabaseID != ns.DatabaseID || nsDep.SchemaID != ns.SchemaID {
+					panic(sqlerrors.NewDependentBlocksOpError(op, objType, t.Column.String(), "view", qualifiedName(b, e.ViewID)))
+				}
+				panic(sqlerrors.NewDependentBlocksOpError(op, objType, t.Column.String(), "view", nsDep.Name))
 			}
-			panic(sqlerrors.NewDependentBlocksOpError(op, objType, t.Column.String(), "view", nsDep.Name))
+			// Other views and routines are planned from their query text, so they
+			// pick up the new type of the column as long as they still type-check
+			// with it, see validateDependentsForNewType. Only their back-references
+			// need to follow the column if it gets replaced.
+			hasViewOrRoutineDeps = true
+			dependentViews = append(dependentViews, e.ViewID)
 		case *scpb.FunctionBody:
-			fnName := b.QueryByID(e.FunctionID).FilterFunctionName().MustGetOneElement()
-			panic(sqlerrors.NewDependentBlocksOpError(op, objType, t.Column.String(), "function", fnName.Name))
+			// Routines whose signature uses the row type of the table would no
+			// longer return or accept values of that type.
+			if !canReplaceDeps || routineUsesTableType(b, e.FunctionID, col.TableID) {
+				fnName := b.QueryByID(e.FunctionID).FilterFunctionName().MustGetOneElement()
+				panic(sqlerrors.NewDependentBlocksOpError(op, objType, t.Column.String(), "function", fnName.Name))
+			}
+			hasViewOrRoutineDeps = true
+			dependentRoutines = append(dependentRoutines, e.FunctionID)
 		case *scpb.TriggerDeps:
 			tableElts := b.QueryByID(e.TableID)
 			tableName := tableElts.FilterNamespace().MustGetOneElement()
@@ -96,7 +117,7 @@ func alterTableAlterColumnType(
 
 	var err error
 	newColType.Type, err = schemachange.ValidateAlterColumnTypeChecks(
-		b, t, b.ClusterSettings(), newColType.Type,
+		b, t, b.ClusterSettings(), b.SemaCtx().GetTypeResolver(), newColType.Type,
 		isColumnGeneratedAsIdentity(b, tbl.TableID, col.ColumnID),
 		newColType.IsVirtual)
 	if err != nil {
@@ -104,6 +125,7 @@ func alterTableAlterColumnType(
 	}
 
 	validateNewTypeForComputedColumn(b, tbl.TableID, colID, tn, newColType.Type)
+	validateDependentsForNewType(b, col, t.Column.String(), newColType.Type, dependentViews, dependentRoutines)
 	validateAutomaticCastForNewType(b, tbl.TableID, colID, t.Column.String(),
 		oldColType.Type, newColType.Type, t.Using != nil)
 
@@ -119,12 +141,73 @@ func alterTableAlterColumnType(
 	case schemachange.ColumnConversionValidate:
 		handleValidationOnlyColumnConversion(b, t, col, oldColType, &newColType)
 	case schemachange.ColumnConversionGeneral:
-		handleGeneralColumnConversion(b, stmt, t, tn, tbl, col, oldColType, &newColType)
+		handleGeneralColumnConversion(b, stmt, t, tn, tbl, col, oldColType, &newColType, hasViewOrRoutineDeps)
 	default:
 		panic(errors.AssertionFailedf("alter type conversion %v not handled", kind))
 	}
 }
 
+// routineUsesTableType returns whether the signature of a routine refers to
+// the implicit record type of a table.
+func routineUsesTableType(b BuildCtx, functionID, tableID catid.DescID) bool {
+	fn := b.QueryByID(functionID).FilterFunction().MustGetOneElement()
+	isTableType := func(typ *types.T) bool {
+		for typ.Family() == types.ArrayFamily {
+			typ = typ.ArrayContents()
+		}
+		return typ.UserDefined() && catid.UserDefinedOIDToID(typ.Oid()) == tableID
+	}
+	if isTableType(fn.ReturnType.Type) {
+		return true
+	}
+	for _, p := range fn.Params {
+		if isTableType(p.Type.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDependentsForNewType re-plans the views and routines that depend on
+// the column as if it had the new type. The types stored for the columns of a
+// view and for the signature of a routine are not rewritten, so the ALTER is
+// rejected if any of their queries no longer type-checks, or if a view would
+// return a column of a different type.
+func validateDependentsForNewType(
+	b BuildCtx,
+	col *scpb.Column,
+	colName string,
+	newType *types.T,
+	dependentViews, dependentRoutines []catid.DescID,
+) {
+	var seen catalog.DescriptorIDSet
+	check := func(id catid.DescID, dependentType, dependentName string) {
+		if seen.Contains(id) {
+			return
+		}
+		seen.Add(id)
+		if err := b.TypeCheckWithColumnType(id, col.TableID, col.ColumnID, newType); err != nil {
+			panic(errors.WithDetailf(
+				sqlerrors.NewDependentBlocksOpError("alter type of", "column", colName, dependentType, dependentName),
+				"%s", err.Error(),
+			))
+		}
+	}
+	for _, id := range dependentViews {
+		ns := b.QueryByID(col.TableID).FilterNamespace().MustGetOneElement()
+		nsDep := b.QueryByID(id).FilterNamespace().MustGetOneElement()
+		name := nsDep.Name
+		if nsDep.DatabaseID != ns.DatabaseID || nsDep.SchemaID != ns.SchemaID {
+			name = qualifiedName(b, id)
+		}
+		check(id, "view", name)
+	}
+	for _, id := range dependentRoutines {
+		fnName := b.QueryByID(id).FilterFunctionName().MustGetOneElement()
+		check(id, "function", fnName.Name)
+	}
+}
+
 // ValidateColExprForNewType will ensure that the existing expressions for
 // DEFAULT and ON UPDATE will work for the new data type.
 func validateAutomaticCastForNewType(
@@ -277,9 +360,9 @@ func handleGeneralColumnConversion(
 	tbl *scpb.Table,
 	col *scpb.Column,
 	oldColType, newColType *scpb.ColumnType,
+	hasViewOrRoutineDeps bool,
 ) {
 	failIfExplicitTransaction(b)
-	failIfExperimentalSettingNotSet(b, oldColType, newColType)
 	failIfSafeUpdates(b, t)
 
 	// TODO(#47137): Only support alter statements that only have a single command.
@@ -294,19 +377,35 @@ func handleGeneralColumnConversion(
 	// the correct type. The new column will temporarily have a computed expression
 	// referring to the old column, used only for the backfill process.
 	//
-	// Because we need to rewrite data to change the data type, there are
-	// additional validation checks required that are incompatible with this
-	// process.
+	// Secondary indexes and constraints which reference the old column are
+	// recreated on the new one. They are collected here, so that they can be
+	// dropped along with the old column and added back once the new column
+	// exists.
+	var indexIDs catid.IndexSet
+	var constraintIDs catid.ConstraintSet
+	canReplaceDeps := b.ClusterSettings().Version.IsActive(b, clusterversion.V26_2_AlterColumnTypeGeneral)
 	walkColumnDependencies(b, col, "alter type of", "column", func(e scpb.Element, op, objType string) {
-		switch e.(type) {
+		switch e := e.(type) {
 		case *scpb.SequenceOwner:
 			panic(sqlerrors.NewAlterColumnTypeColOwnsSequenceNotSupportedErr())
 		case *scpb.CheckConstraint, *scpb.CheckConstraintUnvalidated,
 			*scpb.UniqueWithoutIndexConstraint, *scpb.UniqueWithoutIndexConstraintUnvalidated,
 			*scpb.ForeignKeyConstraint, *scpb.ForeignKeyConstraintUnvalidated:
-			panic(sqlerrors.NewAlterColumnTypeColWithConstraintNotSupportedErr())
+			if !canReplaceDeps {
+				panic(sqlerrors.NewAlterColumnTypeColWithConstraintNotSupportedErr())
+			}
+			// A foreign key on another table would be enforced against the new
+			// column before it has been backfilled.
+			if screl.GetDescID(e) != col.TableID {
+				panic(sqlerrors.NewAlterColumnTypeColWithConstraintNotSupportedErr())
+			}
+			constraintID, _ := screl.Schema.GetAttribute(screl.ConstraintID, e)
+			constraintIDs.Add(constraintID.(catid.ConstraintID))
 		case *scpb.SecondaryIndex:
-			panic(sqlerrors.NewAlterColumnTypeColInIndexNotSupportedErr())
+			if !canReplaceDeps {
+				panic(sqlerrors.NewAlterColumnTypeColInIndexNotSupportedErr())
+			}
+			indexIDs.Add(e.IndexID)
 		}
 	}, false /* allowPartialIdxPredicateRef */)
 
@@ -326,14 +425,9 @@ func handleGeneralColumnConversion(
 		}
 	}
 
-	// In version 25.1, we introduced the necessary dependency rules to ensure the
-	// general path works. Without these rules, we encounter failures during the
-	// ALTER operation. To avoid this, we revert to legacy handling if not running
-	// on version 25.1.
-	if !b.EvalCtx().Settings.Version.ActiveVersion(b).IsActive(clusterversion.V25_1) {
-		panic(scerrors.NotImplementedErrorf(t,
-			"old active version; ALTER COLUMN TYPE requires backfill. Reverting to legacy handling"))
-	}
+	indexIDs.ForEach(func(indexID catid.IndexID) {
+		validateSecondaryIndexForColumnReplacement(b, tbl.TableID, indexID, col.ColumnID, newColType, t.Column)
+	})
 
 	colHidden := retrieveColumnHidden(b, tbl.TableID, col.ColumnID)
 
@@ -342,6 +436,9 @@ func handleGeneralColumnConversion(
 	// Generate the ID of the new column we are adding.
 	newColID := b.NextTableColumnID(tbl)
 	newColType.ColumnID = newColID
+	// The new column is backfilled from the old one, so every row stores a value
+	// for it.
+	newColType.MissingValue = nil
 
 	// Create a computed expression for the new column that references the old column.
 	//
@@ -389,6 +486,20 @@ func handleGeneralColumnConversion(
 		b.Drop(oldColComment)
 	}
 	handleDropColumnPrimaryIndexes(b, tbl, col)
+	var oldIndexes []indexSpec
+	indexIDs.ForEach(func(indexID catid.IndexID) {
+		out := makeIndexSpec(b, tbl.TableID, indexID)
+		out.apply(b.Drop)
+		oldIndexes = append(oldIndexes, out)
+	})
+	var oldConstraints []ElementResultSet
+	constraintIDs.ForEach(func(constraintID catid.ConstraintID) {
+		elts := constraintElements(b, tbl.TableID, constraintID).Filter(publicTargetFilter)
+		elts.ForEach(func(_ scpb.Status, _ scpb.TargetStatus, e scpb.Element) {
+			b.Drop(e)
+		})
+		oldConstraints = append(oldConstraints, elts)
+	})
 
 	// Ensure all elements for the column are dropped before proceeding with the add.
 	// This check is run prior to adding any new elements, as it relies on column names,
@@ -402,6 +513,11 @@ func handleGeneralColumnConversion(
 	// column family at the same position as the old column. Normally, when adding a new
 	// column, it is appended to the end of the column family.
 	newColType.ColumnFamilyOrderFollowsColumnID = oldColType.ColumnID
+	// Views and routines track the columns they use by ID, so their
+	// back-references need to be moved over to the new column.
+	if hasViewOrRoutineDeps {
+		newColType.BackReferencesFromColumnID = oldColType.ColumnID
+	}
 
 	// Add the spec for the new column. It will be identical to the column it is replacing,
 	// except the type will differ, and it will have a transient computed expression.
@@ -439,7 +555,218 @@ func handleGeneralColumnConversion(
 		// it's replacing, so there's no need to specify a family.
 		fam: nil,
 	}
-	addColumn(b, spec, t)
+	backing := addColumn(b, spec, t)
+
+	// Recreate the secondary indexes on the new column. They are backfilled from
+	// the new primary index, which is the only one containing the new column,
+	// and take over the names of the indexes they replace once those are
+	// no longer in use.
+	for _, out := range oldIndexes {
+		recreateSecondaryIndexForColumnReplacement(b, out, backing.IndexID, col.ColumnID, newColID)
+	}
+	// Recreate the constraints on the new column. Dependency rules ensure that
+	// they are only enforced once the new column is public.
+	for _, elts := range oldConstraints {
+		recreateConstraintForColumnReplacement(b, tn, elts, col, colName.Name, newColType)
+	}
+}
+
+// validateSecondaryIndexForColumnReplacement checks that a secondary index
+// referencing the column being replaced can be recreated with the new type.
+func validateSecondaryIndexForColumnReplacement(
+	b BuildCtx,
+	tableID catid.DescID,
+	indexID catid.IndexID,
+	colID catid.ColumnID,
+	newColType *scpb.ColumnType,
+	colName tree.Name,
+) {
+	spec := makeIndexSpec(b, tableID, indexID)
+	panicIfIndexReferencedByViewOrFunction(b, tableID, indexID, spec.name.Name)
+	for _, ic := range spec.columns {
+		if ic.ColumnID != colID || ic.Kind != scpb.IndexColumn_KEY {
+			continue
+		}
+		// The keys of inverted and vector indexes are derived from the values of
+		// the indexed column, so they cannot be carried over to another type.
+		if spec.secondary.Type != idxtype.FORWARD {
+			panic(sqlerrors.NewAlterColumnTypeColInIndexNotSupportedErr())
+		}
+		if !colinfo.ColumnTypeIsIndexable(newColType.Type) {
+			panic(sqlerrors.NewColumnNotIndexableError(
+				colName.String(), newColType.Type.Name(), newColType.Type.DebugString()))
+		}
+	}
+}
+
+// recreateSecondaryIndexForColumnReplacement adds a copy of the secondary index
+// `out`, in which the column being replaced is swapped for the new one. The
+// copy is backfilled from `sourceIndexID` and replaces `out` once it has been
+// validated.
+func recreateSecondaryIndexForColumnReplacement(
+	b BuildCtx, out indexSpec, sourceIndexID catid.IndexID, oldColID, newColID catid.ColumnID,
+) {
+	columns := append([]*scpb.IndexColumn(nil), out.columns...)
+	sort.Slice(columns, func(i, j int) bool {
+		if columns[i].Kind != columns[j].Kind {
+			return columns[i].Kind < columns[j].Kind
+		}
+		return columns[i].OrdinalInKind < columns[j].OrdinalInKind
+	})
+	inColumns := make([]indexColumnSpec, 0, len(columns))
+	for _, ic := range columns {
+		cs := makeIndexColumnSpec(ic)
+		if cs.columnID == oldColID {
+			cs.columnID = newColID
+		}
+		inColumns = append(inColumns, cs)
+	}
+	in, temp := makeSwapIndexSpec(b, out, sourceIndexID, inColumns, false /* inUseTentativeIDs */)
+	in.secondary.RecreateSourceIndexID = out.indexID()
+	in.apply(b.Add)
+	temp.apply(b.AddTransient)
+	if err := configureZoneConfigForReplacementIndexPartitioning(
+		b, in.secondary.TableID, out.indexID(), in.indexID(),
+	); err != nil {
+		panic(err)
+	}
+}
+
+// recreateConstraintForColumnReplacement adds a copy of the constraint made up
+// of the elements in `elts`, in which the column being replaced is swapped for
+// the new one. Check expressions are type checked again against the new type.
+func recreateConstraintForColumnReplacement(
+	b BuildCtx,
+	tn *tree.TableName,
+	elts ElementResultSet,
+	col *scpb.Column,
+	colName string,
+	newColType *scpb.ColumnType,
+) {
+	tableID := col.TableID
+	constraintID := b.NextTableConstraintID(tableID)
+	replaceColumnID := func(ids []catid.ColumnID) []catid.ColumnID {
+		ret := make([]catid.ColumnID, len(ids))
+		for i, id := range ids {
+			if id == col.ColumnID {
+				id = newColType.ColumnID
+			}
+			ret[i] = id
+		}
+		return ret
+	}
+	var constraintName string
+	if n := elts.FilterConstraintWithoutIndexName().MustGetZeroOrOneElement(); n != nil {
+		constraintName = n.Name
+	}
+	retypeCheckExpr := func(expr scpb.Expression) (scpb.Expression, []catid.ColumnID) {
+		parsedExpr, err := parser.ParseExpr(string(expr.Expr))
+		if err != nil {
+			panic(err)
+		}
+		isComputed := retrieveColumnComputeExpression(b, tableID, col.ColumnID) != nil
+		typedExpr, _, colIDs, err := schemaexpr.DequalifyAndValidateExprImpl(b, parsedExpr, types.Bool,
+			tree.CheckConstraintExpr, b.SemaCtx(), volatility.Volatile, tn, b.ClusterSettings().Version.ActiveVersion(b),
+			func() colinfo.ResultColumns {
+				return getNonDropResultColumns(b, tableID)
+			},
+			func(columnName tree.Name) (exists, accessible, computed bool, id catid.ColumnID, typ *types.T) {
+				// The old and the new column share the same name at this point, so
+				// resolve it to the new column explicitly.
+				if string(columnName) == colName {
+					return true, !col.IsInaccessible, isComputed, newColType.ColumnID, newColType.Type
+				}
+				return columnLookupFn(b, tableID, columnName)
+			},
+		)
+		if err != nil {
+			panic(errors.Wrapf(err, "check constraint %q cannot be applied to column %q of type %s",
+				constraintName, colName, newColType.Type.SQLString()))
+		}
+		typedCkExpr, err := parser.ParseExpr(typedExpr)
+		if err != nil {
+			panic(err)
+		}
+		return *b.WrapExpression(tableID, typedCkExpr), colIDs.Ordered()
+	}
+	elts.ForEach(func(_ scpb.Status, _ scpb.TargetStatus, e scpb.Element) {
+		switch e := protoutil.Clone(e).(type) {
+		case *scpb.CheckConstraint:
+			e.ConstraintID = constraintID
+			e.Expression, e.ColumnIDs = retypeCheckExpr(e.Expression)
+			e.IndexIDForValidation = getIndexIDForValidationForConstraint(b, tableID)
+			b.Add(e)
+		case *scpb.CheckConstraintUnvalidated:
+			e.ConstraintID = constraintID
+			e.Expression, e.ColumnIDs = retypeCheckExpr(e.Expression)
+			b.Add(e)
+		case *scpb.UniqueWithoutIndexConstraint:
+			e.ConstraintID = constraintID
+			e.ColumnIDs = replaceColumnID(e.ColumnIDs)
+			e.IndexIDForValidation = getIndexIDForValidationForConstraint(b, tableID)
+			b.Add(e)
+		case *scpb.UniqueWithoutIndexConstraintUnvalidated:
+			e.ConstraintID = constraintID
+			e.ColumnIDs = replaceColumnID(e.ColumnIDs)
+			b.Add(e)
+		case *scpb.ForeignKeyConstraint:
+			e.ConstraintID = constraintID
+			e.ColumnIDs = replaceColumnID(e.ColumnIDs)
+			if e.ReferencedTableID == tableID {
+				e.ReferencedColumnIDs = replaceColumnID(e.ReferencedColumnIDs)
+			}
+			validateForeignKeyTypesForColumnReplacement(b, e.TableID, e.ColumnIDs,
+				e.ReferencedTableID, e.ReferencedColumnIDs, newColType.ColumnID)
+			e.IndexIDForValidation = getIndexIDForValidationForConstraint(b, tableID)
+			b.Add(e)
+		case *scpb.ForeignKeyConstraintUnvalidated:
+			e.ConstraintID = constraintID
+			e.ColumnIDs = replaceColumnID(e.ColumnIDs)
+			if e.ReferencedTableID == tableID {
+				e.ReferencedColumnIDs = replaceColumnID(e.ReferencedColumnIDs)
+			}
+			validateForeignKeyTypesForColumnReplacement(b, e.TableID, e.ColumnIDs,
+				e.ReferencedTableID, e.ReferencedColumnIDs, newColType.ColumnID)
+			b.Add(e)
+		case *scpb.ConstraintWithoutIndexName:
+			e.ConstraintID = constraintID
+			b.Add(e)
+		case *scpb.ConstraintComment:
+			e.ConstraintID = constraintID
+			b.Add(e)
+		default:
+			panic(errors.AssertionFailedf("unexpected constraint element %T", e))
+		}
+	})
+}
+
+// validateForeignKeyTypesForColumnReplacement checks that the new type of a
+// replaced column is compatible with the columns it is paired with in a
+// foreign key constraint.
+func validateForeignKeyTypesForColumnReplacement(
+	b BuildCtx,
+	tableID catid.DescID,
+	columnIDs []catid.ColumnID,
+	referencedTableID catid.DescID,
+	referencedColumnIDs []catid.ColumnID,
+	newColID catid.ColumnID,
+) {
+	referencedTableNamespaceElem := mustRetrieveNamespaceElem(b, referencedTableID)
+	for i := range columnIDs {
+		if columnIDs[i] != newColID &&
+			(referencedTableID != tableID || referencedColumnIDs[i] != newColID) {
+			continue
+		}
+		originColName := mustRetrieveColumnNameElem(b, tableID, columnIDs[i]).Name
+		originColType := mustRetrieveColumnTypeElem(b, tableID, columnIDs[i]).Type
+		referencedColName := mustRetrieveColumnNameElem(b, referencedTableID, referencedColumnIDs[i]).Name
+		referencedColType := mustRetrieveColumnTypeElem(b, referencedTableID, referencedColumnIDs[i]).Type
+		if !originColType.Equivalent(referencedColType) {
+			panic(pgerror.Newf(pgcode.DatatypeMismatch,
+				"type of %q (%s) does not match foreign key %q.%q (%s)", originColName, originColType.String(),
+				referencedTableNamespaceElem.Name, referencedColName, referencedColType.String()))
+		}
+	}
 }
 
 func updateColumnType(b BuildCtx, oldColType, newColType *scpb.ColumnType) {
@@ -459,24 +786,6 @@ func failIfExplicitTransaction(b BuildCtx) {
 	}
 }
 
-// failIfExperimentalSettingNotSet checks if the current version requires a
-// setting to be enabled to perform an ALTER COLUMN TYPE operation.
-func failIfExperimentalSettingNotSet(b BuildCtx, oldColType, newColType *scpb.ColumnType) {
-	if !b.SessionData().AlterColumnTypeGeneralEnabled &&
-		!b.EvalCtx().Settings.Version.ActiveVersion(b).IsActive(clusterversion.V25_1) {
-		panic(pgerror.WithCandidateCode(
-			errors.WithHint(
-				errors.WithIssueLink(
-					errors.Newf("ALTER COLUMN TYPE from %v to %v is only "+
-						"supported experimentally",
-						oldColType.Type, newColType.Type),
-					errors.IssueLink{IssueURL: build.MakeIssueURL(49329)}),
-				"you can enable alter column type general support by running "+
-					"`SET enable_experimental_alter_column_type_general = true`"),
-			pgcode.ExperimentalFeature))
-	}
-}
-
 // maybeWriteNoticeForFKColTypeMismatch will find any FK cols, and if the column
 // that we are changing doesn't match the column in the referenced table, we
 // will write a notice. This is a similar notice that is written when a table
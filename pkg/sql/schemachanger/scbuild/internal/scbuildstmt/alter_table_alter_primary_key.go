@@ -55,6 +55,19 @@ type alterPrimaryKeySpec struct {
 	Sharded       *tree.ShardedIndexDef
 	Name          tree.Name
 	StorageParams tree.StorageParams
+	// PartitionBy, if set, replaces the partitioning of the primary index,
+	// which is otherwise carried over from the old primary index.
+	PartitionBy *tree.PartitionBy
+	// RepartitionAll is set to replace the partitioning of all indexes of a
+	// PARTITION ALL BY table with PartitionBy, which is nil for PARTITION ALL BY
+	// NOTHING.
+	RepartitionAll bool
+}
+
+// replacesPartitioning returns whether the partitioning of the primary index
+// is replaced rather than carried over from the old primary index.
+func (t alterPrimaryKeySpec) replacesPartitioning() bool {
+	return t.PartitionBy != nil || t.RepartitionAll
 }
 
 func alterPrimaryKey(
@@ -139,7 +152,11 @@ func alterPrimaryKey(
 	// This is a CRDB unique feature to not regress on performance after altering PK.
 	// Note that it has to precede recreating all secondary indexes because it is
 	// possible we need to recreate this unique index.
-	maybeAddUniqueIndexForOldPrimaryKey(b, tn, tbl, t, inflatedChain.oldSpec.primary, inflatedChain.finalSpec.primary, rowidToDrop)
+	// A change of partitioning keeps the same primary key columns, so there is
+	// no need for one in that case.
+	if !t.replacesPartitioning() {
+		maybeAddUniqueIndexForOldPrimaryKey(b, tn, tbl, t, inflatedChain.oldSpec.primary, inflatedChain.finalSpec.primary, rowidToDrop)
+	}
 
 	// Drop the old shard column, if the old PK is hash-sharded.
 	// This behavior is added in V23.1 and gated.
@@ -148,6 +165,15 @@ func alterPrimaryKey(
 		elts := b.QueryByID(oldShardColToDrop.TableID).Filter(hasColumnIDAttrFilter(oldShardColToDrop.ColumnID))
 		dropColumn(b, tn, tbl, stmt, t.n, oldShardColToDrop, elts, tree.DropRestrict)
 	}
+
+	// Drop the old hash partition column, if the partitioning was replaced.
+	if t.replacesPartitioning() {
+		oldHashColToDrop := getPrimaryIndexHashPartitionColumn(b, tbl.TableID, &inflatedChain.oldSpec)
+		if checkIfColumnCanBeDropped(b, oldHashColToDrop) {
+			elts := b.QueryByID(oldHashColToDrop.TableID).Filter(hasColumnIDAttrFilter(oldHashColToDrop.ColumnID))
+			dropColumn(b, tn, tbl, stmt, t.n, oldHashColToDrop, elts, tree.DropRestrict)
+		}
+	}
 }
 
 // setupSharding set up or reset sharding. It includes
@@ -210,6 +236,19 @@ func getprimaryIndexShardColumn(
 	return mustRetrieveColumnElem(b, tableID, shardColID)
 }
 
+// getPrimaryIndexHashPartitionColumn returns the hidden partition column of a
+// primary index partitioned with PARTITION BY HASH, which is its first key
+// column, or nil if the index isn't partitioned this way.
+func getPrimaryIndexHashPartitionColumn(
+	b BuildCtx, tableID catid.DescID, spec *indexSpec,
+) *scpb.Column {
+	if spec.partitioning == nil || len(spec.partitioning.HashColumnNames) == 0 {
+		return nil
+	}
+	keyCols := mustRetrieveKeyIndexColumns(b, tableID, spec.primary.IndexID)
+	return mustRetrieveColumnElem(b, tableID, keyCols[0].ColumnID)
+}
+
 func alterPKInPrimaryIndexAndItsTemp(
 	b BuildCtx,
 	tn *tree.TableName,
@@ -272,20 +311,39 @@ func alterPKInPrimaryIndexAndItsTemp(
 				Kind:     scpb.IndexColumn_STORED,
 			})
 		}
-		var partitionByIndex *tree.PartitionByIndex
-		// Check if a partition all by already exists, which should take precedence.
-		partitionAllBy := b.QueryByID(tableID).FilterTablePartitioning().MustHaveZeroOrOne()
-		// Otherwise, retrieve the partitioning by from the previous index.
-		if partitionAllBy == nil {
-			partitionBy, err := partitionByFromTableID(b, tableID, prevSpec.indexID())
+		if t.RepartitionAll {
+			// The partitioning of a PARTITION ALL BY table is replaced. The table
+			// keeps its PARTITION ALL BY definition, so the new partitioning is
+			// applied directly instead of being derived from the previous index.
+			newSpec.partitioning = nil
+			if err := partitionIndexSpec(
+				b, tableID, &newSpec.indexSpec, newSpec, true /* isPrimary */, t.PartitionBy,
+			); err != nil {
+				panic(err)
+			}
+		} else {
+			var partitionByIndex *tree.PartitionByIndex
+			configureFromSpec := prevSpec
+			// Check if a partition all by already exists, which should take precedence.
+			partitionAllBy := b.QueryByID(tableID).FilterTablePartitioning().MustHaveZeroOrOne()
+			if t.PartitionBy != nil {
+				// The partitioning is replaced, so the implicit partitioning columns of
+				// the previous index are dropped from the key rather than carried over.
+				partitionByIndex = &tree.PartitionByIndex{PartitionBy: t.PartitionBy}
+				newSpec.partitioning = nil
+				configureFromSpec = nil
+			} else if partitionAllBy == nil {
+				// Otherwise, retrieve the partitioning by from the previous index.
+				partitionBy, err := partitionByFromTableID(b, tableID, prevSpec.indexID())
+				if err != nil {
+					panic(err)
+				}
+				partitionByIndex = &tree.PartitionByIndex{PartitionBy: partitionBy}
+			}
+			err := configureIndexDescForNewIndexPartitioning(b, tableID, prevSpec.indexID(), configureFromSpec, newSpec, true /* isPrimary */, partitionByIndex)
 			if err != nil {
 				panic(err)
 			}
-			partitionByIndex = &tree.PartitionByIndex{PartitionBy: partitionBy}
-		}
-		err := configureIndexDescForNewIndexPartitioning(b, tableID, prevSpec.indexID(), prevSpec, newSpec, true /* isPrimary */, partitionByIndex)
-		if err != nil {
-			panic(err)
 		}
 		// Apply the updates into the builder state.
 		newSpec.applyDeltaForIndexColumns(b, &oldSpec, isIndexFinal)
@@ -296,6 +354,9 @@ func alterPKInPrimaryIndexAndItsTemp(
 			} else {
 				b.AddTransient(newSpec.partitioning)
 			}
+		} else if oldSpec.partitioning != nil {
+			// The index is no longer partitioned.
+			b.Drop(oldSpec.partitioning)
 		}
 	}
 	updateIndexColumnForNewPK(index.IndexID, isIndexFinal)
@@ -384,6 +445,11 @@ func checkForEarlyExit(b BuildCtx, tbl *scpb.Table, t alterPrimaryKeySpec) {
 // isNewPrimaryKeySameAsOldPrimaryKey returns whether the requested new
 // primary key is the same as the old primary key.
 func isNewPrimaryKeySameAsOldPrimaryKey(b BuildCtx, tbl *scpb.Table, t alterPrimaryKeySpec) bool {
+	// A change of partitioning keeps the same primary key columns, and it is up
+	// to the caller to skip it if the partitioning is unchanged.
+	if t.replacesPartitioning() {
+		return false
+	}
 	oldPrimaryIndexElem := mustRetrieveCurrentPrimaryIndexElement(b, tbl.TableID)
 	oldPrimaryIndexKeyColumns := mustRetrieveKeyIndexColumns(b, tbl.TableID, oldPrimaryIndexElem.IndexID)
 
@@ -645,33 +711,7 @@ func recreateAllSecondaryIndexes(
 		// If this index is referenced by any other objects, then we will
 		// block the primary key swap, since we don't have a mechanism to
 		// fix these references yet.
-		// TODO(fqazi): As a part of #124131 we should add logic to fix
-		// these references.
-		backrefs := b.BackReferences(idx.TableID)
-		functions := backrefs.FilterFunctionBody().Elements()
-		for _, function := range functions {
-			for _, tableRef := range function.UsesTables {
-				if tableRef.TableID == idx.TableID && tableRef.IndexID == idx.IndexID {
-					panic(unimplemented.NewWithIssuef(124131,
-						"table %q has an index (%s) that is still referenced by %q",
-						publicTableElts.FilterNamespace().MustGetOneElement().Name,
-						out.name.Name,
-						b.QueryByID(function.FunctionID).FilterFunctionName().MustGetOneElement().Name))
-				}
-			}
-		}
-		views := backrefs.FilterView().Elements()
-		for _, view := range views {
-			for _, f := range view.ForwardReferences {
-				if f.ToID == idx.TableID && f.IndexID == idx.IndexID {
-					panic(unimplemented.NewWithIssuef(124131,
-						"table %q has an index (%s) that is still referenced by %q",
-						publicTableElts.FilterNamespace().MustGetOneElement().Name,
-						out.name.Name,
-						b.QueryByID(view.ViewID).FilterNamespace().MustGetOneElement().Name))
-				}
-			}
-		}
+		panicIfIndexReferencedByViewOrFunction(b, idx.TableID, idx.IndexID, out.name.Name)
 
 		var idxColIDs catalog.TableColSet
 		inColumns := make([]indexColumnSpec, 0, len(out.columns))
@@ -683,6 +723,11 @@ func recreateAllSecondaryIndexes(
 			// Also determine the ID of the inverted column, if applicable.
 			for _, ic := range out.columns {
 				if ic.Kind == scpb.IndexColumn_KEY {
+					// The implicit partitioning columns are replaced when the table is
+					// repartitioned.
+					if t.RepartitionAll && ic.Implicit {
+						continue
+					}
 					idxColIDs.Add(ic.ColumnID)
 					inColumns = append(inColumns, indexColumnSpec{
 						columnID:  ic.ColumnID,
@@ -742,6 +787,10 @@ func recreateAllSecondaryIndexes(
 			}
 		}
 		in, temp := makeSwapIndexSpec(b, out, sourcePrimaryIndex.IndexID, inColumns, false /* inUseTempIDs */)
+		if t.RepartitionAll {
+			in = repartitionRecreatedSecondaryIndex(b, in, t.PartitionBy)
+			temp = makeTempIndexSpec(b, in)
+		}
 		// Set RecreateSourceIndexID only if the original index is already public.
 		// This enables index swapping: the new index will replace the old one when
 		// the old index becomes non-public.
@@ -771,6 +820,69 @@ func recreateAllSecondaryIndexes(
 	})
 }
 
+// repartitionRecreatedSecondaryIndex replaces the partitioning of a recreated
+// secondary index with partitionBy, which is nil to remove it. The new implicit
+// partitioning columns are prepended to the key, so they are no longer part of
+// the key suffix.
+func repartitionRecreatedSecondaryIndex(
+	b BuildCtx, spec indexSpec, partitionBy *tree.PartitionBy,
+) indexSpec {
+	m := spec.makeMutator()
+	m.partitioning = nil
+	if err := partitionIndexSpec(
+		b, spec.tableID(), &m.indexSpec, m, false /* isPrimary */, partitionBy,
+	); err != nil {
+		panic(err)
+	}
+	var implicitColIDs catalog.TableColSet
+	for _, ic := range m.columns {
+		if ic.Implicit {
+			implicitColIDs.Add(ic.ColumnID)
+		}
+	}
+	// Iterate over a copy of the list since we are modifying it below.
+	for _, ic := range append([]*scpb.IndexColumn{}, m.columns...) {
+		if ic.Kind == scpb.IndexColumn_KEY_SUFFIX && implicitColIDs.Contains(ic.ColumnID) {
+			m.removeColumn(ic.ColumnID, scpb.IndexColumn_KEY_SUFFIX)
+		}
+	}
+	return m.indexSpec
+}
+
+// panicIfIndexReferencedByViewOrFunction panics if a view or a function
+// references the index explicitly, which prevents it from being recreated.
+// TODO(fqazi): As a part of #124131 we should add logic to fix these
+// references.
+func panicIfIndexReferencedByViewOrFunction(
+	b BuildCtx, tableID catid.DescID, indexID catid.IndexID, indexName string,
+) {
+	backrefs := b.BackReferences(tableID)
+	functions := backrefs.FilterFunctionBody().Elements()
+	for _, function := range functions {
+		for _, tableRef := range function.UsesTables {
+			if tableRef.TableID == tableID && tableRef.IndexID == indexID {
+				panic(unimplemented.NewWithIssuef(124131,
+					"table %q has an index (%s) that is still referenced by %q",
+					mustRetrieveNamespaceElem(b, tableID).Name,
+					indexName,
+					b.QueryByID(function.FunctionID).FilterFunctionName().MustGetOneElement().Name))
+			}
+		}
+	}
+	views := backrefs.FilterView().Elements()
+	for _, view := range views {
+		for _, f := range view.ForwardReferences {
+			if f.ToID == tableID && f.IndexID == indexID {
+				panic(unimplemented.NewWithIssuef(124131,
+					"table %q has an index (%s) that is still referenced by %q",
+					mustRetrieveNamespaceElem(b, tableID).Name,
+					indexName,
+					b.QueryByID(view.ViewID).FilterNamespace().MustGetOneElement().Name))
+			}
+		}
+	}
+}
+
 // maybeAddUniqueIndexForOldPrimaryKey constructs and adds all necessary elements
 // for a unique index on the old primary key columns, if certain conditions are
 // met (see comments of shouldCreateUniqueIndexOnOldPrimaryKeyColumns for details).
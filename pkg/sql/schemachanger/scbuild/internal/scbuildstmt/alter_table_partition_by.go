@@ -0,0 +1,188 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"slices"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catenumpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scdecomp"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondatapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+)
+
+// alterTablePartitionByTableChecks limits the declarative schema changer to
+// ALTER TABLE ... PARTITION BY HASH and ALTER TABLE ... PARTITION ALL BY,
+// which require rewriting the indexes of the table. Other partitionings are
+// left to the legacy schema changer, which updates them in place.
+func alterTablePartitionByTableChecks(
+	t *tree.AlterTablePartitionByTable,
+	_ sessiondatapb.NewSchemaChangerMode,
+	activeVersion clusterversion.ClusterVersion,
+) bool {
+	if t.All {
+		return activeVersion.IsActive(clusterversion.V26_2_RepartitionAllBy)
+	}
+	return t.PartitionBy.IsHash() &&
+		activeVersion.IsActive(clusterversion.V26_2_HashPartitioning)
+}
+
+// alterTablePartitionByTable implements ALTER TABLE ... PARTITION BY HASH.
+// The primary index of a hash partitioned table is prefixed by a hidden
+// column holding the partition of each row, so partitioning a table by hash,
+// or changing its number of partitions, rewrites the primary index like
+// ALTER PRIMARY KEY does while keeping the same primary key columns.
+//
+// It also implements ALTER TABLE ... PARTITION ALL BY on a table which was
+// created with PARTITION ALL BY. Every index of such a table shares the same
+// partitioning, so all of them are rebuilt with the new partitioning, and
+// partitions which keep their name keep their zone configurations.
+func alterTablePartitionByTable(
+	b BuildCtx,
+	tn *tree.TableName,
+	tbl *scpb.Table,
+	stmt tree.Statement,
+	t *tree.AlterTablePartitionByTable,
+) {
+	hasPartitionAllBy := !b.QueryByID(tbl.TableID).FilterTablePartitioning().IsEmpty()
+	if t.All && !hasPartitionAllBy {
+		panic(scerrors.NotImplementedErrorf(t, "PARTITION ALL BY is only supported on tables "+
+			"created with PARTITION ALL BY"))
+	}
+	if !t.All && !t.PartitionBy.IsHash() {
+		panic(scerrors.NotImplementedErrorf(t, "only PARTITION BY HASH is supported"))
+	}
+	if isTableLocalityRegionalByRow(b, tbl.TableID) ||
+		!b.QueryByID(tbl.TableID).FilterTableLocalityGlobal().IsEmpty() ||
+		!b.QueryByID(tbl.TableID).FilterTableLocalityPrimaryRegion().IsEmpty() ||
+		!b.QueryByID(tbl.TableID).FilterTableLocalitySecondaryRegion().IsEmpty() {
+		panic(pgerror.Newf(
+			pgcode.FeatureNotSupported,
+			"cannot set PARTITION BY on a table in a multi-region enabled database",
+		))
+	}
+	if !t.All && hasPartitionAllBy {
+		panic(unimplemented.NewWithIssue(58736, "changing partition of table with PARTITION ALL BY not yet implemented"))
+	}
+	primaryIndex := mustRetrieveCurrentPrimaryIndexElement(b, tbl.TableID)
+	if primaryIndex.Sharding != nil {
+		panic(pgerror.New(
+			pgcode.FeatureNotSupported,
+			"cannot set explicit partitioning with PARTITION BY on hash sharded primary key",
+		))
+	}
+	if t.PartitionBy.IsHash() {
+		if err := tabledesc.ValidateHashPartitionCount(t.PartitionBy.HashPartitions); err != nil {
+			panic(err)
+		}
+	}
+	oldPartitioning := b.QueryByID(tbl.TableID).Filter(hasIndexIDAttrFilter(primaryIndex.IndexID)).
+		FilterIndexPartitioning().MustGetZeroOrOneElement()
+	if oldPartitioning != nil && oldPartitioning.NumImplicitColumns > 0 {
+		if len(oldPartitioning.HashColumnNames) == 0 {
+			if !t.All {
+				panic(unimplemented.NewWithIssue(
+					58731,
+					"cannot ALTER TABLE PARTITION BY on a table which already has implicit column partitioning",
+				))
+			}
+		} else if t.PartitionBy.IsHash() {
+			// Nothing to do if the table is already partitioned in the same way.
+			newHashColNames, _ := tabledesc.HashPartitionColumnNames(t.PartitionBy)
+			if int(t.PartitionBy.HashPartitions) == len(oldPartitioning.List) &&
+				slices.Equal(oldPartitioning.HashColumnNames, newHashColNames) {
+				return
+			}
+		}
+	}
+
+	// Keep the explicit key columns of the current primary key.
+	var columns tree.IndexElemList
+	for _, keyCol := range mustRetrieveKeyIndexColumns(b, tbl.TableID, primaryIndex.IndexID) {
+		if keyCol.Implicit {
+			continue
+		}
+		dir := tree.Ascending
+		if keyCol.Direction == catenumpb.IndexColumn_DESC {
+			dir = tree.Descending
+		}
+		columns = append(columns, tree.IndexElem{
+			Column:    tree.Name(mustRetrieveColumnName(b, tbl.TableID, keyCol.ColumnID).Name),
+			Direction: dir,
+		})
+	}
+	if t.PartitionBy.IsHash() {
+		maybeCreateAndAddHashPartitionCol(b, tbl, t.PartitionBy, t)
+	}
+	alterPrimaryKey(b, tn, tbl, stmt, alterPrimaryKeySpec{
+		n:              t,
+		Columns:        columns,
+		PartitionBy:    t.PartitionBy,
+		RepartitionAll: t.All,
+	})
+}
+
+// maybeCreateAndAddHashPartitionCol adds the hidden partition column of a
+// PARTITION BY HASH clause to the table, unless it already exists.
+func maybeCreateAndAddHashPartitionCol(
+	b BuildCtx, tbl *scpb.Table, partBy *tree.PartitionBy, n tree.NodeFormatter,
+) (hashColID catid.ColumnID) {
+	colNames, hashColName := tabledesc.HashPartitionColumnNames(partBy)
+	elts := b.QueryByID(tbl.TableID)
+	scpb.ForEachColumnName(elts, func(_ scpb.Status, target scpb.TargetStatus, name *scpb.ColumnName) {
+		if target == scpb.ToPublic && name.Name == hashColName {
+			hashColID = name.ColumnID
+		}
+	})
+	if hashColID != 0 {
+		return hashColID
+	}
+	for _, colName := range colNames {
+		// Resolve the hashed columns up front for a proper error if any of them
+		// does not exist.
+		getColumnIDFromColumnName(b, tbl.TableID, tree.Name(colName), true /* required */)
+	}
+	hashColID = b.NextTableColumnID(tbl)
+	spec := addColumnSpec{
+		tbl: tbl,
+		col: &scpb.Column{
+			TableID:  tbl.TableID,
+			ColumnID: hashColID,
+		},
+		name: &scpb.ColumnName{
+			TableID:  tbl.TableID,
+			ColumnID: hashColID,
+			Name:     hashColName,
+		},
+		colType: &scpb.ColumnType{
+			TableID:                 tbl.TableID,
+			ColumnID:                hashColID,
+			TypeT:                   newTypeT(types.Int),
+			IsVirtual:               true,
+			ElementCreationMetadata: scdecomp.NewElementCreationMetadata(b.EvalCtx().Settings.Version.ActiveVersion(b)),
+		},
+		notNull: true,
+		hidden:  true,
+	}
+	expr := schemaexpr.MakeHashPartitionComputeExpr(colNames, int(partBy.HashPartitions))
+	spec.compute = &scpb.ColumnComputeExpression{
+		TableID:    tbl.TableID,
+		ColumnID:   hashColID,
+		Expression: *b.WrapExpression(tbl.TableID, expr),
+	}
+	addColumn(b, spec, n)
+	return hashColID
+}
@@ -45,6 +45,12 @@ func alterTableRenameColumn(
 		panic(errors.AssertionFailedf("column element resolved for %s, but column name element not found", tree.ErrString(&t.Column)))
 	}
 	renameColumnChecks(b, colElt, t.Column, t.NewName)
+	// Hash partitioned indexes refer to their hashed columns by name, and the
+	// name of their hidden partition column is derived from these, so leave
+	// renaming them to the legacy schema changer.
+	if isHashPartitionedColumn(b, tbl.TableID, colNameElt.Name) {
+		panic(scerrors.NotImplementedErrorf(n, "renaming a column used by PARTITION BY HASH"))
+	}
 
 	// Short circuit if the name is unchanged.
 	if colNameElt.Name == string(t.NewName) {
@@ -131,3 +137,19 @@ func checkNewColumnNameConflicts(b BuildCtx, tableID catid.DescID, oldName, newN
 		}
 	})
 }
+
+// isHashPartitionedColumn returns whether the named column is hashed by the
+// PARTITION BY HASH of any index of the table.
+func isHashPartitionedColumn(b BuildCtx, tableID catid.DescID, colName string) (ret bool) {
+	b.QueryByID(tableID).FilterIndexPartitioning().ForEach(func(
+		_ scpb.Status, target scpb.TargetStatus, e *scpb.IndexPartitioning,
+	) {
+		if target == scpb.ToAbsent {
+			return
+		}
+		for _, hashColName := range e.HashColumnNames {
+			ret = ret || hashColName == colName
+		}
+	})
+	return ret
+}
@@ -53,7 +53,7 @@ func alterTableRenameConstraint(
 
 	// For index-backed constraints, check for dependent views.
 	if indexName != nil {
-		checkForDependentViews(b, tbl.TableID, indexName.IndexID, t.Constraint)
+		checkForDependentViews(b, tbl.TableID, indexName.IndexID, "constraint", t.Constraint)
 	}
 
 	// Rename based on constraint type.
@@ -130,9 +130,11 @@ func checkConstraintNameConflicts(b BuildCtx, tableID catid.DescID, newName tree
 }
 
 // checkForDependentViews ensures that views don't depend on the index being
-// renamed. This follows the same logic as the legacy schema changer.
+// renamed. This follows the same logic as the legacy schema changer. objType
+// and name describe the renamed object in the error, which is either the index
+// itself or the constraint it backs.
 func checkForDependentViews(
-	b BuildCtx, tableID catid.DescID, indexID catid.IndexID, constraintName tree.Name,
+	b BuildCtx, tableID catid.DescID, indexID catid.IndexID, objType string, name tree.Name,
 ) {
 	// Check for views that depend on this index.
 	scpb.ForEachView(b.BackReferences(tableID), func(
@@ -144,16 +146,16 @@ func checkForDependentViews(
 				continue
 			}
 
-			// This view depends on the index-backed constraint being renamed.
+			// This view depends on the index being renamed.
 			viewName := b.QueryByID(e.ViewID).FilterNamespace().MustGetOneElement()
 			tableName := b.QueryByID(tableID).FilterNamespace().MustGetOneElement()
 			// Check if view is in the same database and schema.
 			if viewName.DatabaseID != tableName.DatabaseID || viewName.SchemaID != tableName.SchemaID {
-				panic(sqlerrors.NewDependentBlocksOpError("rename", "constraint",
-					tree.ErrString(&constraintName), "view", qualifiedName(b, e.ViewID)))
+				panic(sqlerrors.NewDependentBlocksOpError("rename", objType,
+					tree.ErrString(&name), "view", qualifiedName(b, e.ViewID)))
 			}
-			panic(sqlerrors.NewDependentBlocksOpError("rename", "constraint",
-				tree.ErrString(&constraintName), "view", viewName.Name))
+			panic(sqlerrors.NewDependentBlocksOpError("rename", objType,
+				tree.ErrString(&name), "view", viewName.Name))
 		}
 	})
 }
@@ -0,0 +1,286 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/enum"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondatapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/errors"
+)
+
+// AlterType implements ALTER TYPE for enum and composite types. Dropping enum
+// values and changing the owner fall back to the legacy schema changer.
+func AlterType(b BuildCtx, n *tree.AlterType) {
+	elts := b.ResolveAlterableType(n.Type)
+	var typ scpb.Element
+	var typeID, arrayTypeID catid.DescID
+	if _, _, enumType := scpb.FindEnumType(elts); enumType != nil {
+		b.IncrementEnumCounter(sqltelemetry.EnumAlter)
+		typeID, arrayTypeID = enumType.TypeID, enumType.ArrayTypeID
+		typ = enumType
+	} else if _, _, compositeType := scpb.FindCompositeType(elts); compositeType != nil {
+		typeID, arrayTypeID = compositeType.TypeID, compositeType.ArrayTypeID
+		typ = compositeType
+	} else {
+		panic(errors.AssertionFailedf("type %q is neither an enum nor a composite type", n.Type))
+	}
+	b.IncrementSchemaChangeAlterCounter("type", n.Cmd.TelemetryName())
+	typeName := tree.MakeTypeNameWithPrefix(b.NamePrefix(typ), simpleName(b, typeID))
+	b.SetUnresolvedNameAnnotation(n.Type, &typeName)
+
+	switch t := n.Cmd.(type) {
+	case *tree.AlterTypeAddValue:
+		alterTypeAddValue(b, elts, typeName, typ, t)
+	case *tree.AlterTypeRenameValue:
+		alterTypeRenameValue(b, elts, typeName, typeID, t)
+	case *tree.AlterTypeRename:
+		alterTypeRename(b, typeName, typeID, arrayTypeID, t)
+	case *tree.AlterTypeSetSchema:
+		alterTypeSetSchema(b, typeName, typeID, arrayTypeID, t)
+	default:
+		panic(errors.AssertionFailedf("unsupported alter type cmd %T", t))
+	}
+}
+
+// alterTypeChecks determines whether the ALTER TYPE command is supported by
+// the declarative schema changer.
+func alterTypeChecks(
+	n *tree.AlterType,
+	mode sessiondatapb.NewSchemaChangerMode,
+	activeVersion clusterversion.ClusterVersion,
+) bool {
+	if !isDeclarativeCreateAndAlterActive(n, mode, activeVersion) {
+		return false
+	}
+	switch n.Cmd.(type) {
+	case *tree.AlterTypeAddValue, *tree.AlterTypeRenameValue,
+		*tree.AlterTypeRename, *tree.AlterTypeSetSchema:
+		return true
+	}
+	return false
+}
+
+// enumTypeValues returns the values of the enum type which are or are becoming
+// public, sorted by their physical representation.
+func enumTypeValues(elts ElementResultSet) []*scpb.EnumTypeValue {
+	values := elts.FilterEnumTypeValue().NotToAbsent().Elements()
+	sort.Slice(values, func(i, j int) bool {
+		return bytes.Compare(values[i].PhysicalRepresentation, values[j].PhysicalRepresentation) < 0
+	})
+	return values
+}
+
+func alterTypeAddValue(
+	b BuildCtx,
+	elts ElementResultSet,
+	typeName tree.TypeName,
+	typ scpb.Element,
+	t *tree.AlterTypeAddValue,
+) {
+	enumType, ok := typ.(*scpb.EnumType)
+	if !ok {
+		panic(pgerror.Newf(pgcode.WrongObjectType, "%q is not an enum", typeName.Object()))
+	}
+	values := enumTypeValues(elts)
+	for _, value := range values {
+		if value.LogicalRepresentation != string(t.NewVal) {
+			continue
+		}
+		if t.IfNotExists {
+			b.EvalCtx().ClientNoticeSender.BufferClientNotice(b,
+				pgnotice.Newf("enum value %q already exists, skipping", t.NewVal))
+			return
+		}
+		panic(pgerror.Newf(pgcode.DuplicateObject, "enum value %q already exists", t.NewVal))
+	}
+	physicalRep := func(idx int) []byte {
+		if idx < 0 || idx >= len(values) {
+			return nil
+		}
+		return values[idx].PhysicalRepresentation
+	}
+	// The new value is inserted between pos and pos+1. By default, values are
+	// inserted at the end of the current list of values.
+	pos := len(values) - 1
+	if t.Placement != nil {
+		found := -1
+		for i, value := range values {
+			if value.LogicalRepresentation == string(t.Placement.ExistingVal) {
+				found = i
+			}
+		}
+		if found == -1 {
+			panic(pgerror.Newf(pgcode.InvalidParameterValue,
+				"%q is not an existing enum value", t.Placement.ExistingVal))
+		}
+		pos = found
+		if t.Placement.Before {
+			pos--
+		}
+	}
+	newValue := &scpb.EnumTypeValue{
+		TypeID: enumType.TypeID,
+		PhysicalRepresentation: enum.GenByteStringBetween(
+			physicalRep(pos), physicalRep(pos+1), enum.SpreadSpacing,
+		),
+		LogicalRepresentation: string(t.NewVal),
+	}
+	b.Add(newValue)
+	b.LogEventForExistingPayload(newValue, &eventpb.AlterType{
+		TypeName: typeName.FQString(),
+	})
+}
+
+func alterTypeRenameValue(
+	b BuildCtx,
+	elts ElementResultSet,
+	typeName tree.TypeName,
+	typeID catid.DescID,
+	t *tree.AlterTypeRenameValue,
+) {
+	var oldValue *scpb.EnumTypeValue
+	var isAdding bool
+	elts.FilterEnumTypeValue().NotToAbsent().ForEach(func(
+		current scpb.Status, _ scpb.TargetStatus, value *scpb.EnumTypeValue,
+	) {
+		switch value.LogicalRepresentation {
+		case string(t.OldVal):
+			oldValue, isAdding = value, current != scpb.Status_PUBLIC
+		case string(t.NewVal):
+			panic(pgerror.Newf(pgcode.DuplicateObject, "enum value %s already exists", t.NewVal))
+		}
+	})
+	if oldValue == nil {
+		panic(pgerror.Newf(pgcode.InvalidParameterValue,
+			"%s is not an existing enum value", t.OldVal))
+	}
+	if isAdding {
+		panic(pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+			"enum value %q is being added, try again later", t.OldVal))
+	}
+	// The renamed value keeps the physical representation of the old one, so
+	// existing rows refer to it without being rewritten.
+	newValue := &scpb.EnumTypeValue{
+		TypeID:                 typeID,
+		PhysicalRepresentation: oldValue.PhysicalRepresentation,
+		LogicalRepresentation:  string(t.NewVal),
+	}
+	b.Drop(oldValue)
+	b.Add(newValue)
+	b.LogEventForExistingPayload(newValue, &eventpb.AlterType{
+		TypeName: typeName.FQString(),
+	})
+}
+
+func alterTypeRename(
+	b BuildCtx,
+	typeName tree.TypeName,
+	typeID, arrayTypeID catid.DescID,
+	t *tree.AlterTypeRename,
+) {
+	newTypeName := typeName
+	newTypeName.ObjectName = t.NewName
+	if ers := resolveObjectByName(b, newTypeName.ToUnresolvedObjectName()); ers != nil {
+		if isTypeElementSet(ers) {
+			panic(sqlerrors.NewTypeAlreadyExistsError(newTypeName.String()))
+		}
+		panic(sqlerrors.NewRelationAlreadyExistsError(newTypeName.String()))
+	}
+	newNamespace := renameTypeNamespace(b, typeID, string(t.NewName))
+	// The array type is renamed along with the type, using the first free name
+	// formed by prefixing the new name with underscores.
+	arrayTypeName := newTypeName
+	for {
+		arrayTypeName.ObjectName = "_" + arrayTypeName.ObjectName
+		if resolveObjectByName(b, arrayTypeName.ToUnresolvedObjectName()) == nil {
+			break
+		}
+	}
+	b.IncrementSubWorkID()
+	renameTypeNamespace(b, arrayTypeID, arrayTypeName.Object())
+	b.LogEventForExistingPayload(newNamespace, &eventpb.RenameType{
+		TypeName:    typeName.FQString(),
+		NewTypeName: string(t.NewName),
+	})
+}
+
+// renameTypeNamespace replaces the namespace entry of a type with one using
+// the new name, and returns the new entry.
+func renameTypeNamespace(b BuildCtx, typeID catid.DescID, newName string) *scpb.Namespace {
+	oldNamespace := mustRetrieveNamespaceElem(b, typeID)
+	newNamespace := *oldNamespace
+	newNamespace.Name = newName
+	b.Drop(oldNamespace)
+	b.Add(&newNamespace)
+	return &newNamespace
+}
+
+func alterTypeSetSchema(
+	b BuildCtx,
+	typeName tree.TypeName,
+	typeID, arrayTypeID catid.DescID,
+	t *tree.AlterTypeSetSchema,
+) {
+	currNamespace := mustRetrieveNamespaceElem(b, typeID)
+	newSchema := resolveSchemaByName(b, t.Schema, currNamespace.DatabaseID)
+	panicIfSchemaIsTemporaryOrVirtual(newSchema)
+	// If the type is already in the schema, this is a no-op.
+	if currNamespace.SchemaID == newSchema.SchemaID {
+		return
+	}
+	newTypeName := typeName
+	newTypeName.SchemaName = t.Schema
+	if ers := resolveObjectByName(b, newTypeName.ToUnresolvedObjectName()); ers != nil {
+		if isTypeElementSet(ers) {
+			panic(sqlerrors.NewTypeAlreadyExistsError(newTypeName.String()))
+		}
+		panic(sqlerrors.NewRelationAlreadyExistsError(newTypeName.String()))
+	}
+	newNamespace, newSchemaChild := setTypeSchema(b, typeID, newSchema.SchemaID)
+	b.IncrementSubWorkID()
+	setTypeSchema(b, arrayTypeID, newSchema.SchemaID)
+	b.LogEventForExistingPayload(newNamespace, &eventpb.SetSchema{
+		DescriptorName:    typeName.FQString(),
+		NewDescriptorName: newTypeName.FQString(),
+		DescriptorType:    "type",
+	})
+	b.LogEventForExistingPayload(newSchemaChild, &eventpb.AlterType{
+		TypeName: newTypeName.FQString(),
+	})
+}
+
+// setTypeSchema moves a type into the schema with the given ID and returns
+// its new namespace and schema child elements.
+func setTypeSchema(
+	b BuildCtx, typeID, schemaID catid.DescID,
+) (*scpb.Namespace, *scpb.SchemaChild) {
+	currNamespace := mustRetrieveNamespaceElem(b, typeID)
+	newNamespace := *currNamespace
+	newNamespace.SchemaID = schemaID
+	b.Drop(currNamespace)
+	b.Add(&newNamespace)
+	currSchemaChild := b.QueryByID(typeID).FilterSchemaChild().MustGetOneElement()
+	newSchemaChild := scpb.SchemaChild{
+		ChildObjectID: typeID,
+		SchemaID:      schemaID,
+	}
+	b.Drop(currSchemaChild)
+	b.Add(&newSchemaChild)
+	return &newNamespace, &newSchemaChild
+}
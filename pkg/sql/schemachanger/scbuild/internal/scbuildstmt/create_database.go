@@ -28,6 +28,7 @@ import (
 func CreateDatabase(b BuildCtx, n *tree.CreateDatabase) {
 	// TODO (xiang): Remove the fallback cases.
 	fallBackCreateDatabaseIfMultiRegion(b, n)
+	fallBackCreateDatabaseIfDefaultCollation(n)
 
 	// 1. Run a bunch of pre-checks.
 	createDatabasePreChecks(b, n)
@@ -157,6 +158,15 @@ func fallBackCreateDatabaseIfMultiRegion(b BuildCtx, n *tree.CreateDatabase) {
 	}
 }
 
+// fallBackCreateDatabaseIfDefaultCollation falls back if the database is
+// created with a default collation, which is only stored on the database
+// descriptor by the legacy schema changer.
+func fallBackCreateDatabaseIfDefaultCollation(n *tree.CreateDatabase) {
+	if locale, err := n.DefaultCollation(); err != nil || locale != "" {
+		panic(scerrors.NotImplementedError(n))
+	}
+}
+
 // createDatabasePreChecks includes a bunch of quick pre-checks.
 // It panics if any checks fails.
 // The logics are copied from legacy schema changer.
@@ -183,17 +193,10 @@ func createDatabasePreChecks(b BuildCtx, n *tree.CreateDatabase) {
 		}
 	}
 
-	if col := n.Collate; col != "" {
-		// We only support C and C.UTF-8.
-		if col != "C" && col != "C.UTF-8" {
-			panic(unimplemented.NewWithIssueDetailf(16618, "create.db.collation",
-				"unsupported collation: %s", col))
-		}
-	}
-
 	if ctype := n.CType; ctype != "" {
-		// We only support C and C.UTF-8.
-		if ctype != "C" && ctype != "C.UTF-8" {
+		// Character classification always follows Unicode, so we only support
+		// C, C.UTF-8 and the locale of the collation.
+		if ctype != "C" && ctype != "C.UTF-8" && ctype != n.Collate {
 			panic(unimplemented.NewWithIssueDetailf(35882, "create.db.classification",
 				"unsupported character classification: %s", ctype))
 		}
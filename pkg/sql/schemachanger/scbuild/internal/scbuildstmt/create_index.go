@@ -48,6 +48,9 @@ func CreateIndex(b BuildCtx, n *tree.CreateIndex) {
 	if n.Type == idxtype.VECTOR && !b.EvalCtx().Settings.Version.ActiveVersion(b).AtLeast(clusterversion.V25_2.Version()) {
 		panic(pgerror.Newf(pgcode.FeatureNotSupported, "cannot create a vector index until finalizing on 25.2"))
 	}
+	if n.Type == idxtype.BRIN && !b.EvalCtx().Settings.Version.ActiveVersion(b).IsActive(clusterversion.V26_2_BrinIndexes) {
+		panic(pgerror.Newf(pgcode.FeatureNotSupported, "cannot create a BRIN index until finalizing on 26.2"))
+	}
 
 	b.IncrementSchemaChangeCreateCounter("index")
 	// Resolve the table name and start building the new index element.
@@ -186,6 +189,22 @@ func CreateIndex(b BuildCtx, n *tree.CreateIndex) {
 		panic(pgerror.Newf(pgcode.InvalidSQLStatementName,
 			"%s indexes can't be unique", strings.ToLower(n.Type.String())))
 	}
+	if n.Type == idxtype.BRIN {
+		if n.Predicate != nil {
+			panic(pgerror.New(pgcode.InvalidSQLStatementName, "brin indexes can't be partial"))
+		}
+		if n.PartitionByIndex.ContainsPartitions() {
+			panic(pgerror.New(pgcode.InvalidSQLStatementName, "brin indexes don't support partitioning"))
+		}
+	}
+
+	// The hidden partition column of a PARTITION BY HASH clause is virtual, so
+	// it can be added to the table along with the index.
+	if n.PartitionByIndex.ContainsPartitions() && n.PartitionByIndex.IsHash() {
+		if _, _, tbl := scpb.FindTable(relationElements); tbl != nil {
+			maybeCreateAndAddHashPartitionCol(b, tbl, n.PartitionByIndex.PartitionBy, n)
+		}
+	}
 
 	// Assign the ID here, since we may have added columns
 	// and made a new primary key above.
@@ -214,6 +233,9 @@ func CreateIndex(b BuildCtx, n *tree.CreateIndex) {
 		if len(n.Columns) > 1 {
 			b.IncrementSchemaChangeIndexCounter("multi_column_vector")
 		}
+
+	case idxtype.BRIN:
+		b.IncrementSchemaChangeIndexCounter("brin")
 	}
 
 	// Assign the secondary constraint ID now, since we may have added a check
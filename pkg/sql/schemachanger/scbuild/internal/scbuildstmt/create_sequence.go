@@ -90,6 +90,9 @@ func doCreateSequence(b BuildCtx, n *tree.CreateSequence) *scpb.Sequence {
 	tempSequenceOpts := descpb.TableDescriptor_SequenceOpts{
 		Increment: 1,
 	}
+	if err := schemaexpr.CheckSequenceCycleSupported(b, b.ClusterSettings().Version, n.Options); err != nil {
+		panic(err)
+	}
 	if err := schemaexpr.AssignSequenceOptions(
 		&tempSequenceOpts,
 		n.Options,
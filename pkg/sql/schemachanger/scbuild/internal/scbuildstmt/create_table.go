@@ -0,0 +1,668 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catenumpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scdecomp"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondatapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/redact"
+	"github.com/lib/pq/oid"
+)
+
+// CreateTable implements CREATE TABLE for plain tables: columns with DEFAULT,
+// ON UPDATE and computed expressions, a primary key, secondary and unique
+// indexes and check constraints. Anything else falls back to the legacy
+// schema changer, see unsupportedCreateTableFeature.
+func CreateTable(b BuildCtx, n *tree.CreateTable) {
+	if feature := unsupportedCreateTableFeature(n); feature != "" {
+		panic(scerrors.NotImplementedErrorf(n, feature))
+	}
+	b.IncrementSchemaChangeCreateCounter("table")
+	if n.OnCommit != tree.CreateTableOnCommitUnset {
+		panic(pgerror.New(pgcode.InvalidTableDefinition,
+			"ON COMMIT can only be used on temporary tables"))
+	}
+	// Column CHECK constraints are moved to the table level, which leaves the
+	// column definitions in the shape tabledesc.MakeColumnDefDescs expects.
+	n.HoistConstraints()
+
+	dbElts, scElts := b.ResolveTargetObject(n.Table.ToUnresolvedObjectName(), privilege.CREATE)
+	_, _, dbElem := scpb.FindDatabase(dbElts)
+	_, _, schemaElem := scpb.FindSchema(scElts)
+	_, _, dbNamespace := scpb.FindNamespace(dbElts)
+	_, _, scNamespace := scpb.FindNamespace(scElts)
+	if schemaElem.IsTemporary {
+		panic(scerrors.NotImplementedErrorf(n, "temporary tables"))
+	}
+	if _, _, dbRegionConfig := scpb.FindDatabaseRegionConfig(dbElts); dbRegionConfig != nil {
+		panic(scerrors.NotImplementedErrorf(n, "CREATE TABLE in a multi-region database"))
+	}
+	n.Table.CatalogName = tree.Name(dbNamespace.Name)
+	n.Table.SchemaName = tree.Name(scNamespace.Name)
+	n.Table.ExplicitCatalog = true
+	n.Table.ExplicitSchema = true
+
+	// Detect name collisions with existing relations and types.
+	if ers := resolveObjectByName(b, n.Table.ToUnresolvedObjectName()); ers != nil {
+		if n.IfNotExists {
+			b.EvalCtx().ClientNoticeSender.BufferClientNotice(b,
+				pgnotice.Newf("relation %q already exists, skipping", n.Table.Table()))
+			return
+		}
+		panic(sqlerrors.NewRelationAlreadyExistsError(n.Table.FQString()))
+	}
+
+	// Set up the descriptor-level elements.
+	tableID := b.GenerateUniqueDescID()
+	tbl := &scpb.Table{TableID: tableID}
+	b.Add(tbl)
+	b.Add(&scpb.Namespace{
+		DatabaseID:   dbElem.DatabaseID,
+		SchemaID:     schemaElem.SchemaID,
+		DescriptorID: tableID,
+		Name:         string(n.Table.ObjectName),
+	})
+	b.Add(&scpb.SchemaChild{
+		ChildObjectID: tableID,
+		SchemaID:      schemaElem.SchemaID,
+	})
+	b.Add(&scpb.TableData{
+		TableID:    tableID,
+		DatabaseID: dbElem.DatabaseID,
+	})
+	ownerElem, userPrivsElems := b.BuildUserPrivilegesFromDefaultPrivileges(
+		dbElem, schemaElem, tableID, privilege.Tables, b.CurrentUser(),
+	)
+	b.Add(ownerElem)
+	for _, userPrivsElem := range userPrivsElems {
+		b.Add(userPrivsElem)
+	}
+	b.Add(&scpb.ColumnFamily{
+		TableID:  tableID,
+		FamilyID: 0,
+		Name:     "primary",
+	})
+
+	ct := createTableState{b: b, n: n, tbl: tbl, dbID: dbElem.DatabaseID}
+	// Columns are added before any expression is built, since computed
+	// columns and constraints may reference columns defined later on.
+	for _, def := range n.Defs {
+		if d, ok := def.(*tree.ColumnTableDef); ok {
+			ct.addColumn(d)
+		}
+	}
+	for _, def := range n.Defs {
+		switch d := def.(type) {
+		case *tree.ColumnTableDef:
+			if d.PrimaryKey.IsPrimaryKey {
+				ct.setPrimaryKey(&tree.IndexTableDef{
+					Columns: tree.IndexElemList{{Column: d.Name}},
+				})
+			}
+			if d.Unique.IsUnique {
+				ct.uniqueIndexes = append(ct.uniqueIndexes, &tree.IndexTableDef{
+					Name:    d.Unique.ConstraintName,
+					Columns: tree.IndexElemList{{Column: d.Name}},
+				})
+			}
+		case *tree.UniqueConstraintTableDef:
+			if d.PrimaryKey {
+				ct.setPrimaryKey(&d.IndexTableDef)
+			} else {
+				ct.uniqueIndexes = append(ct.uniqueIndexes, &d.IndexTableDef)
+			}
+		case *tree.IndexTableDef:
+			ct.indexes = append(ct.indexes, d)
+		case *tree.CheckConstraintTableDef:
+			ct.checks = append(ct.checks, d)
+		}
+	}
+	for _, def := range n.Defs {
+		if d, ok := def.(*tree.ColumnTableDef); ok && d.IsComputed() {
+			ct.addComputeExpression(d)
+		}
+	}
+	if ct.primaryKey == nil {
+		ct.addRowIDColumn()
+	}
+	ct.addPrimaryIndex()
+	for _, d := range ct.uniqueIndexes {
+		ct.addSecondaryIndex(d, true /* isUnique */)
+	}
+	for _, d := range ct.indexes {
+		ct.addSecondaryIndex(d, false /* isUnique */)
+	}
+	for _, d := range ct.checks {
+		ct.addCheckConstraint(d)
+	}
+	if n.StorageParams.GetVal("schema_locked") == nil &&
+		!b.SessionData().Internal &&
+		b.SessionData().CreateTableWithSchemaLocked {
+		b.Add(&scpb.TableSchemaLocked{TableID: tableID})
+	}
+	b.LogEventForExistingTarget(tbl)
+}
+
+// createTableChecks determines whether the CREATE TABLE statement only uses
+// features supported by the declarative schema changer.
+func createTableChecks(
+	n *tree.CreateTable,
+	mode sessiondatapb.NewSchemaChangerMode,
+	activeVersion clusterversion.ClusterVersion,
+) bool {
+	return isDeclarativeCreateAndAlterActive(n, mode, activeVersion) && unsupportedCreateTableFeature(n) == ""
+}
+
+// unsupportedCreateTableFeature returns a description of the first feature
+// used by the CREATE TABLE statement which the declarative schema changer does
+// not support yet, or an empty string if there is none. It only inspects the
+// syntax tree so that it can be used to filter statements cheaply.
+func unsupportedCreateTableFeature(n *tree.CreateTable) redact.RedactableString {
+	switch {
+	case n.As():
+		return "CREATE TABLE ... AS"
+	case n.Persistence != tree.PersistencePermanent,
+		n.Table.SchemaName == catconstants.PgTempSchemaName:
+		return "temporary or unlogged tables"
+	case n.PartitionByTable != nil:
+		return "PARTITION BY"
+	case n.Locality != nil:
+		return "LOCALITY"
+	case len(n.StorageParams) > 0:
+		return "storage parameters"
+	}
+	for _, def := range n.Defs {
+		switch d := def.(type) {
+		case *tree.ColumnTableDef:
+			switch {
+			case d.IsSerial, d.GeneratedIdentity.IsGeneratedAsIdentity:
+				return "SERIAL and identity columns"
+			case d.HasFKConstraint():
+				return "foreign keys"
+			case d.HasColumnFamily():
+				return "column families"
+			case d.IsEncrypted():
+				return "encrypted columns"
+			case d.Unique.WithoutIndex:
+				return "UNIQUE WITHOUT INDEX"
+			case d.PrimaryKey.Sharded:
+				return "hash-sharded indexes"
+			case len(d.PrimaryKey.StorageParams) > 0:
+				return "index storage parameters"
+			}
+		case *tree.UniqueConstraintTableDef:
+			if d.WithoutIndex {
+				return "UNIQUE WITHOUT INDEX"
+			}
+			if feature := unsupportedCreateTableIndexFeature(&d.IndexTableDef); feature != "" {
+				return feature
+			}
+		case *tree.IndexTableDef:
+			if feature := unsupportedCreateTableIndexFeature(d); feature != "" {
+				return feature
+			}
+		case *tree.CheckConstraintTableDef:
+		case *tree.ForeignKeyConstraintTableDef:
+			return "foreign keys"
+		case *tree.FamilyTableDef:
+			return "column families"
+		default:
+			return "table definitions other than columns, indexes and constraints"
+		}
+	}
+	return ""
+}
+
+func unsupportedCreateTableIndexFeature(d *tree.IndexTableDef) redact.RedactableString {
+	switch {
+	case d.Type != idxtype.FORWARD:
+		return "inverted and vector indexes"
+	case d.Sharded != nil:
+		return "hash-sharded indexes"
+	case d.PartitionByIndex != nil:
+		return "PARTITION BY"
+	case len(d.StorageParams) > 0:
+		return "index storage parameters"
+	case d.Predicate != nil:
+		return "partial indexes"
+	}
+	for _, elem := range d.Columns {
+		switch {
+		case elem.Expr != nil:
+			return "expression indexes"
+		case elem.OpClass != "":
+			return "operator classes"
+		case elem.NullsOrder != tree.DefaultNullsOrder:
+			return "NULLS FIRST and NULLS LAST"
+		}
+	}
+	return ""
+}
+
+// createTableState tracks the elements of a table which is being built by
+// CREATE TABLE.
+type createTableState struct {
+	b    BuildCtx
+	n    *tree.CreateTable
+	tbl  *scpb.Table
+	dbID catid.DescID
+
+	// columns holds the columns in the order in which they were defined.
+	columns []createTableColumn
+
+	primaryKey    *tree.IndexTableDef
+	primaryKeyID  catid.IndexID
+	uniqueIndexes []*tree.IndexTableDef
+	indexes       []*tree.IndexTableDef
+	checks        []*tree.CheckConstraintTableDef
+}
+
+type createTableColumn struct {
+	name     tree.Name
+	id       catid.ColumnID
+	colType  *scpb.ColumnType
+	notNull  bool
+	computed bool
+}
+
+func (ct *createTableState) tableName() string {
+	return string(ct.n.Table.ObjectName)
+}
+
+func (ct *createTableState) lookupColumn(name tree.Name) *createTableColumn {
+	for i := range ct.columns {
+		if ct.columns[i].name == name {
+			return &ct.columns[i]
+		}
+	}
+	return nil
+}
+
+func (ct *createTableState) mustLookupColumn(name tree.Name) *createTableColumn {
+	col := ct.lookupColumn(name)
+	if col == nil {
+		panic(colinfo.NewUndefinedColumnError(string(name)))
+	}
+	return col
+}
+
+// addColumn adds the elements for a column definition, except for its
+// computed expression which is added by addComputeExpression.
+func (ct *createTableState) addColumn(d *tree.ColumnTableDef) {
+	b, tableID := ct.b, ct.tbl.TableID
+	if colinfo.IsSystemColumnName(string(d.Name)) {
+		panic(pgerror.Newf(pgcode.DuplicateColumn,
+			"column name %q conflicts with a system column name", d.Name))
+	}
+	if ct.lookupColumn(d.Name) != nil {
+		panic(pgerror.Newf(pgcode.DuplicateColumn, "duplicate column name: %q", d.Name))
+	}
+	if d.IsComputed() {
+		d.Computed.Expr = schemaexpr.MaybeRewriteComputedColumn(d.Computed.Expr, b.SessionData())
+	}
+	d = d.WithDatabaseCollation(b.DatabaseDefaultCollation(ct.dbID))
+	cdd, err := tabledesc.MakeColumnDefDescs(b, d, b.SemaCtx(), b.EvalCtx(), tree.ColumnDefaultExprInNewTable)
+	if err != nil {
+		panic(err)
+	}
+	desc := cdd.ColumnDescriptor
+	creationMetadata := scdecomp.NewElementCreationMetadata(b.EvalCtx().Settings.Version.ActiveVersion(b))
+	col := &scpb.Column{
+		TableID:        tableID,
+		ColumnID:       b.NextTableColumnID(ct.tbl),
+		IsInaccessible: desc.Inaccessible,
+		// Versions from 26.1 store the hidden bit in a separate element.
+		IsHidden: d.Hidden && !creationMetadata.In_26_1OrLater,
+	}
+	b.Add(col)
+	b.Add(&scpb.ColumnName{
+		TableID:  tableID,
+		ColumnID: col.ColumnID,
+		Name:     string(d.Name),
+	})
+	colType := &scpb.ColumnType{
+		TableID:                 tableID,
+		ColumnID:                col.ColumnID,
+		IsVirtual:               desc.Virtual,
+		TypeT:                   b.ResolveTypeRef(d.Type),
+		ElementCreationMetadata: creationMetadata,
+	}
+	if colType.Type.UserDefined() {
+		typeID := typedesc.UserDefinedTypeOIDToID(colType.Type.Oid())
+		maybeFailOnCrossDBTypeReference(b, typeID, ct.dbID)
+	}
+	switch colType.Type.Oid() {
+	case oid.T_int2vector, oid.T_oidvector:
+		panic(pgerror.Newf(pgcode.FeatureNotSupported, "VECTOR column types are unsupported"))
+	}
+	b.Add(colType)
+	if !desc.Nullable {
+		b.Add(&scpb.ColumnNotNull{
+			TableID:  tableID,
+			ColumnID: col.ColumnID,
+		})
+	}
+	if desc.HasDefault() {
+		b.Add(&scpb.ColumnDefaultExpression{
+			TableID:    tableID,
+			ColumnID:   col.ColumnID,
+			Expression: *b.WrapExpression(tableID, cdd.DefaultExpr),
+		})
+	}
+	if desc.HasOnUpdate() {
+		b.Add(&scpb.ColumnOnUpdateExpression{
+			TableID:    tableID,
+			ColumnID:   col.ColumnID,
+			Expression: *b.WrapExpression(tableID, cdd.OnUpdateExpr),
+		})
+	}
+	if d.Hidden && creationMetadata.In_26_1OrLater {
+		b.Add(&scpb.ColumnHidden{
+			TableID:  tableID,
+			ColumnID: col.ColumnID,
+		})
+	}
+	ct.columns = append(ct.columns, createTableColumn{
+		name:     d.Name,
+		id:       col.ColumnID,
+		colType:  colType,
+		notNull:  !desc.Nullable,
+		computed: desc.IsComputed(),
+	})
+	switch colType.Type.Family() {
+	case types.EnumFamily:
+		b.IncrementEnumCounter(sqltelemetry.EnumInTable)
+	default:
+		b.IncrementSchemaChangeAddColumnTypeCounter(colType.Type.TelemetryName())
+	}
+}
+
+// addComputeExpression validates and adds the expression of a computed
+// column. All columns of the table must have been added beforehand.
+func (ct *createTableState) addComputeExpression(d *tree.ColumnTableDef) {
+	b, tableID := ct.b, ct.tbl.TableID
+	col := ct.mustLookupColumn(d.Name)
+	validExpr, _ := b.ComputedColumnExpression(
+		ct.tbl, d, tree.ComputedColumnExprContext(d.IsVirtual()),
+		func() colinfo.ResultColumns {
+			return getNonDropResultColumns(b, tableID)
+		},
+		func(columnName tree.Name) (exists, accessible, computed bool, id catid.ColumnID, typ *types.T) {
+			exists, accessible, computed, id, typ = columnLookupFn(b, tableID, columnName)
+			// Computed columns defined later in the statement have no compute
+			// expression element yet.
+			if other := ct.lookupColumn(columnName); other != nil {
+				computed = computed || other.computed
+			}
+			return exists, accessible, computed, id, typ
+		},
+	)
+	b.Add(&scpb.ColumnComputeExpression{
+		TableID:    tableID,
+		ColumnID:   col.id,
+		Expression: *b.WrapExpression(tableID, validExpr),
+	})
+}
+
+func (ct *createTableState) setPrimaryKey(d *tree.IndexTableDef) {
+	if ct.primaryKey != nil {
+		panic(pgerror.Newf(pgcode.InvalidTableDefinition,
+			"multiple primary keys for table %q are not allowed", ct.tableName()))
+	}
+	ct.primaryKey = d
+}
+
+// addRowIDColumn adds the hidden rowid column which serves as the primary key
+// of tables which do not define one, like tabledesc does.
+func (ct *createTableState) addRowIDColumn() {
+	name := tree.Name(tabledesc.GenerateUniqueName("rowid", func(name string) bool {
+		return ct.lookupColumn(tree.Name(name)) != nil
+	}))
+	defaultExpr, err := parser.ParseExpr("unique_rowid()")
+	if err != nil {
+		panic(err)
+	}
+	ct.addColumn(&tree.ColumnTableDef{
+		Name:   name,
+		Type:   types.Int,
+		Hidden: true,
+		Nullable: struct {
+			Nullability    tree.Nullability
+			ConstraintName tree.Name
+		}{Nullability: tree.NotNull},
+		DefaultExpr: struct {
+			Expr           tree.Expr
+			ConstraintName tree.Name
+		}{Expr: defaultExpr},
+	})
+	ct.primaryKey = &tree.IndexTableDef{
+		Columns: tree.IndexElemList{{Column: name}},
+	}
+}
+
+// addIndexKeyColumns adds the key columns of an index and returns their IDs.
+func (ct *createTableState) addIndexKeyColumns(
+	indexID catid.IndexID, d *tree.IndexTableDef,
+) (keyColumnIDs descpb.ColumnIDs) {
+	for i, elem := range d.Columns {
+		col := ct.mustLookupColumn(elem.Column)
+		if keyColumnIDs.Contains(col.id) {
+			panic(pgerror.Newf(pgcode.DuplicateColumn,
+				"index %q contains duplicate column %q", d.Name, elem.Column))
+		}
+		if !colinfo.ColumnTypeIsIndexable(col.colType.Type) {
+			panic(sqlerrors.NewColumnNotIndexableError(
+				string(elem.Column), col.colType.Type.Name(), col.colType.Type.DebugString(),
+			))
+		}
+		direction := catenumpb.IndexColumn_ASC
+		if elem.Direction == tree.Descending {
+			direction = catenumpb.IndexColumn_DESC
+		}
+		ct.b.Add(&scpb.IndexColumn{
+			TableID:       ct.tbl.TableID,
+			IndexID:       indexID,
+			ColumnID:      col.id,
+			OrdinalInKind: uint32(i),
+			Kind:          scpb.IndexColumn_KEY,
+			Direction:     direction,
+		})
+		keyColumnIDs = append(keyColumnIDs, col.id)
+	}
+	return keyColumnIDs
+}
+
+func (ct *createTableState) addPrimaryIndex() {
+	b, tableID := ct.b, ct.tbl.TableID
+	ct.primaryKeyID = b.NextTableIndexID(tableID)
+	b.Add(&scpb.PrimaryIndex{
+		Index: scpb.Index{
+			TableID:      tableID,
+			IndexID:      ct.primaryKeyID,
+			IsUnique:     true,
+			ConstraintID: b.NextTableConstraintID(tableID),
+		},
+	})
+	name := string(ct.primaryKey.Name)
+	if name == "" {
+		name = tabledesc.PrimaryKeyIndexName(ct.tableName())
+	}
+	b.Add(&scpb.IndexName{
+		TableID: tableID,
+		IndexID: ct.primaryKeyID,
+		Name:    name,
+	})
+	keyColumnIDs := ct.addIndexKeyColumns(ct.primaryKeyID, ct.primaryKey)
+	// Primary key columns are implicitly NOT NULL.
+	for _, colID := range keyColumnIDs {
+		for i := range ct.columns {
+			if col := &ct.columns[i]; col.id == colID {
+				if !col.notNull {
+					b.Add(&scpb.ColumnNotNull{TableID: tableID, ColumnID: col.id})
+					col.notNull = true
+				}
+			}
+		}
+	}
+	// All other stored columns are stored in the primary index.
+	var ordinal uint32
+	for _, col := range ct.columns {
+		if col.colType.IsVirtual || keyColumnIDs.Contains(col.id) {
+			continue
+		}
+		b.Add(&scpb.IndexColumn{
+			TableID:       tableID,
+			IndexID:       ct.primaryKeyID,
+			ColumnID:      col.id,
+			OrdinalInKind: ordinal,
+			Kind:          scpb.IndexColumn_STORED,
+		})
+		ordinal++
+	}
+	b.Add(&scpb.IndexData{TableID: tableID, IndexID: ct.primaryKeyID})
+}
+
+func (ct *createTableState) addSecondaryIndex(d *tree.IndexTableDef, isUnique bool) {
+	b, tableID := ct.b, ct.tbl.TableID
+	idx := &scpb.SecondaryIndex{
+		Index: scpb.Index{
+			TableID:      tableID,
+			IndexID:      b.NextTableIndexID(tableID),
+			IsUnique:     isUnique,
+			IsNotVisible: d.Invisibility.Value != 0.0,
+			Invisibility: d.Invisibility.Value,
+		},
+	}
+	if isUnique {
+		idx.ConstraintID = b.NextTableConstraintID(tableID)
+	}
+	b.Add(idx)
+	keyColumnIDs := ct.addIndexKeyColumns(idx.IndexID, d)
+	// The primary key columns which are not part of the key make up the key
+	// suffix.
+	var suffixColumnIDs descpb.ColumnIDs
+	for _, elem := range ct.primaryKey.Columns {
+		col := ct.mustLookupColumn(elem.Column)
+		if keyColumnIDs.Contains(col.id) {
+			continue
+		}
+		b.Add(&scpb.IndexColumn{
+			TableID:       tableID,
+			IndexID:       idx.IndexID,
+			ColumnID:      col.id,
+			OrdinalInKind: uint32(len(suffixColumnIDs)),
+			Kind:          scpb.IndexColumn_KEY_SUFFIX,
+		})
+		suffixColumnIDs = append(suffixColumnIDs, col.id)
+	}
+	var storedColumnIDs descpb.ColumnIDs
+	for _, name := range d.Storing {
+		col := ct.mustLookupColumn(name)
+		if col.colType.IsVirtual {
+			panic(pgerror.Newf(pgcode.FeatureNotSupported,
+				"index cannot store virtual column %v", name))
+		}
+		if keyColumnIDs.Contains(col.id) || suffixColumnIDs.Contains(col.id) ||
+			storedColumnIDs.Contains(col.id) {
+			panic(sqlerrors.NewColumnAlreadyExistsInIndexError(string(d.Name), string(name)))
+		}
+		b.Add(&scpb.IndexColumn{
+			TableID:       tableID,
+			IndexID:       idx.IndexID,
+			ColumnID:      col.id,
+			OrdinalInKind: uint32(len(storedColumnIDs)),
+			Kind:          scpb.IndexColumn_STORED,
+		})
+		storedColumnIDs = append(storedColumnIDs, col.id)
+	}
+	name := string(d.Name)
+	if name == "" {
+		name = getImplicitSecondaryIndexName(b, tableID, idx.IndexID, 0 /* numImplicitColumns */)
+	} else if indexNameInUse(b, tableID, name) {
+		panic(pgerror.Newf(pgcode.DuplicateRelation, "duplicate index name: %q", name))
+	}
+	b.Add(&scpb.IndexName{
+		TableID: tableID,
+		IndexID: idx.IndexID,
+		Name:    name,
+	})
+	b.Add(&scpb.IndexData{TableID: tableID, IndexID: idx.IndexID})
+}
+
+func (ct *createTableState) addCheckConstraint(d *tree.CheckConstraintTableDef) {
+	b, tableID := ct.b, ct.tbl.TableID
+	ckExpr, _, colIDs, err := schemaexpr.DequalifyAndValidateExprImpl(b, d.Expr, types.Bool,
+		tree.CheckConstraintExpr, b.SemaCtx(), volatility.Volatile, &ct.n.Table,
+		b.ClusterSettings().Version.ActiveVersion(b),
+		func() colinfo.ResultColumns {
+			return getNonDropResultColumns(b, tableID)
+		},
+		func(columnName tree.Name) (exists, accessible, computed bool, id catid.ColumnID, typ *types.T) {
+			return columnLookupFn(b, tableID, columnName)
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	typedCkExpr, err := parser.ParseExpr(ckExpr)
+	if err != nil {
+		panic(err)
+	}
+	name := string(d.Name)
+	if name == "" {
+		name = generateUniqueCheckConstraintName(b, tableID, d.Expr)
+	} else if constraintNameInUse(b, tableID, name) {
+		panic(pgerror.Newf(pgcode.DuplicateObject,
+			"duplicate constraint name: %q", name))
+	}
+	ck := &scpb.CheckConstraint{
+		TableID:      tableID,
+		ConstraintID: b.NextTableConstraintID(tableID),
+		ColumnIDs:    colIDs.Ordered(),
+		Expression:   *b.WrapExpression(tableID, typedCkExpr),
+	}
+	b.Add(ck)
+	b.Add(&scpb.ConstraintWithoutIndexName{
+		TableID:      tableID,
+		ConstraintID: ck.ConstraintID,
+		Name:         name,
+	})
+}
+
+// indexNameInUse returns whether `name` is used by any non-dropping index of
+// the table.
+func indexNameInUse(b BuildCtx, tableID catid.DescID, name string) (ret bool) {
+	scpb.ForEachIndexName(b.QueryByID(tableID).Filter(publicTargetFilter), func(
+		_ scpb.Status, _ scpb.TargetStatus, e *scpb.IndexName,
+	) {
+		if e.Name == name {
+			ret = true
+		}
+	})
+	return ret
+}
@@ -6,10 +6,14 @@
 package scbuildstmt
 
 import (
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/errors"
@@ -99,6 +103,39 @@ func CreateTrigger(b BuildCtx, n *tree.CreateTrigger) {
 			WhenExpr:  string(when.Expr),
 		})
 	}
+	// Builtin trigger functions have no descriptor to reference.
+	var funcID catid.DescID
+	if n.FuncBuiltin == "" {
+		funcID = resolveTriggerFunction(b, n)
+	} else if !b.ClusterSettings().Version.IsActive(b, clusterversion.V26_2_BuiltinTriggerFunctions) {
+		panic(pgerror.Newf(pgcode.FeatureNotSupported,
+			"builtin trigger function %s is only supported after the cluster upgrade is finalized",
+			n.FuncBuiltin))
+	}
+	if n.FuncBody == "" {
+		panic(errors.AssertionFailedf("expected non-empty function body"))
+	}
+	b.Add(&scpb.TriggerFunctionCall{
+		TableID:         tableID,
+		TriggerID:       triggerID,
+		FuncID:          funcID,
+		FuncBody:        b.ReplaceSeqTypeNamesInStatements(n.FuncBody, catpb.Function_PLPGSQL),
+		FuncArgs:        n.FuncArgs,
+		BuiltinFuncName: n.FuncBuiltin,
+	})
+	b.Add(&scpb.TriggerDeps{
+		TableID:        tableID,
+		TriggerID:      triggerID,
+		UsesRelations:  buildRelationDeps(tableID, refProvider),
+		UsesTypeIDs:    refProvider.ReferencedTypes().Ordered(),
+		UsesRoutineIDs: refProvider.ReferencedRoutines().Ordered(),
+	})
+	b.LogEventForExistingTarget(trigger)
+}
+
+// resolveTriggerFunction returns the ID of the user-defined function called by
+// the trigger.
+func resolveTriggerFunction(b BuildCtx, n *tree.CreateTrigger) catid.DescID {
 	routineName, err := n.FuncName.ToRoutineName()
 	if err != nil {
 		panic(err)
@@ -118,24 +155,7 @@ func CreateTrigger(b BuildCtx, n *tree.CreateTrigger) {
 	if fn == nil {
 		panic(errors.AssertionFailedf("expected function %v to be resolved", routineName))
 	}
-	if n.FuncBody == "" {
-		panic(errors.AssertionFailedf("expected non-empty function body"))
-	}
-	b.Add(&scpb.TriggerFunctionCall{
-		TableID:   tableID,
-		TriggerID: triggerID,
-		FuncID:    fn.FunctionID,
-		FuncBody:  b.ReplaceSeqTypeNamesInStatements(n.FuncBody, catpb.Function_PLPGSQL),
-		FuncArgs:  n.FuncArgs,
-	})
-	b.Add(&scpb.TriggerDeps{
-		TableID:        tableID,
-		TriggerID:      triggerID,
-		UsesRelations:  buildRelationDeps(tableID, refProvider),
-		UsesTypeIDs:    refProvider.ReferencedTypes().Ordered(),
-		UsesRoutineIDs: refProvider.ReferencedRoutines().Ordered(),
-	})
-	b.LogEventForExistingTarget(trigger)
+	return fn.FunctionID
 }
 
 // buildRelationDeps builds the list of relations that the trigger depends on.
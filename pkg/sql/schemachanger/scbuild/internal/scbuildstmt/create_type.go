@@ -0,0 +1,180 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/sql/enum"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondatapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+)
+
+// CreateType implements CREATE TYPE ... AS ENUM. Like in postgres, an array
+// type of the enum is created implicitly alongside it. Composite types fall
+// back to the legacy schema changer.
+func CreateType(b BuildCtx, n *tree.CreateType) {
+	if n.Variety != tree.Enum {
+		panic(scerrors.NotImplementedErrorf(n, "only enum types are supported"))
+	}
+	b.IncrementSchemaChangeCreateCounter("type")
+	// Temporary schemas cannot hold types, since the temporary object cleaner
+	// does not know about them.
+	if n.TypeName.HasExplicitSchema() &&
+		strings.HasPrefix(n.TypeName.Schema(), catconstants.PgTempSchemaName) {
+		panic(pgerror.Newf(pgcode.InvalidSchemaName,
+			"cannot create type %q in temporary schema", n.TypeName.Object()))
+	}
+	dbElts, scElts := b.ResolveTargetObject(n.TypeName, privilege.CREATE)
+	_, _, dbElem := scpb.FindDatabase(dbElts)
+	_, _, schemaElem := scpb.FindSchema(scElts)
+	_, _, dbNamespace := scpb.FindNamespace(dbElts)
+	_, _, scNamespace := scpb.FindNamespace(scElts)
+	if dbElem.DatabaseID == keys.SystemDatabaseID {
+		panic(errors.New("cannot create a type in the system database"))
+	}
+	if schemaElem.IsTemporary {
+		panic(pgerror.Newf(pgcode.InvalidSchemaName,
+			"cannot create type %q in temporary schema", n.TypeName.Object()))
+	}
+	typeName := tree.MakeQualifiedTypeName(dbNamespace.Name, scNamespace.Name, n.TypeName.Object())
+	// Types which are built-in in CockroachDB but extensions in the public
+	// schema in postgres cannot be shadowed.
+	if scNamespace.Name == catconstants.PublicSchemaName {
+		if _, ok := types.PublicSchemaAliases[typeName.Object()]; ok {
+			panic(sqlerrors.NewTypeAlreadyExistsError(typeName.String()))
+		}
+	}
+	// Detect name collisions with existing types and relations.
+	if ers := resolveObjectByName(b, typeName.ToUnresolvedObjectName()); ers != nil {
+		if isTypeElementSet(ers) {
+			if n.IfNotExists {
+				b.EvalCtx().ClientNoticeSender.BufferClientNotice(b,
+					pgnotice.Newf("type %q already exists, skipping", &typeName))
+				return
+			}
+			panic(sqlerrors.NewTypeAlreadyExistsError(typeName.String()))
+		}
+		panic(sqlerrors.NewRelationAlreadyExistsError(typeName.String()))
+	}
+
+	// Ensure there are no duplicates in the enum values.
+	seenVals := make(map[tree.EnumValue]struct{}, len(n.EnumLabels))
+	for _, value := range n.EnumLabels {
+		if _, ok := seenVals[value]; ok {
+			panic(pgerror.Newf(pgcode.InvalidObjectDefinition,
+				"enum definition contains duplicate value %q", value))
+		}
+		seenVals[value] = struct{}{}
+	}
+
+	typeID := b.GenerateUniqueDescID()
+	arrayTypeID := b.GenerateUniqueDescID()
+	enumElem := &scpb.EnumType{
+		TypeID:      typeID,
+		ArrayTypeID: arrayTypeID,
+	}
+	b.Add(enumElem)
+	addTypeDescriptorElements(b, dbElem, schemaElem, typeID, typeName.Object())
+	physReps := enum.GenerateNEvenlySpacedBytes(len(n.EnumLabels))
+	for i, label := range n.EnumLabels {
+		b.Add(&scpb.EnumTypeValue{
+			TypeID:                 typeID,
+			PhysicalRepresentation: physReps[i],
+			LogicalRepresentation:  string(label),
+		})
+	}
+
+	// Set up the implicit array type, whose name is the enum's name prefixed
+	// with as many underscores as are needed to make it unique.
+	arrayTypeName := typeName
+	for {
+		arrayTypeName.ObjectName = "_" + arrayTypeName.ObjectName
+		if resolveObjectByName(b, arrayTypeName.ToUnresolvedObjectName()) == nil {
+			break
+		}
+	}
+	b.Add(&scpb.AliasType{
+		TypeID: arrayTypeID,
+		TypeT: newTypeT(types.MakeArray(
+			types.MakeEnum(catid.TypeIDToOID(typeID), catid.TypeIDToOID(arrayTypeID)),
+		)),
+	})
+	addTypeDescriptorElements(b, dbElem, schemaElem, arrayTypeID, arrayTypeName.Object())
+
+	b.LogEventForExistingTarget(enumElem)
+	b.IncrementEnumCounter(sqltelemetry.EnumCreate)
+}
+
+// createTypeChecks determines whether the CREATE TYPE statement creates a type
+// supported by the declarative schema changer.
+func createTypeChecks(
+	n *tree.CreateType,
+	mode sessiondatapb.NewSchemaChangerMode,
+	activeVersion clusterversion.ClusterVersion,
+) bool {
+	return isDeclarativeCreateAndAlterActive(n, mode, activeVersion) && n.Variety == tree.Enum
+}
+
+// addTypeDescriptorElements adds the elements common to all new type
+// descriptors: their name, parent schema, owner and privileges.
+func addTypeDescriptorElements(
+	b BuildCtx, dbElem *scpb.Database, schemaElem *scpb.Schema, typeID catid.DescID, name string,
+) {
+	b.Add(&scpb.Namespace{
+		DatabaseID:   dbElem.DatabaseID,
+		SchemaID:     schemaElem.SchemaID,
+		DescriptorID: typeID,
+		Name:         name,
+	})
+	b.Add(&scpb.SchemaChild{
+		ChildObjectID: typeID,
+		SchemaID:      schemaElem.SchemaID,
+	})
+	ownerElem, userPrivsElems := b.BuildUserPrivilegesFromDefaultPrivileges(
+		dbElem, schemaElem, typeID, privilege.Types, b.CurrentUser(),
+	)
+	b.Add(ownerElem)
+	for _, userPrivsElem := range userPrivsElems {
+		b.Add(userPrivsElem)
+	}
+}
+
+// resolveObjectByName returns the elements of the relation or type with the
+// given name, or nil if there is none.
+func resolveObjectByName(b BuildCtx, name *tree.UnresolvedObjectName) ElementResultSet {
+	ers := b.ResolveRelation(name, ResolveParams{
+		IsExistenceOptional: true,
+		WithOffline:         true,
+		ResolveTypes:        true,
+	})
+	if ers == nil || ers.IsEmpty() {
+		return nil
+	}
+	return ers
+}
+
+// isTypeElementSet returns whether the elements describe a type descriptor.
+func isTypeElementSet(ers ElementResultSet) bool {
+	_, _, enumType := scpb.FindEnumType(ers)
+	_, _, aliasType := scpb.FindAliasType(ers)
+	_, _, compositeType := scpb.FindCompositeType(ers)
+	return enumType != nil || aliasType != nil || compositeType != nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scdecomp"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondatapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+)
+
+// CreateView implements CREATE VIEW for plain, permanent views whose
+// dependencies all live in the view's database. Materialized views,
+// CREATE OR REPLACE VIEW and views with options fall back to the legacy
+// schema changer, see createViewChecks.
+func CreateView(b BuildCtx, n *tree.CreateView) {
+	if !isSupportedCreateView(n) {
+		panic(scerrors.NotImplementedErrorf(n, "CREATE VIEW variant"))
+	}
+	b.IncrementSchemaChangeCreateCounter("view")
+
+	dbElts, scElts := b.ResolveTargetObject(n.Name.ToUnresolvedObjectName(), privilege.CREATE)
+	_, _, dbElem := scpb.FindDatabase(dbElts)
+	_, _, schemaElem := scpb.FindSchema(scElts)
+	_, _, dbNamespace := scpb.FindNamespace(dbElts)
+	_, _, scNamespace := scpb.FindNamespace(scElts)
+	if schemaElem.IsTemporary {
+		panic(scerrors.NotImplementedErrorf(n, "temporary views"))
+	}
+	if _, _, dbRegionConfig := scpb.FindDatabaseRegionConfig(dbElts); dbRegionConfig != nil {
+		panic(scerrors.NotImplementedErrorf(n, "CREATE VIEW in a multi-region database"))
+	}
+	n.Name.CatalogName = tree.Name(dbNamespace.Name)
+	n.Name.SchemaName = tree.Name(scNamespace.Name)
+	n.Name.ExplicitCatalog = true
+	n.Name.ExplicitSchema = true
+
+	// Detect name collisions with existing relations and types.
+	if ers := resolveObjectByName(b, n.Name.ToUnresolvedObjectName()); ers != nil {
+		if n.IfNotExists {
+			b.EvalCtx().ClientNoticeSender.BufferClientNotice(b,
+				pgnotice.Newf("relation %q already exists, skipping", n.Name.Table()))
+			return
+		}
+		panic(sqlerrors.NewRelationAlreadyExistsError(n.Name.FQString()))
+	}
+
+	// Plan the view query to collect its columns and dependencies.
+	refProvider := b.BuildReferenceProvider(n)
+	checkViewReferencesSupported(b, n, refProvider, dbElem.DatabaseID)
+	var forwardRefs []*scpb.View_Reference
+	if err := refProvider.ForEachTableReference(
+		func(tblID descpb.ID, idxID descpb.IndexID, colIDs descpb.ColumnIDs) error {
+			forwardRefs = append(forwardRefs, &scpb.View_Reference{
+				ToID:      tblID,
+				IndexID:   idxID,
+				ColumnIDs: colIDs,
+			})
+			return nil
+		},
+	); err != nil {
+		panic(err)
+	}
+	if err := refProvider.ForEachViewReference(
+		func(viewID descpb.ID, colIDs descpb.ColumnIDs) error {
+			forwardRefs = append(forwardRefs, &scpb.View_Reference{
+				ToID:      viewID,
+				ColumnIDs: colIDs,
+			})
+			return nil
+		},
+	); err != nil {
+		panic(err)
+	}
+	for _, seqID := range refProvider.ReferencedSequences().Ordered() {
+		forwardRefs = append(forwardRefs, &scpb.View_Reference{ToID: seqID})
+	}
+
+	// Set up the descriptor-level elements.
+	viewID := b.GenerateUniqueDescID()
+	view := &scpb.View{
+		ViewID:            viewID,
+		UsesTypeIDs:       refProvider.ReferencedTypes().Ordered(),
+		UsesRelationIDs:   refProvider.ReferencedRelationIDs().Ordered(),
+		UsesRoutineIDs:    refProvider.ReferencedRoutines().Ordered(),
+		ForwardReferences: forwardRefs,
+		ViewQuery:         b.ReplaceSeqTypeNamesInStatements(refProvider.ViewQuery(), catpb.Function_SQL),
+	}
+	b.Add(view)
+	b.Add(&scpb.Namespace{
+		DatabaseID:   dbElem.DatabaseID,
+		SchemaID:     schemaElem.SchemaID,
+		DescriptorID: viewID,
+		Name:         string(n.Name.ObjectName),
+	})
+	b.Add(&scpb.SchemaChild{
+		ChildObjectID: viewID,
+		SchemaID:      schemaElem.SchemaID,
+	})
+	ownerElem, userPrivsElems := b.BuildUserPrivilegesFromDefaultPrivileges(
+		dbElem, schemaElem, viewID, privilege.Tables, b.CurrentUser(),
+	)
+	b.Add(ownerElem)
+	for _, userPrivsElem := range userPrivsElems {
+		b.Add(userPrivsElem)
+	}
+
+	// Add the view's columns. Nullability is enforced by the source data, so
+	// none of them are marked NOT NULL.
+	creationMetadata := scdecomp.NewElementCreationMetadata(b.EvalCtx().Settings.Version.ActiveVersion(b))
+	names := make(map[string]struct{})
+	for i, resCol := range refProvider.ViewColumns() {
+		if _, ok := names[resCol.Name]; ok {
+			panic(pgerror.Newf(pgcode.DuplicateColumn, "duplicate column name: %q", resCol.Name))
+		}
+		names[resCol.Name] = struct{}{}
+		colID := catid.ColumnID(i + 1)
+		b.Add(&scpb.Column{
+			TableID:  viewID,
+			ColumnID: colID,
+		})
+		b.Add(&scpb.ColumnName{
+			TableID:  viewID,
+			ColumnID: colID,
+			Name:     resCol.Name,
+		})
+		typ := resCol.Typ
+		if typ.Family() == types.UnknownFamily {
+			typ = types.String
+		}
+		b.Add(&scpb.ColumnType{
+			TableID:                 viewID,
+			ColumnID:                colID,
+			TypeT:                   newTypeT(typ),
+			ElementCreationMetadata: creationMetadata,
+		})
+	}
+	b.LogEventForExistingTarget(view)
+}
+
+// createViewChecks determines whether the CREATE VIEW statement is supported
+// by the declarative schema changer.
+func createViewChecks(
+	n *tree.CreateView,
+	mode sessiondatapb.NewSchemaChangerMode,
+	activeVersion clusterversion.ClusterVersion,
+) bool {
+	return isDeclarativeCreateAndAlterActive(n, mode, activeVersion) && isSupportedCreateView(n)
+}
+
+// isSupportedCreateView only inspects the syntax tree so that it can be used
+// to filter statements cheaply.
+func isSupportedCreateView(n *tree.CreateView) bool {
+	return !n.Materialized && !n.Replace && n.Options == nil &&
+		n.Persistence == tree.PersistencePermanent &&
+		n.Name.SchemaName != catconstants.PgTempSchemaName
+}
+
+// checkViewReferencesSupported falls back to the legacy schema changer if the
+// view depends on temporary relations, which turn it into a temporary view, or
+// on objects in other databases, which are subject to a cluster setting.
+func checkViewReferencesSupported(
+	b BuildCtx, n *tree.CreateView, refProvider ReferenceProvider, parentDBID descpb.ID,
+) {
+	for _, id := range refProvider.ReferencedRelationIDs().Ordered() {
+		elts := b.QueryByID(id)
+		_, _, tbl := scpb.FindTable(elts)
+		_, _, view := scpb.FindView(elts)
+		_, _, seq := scpb.FindSequence(elts)
+		if (tbl != nil && tbl.IsTemporary) || (view != nil && view.IsTemporary) ||
+			(seq != nil && seq.IsTemporary) {
+			panic(scerrors.NotImplementedErrorf(n, "views referencing temporary relations"))
+		}
+	}
+	var ids []descpb.ID
+	ids = append(ids, refProvider.ReferencedRelationIDs().Ordered()...)
+	ids = append(ids, refProvider.ReferencedTypes().Ordered()...)
+	for _, id := range ids {
+		if _, _, ns := scpb.FindNamespace(b.QueryByID(id)); ns == nil || ns.DatabaseID != parentDBID {
+			panic(scerrors.NotImplementedErrorf(n, "views referencing other databases"))
+		}
+	}
+	for _, id := range refProvider.ReferencedRoutines().Ordered() {
+		schemaParent := b.QueryByID(id).FilterSchemaChild().MustGetOneElement()
+		if ns := b.QueryByID(schemaParent.SchemaID).FilterNamespace().MustGetOneElement(); ns.DatabaseID != parentDBID {
+			panic(scerrors.NotImplementedErrorf(n, "views referencing other databases"))
+		}
+	}
+}
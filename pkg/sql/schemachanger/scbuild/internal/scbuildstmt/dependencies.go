@@ -330,6 +330,11 @@ type TableHelpers interface {
 	// IsTableEmpty returns if the table is empty or not.
 	IsTableEmpty(tbl *scpb.Table) bool
 
+	// DatabaseDefaultCollation returns the locale of the collation given to
+	// string columns created in the database without a COLLATE clause, or the
+	// empty string if the database uses the default collation.
+	DatabaseDefaultCollation(dbID catid.DescID) string
+
 	// TTLExpirationExpression returns a validated TTL expiration expression for
 	// a table's row-level TTL configuration. It verifies that the expression:
 	// - type-checks as a TIMESTAMPTZ.
@@ -345,9 +350,18 @@ type TableHelpers interface {
 
 type FunctionHelpers interface {
 	BuildReferenceProvider(stmt tree.Statement) ReferenceProvider
+	// TypeCheckWithColumnType re-plans the view or routine with the given ID as
+	// if the column of the table had the given type, and returns an error if
+	// it no longer type-checks or if the types of the view's columns change.
+	TypeCheckWithColumnType(dependentID, tableID descpb.ID, columnID descpb.ColumnID, typ *types.T) error
 	WrapFunctionBody(fnID descpb.ID, bodyStr string, lang catpb.Function_Language,
 		returnType tree.ResolvableTypeReference, provider ReferenceProvider) *scpb.FunctionBody
 	ReplaceSeqTypeNamesInStatements(queryStr string, lang catpb.Function_Language) string
+
+	// CastOrOperatorUsingFunction returns a description of a user-defined cast
+	// or operator that is evaluated by calling the given function, or the empty
+	// string if there is none.
+	CastOrOperatorUsingFunction(fnID descpb.ID) string
 }
 
 type SchemaHelpers interface {
@@ -420,6 +434,10 @@ type NameResolver interface {
 	// ResolveUserDefinedTypeType retrieves a type by name and returns its elements.
 	ResolveUserDefinedTypeType(name *tree.UnresolvedObjectName, p ResolveParams) ElementResultSet
 
+	// ResolveAlterableType retrieves a type by name which the current user may
+	// alter using ALTER TYPE and returns its elements.
+	ResolveAlterableType(name *tree.UnresolvedObjectName) ElementResultSet
+
 	// ResolveRelation retrieves a relation by name and returns its elements.
 	ResolveRelation(name *tree.UnresolvedObjectName, p ResolveParams) ElementResultSet
 
@@ -486,6 +504,12 @@ type ReferenceProvider interface {
 	ReferencedRelationIDs() catalog.DescriptorIDSet
 	// ReferencedRoutines returns all referenced routine IDs.
 	ReferencedRoutines() catalog.DescriptorIDSet
+	// ViewQuery returns the query of the view created by a CREATE VIEW
+	// statement, with fully qualified data sources.
+	ViewQuery() string
+	// ViewColumns returns the columns of the view created by a CREATE VIEW
+	// statement.
+	ViewColumns() colinfo.ResultColumns
 }
 
 // TemporarySchemaProvider provides functions needed to help support
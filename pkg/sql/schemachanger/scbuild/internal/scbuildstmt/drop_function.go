@@ -60,5 +60,12 @@ func DropFunction(b BuildCtx, n *tree.DropRoutine) {
 			))
 
 		}
+		if dependent := b.CastOrOperatorUsingFunction(fnID); dependent != "" {
+			panic(pgerror.Newf(
+				pgcode.DependentObjectsStillExist,
+				"cannot drop function %q because other objects ([%s]) still depend on it",
+				toCheckBackRefsNames[i].Name, dependent,
+			))
+		}
 	}
 }
@@ -16,7 +16,6 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
-	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/errors"
 )
 
@@ -51,6 +50,7 @@ func DropOwnedBy(b BuildCtx, n *tree.DropOwnedBy) {
 
 	var objects []descpb.ID
 	var toCheckBackrefs []descpb.ID
+	var droppedCascade []descpb.ID
 
 	// Lookup all objects in the current database.
 	_, _, db := scpb.FindDatabase(b.ResolveDatabase(tree.Name(b.SessionData().Database), ResolveParams{
@@ -73,7 +73,8 @@ func DropOwnedBy(b BuildCtx, n *tree.DropOwnedBy) {
 		for _, role := range normalizedRoles {
 			if owner.Owner == role.Normalized() {
 				if n.DropBehavior == tree.DropCascade {
-					panic(unimplemented.NewWithIssue(55908, "DROP OWNED BY CASCADE is not yet supported"))
+					dropCascadeDescriptor(b, id)
+					droppedCascade = append(droppedCascade, id)
 				} else {
 					if dropRestrictDescriptor(b, id) {
 						toCheckBackrefs = append(toCheckBackrefs, id)
@@ -89,6 +90,20 @@ func DropOwnedBy(b BuildCtx, n *tree.DropOwnedBy) {
 		}
 	}
 
+	// Drop the columns which use any of the dropped types. This is done once all
+	// the owned objects have been dropped, so that the columns of owned tables
+	// are dropped along with their table.
+	for _, id := range droppedCascade {
+		elts := b.QueryByID(id)
+		if _, _, sc := scpb.FindSchema(elts); sc != nil {
+			dropColumnsUsingTypesInSchema(b, n, sc.SchemaID)
+		} else if _, _, enum := scpb.FindEnumType(elts); enum != nil {
+			dropColumnsUsingType(b, n, enum.TypeID)
+		} else if _, _, composite := scpb.FindCompositeType(elts); composite != nil {
+			dropColumnsUsingType(b, n, composite.TypeID)
+		}
+	}
+
 	// Revoke privileges for the database. The current user shouldn't revoke
 	// their own database privileges.
 	dbElts := b.QueryByID(db.DatabaseID)
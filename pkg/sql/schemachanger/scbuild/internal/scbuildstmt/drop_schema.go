@@ -15,7 +15,6 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
-	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 )
 
 // DropSchema implements DROP SCHEMA.
@@ -51,33 +50,32 @@ func DropSchema(b BuildCtx, n *tree.DropSchema) {
 	// Check if there are any back-references which would prevent a DROP RESTRICT.
 	for _, schemaID := range toCheckBackrefs {
 		if n.DropBehavior == tree.DropCascade {
-			// Special case to handle dropped types which aren't supported in CASCADE.
-			var objectIDs, typeIDs catalog.DescriptorIDSet
-			scpb.ForEachSchemaChild(b.BackReferences(schemaID), func(_ scpb.Status, _ scpb.TargetStatus, op *scpb.SchemaChild) {
-				objectIDs.Add(op.ChildObjectID)
-			})
-			objectIDs.ForEach(func(id descpb.ID) {
-				elts := b.QueryByID(id)
-				if _, _, enum := scpb.FindEnumType(elts); enum != nil {
-					typeIDs.Add(enum.TypeID)
-				} else if _, _, alias := scpb.FindAliasType(elts); alias != nil {
-					typeIDs.Add(alias.TypeID)
-				}
-			})
-			typeIDs.ForEach(func(id descpb.ID) {
-				if dependentNames := dependentTypeNames(b, id); len(dependentNames) > 0 {
-					panic(unimplemented.NewWithIssueDetailf(51480, "DROP TYPE CASCADE is not yet supported",
-						"cannot drop type %q because other objects (%v) still depend on it",
-						qualifiedName(b, id), dependentNames))
-				}
-			})
-		} else {
-			backrefs := undroppedBackrefs(b, schemaID)
-			if backrefs.IsEmpty() {
-				continue
-			}
-			panic(pgerror.Newf(pgcode.DependentObjectsStillExist,
-				"schema %q is not empty and CASCADE was not specified", simpleName(b, schemaID)))
+			// Columns outside of the schema which use its types must be dropped too.
+			dropColumnsUsingTypesInSchema(b, n, schemaID)
+			continue
+		}
+		backrefs := undroppedBackrefs(b, schemaID)
+		if backrefs.IsEmpty() {
+			continue
 		}
+		panic(pgerror.Newf(pgcode.DependentObjectsStillExist,
+			"schema %q is not empty and CASCADE was not specified", simpleName(b, schemaID)))
 	}
 }
+
+// dropColumnsUsingTypesInSchema calls dropColumnsUsingType for each of the
+// enum and composite types in a schema which is being dropped with CASCADE.
+func dropColumnsUsingTypesInSchema(b BuildCtx, stmt tree.Statement, schemaID catid.DescID) {
+	var objectIDs catalog.DescriptorIDSet
+	scpb.ForEachSchemaChild(b.BackReferences(schemaID), func(_ scpb.Status, _ scpb.TargetStatus, op *scpb.SchemaChild) {
+		objectIDs.Add(op.ChildObjectID)
+	})
+	objectIDs.ForEach(func(id descpb.ID) {
+		elts := b.QueryByID(id)
+		if _, _, enum := scpb.FindEnumType(elts); enum != nil {
+			dropColumnsUsingType(b, stmt, enum.TypeID)
+		} else if _, _, composite := scpb.FindCompositeType(elts); composite != nil {
+			dropColumnsUsingType(b, stmt, composite.TypeID)
+		}
+	})
+}
@@ -6,11 +6,12 @@
 package scbuildstmt
 
 import (
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
-	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
@@ -19,9 +20,6 @@ import (
 
 // DropType implements DROP TYPE.
 func DropType(b BuildCtx, n *tree.DropType) {
-	if n.DropBehavior == tree.DropCascade {
-		panic(scerrors.NotImplementedErrorf(n, "DROP TYPE CASCADE is not yet supported"))
-	}
 	var toCheckBackrefs []catid.DescID
 	arrayTypesToAlsoCheck := make(map[catid.DescID]catid.DescID)
 	for _, name := range n.Names {
@@ -49,6 +47,7 @@ func DropType(b BuildCtx, n *tree.DropType) {
 		// Drop the type.
 		if n.DropBehavior == tree.DropCascade {
 			dropCascadeDescriptor(b, typeID)
+			dropColumnsUsingType(b, n, typeID)
 		} else {
 			if dropRestrictDescriptor(b, typeID) {
 				toCheckBackrefs = append(toCheckBackrefs, typeID)
@@ -87,3 +86,117 @@ func dependentTypeNames(b BuildCtx, typeID catid.DescID) (dependentNames []strin
 	})
 	return dependentNames
 }
+
+// dropColumnsUsingType drops, with CASCADE, the columns whose type refers to
+// the given type, to its implicit array type, or to any type defined in terms
+// of either. dropCascadeDescriptor leaves these columns alone because it
+// only ever reaches them while dropping the entire table, schema or database
+// which contains them; this must therefore be called after the type has been
+// dropped so that columns of tables which are being dropped anyway are skipped.
+// Partial indexes whose predicate refers to one of these types are dropped as
+// well, once the columns are, since they may already be gone along with them.
+func dropColumnsUsingType(b BuildCtx, stmt tree.Statement, typeID catid.DescID) {
+	var typeIDs catalog.DescriptorIDSet
+	var collect func(id catid.DescID)
+	collect = func(id catid.DescID) {
+		if id == descpb.InvalidID || typeIDs.Contains(id) {
+			return
+		}
+		typeIDs.Add(id)
+		elts := b.QueryByID(id)
+		if _, _, enum := scpb.FindEnumType(elts); enum != nil {
+			collect(enum.ArrayTypeID)
+		} else if _, _, composite := scpb.FindCompositeType(elts); composite != nil {
+			collect(composite.ArrayTypeID)
+		}
+		b.BackReferences(id).ForEach(func(_ scpb.Status, _ scpb.TargetStatus, e scpb.Element) {
+			switch t := e.(type) {
+			case *scpb.AliasType:
+				collect(t.TypeID)
+			case *scpb.CompositeTypeAttrType:
+				collect(t.CompositeTypeID)
+			}
+		})
+	}
+	collect(typeID)
+	typeIDs.ForEach(func(id descpb.ID) {
+		scpb.ForEachColumnType(b.BackReferences(id), func(
+			_ scpb.Status, target scpb.TargetStatus, ct *scpb.ColumnType,
+		) {
+			if target != scpb.ToPublic || !catalog.MakeDescriptorIDSet(ct.TypeT.ClosedTypeIDs...).Contains(id) {
+				return
+			}
+			dropColumnUsingDroppedType(b, stmt, ct)
+		})
+	})
+	typeIDs.ForEach(func(id descpb.ID) {
+		scpb.ForEachSecondaryIndex(b.BackReferences(id), func(
+			_ scpb.Status, target scpb.TargetStatus, idx *scpb.SecondaryIndex,
+		) {
+			if target != scpb.ToPublic || idx.EmbeddedExpr == nil ||
+				!catalog.MakeDescriptorIDSet(idx.EmbeddedExpr.UsesTypeIDs...).Contains(id) {
+				return
+			}
+			dropPartialIndexUsingDroppedType(b, stmt, idx)
+		})
+	})
+}
+
+// dropPartialIndexUsingDroppedType drops the partial index whose predicate
+// uses a dropped type, unless the index or its table is already being dropped.
+func dropPartialIndexUsingDroppedType(b BuildCtx, stmt tree.Statement, idx *scpb.SecondaryIndex) {
+	tblElts := b.QueryByID(idx.TableID)
+	_, tblTarget, tbl := scpb.FindTable(tblElts)
+	if tbl == nil || tblTarget == scpb.ToAbsent {
+		return
+	}
+	idxElts := tblElts.Filter(hasIndexIDAttrFilter(idx.IndexID))
+	_, idxTarget, sie := scpb.FindSecondaryIndex(idxElts)
+	if sie == nil || idxTarget == scpb.ToAbsent {
+		// The index was already dropped along with one of its columns.
+		return
+	}
+	if err := b.CheckPrivilege(tbl, privilege.CREATE); err != nil {
+		panic(err)
+	}
+	panicIfRegionChangeUnderwayOnRBRTable(b, "DROP INDEX", tbl.TableID)
+	_, _, in := scpb.FindIndexName(idxElts)
+	_, _, ns := scpb.FindNamespace(tblElts)
+	b.EvalCtx().ClientNoticeSender.BufferClientNotice(b, pgnotice.Newf(
+		"drop cascades to index %s of table %s", in.Name, ns.Name,
+	))
+	indexName := &tree.TableIndexName{
+		Table: tree.MakeTableNameFromPrefix(b.NamePrefix(tbl), tree.Name(ns.Name)),
+		Index: tree.UnrestrictedName(in.Name),
+	}
+	dropSecondaryIndex(b, indexName, tree.DropCascade, sie, stmt)
+	b.LogEventForExistingTarget(sie)
+}
+
+// dropColumnUsingDroppedType drops the column described by the ColumnType
+// element, unless its table is already being dropped.
+func dropColumnUsingDroppedType(b BuildCtx, stmt tree.Statement, ct *scpb.ColumnType) {
+	tblElts := b.QueryByID(ct.TableID)
+	_, tblTarget, tbl := scpb.FindTable(tblElts)
+	if tbl == nil || tblTarget == scpb.ToAbsent {
+		return
+	}
+	colElts := tblElts.Filter(hasColumnIDAttrFilter(ct.ColumnID))
+	_, colTarget, col := scpb.FindColumn(colElts)
+	if col == nil || colTarget == scpb.ToAbsent {
+		// The column was already dropped as a dependent of another column.
+		return
+	}
+	if err := b.CheckPrivilege(tbl, privilege.CREATE); err != nil {
+		panic(err)
+	}
+	panicIfRegionChangeUnderwayOnRBRTable(b, "DROP COLUMN", tbl.TableID)
+	_, _, cn := scpb.FindColumnName(colElts)
+	_, _, ns := scpb.FindNamespace(tblElts)
+	tn := tree.MakeTableNameFromPrefix(b.NamePrefix(tbl), tree.Name(ns.Name))
+	b.EvalCtx().ClientNoticeSender.BufferClientNotice(b, pgnotice.Newf(
+		"drop cascades to column %s of table %s", cn.Name, ns.Name,
+	))
+	dropColumn(b, &tn, tbl, stmt, stmt, col, colElts, tree.DropCascade)
+	b.LogEventForExistingTarget(col)
+}
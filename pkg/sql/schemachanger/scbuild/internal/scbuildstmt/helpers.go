@@ -232,10 +232,16 @@ func dropCascadeDescriptor(b BuildCtx, id catid.DescID) {
 			dropCascadeDescriptor(next, t.TypeID)
 		case *scpb.CompositeType:
 			dropCascadeDescriptor(next, t.TypeID)
+		case *scpb.CompositeTypeAttrType:
+			// Composite types cannot be altered, so drop the entire composite type
+			// rather than just the attribute.
+			dropCascadeDescriptor(next, t.CompositeTypeID)
 		case *scpb.FunctionBody:
 			dropCascadeDescriptor(next, t.FunctionID)
 		case *scpb.TriggerFunctionCall:
-			dropCascadeDescriptor(next, t.FuncID)
+			if t.FuncID != descpb.InvalidID {
+				dropCascadeDescriptor(next, t.FuncID)
+			}
 		case *scpb.TriggerDeps:
 			// Drop only the trigger, not the entire table that owns it.
 			dropTrigger(next, t.TableID, t.TriggerID)
@@ -243,12 +249,15 @@ func dropCascadeDescriptor(b BuildCtx, id catid.DescID) {
 			// Drop only the policy, not the entire table that owns it.
 			dropPolicy(next, t.TableID, t.PolicyID)
 		case *scpb.Column, *scpb.ColumnType:
-			// These only have type references.
+			// These only have type references. Columns of a dropped type outside
+			// of the dropped descriptors are handled by dropColumnsUsingType.
 			break
 		case *scpb.Namespace, *scpb.Function, *scpb.SecondaryIndex, *scpb.PrimaryIndex,
 			*scpb.TableLocalitySecondaryRegion, *scpb.Trigger:
 			// These can be safely skipped and will be cleaned up on their own because
-			// of dependents cleaned up above.
+			// of dependents cleaned up above. Partial indexes whose predicate uses a
+			// dropped type outside of the dropped descriptors are handled by
+			// dropColumnsUsingType.
 		case
 			*scpb.ColumnDefaultExpression,
 			*scpb.ColumnOnUpdateExpression,
@@ -8,12 +8,14 @@ package scbuildstmt
 import (
 	"strings"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/config/zonepb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catenumpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
@@ -65,37 +67,52 @@ func configureIndexDescForNewIndexPartitioning(
 				return err
 			}
 		}
-		localityRBR := b.QueryByID(tableID).FilterTableLocalityRegionalByRow().
-			MustGetZeroOrOneElement()
-		allowImplicitPartitioning := b.EvalCtx().SessionData().ImplicitColumnPartitioningEnabled ||
-			localityRBR != nil
-		if partitionBy != nil {
-			oldNumImplicitColumns := 0
-			if mutatedSpec.partitioning != nil {
-				oldNumImplicitColumns = int(prevSpec.partitioning.NumImplicitColumns)
-			}
-			oldKeyColumns := make([]string, 0, len(prevSpec.columns))
-			for _, col := range prevSpec.columns {
-				if col.Kind == scpb.IndexColumn_KEY {
-					oldKeyColumns = append(oldKeyColumns,
-						mustRetrieveColumnName(b, col.TableID, col.ColumnID).Name)
-				}
-			}
-			newImplicitCols, newPartitioning, err := createPartitioning(
-				b,
-				tableID,
-				partitionBy,
-				oldNumImplicitColumns,
-				oldKeyColumns,
-				nil, /* allowedNewColumnNames */
-				allowImplicitPartitioning,
-			)
-			if err != nil {
-				return err
-			}
-			updateIndexPartitioning(mutatedSpec, isPrimary /* isIndexPrimary */, newImplicitCols, newPartitioning)
+		return partitionIndexSpec(b, tableID, prevSpec, mutatedSpec, isPrimary, partitionBy)
+	}
+	return nil
+}
+
+// partitionIndexSpec applies the given partitioning, if any, to the mutated
+// index spec. prevSpec is the spec the mutated spec was derived from.
+func partitionIndexSpec(
+	b BuildCtx,
+	tableID catid.DescID,
+	prevSpec *indexSpec,
+	mutatedSpec *indexSpecMutator,
+	isPrimary bool,
+	partitionBy *tree.PartitionBy,
+) error {
+	if partitionBy == nil {
+		return nil
+	}
+	localityRBR := b.QueryByID(tableID).FilterTableLocalityRegionalByRow().
+		MustGetZeroOrOneElement()
+	allowImplicitPartitioning := b.EvalCtx().SessionData().ImplicitColumnPartitioningEnabled ||
+		localityRBR != nil
+	oldNumImplicitColumns := 0
+	if mutatedSpec.partitioning != nil {
+		oldNumImplicitColumns = int(prevSpec.partitioning.NumImplicitColumns)
+	}
+	oldKeyColumns := make([]string, 0, len(prevSpec.columns))
+	for _, col := range prevSpec.columns {
+		if col.Kind == scpb.IndexColumn_KEY {
+			oldKeyColumns = append(oldKeyColumns,
+				mustRetrieveColumnName(b, col.TableID, col.ColumnID).Name)
 		}
 	}
+	newImplicitCols, newPartitioning, err := createPartitioning(
+		b,
+		tableID,
+		partitionBy,
+		oldNumImplicitColumns,
+		oldKeyColumns,
+		nil, /* allowedNewColumnNames */
+		allowImplicitPartitioning,
+	)
+	if err != nil {
+		return err
+	}
+	updateIndexPartitioning(mutatedSpec, isPrimary /* isIndexPrimary */, newImplicitCols, newPartitioning)
 	return nil
 }
 
@@ -190,6 +207,9 @@ func partitionByFromTableIDImpl(
 	if part.NumColumns() == 0 {
 		return nil, nil
 	}
+	if partitionBy := tabledesc.HashPartitionByFromPartitioning(part); partitionBy != nil {
+		return partitionBy, nil
+	}
 
 	// We don't need real prefixes in the DecodePartitionTuple calls because we
 	// only use the tree.Datums part of the output.
@@ -325,6 +345,20 @@ func createPartitioning(
 		return nil, newPartitioning, nil
 	}
 
+	// PARTITION BY HASH is implemented as a LIST partitioning on a hidden
+	// computed column, which is never one of the explicitly indexed columns.
+	var hashColNames []string
+	if partBy.IsHash() {
+		hashColNames, partBy, err = desugarHashPartitioning(b, tableID, partBy, allowedNewColumnNames)
+		if err != nil {
+			return nil, newPartitioning, err
+		}
+		// The hidden partition column is virtual, so it needs no backfill and
+		// may be added along with the partitioning.
+		allowedNewColumnNames = append(allowedNewColumnNames[:len(allowedNewColumnNames):len(allowedNewColumnNames)], partBy.Fields[0])
+		allowImplicitPartitioning = true
+	}
+
 	// Truncate existing implicitly partitioned column names.
 	newIdxColumnNames := oldKeyColumnNames[oldNumImplicitColumns:]
 
@@ -380,9 +414,53 @@ func createPartitioning(
 	if err != nil {
 		return nil, catpb.PartitioningDescriptor{}, err
 	}
+	newPartitioning.HashColumnNames = hashColNames
 	return newImplicitCols, newPartitioning, err
 }
 
+// desugarHashPartitioning validates a PARTITION BY HASH clause, and returns the
+// names of the hashed columns along with the LIST partitioning on the hidden
+// partition column which implements it. The hidden column must already have
+// been added to the table by the caller, though it need not be public yet, see
+// maybeCreateAndAddHashPartitionCol.
+func desugarHashPartitioning(
+	b BuildCtx, tableID catid.DescID, partBy *tree.PartitionBy, allowedNewColumnNames []tree.Name,
+) (hashColNames []string, listPartBy *tree.PartitionBy, _ error) {
+	if !b.EvalCtx().Settings.Version.IsActive(b, clusterversion.V26_2_HashPartitioning) {
+		return nil, nil, pgerror.New(pgcode.FeatureNotSupported,
+			"PARTITION BY HASH is not supported until the upgrade to v26.2 is finalized")
+	}
+	if err := tabledesc.ValidateHashPartitionCount(partBy.HashPartitions); err != nil {
+		return nil, nil, err
+	}
+	hasColumnNamed := func(colName string) bool {
+		return !b.QueryByID(tableID).FilterColumnName().Filter(
+			func(_ scpb.Status, target scpb.TargetStatus, e *scpb.ColumnName) bool {
+				return target != scpb.ToAbsent && e.Name == colName
+			}).IsEmpty()
+	}
+	hashColNames, hashColName := tabledesc.HashPartitionColumnNames(partBy)
+	for _, colName := range hashColNames {
+		if !hasColumnNamed(colName) {
+			return nil, nil, colinfo.NewUndefinedColumnError(colName)
+		}
+		if _, err := findColumnByNameOnTable(
+			b, tableID, tree.Name(colName), allowedNewColumnNames,
+		); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !hasColumnNamed(hashColName) {
+		return nil, nil, errors.WithHint(
+			pgerror.Newf(pgcode.FeatureNotSupported,
+				"hash partitioning column %q does not exist", hashColName),
+			"PARTITION BY HASH can only be used when creating a table, or with the "+
+				"declarative schema changer in CREATE INDEX and ALTER TABLE ... PARTITION BY",
+		)
+	}
+	return hashColNames, tabledesc.HashPartitionByToList(partBy), nil
+}
+
 // collectImplicitPartitionColumns collects implicit partitioning columns.
 func collectImplicitPartitionColumns(
 	b BuildCtx,
@@ -481,9 +559,10 @@ func createPartitioningImpl(
 			Filter(func(_ scpb.Status, _ scpb.TargetStatus, e *scpb.ColumnType) bool {
 				return e.ColumnID == col.ColumnID
 			}).MustGetOneElement().Type.Family()
-		if colTypFamily == types.ArrayFamily {
-			return partDesc, unimplemented.NewWithIssuef(91766, "partitioning by array column (%s) not supported",
-				col.Name)
+		if colTypFamily == types.PGVectorFamily {
+			// Can't partition by a column that does not have linear ordering.
+			return partDesc, pgerror.Newf(pgcode.FeatureNotSupported,
+				"partitioning by vector column (%s) not supported", col.Name)
 		}
 	}
 
@@ -501,6 +580,10 @@ func createPartitioningImpl(
 			p.Values = append(p.Values, encodedTuple)
 		}
 		if l.Subpartition != nil {
+			if l.Subpartition.IsHash() {
+				return partDesc, pgerror.Newf(pgcode.FeatureNotSupported,
+					"PARTITION %s: cannot subpartition by HASH", p.Name)
+			}
 			newColOffset := colOffset + int(partDesc.NumColumns)
 			if numImplicitColumns > 0 {
 				return catpb.PartitioningDescriptor{}, unimplemented.New(
@@ -43,38 +43,46 @@ var supportedStatements = map[reflect.Type]supportedStatement{
 	// Alter table will have commands individually whitelisted via the
 	// supportedAlterTableStatements list, so we will consider it fully supported
 	// here.
-	reflect.TypeOf((*tree.AlterTable)(nil)):          {fn: AlterTable, statementTags: []string{tree.AlterTableTag}, on: true, checks: alterTableChecks},
-	reflect.TypeOf((*tree.AlterPolicy)(nil)):         {fn: AlterPolicy, statementTags: []string{tree.AlterPolicyTag}, on: true, checks: isV251Active},
-	reflect.TypeOf((*tree.AlterSequence)(nil)):       {fn: AlterSequence, statementTags: []string{tree.AlterSequenceTag}, on: true, checks: isV262Active},
-	reflect.TypeOf((*tree.CommentOnColumn)(nil)):     {fn: CommentOnColumn, statementTags: []string{tree.CommentOnColumnTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CommentOnConstraint)(nil)): {fn: CommentOnConstraint, statementTags: []string{tree.CommentOnConstraintTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CommentOnDatabase)(nil)):   {fn: CommentOnDatabase, statementTags: []string{tree.CommentOnDatabaseTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CommentOnIndex)(nil)):      {fn: CommentOnIndex, statementTags: []string{tree.CommentOnIndexTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CommentOnSchema)(nil)):     {fn: CommentOnSchema, statementTags: []string{tree.CommentOnSchemaTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CommentOnTable)(nil)):      {fn: CommentOnTable, statementTags: []string{tree.CommentOnTableTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CommentOnType)(nil)):       {fn: CommentOnType, statementTags: []string{tree.CommentOnTypeTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CreateDatabase)(nil)):      {fn: CreateDatabase, statementTags: []string{tree.CreateDatabaseTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CreateIndex)(nil)):         {fn: CreateIndex, statementTags: []string{tree.CreateIndexTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CreatePolicy)(nil)):        {fn: CreatePolicy, statementTags: []string{tree.CreatePolicyTag}, on: true, checks: isV251Active},
-	reflect.TypeOf((*tree.CreateRoutine)(nil)):       {fn: CreateFunction, statementTags: []string{tree.CreateFunctionTag, tree.CreateProcedureTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CreateSchema)(nil)):        {fn: CreateSchema, statementTags: []string{tree.CreateSchemaTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CreateSequence)(nil)):      {fn: CreateSequence, statementTags: []string{tree.CreateSequenceTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.CreateTrigger)(nil)):       {fn: CreateTrigger, statementTags: []string{tree.CreateTriggerTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropDatabase)(nil)):        {fn: DropDatabase, statementTags: []string{tree.DropDatabaseTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropRoutine)(nil)):         {fn: DropFunction, statementTags: []string{tree.DropFunctionTag, tree.DropProcedureTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropIndex)(nil)):           {fn: DropIndex, statementTags: []string{tree.DropIndexTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropOwnedBy)(nil)):         {fn: DropOwnedBy, statementTags: []string{tree.DropOwnedByTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropPolicy)(nil)):          {fn: DropPolicy, statementTags: []string{tree.DropPolicyTag}, on: true, checks: isV251Active},
-	reflect.TypeOf((*tree.DropSchema)(nil)):          {fn: DropSchema, statementTags: []string{tree.DropSchemaTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropSequence)(nil)):        {fn: DropSequence, statementTags: []string{tree.DropSequenceTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropTable)(nil)):           {fn: DropTable, statementTags: []string{tree.DropTableTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropTrigger)(nil)):         {fn: DropTrigger, statementTags: []string{tree.DropTriggerTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropType)(nil)):            {fn: DropType, statementTags: []string{tree.DropTypeTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.DropView)(nil)):            {fn: DropView, statementTags: []string{tree.DropViewTag}, on: true, checks: nil},
-	reflect.TypeOf((*tree.SetZoneConfig)(nil)):       {fn: SetZoneConfig, statementTags: []string{tree.ConfigureZoneTag}, on: true, checks: isV251Active},
-	reflect.TypeOf((*tree.Truncate)(nil)):            {fn: Truncate, statementTags: []string{tree.TruncateTag}, on: true, checks: isV254Active},
-	reflect.TypeOf((*tree.RenameTable)(nil)):         {fn: RenameTable, statementTags: []string{tree.AlterTableTag}, on: true, checks: isV254Active},
-	reflect.TypeOf((*tree.AlterTableSetSchema)(nil)): {fn: AlterTableSetSchema, statementTags: []string{tree.AlterTableTag}, on: true, checks: isV261Active},
+	reflect.TypeOf((*tree.AlterTable)(nil)):                {fn: AlterTable, statementTags: []string{tree.AlterTableTag}, on: true, checks: alterTableChecks},
+	reflect.TypeOf((*tree.AlterDatabasePlacement)(nil)):    {fn: AlterDatabasePlacement, statementTags: []string{tree.AlterDatabaseTag}, on: true, checks: isDeclarativeCreateAndAlterActive},
+	reflect.TypeOf((*tree.AlterDatabaseSurvivalGoal)(nil)): {fn: AlterDatabaseSurvivalGoal, statementTags: []string{tree.AlterDatabaseTag}, on: true, checks: isDeclarativeCreateAndAlterActive},
+	reflect.TypeOf((*tree.AlterPolicy)(nil)):               {fn: AlterPolicy, statementTags: []string{tree.AlterPolicyTag}, on: true, checks: isV251Active},
+	reflect.TypeOf((*tree.AlterSequence)(nil)):             {fn: AlterSequence, statementTags: []string{tree.AlterSequenceTag}, on: true, checks: isV262Active},
+	reflect.TypeOf((*tree.AlterType)(nil)):                 {fn: AlterType, statementTags: []string{tree.AlterTypeTag}, on: true, checks: alterTypeChecks},
+	reflect.TypeOf((*tree.CommentOnColumn)(nil)):           {fn: CommentOnColumn, statementTags: []string{tree.CommentOnColumnTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CommentOnConstraint)(nil)):       {fn: CommentOnConstraint, statementTags: []string{tree.CommentOnConstraintTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CommentOnDatabase)(nil)):         {fn: CommentOnDatabase, statementTags: []string{tree.CommentOnDatabaseTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CommentOnIndex)(nil)):            {fn: CommentOnIndex, statementTags: []string{tree.CommentOnIndexTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CommentOnSchema)(nil)):           {fn: CommentOnSchema, statementTags: []string{tree.CommentOnSchemaTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CommentOnTable)(nil)):            {fn: CommentOnTable, statementTags: []string{tree.CommentOnTableTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CommentOnType)(nil)):             {fn: CommentOnType, statementTags: []string{tree.CommentOnTypeTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CreateDatabase)(nil)):            {fn: CreateDatabase, statementTags: []string{tree.CreateDatabaseTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CreateIndex)(nil)):               {fn: CreateIndex, statementTags: []string{tree.CreateIndexTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CreatePolicy)(nil)):              {fn: CreatePolicy, statementTags: []string{tree.CreatePolicyTag}, on: true, checks: isV251Active},
+	reflect.TypeOf((*tree.CreateRoutine)(nil)):             {fn: CreateFunction, statementTags: []string{tree.CreateFunctionTag, tree.CreateProcedureTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CreateSchema)(nil)):              {fn: CreateSchema, statementTags: []string{tree.CreateSchemaTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CreateSequence)(nil)):            {fn: CreateSequence, statementTags: []string{tree.CreateSequenceTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CreateTable)(nil)):               {fn: CreateTable, statementTags: []string{tree.CreateTableTag}, on: true, checks: createTableChecks},
+	reflect.TypeOf((*tree.CreateTrigger)(nil)):             {fn: CreateTrigger, statementTags: []string{tree.CreateTriggerTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.CreateType)(nil)):                {fn: CreateType, statementTags: []string{tree.CreateTypeTag}, on: true, checks: createTypeChecks},
+	reflect.TypeOf((*tree.CreateView)(nil)):                {fn: CreateView, statementTags: []string{tree.CreateViewTag}, on: true, checks: createViewChecks},
+	reflect.TypeOf((*tree.DropDatabase)(nil)):              {fn: DropDatabase, statementTags: []string{tree.DropDatabaseTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropRoutine)(nil)):               {fn: DropFunction, statementTags: []string{tree.DropFunctionTag, tree.DropProcedureTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropIndex)(nil)):                 {fn: DropIndex, statementTags: []string{tree.DropIndexTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropOwnedBy)(nil)):               {fn: DropOwnedBy, statementTags: []string{tree.DropOwnedByTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropPolicy)(nil)):                {fn: DropPolicy, statementTags: []string{tree.DropPolicyTag}, on: true, checks: isV251Active},
+	reflect.TypeOf((*tree.DropSchema)(nil)):                {fn: DropSchema, statementTags: []string{tree.DropSchemaTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropSequence)(nil)):              {fn: DropSequence, statementTags: []string{tree.DropSequenceTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropTable)(nil)):                 {fn: DropTable, statementTags: []string{tree.DropTableTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropTrigger)(nil)):               {fn: DropTrigger, statementTags: []string{tree.DropTriggerTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropType)(nil)):                  {fn: DropType, statementTags: []string{tree.DropTypeTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.DropView)(nil)):                  {fn: DropView, statementTags: []string{tree.DropViewTag}, on: true, checks: nil},
+	reflect.TypeOf((*tree.RenameDatabase)(nil)):            {fn: RenameDatabase, statementTags: []string{tree.AlterDatabaseTag}, on: true, checks: isDeclarativeCreateAndAlterActive},
+	reflect.TypeOf((*tree.RenameIndex)(nil)):               {fn: RenameIndex, statementTags: []string{tree.AlterIndexTag}, on: true, checks: isV262Active},
+	reflect.TypeOf((*tree.SetZoneConfig)(nil)):             {fn: SetZoneConfig, statementTags: []string{tree.ConfigureZoneTag}, on: true, checks: isV251Active},
+	reflect.TypeOf((*tree.Truncate)(nil)):                  {fn: Truncate, statementTags: []string{tree.TruncateTag}, on: true, checks: isV254Active},
+	reflect.TypeOf((*tree.RenameTable)(nil)):               {fn: RenameTable, statementTags: []string{tree.AlterTableTag}, on: true, checks: isV254Active},
+	reflect.TypeOf((*tree.AlterTableSetSchema)(nil)):       {fn: AlterTableSetSchema, statementTags: []string{tree.AlterTableTag}, on: true, checks: isV261Active},
 }
 
 // supportedStatementTags tracks statement tags which are implemented
@@ -271,3 +279,7 @@ var isV261Active = func(_ tree.NodeFormatter, _ sessiondatapb.NewSchemaChangerMo
 var isV262Active = func(_ tree.NodeFormatter, _ sessiondatapb.NewSchemaChangerMode, activeVersion clusterversion.ClusterVersion) bool {
 	return activeVersion.IsActive(clusterversion.V26_2)
 }
+
+var isDeclarativeCreateAndAlterActive = func(_ tree.NodeFormatter, _ sessiondatapb.NewSchemaChangerMode, activeVersion clusterversion.ClusterVersion) bool {
+	return activeVersion.IsActive(clusterversion.V26_2_DeclarativeCreateAndAlterStatements)
+}
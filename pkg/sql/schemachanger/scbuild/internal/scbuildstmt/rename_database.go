@@ -0,0 +1,119 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/errors"
+)
+
+// RenameDatabase implements ALTER DATABASE ... RENAME TO.
+func RenameDatabase(b BuildCtx, n *tree.RenameDatabase) {
+	if n.Name == "" || n.NewName == "" {
+		panic(sqlerrors.ErrEmptyDatabaseName)
+	}
+	if string(n.Name) == b.SessionData().Database && b.SessionData().SafeUpdates {
+		panic(pgerror.DangerousStatementf("RENAME DATABASE on current database"))
+	}
+	var elts ElementResultSet
+	if b.CurrentUserHasAdminOrIsMemberOf(username.AdminRoleName()) {
+		// Admins must have the DROP privilege on the database, which prevents
+		// them from renaming, e.g., the system database.
+		elts = b.ResolveDatabase(n.Name, ResolveParams{RequiredPrivilege: privilege.DROP})
+	} else {
+		// Other users must own the database and have the CREATEDB privilege.
+		elts = b.ResolveDatabase(n.Name, ResolveParams{RequiredPrivilege: privilege.CONNECT})
+		if !b.HasOwnership(elts.FilterDatabase().MustGetOneElement()) {
+			panic(pgerror.Newf(pgcode.InsufficientPrivilege,
+				"must be owner of database %s", n.Name))
+		}
+		hasCreateDB, err := b.HasGlobalPrivilegeOrRoleOption(b, privilege.CREATEDB)
+		if err != nil {
+			panic(err)
+		}
+		if !hasCreateDB {
+			panic(pgerror.New(pgcode.InsufficientPrivilege, "permission denied to rename database"))
+		}
+	}
+	if n.Name == n.NewName {
+		return
+	}
+	db := elts.FilterDatabase().MustGetOneElement()
+	checkDatabaseRenameDependencies(b, db.DatabaseID)
+	if databaseExists(b, n.NewName) {
+		panic(pgerror.Newf(pgcode.DuplicateDatabase,
+			"the new database name %q already exists", n.NewName))
+	}
+
+	oldNamespace := elts.FilterNamespace().MustGetOneElement()
+	newNamespace := *oldNamespace
+	newNamespace.Name = string(n.NewName)
+	b.Drop(oldNamespace)
+	b.Add(&newNamespace)
+	b.LogEventForExistingPayload(&newNamespace, &eventpb.RenameDatabase{
+		DatabaseName:    n.Name.String(),
+		NewDatabaseName: string(n.NewName),
+	})
+}
+
+// checkDatabaseRenameDependencies panics if any view, routine or trigger
+// depends on a table or view in the database. These refer to the relations by
+// name, including the database name, so they would break after the rename.
+func checkDatabaseRenameDependencies(b BuildCtx, dbID catid.DescID) {
+	dbName := simpleName(b, dbID)
+	b.BackReferences(dbID).FilterSchema().ForEach(func(
+		_ scpb.Status, _ scpb.TargetStatus, sc *scpb.Schema,
+	) {
+		scName := simpleName(b, sc.SchemaID)
+		b.BackReferences(sc.SchemaID).ForEach(func(
+			_ scpb.Status, _ scpb.TargetStatus, e scpb.Element,
+		) {
+			var relationID catid.DescID
+			switch t := e.(type) {
+			case *scpb.Table:
+				relationID = t.TableID
+			case *scpb.View:
+				relationID = t.ViewID
+			default:
+				// Sequences are always referenced by ID.
+				return
+			}
+			var dependentIDs catalog.DescriptorIDSet
+			undroppedBackrefs(b, relationID).ForEach(func(
+				_ scpb.Status, _ scpb.TargetStatus, e scpb.Element,
+			) {
+				switch t := e.(type) {
+				case *scpb.View:
+					dependentIDs.Add(t.ViewID)
+				case *scpb.FunctionBody:
+					dependentIDs.Add(t.FunctionID)
+				case *scpb.TriggerDeps:
+					dependentIDs.Add(t.TableID)
+				}
+			})
+			if dependentIDs.Empty() {
+				return
+			}
+			relationName := tree.MakeTableNameWithSchema(
+				tree.Name(dbName), tree.Name(scName), tree.Name(simpleName(b, relationID)),
+			)
+			dependentName := qualifiedName(b, dependentIDs.Ordered()[0])
+			panic(errors.WithHintf(sqlerrors.NewDependentObjectErrorf(
+				"cannot rename database because relation %q depends on relation %q",
+				dependentName, relationName.String(),
+			), "consider dropping %q first", dependentName))
+		})
+	})
+}
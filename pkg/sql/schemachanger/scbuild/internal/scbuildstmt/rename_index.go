@@ -0,0 +1,48 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scbuildstmt
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// RenameIndex implements ALTER INDEX ... RENAME TO.
+func RenameIndex(b BuildCtx, n *tree.RenameIndex) {
+	indexElts := b.ResolveIndexByName(n.Index, ResolveParams{
+		IsExistenceOptional: n.IfExists,
+		RequiredPrivilege:   privilege.CREATE,
+	})
+	if indexElts == nil {
+		// Attempt to resolve this index failed but `IF EXISTS` is set.
+		b.MarkNameAsNonExistent(&n.Index.Table)
+		return
+	}
+	indexName := indexElts.FilterIndexName().NotToAbsent().MustGetOneElement()
+	tableID := indexName.TableID
+	defer checkTableSchemaChangePrerequisites(b, b.QueryByID(tableID), n)()
+
+	checkForDependentViews(b, tableID, indexName.IndexID, "index", tree.Name(n.Index.Index))
+	if n.NewName == "" {
+		panic(pgerror.New(pgcode.Syntax, "empty index name"))
+	}
+	// Short circuit if the name is unchanged.
+	if n.Index.Index == n.NewName {
+		return
+	}
+	if indexNameInUse(b, tableID, string(n.NewName)) {
+		panic(pgerror.Newf(pgcode.DuplicateRelation, "index name %q already exists", string(n.NewName)))
+	}
+	b.Drop(indexName)
+	b.Add(&scpb.IndexName{
+		TableID: tableID,
+		IndexID: indexName.IndexID,
+		Name:    string(n.NewName),
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/multiregion"
@@ -1447,12 +1448,28 @@ func configureZoneConfigForNewIndexBackfill(
 	// NOTE: The subzones for the old index and temporary index will eventually
 	// be removed by the schema change GC job, but we need them to be present
 	// for the duration of this schema change.
+	// Partitions are matched by name, so that the zone configurations of
+	// partitions which keep their name are carried over when the primary index
+	// is repartitioned, e.g. by ALTER TABLE ... PARTITION BY HASH, and those of
+	// partitions which no longer exist are not.
 	for _, idxToAdd := range newIndexesForBackfill {
 		// Only update new subzone references.
 		if _, found := indexesAlreadyMapped[uint32(idxToAdd)]; found {
 			continue
 		}
+		partitionNames := make(map[string]struct{})
+		if err := mustRetrievePartitioningFromIndexPartitioning(b, tableID, idxToAdd).ForEachPartitionName(
+			func(name string) error {
+				partitionNames[name] = struct{}{}
+				return nil
+			},
+		); err != nil {
+			return err
+		}
 		for _, subzone := range newZoneConfig.Subzones {
+			if _, found := partitionNames[subzone.PartitionName]; subzone.PartitionName != "" && !found {
+				continue
+			}
 			if subzone.IndexID == uint32(oldIndexID) {
 				subzone.IndexID = uint32(idxToAdd)
 				newSubzones = append(newSubzones, subzone)
@@ -1645,6 +1662,10 @@ func applyZoneConfigForMultiRegionTable(
 	deleteZoneConfig := newZoneConfigIsEmpty && !currentZoneConfigIsEmpty
 
 	if deleteZoneConfig {
+		b.QueryByID(tableID).FilterTableZoneConfig().NotToAbsent().ForEach(
+			func(_ scpb.Status, _ scpb.TargetStatus, e *scpb.TableZoneConfig) {
+				b.Drop(e)
+			})
 		return nil
 	}
 	if !rewriteZoneConfig {
@@ -1743,6 +1764,45 @@ func applyZoneConfigForMultiRegionTableOptionNewIndexes(
 	}
 }
 
+// applyZoneConfigForMultiRegionTableOptionTableAndIndexes applies the table
+// and index zone configs implied by the locality of the table, overwriting
+// the multi-region fields of any existing configuration.
+func applyZoneConfigForMultiRegionTableOptionTableAndIndexes(
+	localityConfig catpb.LocalityConfig, indexIDs ...descpb.IndexID,
+) applyZoneConfigForMultiRegionTableOption {
+	return func(
+		zoneConfig zonepb.ZoneConfig,
+		regionConfig multiregion.RegionConfig,
+		tableID catid.DescID,
+	) (newZoneConfig zonepb.ZoneConfig, err error) {
+		localityZoneConfig, err := regions.ZoneConfigForMultiRegionTable(localityConfig, regionConfig)
+		if err != nil {
+			return zoneConfig, err
+		}
+		// Wipe out the multi-region fields of the subzones, which are either
+		// regenerated below or no longer apply to the table's locality.
+		zoneConfig.ClearFieldsOfAllSubzones(zonepb.MultiRegionZoneConfigFields)
+		zoneConfig.CopyFromZone(localityZoneConfig, zonepb.MultiRegionZoneConfigFields)
+		if localityConfig.GetRegionalByRow() == nil {
+			return zoneConfig, nil
+		}
+		for _, region := range regionConfig.Regions() {
+			subzoneConfig, err := regions.ZoneConfigForMultiRegionPartition(region, regionConfig)
+			if err != nil {
+				return zoneConfig, err
+			}
+			for _, indexID := range indexIDs {
+				zoneConfig.SetSubzone(zonepb.Subzone{
+					IndexID:       uint32(indexID),
+					PartitionName: string(region),
+					Config:        subzoneConfig,
+				})
+			}
+		}
+		return zoneConfig, nil
+	}
+}
+
 // applyZoneConfigForMultiRegionTableOption is an option that can be passed into
 // applyZoneConfigForMultiRegionTable.
 type applyZoneConfigForMultiRegionTableOption func(
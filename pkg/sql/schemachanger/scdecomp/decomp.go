@@ -141,6 +141,8 @@ func (w *walkCtx) walkDatabase(db catalog.DatabaseDescriptor) {
 		w.ev(scpb.Status_PUBLIC, &scpb.DatabaseRegionConfig{
 			DatabaseID:       db.GetID(),
 			RegionEnumTypeID: db.GetRegionConfig().RegionEnumID,
+			SurvivalGoal:     uint32(db.GetRegionConfig().SurvivalGoal),
+			Placement:        uint32(db.GetRegionConfig().Placement),
 		})
 	}
 	w.ev(scpb.Status_PUBLIC, &scpb.DatabaseData{DatabaseID: db.GetID()})
@@ -267,6 +269,7 @@ func GetSequenceOptions(
 	addSequenceOption(tree.SeqOptMaxValue, defaultOpts.MaxValue, opts.MaxValue)
 	addSequenceOption(tree.SeqOptStart, defaultOpts.Start, opts.Start)
 	addSequenceOption(tree.SeqOptVirtual, defaultOpts.Virtual, opts.Virtual)
+	addSequenceOption(tree.SeqOptCycle, defaultOpts.Cycle, opts.Cycle)
 	addSequenceOption(tree.SeqOptCacheSession, defaultOpts.SessionCacheSize, opts.SessionCacheSize)
 	addSequenceOption(tree.SeqOptCacheNode, defaultOpts.NodeCacheSize, opts.NodeCacheSize)
 	addSequenceOption(tree.SeqOptAs, defaultOpts.AsIntegerType, opts.AsIntegerType)
@@ -585,6 +588,7 @@ func (w *walkCtx) walkColumn(tbl catalog.TableDescriptor, col catalog.Column) {
 			TableID:                 tbl.GetID(),
 			ColumnID:                col.GetID(),
 			IsVirtual:               col.IsVirtual(),
+			MissingValue:            col.GetMissingValue(),
 			ElementCreationMetadata: NewElementCreationMetadata(w.clusterVersion),
 		}
 		_ = tbl.ForeachFamily(func(family *descpb.ColumnFamilyDescriptor) error {
@@ -930,11 +934,12 @@ func (w *walkCtx) walkTrigger(tbl catalog.TableDescriptor, t *descpb.TriggerDesc
 		})
 	}
 	w.ev(scpb.Status_PUBLIC, &scpb.TriggerFunctionCall{
-		TableID:   tbl.GetID(),
-		TriggerID: t.ID,
-		FuncID:    t.FuncID,
-		FuncBody:  t.FuncBody,
-		FuncArgs:  t.FuncArgs,
+		TableID:         tbl.GetID(),
+		TriggerID:       t.ID,
+		FuncID:          t.FuncID,
+		FuncBody:        t.FuncBody,
+		FuncArgs:        t.FuncArgs,
+		BuiltinFuncName: t.BuiltinFuncName,
 	})
 	w.ev(scpb.Status_PUBLIC, &scpb.TriggerDeps{
 		TableID:        tbl.GetID(),
@@ -266,6 +266,16 @@ func (d *buildDeps) ResolveTypeByOID(ctx context.Context, oid oid.Oid) (*types.T
 	return d.schemaResolver.ResolveTypeByOID(ctx, oid)
 }
 
+// ResolveCollation implements the tree.CollationResolver interface.
+func (d *buildDeps) ResolveCollation(
+	ctx context.Context, name string,
+) (locale string, found bool, _ error) {
+	if cr, ok := d.schemaResolver.(tree.CollationResolver); ok {
+		return cr.ResolveCollation(ctx, name)
+	}
+	return "", false, nil
+}
+
 // ResolveFunction implements the scbuild.CatalogReader interface.
 func (d *buildDeps) ResolveFunction(
 	ctx context.Context, name tree.UnresolvedRoutineName, path tree.SearchPath,
@@ -78,6 +78,7 @@ func (i *immediateVisitor) addNewColumnType(
 	col.Type = op.ColumnType.Type
 	col.Nullable = true
 	col.Virtual = op.ColumnType.IsVirtual
+	col.MissingValue = op.ColumnType.MissingValue
 	if !col.Virtual {
 		for i := range tbl.Families {
 			fam := &tbl.Families[i]
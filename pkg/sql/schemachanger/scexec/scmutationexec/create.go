@@ -64,6 +64,9 @@ func (i *immediateVisitor) AddDescriptorName(ctx context.Context, op scop.AddDes
 	case *tabledesc.Mutable:
 		t.ParentID = op.Namespace.DatabaseID
 		t.UnexposedParentSchemaID = op.Namespace.SchemaID
+	case *typedesc.Mutable:
+		t.ParentID = op.Namespace.DatabaseID
+		t.ParentSchemaID = op.Namespace.SchemaID
 	}
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scop"
 	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
+	"github.com/cockroachdb/errors"
 )
 
 func (i *immediateVisitor) CreateDatabaseDescriptor(
@@ -35,6 +36,21 @@ func (i *immediateVisitor) CreateDatabaseDescriptor(
 	return nil
 }
 
+func (i *immediateVisitor) UpdateDatabaseRegionConfig(
+	ctx context.Context, op scop.UpdateDatabaseRegionConfig,
+) error {
+	db, err := i.checkOutDatabase(ctx, op.DatabaseID)
+	if err != nil {
+		return err
+	}
+	if db.RegionConfig == nil {
+		return errors.AssertionFailedf("database %d is not a multi-region database", op.DatabaseID)
+	}
+	db.RegionConfig.SurvivalGoal = op.SurvivalGoal
+	db.RegionConfig.Placement = op.Placement
+	return nil
+}
+
 func (i *immediateVisitor) AddDatabaseZoneConfig(
 	ctx context.Context, op scop.AddDatabaseZoneConfig,
 ) error {
@@ -295,6 +295,9 @@ func updateColumnExprFunctionsUsage(d *descpb.ColumnDescriptor) error {
 		}
 		ids.ForEach(all.Add)
 	}
+	if d.MaskingPolicy != nil {
+		all.Add(d.MaskingPolicy.FunctionID)
+	}
 	d.UsesFunctionIds = all.Ordered()
 	return nil
 }
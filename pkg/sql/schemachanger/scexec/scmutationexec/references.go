@@ -827,3 +827,21 @@ func (i *immediateVisitor) SetObjectParentID(ctx context.Context, op scop.SetObj
 	}
 	return nil
 }
+
+func (i *immediateVisitor) UpdateColumnIDInBackReferences(
+	ctx context.Context, op scop.UpdateColumnIDInBackReferences,
+) error {
+	tbl, err := i.checkOutTable(ctx, op.TableID)
+	if err != nil || tbl.Dropped() {
+		return err
+	}
+	for j := range tbl.DependedOnBy {
+		ref := &tbl.DependedOnBy[j]
+		for k, colID := range ref.ColumnIDs {
+			if colID == op.OldColumnID {
+				ref.ColumnIDs[k] = op.NewColumnID
+			}
+		}
+	}
+	return nil
+}
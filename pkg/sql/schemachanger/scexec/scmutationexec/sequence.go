@@ -81,6 +81,7 @@ func (i *immediateVisitor) SetSequenceOption(ctx context.Context, op scop.SetSeq
 		tree.SeqOptCacheSession: {SetFunc: setIntValue(&sc.SequenceOpts.SessionCacheSize)},
 		tree.SeqOptCacheNode:    {SetFunc: setIntValue(&sc.SequenceOpts.NodeCacheSize)},
 		tree.SeqOptVirtual:      {SetFunc: setBoolValue(&sc.SequenceOpts.Virtual)},
+		tree.SeqOptCycle:        {SetFunc: setBoolValue(&sc.SequenceOpts.Cycle)},
 		tree.SeqOptAs: {SetFunc: func(Value string) error {
 			sc.SequenceOpts.AsIntegerType = Value
 			return nil
@@ -132,6 +133,8 @@ func (i *immediateVisitor) UnsetSequenceOption(
 		return nil
 	case tree.SeqOptVirtual:
 		setOp.Value = fmt.Sprintf("%t", defaultOpts.Virtual)
+	case tree.SeqOptCycle:
+		setOp.Value = fmt.Sprintf("%t", defaultOpts.Cycle)
 	default:
 		panic(fmt.Sprintf("unexpected sequence option: %s", op.Key))
 	}
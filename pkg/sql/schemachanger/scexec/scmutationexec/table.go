@@ -9,12 +9,100 @@ import (
 	"context"
 
 	"github.com/cockroachdb/cockroach/pkg/config/zonepb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scop"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
 	"github.com/cockroachdb/cockroach/pkg/sql/storageparam/tablestorageparam"
 	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
 )
 
+func (i *immediateVisitor) CreateTableDescriptor(
+	_ context.Context, op scop.CreateTableDescriptor,
+) error {
+	mut := tabledesc.NewBuilder(&descpb.TableDescriptor{
+		ParentID:      catid.InvalidDescID, // Set by `Namespace` element
+		Name:          "",                  // Set by `Namespace` element
+		ID:            op.TableID,
+		Privileges:    &catpb.PrivilegeDescriptor{Version: catpb.Version23_2}, // Populated by `UserPrivileges` elements and `Owner` element
+		Version:       1,
+		FormatVersion: descpb.InterleavedFormatVersion,
+		Temporary:     op.Temporary,
+	}).BuildCreatedMutable()
+	tableDesc := mut.(*tabledesc.Mutable)
+	tableDesc.State = descpb.DescriptorState_ADD
+	i.CreateDescriptor(mut)
+	return nil
+}
+
+func (i *immediateVisitor) CreateViewDescriptor(
+	_ context.Context, op scop.CreateViewDescriptor,
+) error {
+	mut := tabledesc.NewBuilder(&descpb.TableDescriptor{
+		ParentID:      catid.InvalidDescID, // Set by `Namespace` element
+		Name:          "",                  // Set by `Namespace` element
+		ID:            op.ViewID,
+		Privileges:    &catpb.PrivilegeDescriptor{Version: catpb.Version23_2}, // Populated by `UserPrivileges` elements and `Owner` element
+		Version:       1,
+		FormatVersion: descpb.InterleavedFormatVersion,
+		ViewQuery:     op.ViewQuery,
+	}).BuildCreatedMutable()
+	viewDesc := mut.(*tabledesc.Mutable)
+	viewDesc.State = descpb.DescriptorState_ADD
+	i.CreateDescriptor(mut)
+	return nil
+}
+
+func (i *immediateVisitor) AddViewBackReferences(
+	ctx context.Context, op scop.AddViewBackReferences,
+) error {
+	view, err := i.checkOutTable(ctx, op.ViewID)
+	if err != nil {
+		return err
+	}
+	view.DependsOn = op.RelationIDs
+	view.DependsOnTypes = op.TypeIDs
+	view.DependsOnFunctions = op.RoutineIDs
+	relIDToReferences := make(map[descpb.ID][]descpb.TableDescriptor_Reference)
+	for _, ref := range op.ForwardReferences {
+		relIDToReferences[ref.ToID] = append(relIDToReferences[ref.ToID], descpb.TableDescriptor_Reference{
+			ID:        op.ViewID,
+			IndexID:   ref.IndexID,
+			ColumnIDs: ref.ColumnIDs,
+		})
+	}
+	for _, relID := range op.RelationIDs {
+		refs := relIDToReferences[relID]
+		rel, err := i.checkOutTable(ctx, relID)
+		if err != nil {
+			return err
+		}
+		if rel.IsSequence() {
+			refs = []descpb.TableDescriptor_Reference{{ID: op.ViewID, ByID: true}}
+		}
+		if err := updateBackReferencesInRelation(ctx, i, relID, op.ViewID, refs); err != nil {
+			return err
+		}
+	}
+	if err := updateBackReferencesInTypes(
+		ctx, i, op.TypeIDs, op.ViewID, catalog.MakeDescriptorIDSet(op.TypeIDs...),
+	); err != nil {
+		return err
+	}
+	for _, routineID := range op.RoutineIDs {
+		fn, err := i.checkOutFunction(ctx, routineID)
+		if err != nil {
+			return err
+		}
+		if err := fn.AddViewReference(op.ViewID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (i *immediateVisitor) AddTableZoneConfig(
 	ctx context.Context, op scop.AddTableZoneConfig,
 ) error {
@@ -113,6 +113,7 @@ func (i *immediateVisitor) SetTriggerFunctionCall(
 	trigger.FuncID = op.FunctionCall.FuncID
 	trigger.FuncArgs = op.FunctionCall.FuncArgs
 	trigger.FuncBody = op.FunctionCall.FuncBody
+	trigger.BuiltinFuncName = op.FunctionCall.BuiltinFuncName
 	return nil
 }
 
@@ -0,0 +1,122 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package scmutationexec
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scop"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/errors"
+)
+
+func (i *immediateVisitor) CreateEnumTypeDescriptor(
+	_ context.Context, op scop.CreateEnumTypeDescriptor,
+) error {
+	mut := typedesc.NewBuilder(&descpb.TypeDescriptor{
+		ParentID:       catid.InvalidDescID, // Set by `Namespace` element
+		ParentSchemaID: catid.InvalidDescID, // Set by `Namespace` element
+		Name:           "",                  // Set by `Namespace` element
+		ID:             op.TypeID,
+		Kind:           descpb.TypeDescriptor_ENUM,
+		ArrayTypeID:    op.ArrayTypeID,
+		Privileges:     &catpb.PrivilegeDescriptor{Version: catpb.Version23_2}, // Populated by `UserPrivileges` elements and `Owner` element
+		Version:        1,
+		State:          descpb.DescriptorState_ADD,
+	}).BuildCreatedMutableType()
+	i.CreateDescriptor(mut)
+	return nil
+}
+
+func (i *immediateVisitor) CreateAliasTypeDescriptor(
+	_ context.Context, op scop.CreateAliasTypeDescriptor,
+) error {
+	mut := typedesc.NewBuilder(&descpb.TypeDescriptor{
+		ParentID:       catid.InvalidDescID, // Set by `Namespace` element
+		ParentSchemaID: catid.InvalidDescID, // Set by `Namespace` element
+		Name:           "",                  // Set by `Namespace` element
+		ID:             op.TypeID,
+		Kind:           descpb.TypeDescriptor_ALIAS,
+		Alias:          op.TypeT.Type,
+		Privileges:     &catpb.PrivilegeDescriptor{Version: catpb.Version23_2}, // Populated by `UserPrivileges` elements and `Owner` element
+		Version:        1,
+		State:          descpb.DescriptorState_ADD,
+	}).BuildCreatedMutableType()
+	i.CreateDescriptor(mut)
+	return nil
+}
+
+// AddEnumTypeValue adds a read-only member to an enum type. Members are kept
+// sorted by their physical representation, which is how the type descriptor
+// expects them to be ordered.
+func (i *immediateVisitor) AddEnumTypeValue(ctx context.Context, op scop.AddEnumTypeValue) error {
+	typ, err := i.checkOutType(ctx, op.TypeID)
+	if err != nil {
+		return err
+	}
+	for _, member := range typ.EnumMembers {
+		if member.LogicalRepresentation == op.LogicalRepresentation {
+			return errors.AssertionFailedf("enum value %q already exists in type %d",
+				op.LogicalRepresentation, op.TypeID)
+		}
+	}
+	typ.EnumMembers = append(typ.EnumMembers, descpb.TypeDescriptor_EnumMember{
+		LogicalRepresentation:  op.LogicalRepresentation,
+		PhysicalRepresentation: op.PhysicalRepresentation,
+		Capability:             descpb.TypeDescriptor_EnumMember_READ_ONLY,
+		Direction:              descpb.TypeDescriptor_EnumMember_ADD,
+	})
+	sort.Slice(typ.EnumMembers, func(a, b int) bool {
+		return bytes.Compare(
+			typ.EnumMembers[a].PhysicalRepresentation, typ.EnumMembers[b].PhysicalRepresentation,
+		) < 0
+	})
+	return nil
+}
+
+func (i *immediateVisitor) MakeEnumTypeValuePublic(
+	ctx context.Context, op scop.MakeEnumTypeValuePublic,
+) error {
+	typ, err := i.checkOutType(ctx, op.TypeID)
+	if err != nil {
+		return err
+	}
+	for idx := range typ.EnumMembers {
+		member := &typ.EnumMembers[idx]
+		if member.LogicalRepresentation == op.LogicalRepresentation {
+			member.Capability = descpb.TypeDescriptor_EnumMember_ALL
+			member.Direction = descpb.TypeDescriptor_EnumMember_NONE
+			return nil
+		}
+	}
+	return errors.AssertionFailedf("enum value %q not found in type %d",
+		op.LogicalRepresentation, op.TypeID)
+}
+
+func (i *immediateVisitor) RemoveEnumTypeValue(
+	ctx context.Context, op scop.RemoveEnumTypeValue,
+) error {
+	typ, err := i.checkOutType(ctx, op.TypeID)
+	if err != nil {
+		return err
+	}
+	// The members of a dropped type are removed along with its descriptor.
+	if typ.Dropped() {
+		return nil
+	}
+	for idx := range typ.EnumMembers {
+		if typ.EnumMembers[idx].LogicalRepresentation == op.LogicalRepresentation {
+			typ.EnumMembers = append(typ.EnumMembers[:idx], typ.EnumMembers[idx+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
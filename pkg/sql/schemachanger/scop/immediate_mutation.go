@@ -1134,11 +1134,85 @@ type InitSequence struct {
 	UseRestartWith bool
 }
 
+// CreateTableDescriptor creates an empty table descriptor in the ADD state.
+// Its columns, indexes and constraints are populated by subsequent ops.
+type CreateTableDescriptor struct {
+	immediateMutationOp
+	TableID   descpb.ID
+	Temporary bool
+}
+
+// CreateViewDescriptor creates a descriptor for a new view.
+type CreateViewDescriptor struct {
+	immediateMutationOp
+	ViewID    descpb.ID
+	ViewQuery string
+}
+
+// AddViewBackReferences sets the forward references of a new view and adds the
+// corresponding back references to the relations, types and routines it uses.
+type AddViewBackReferences struct {
+	immediateMutationOp
+	ViewID            descpb.ID
+	ForwardReferences []*scpb.View_Reference
+	RelationIDs       []descpb.ID
+	TypeIDs           []descpb.ID
+	RoutineIDs        []descpb.ID
+}
+
+// CreateEnumTypeDescriptor creates an enum type descriptor without any
+// members in the ADD state. Members are added by AddEnumTypeValue.
+type CreateEnumTypeDescriptor struct {
+	immediateMutationOp
+	TypeID      descpb.ID
+	ArrayTypeID descpb.ID
+}
+
+// CreateAliasTypeDescriptor creates an alias type descriptor, such as the
+// implicit array type of an enum, in the ADD state.
+type CreateAliasTypeDescriptor struct {
+	immediateMutationOp
+	TypeID descpb.ID
+	TypeT  scpb.TypeT
+}
+
+// AddEnumTypeValue adds a read-only member to an enum type. The member is
+// made usable by MakeEnumTypeValuePublic.
+type AddEnumTypeValue struct {
+	immediateMutationOp
+	TypeID                 descpb.ID
+	PhysicalRepresentation []byte
+	LogicalRepresentation  string
+}
+
+// MakeEnumTypeValuePublic makes a member added by AddEnumTypeValue writable.
+type MakeEnumTypeValuePublic struct {
+	immediateMutationOp
+	TypeID                descpb.ID
+	LogicalRepresentation string
+}
+
+// RemoveEnumTypeValue removes a member from an enum type.
+type RemoveEnumTypeValue struct {
+	immediateMutationOp
+	TypeID                descpb.ID
+	LogicalRepresentation string
+}
+
 type CreateDatabaseDescriptor struct {
 	immediateMutationOp
 	DatabaseID descpb.ID
 }
 
+// UpdateDatabaseRegionConfig sets the survival goal and the data placement
+// in the region config of a multi-region database.
+type UpdateDatabaseRegionConfig struct {
+	immediateMutationOp
+	DatabaseID   descpb.ID
+	SurvivalGoal descpb.SurvivalGoal
+	Placement    descpb.DataPlacement
+}
+
 // AddNamedRangeZoneConfig adds a zone config to a named range.
 type AddNamedRangeZoneConfig struct {
 	immediateMutationOp
@@ -1281,3 +1355,14 @@ type UpsertRowLevelTTL struct {
 	RowLevelTTL catpb.RowLevelTTL
 	TTLExpr     *scpb.Expression
 }
+
+// UpdateColumnIDInBackReferences replaces a column ID with another one in the
+// DependedOnBy back-references of a table, which views and routines use to
+// track the columns they reference. It is used when ALTER COLUMN TYPE
+// replaces a column with a new one.
+type UpdateColumnIDInBackReferences struct {
+	immediateMutationOp
+	TableID     descpb.ID
+	OldColumnID descpb.ColumnID
+	NewColumnID descpb.ColumnID
+}
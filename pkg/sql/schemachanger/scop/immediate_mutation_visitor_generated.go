@@ -162,7 +162,16 @@ type ImmediateMutationVisitor interface {
 	UnsetSequenceOption(context.Context, UnsetSequenceOption) error
 	MaybeUpdateSequenceValue(context.Context, MaybeUpdateSequenceValue) error
 	InitSequence(context.Context, InitSequence) error
+	CreateTableDescriptor(context.Context, CreateTableDescriptor) error
+	CreateViewDescriptor(context.Context, CreateViewDescriptor) error
+	AddViewBackReferences(context.Context, AddViewBackReferences) error
+	CreateEnumTypeDescriptor(context.Context, CreateEnumTypeDescriptor) error
+	CreateAliasTypeDescriptor(context.Context, CreateAliasTypeDescriptor) error
+	AddEnumTypeValue(context.Context, AddEnumTypeValue) error
+	MakeEnumTypeValuePublic(context.Context, MakeEnumTypeValuePublic) error
+	RemoveEnumTypeValue(context.Context, RemoveEnumTypeValue) error
 	CreateDatabaseDescriptor(context.Context, CreateDatabaseDescriptor) error
+	UpdateDatabaseRegionConfig(context.Context, UpdateDatabaseRegionConfig) error
 	AddNamedRangeZoneConfig(context.Context, AddNamedRangeZoneConfig) error
 	DiscardNamedRangeZoneConfig(context.Context, DiscardNamedRangeZoneConfig) error
 	AddDatabaseZoneConfig(context.Context, AddDatabaseZoneConfig) error
@@ -181,6 +190,7 @@ type ImmediateMutationVisitor interface {
 	SetTableStorageParam(context.Context, SetTableStorageParam) error
 	ResetTableStorageParam(context.Context, ResetTableStorageParam) error
 	UpsertRowLevelTTL(context.Context, UpsertRowLevelTTL) error
+	UpdateColumnIDInBackReferences(context.Context, UpdateColumnIDInBackReferences) error
 }
 
 // Visit is part of the ImmediateMutationOp interface.
@@ -908,11 +918,56 @@ func (op InitSequence) Visit(ctx context.Context, v ImmediateMutationVisitor) er
 	return v.InitSequence(ctx, op)
 }
 
+// Visit is part of the ImmediateMutationOp interface.
+func (op CreateTableDescriptor) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.CreateTableDescriptor(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op CreateViewDescriptor) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.CreateViewDescriptor(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op AddViewBackReferences) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.AddViewBackReferences(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op CreateEnumTypeDescriptor) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.CreateEnumTypeDescriptor(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op CreateAliasTypeDescriptor) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.CreateAliasTypeDescriptor(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op AddEnumTypeValue) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.AddEnumTypeValue(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op MakeEnumTypeValuePublic) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.MakeEnumTypeValuePublic(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op RemoveEnumTypeValue) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.RemoveEnumTypeValue(ctx, op)
+}
+
 // Visit is part of the ImmediateMutationOp interface.
 func (op CreateDatabaseDescriptor) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
 	return v.CreateDatabaseDescriptor(ctx, op)
 }
 
+// Visit is part of the ImmediateMutationOp interface.
+func (op UpdateDatabaseRegionConfig) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.UpdateDatabaseRegionConfig(ctx, op)
+}
+
 // Visit is part of the ImmediateMutationOp interface.
 func (op AddNamedRangeZoneConfig) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
 	return v.AddNamedRangeZoneConfig(ctx, op)
@@ -1002,3 +1057,8 @@ func (op ResetTableStorageParam) Visit(ctx context.Context, v ImmediateMutationV
 func (op UpsertRowLevelTTL) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
 	return v.UpsertRowLevelTTL(ctx, op)
 }
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op UpdateColumnIDInBackReferences) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.UpdateColumnIDInBackReferences(ctx, op)
+}
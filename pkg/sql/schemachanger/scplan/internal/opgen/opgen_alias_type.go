@@ -14,9 +14,13 @@ func init() {
 	opRegistry.register((*scpb.AliasType)(nil),
 		toPublic(
 			scpb.Status_ABSENT,
-			to(scpb.Status_DROPPED,
-				emit(func(this *scpb.AliasType) *scop.NotImplemented {
-					return notImplemented(this)
+			equiv(scpb.Status_DROPPED),
+			to(scpb.Status_DESCRIPTOR_ADDED,
+				emit(func(this *scpb.AliasType) *scop.CreateAliasTypeDescriptor {
+					return &scop.CreateAliasTypeDescriptor{
+						TypeID: this.TypeID,
+						TypeT:  this.TypeT,
+					}
 				}),
 			),
 			to(scpb.Status_PUBLIC,
@@ -29,6 +33,7 @@ func init() {
 		),
 		toAbsent(
 			scpb.Status_PUBLIC,
+			equiv(scpb.Status_DESCRIPTOR_ADDED),
 			to(scpb.Status_DROPPED,
 				revertible(false),
 				emit(func(this *scpb.AliasType) *scop.MarkDescriptorAsDropped {
@@ -34,6 +34,16 @@ func init() {
 					}
 					return nil
 				}),
+				emit(func(this *scpb.ColumnType) *scop.UpdateColumnIDInBackReferences {
+					if this.BackReferencesFromColumnID == 0 {
+						return nil
+					}
+					return &scop.UpdateColumnIDInBackReferences{
+						TableID:     this.TableID,
+						OldColumnID: this.BackReferencesFromColumnID,
+						NewColumnID: this.ColumnID,
+					}
+				}),
 			),
 		),
 		toAbsent(
@@ -64,6 +74,18 @@ func init() {
 					}
 					return nil
 				}),
+				// If the column replacing another one is rolled back, move the
+				// back-references to the original column again.
+				emit(func(this *scpb.ColumnType) *scop.UpdateColumnIDInBackReferences {
+					if this.BackReferencesFromColumnID == 0 {
+						return nil
+					}
+					return &scop.UpdateColumnIDInBackReferences{
+						TableID:     this.TableID,
+						OldColumnID: this.ColumnID,
+						NewColumnID: this.BackReferencesFromColumnID,
+					}
+				}),
 			),
 		),
 	)
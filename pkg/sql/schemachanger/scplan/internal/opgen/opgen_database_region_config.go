@@ -5,13 +5,25 @@
 
 package opgen
 
-import "github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scop"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+)
 
 func init() {
 	opRegistry.register((*scpb.DatabaseRegionConfig)(nil),
 		toPublic(
 			scpb.Status_ABSENT,
-			to(scpb.Status_PUBLIC),
+			to(scpb.Status_PUBLIC,
+				emit(func(this *scpb.DatabaseRegionConfig) *scop.UpdateDatabaseRegionConfig {
+					return &scop.UpdateDatabaseRegionConfig{
+						DatabaseID:   this.DatabaseID,
+						SurvivalGoal: descpb.SurvivalGoal(this.SurvivalGoal),
+						Placement:    descpb.DataPlacement(this.Placement),
+					}
+				}),
+			),
 		),
 		toAbsent(
 			scpb.Status_PUBLIC,
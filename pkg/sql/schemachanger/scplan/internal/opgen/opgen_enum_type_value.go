@@ -14,17 +14,33 @@ func init() {
 	opRegistry.register((*scpb.EnumTypeValue)(nil),
 		toPublic(
 			scpb.Status_ABSENT,
+			to(scpb.Status_WRITE_ONLY,
+				emit(func(this *scpb.EnumTypeValue) *scop.AddEnumTypeValue {
+					return &scop.AddEnumTypeValue{
+						TypeID:                 this.TypeID,
+						PhysicalRepresentation: this.PhysicalRepresentation,
+						LogicalRepresentation:  this.LogicalRepresentation,
+					}
+				}),
+			),
 			to(scpb.Status_PUBLIC,
-				emit(func(this *scpb.EnumTypeValue) *scop.NotImplemented {
-					return notImplemented(this)
+				emit(func(this *scpb.EnumTypeValue) *scop.MakeEnumTypeValuePublic {
+					return &scop.MakeEnumTypeValuePublic{
+						TypeID:                this.TypeID,
+						LogicalRepresentation: this.LogicalRepresentation,
+					}
 				}),
 			),
 		),
 		toAbsent(
 			scpb.Status_PUBLIC,
+			equiv(scpb.Status_WRITE_ONLY),
 			to(scpb.Status_ABSENT,
-				emit(func(this *scpb.EnumTypeValue) *scop.NotImplementedForPublicObjects {
-					return notImplementedForPublicObjects(this)
+				emit(func(this *scpb.EnumTypeValue) *scop.RemoveEnumTypeValue {
+					return &scop.RemoveEnumTypeValue{
+						TypeID:                this.TypeID,
+						LogicalRepresentation: this.LogicalRepresentation,
+					}
 				}),
 			),
 		),
@@ -0,0 +1,71 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package current
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/rel"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+	. "github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scplan/internal/rules"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scplan/internal/scgraph"
+)
+
+// These rules sequence the addition and renaming of enum type values.
+func init() {
+	registerDepRule(
+		"enum type exists before its values are added",
+		scgraph.Precedence,
+		"enum-type", "enum-type-value",
+		func(from, to NodeVars) rel.Clauses {
+			return rel.Clauses{
+				from.Type((*scpb.EnumType)(nil)),
+				to.Type((*scpb.EnumTypeValue)(nil)),
+				JoinOnDescID(from, to, "type-id"),
+				StatusesToPublicOrTransient(from, scpb.Status_DESCRIPTOR_ADDED, to, scpb.Status_WRITE_ONLY),
+			}
+		},
+	)
+
+	// A value added to an existing enum type is read-only until all leases on
+	// the previous version of the type, which cannot decode the value, have
+	// been released. Values of new types and renamed values, which keep the
+	// physical representation of the value they replace, can be used right
+	// away.
+	registerDepRule(
+		"enum type value becomes public in a later transaction than it is added",
+		scgraph.PreviousTransactionPrecedence,
+		"write-only-value", "public-value",
+		func(from, to NodeVars) rel.Clauses {
+			return rel.Clauses{
+				from.Type((*scpb.EnumTypeValue)(nil)),
+				to.Type((*scpb.EnumTypeValue)(nil)),
+				from.El.AttrEqVar(rel.Self, to.El),
+				from.Target.AttrEqVar(rel.Self, to.Target),
+				StatusesToPublicOrTransient(from, scpb.Status_WRITE_ONLY, to, scpb.Status_PUBLIC),
+				from.DescIDEq("type-id"),
+				enumTypeIsNotBeingAdded("type-id"),
+				enumTypeValueIsNotRenamed(from.El),
+			}
+		},
+	)
+
+	registerDepRule(
+		"old enum type value is removed before the renamed value is added",
+		scgraph.SameStagePrecedence,
+		"old-value", "new-value",
+		func(from, to NodeVars) rel.Clauses {
+			return rel.Clauses{
+				from.Type((*scpb.EnumTypeValue)(nil)),
+				to.Type((*scpb.EnumTypeValue)(nil)),
+				JoinOnDescID(from, to, "type-id"),
+				from.TargetStatus(scpb.ToAbsent),
+				from.CurrentStatus(scpb.Status_ABSENT),
+				to.TargetStatus(scpb.ToPublic),
+				to.CurrentStatus(scpb.Status_WRITE_ONLY),
+				FilterElements("isSamePhysicalRepresentation", from, to, isSamePhysicalRepresentation),
+			}
+		},
+	)
+}
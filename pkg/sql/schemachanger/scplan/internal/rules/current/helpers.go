@@ -6,6 +6,8 @@
 package current
 
 import (
+	"bytes"
+
 	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/rel"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
@@ -63,6 +65,50 @@ var descriptorDataIsNotBeingAdded = screl.Schema.DefNotJoin1(
 	},
 )
 
+// enumTypeIsNotBeingAdded indicates if the enum type with the given ID existed
+// before the current schema change, as opposed to being created by it.
+var enumTypeIsNotBeingAdded = screl.Schema.DefNotJoin1(
+	"enumTypeIsNotBeingAdded"+rulesVersion, "typeID", func(
+		typeID rel.Var,
+	) rel.Clauses {
+		enumType := rules.MkNodeVars("enum-type")
+		return rel.Clauses{
+			enumType.Type((*scpb.EnumType)(nil)),
+			enumType.JoinTargetNode(),
+			enumType.TargetStatus(scpb.ToPublic),
+			enumType.CurrentStatus(scpb.Status_ABSENT),
+			enumType.DescIDEq(typeID),
+		}
+	},
+)
+
+// enumTypeValueIsNotRenamed indicates if the passed enum type value is not
+// replacing another value of the same type with the same physical
+// representation, which is how ALTER TYPE ... RENAME VALUE is planned.
+var enumTypeValueIsNotRenamed = screl.Schema.DefNotJoin1(
+	"enumTypeValueIsNotRenamed"+rulesVersion, "value", func(
+		value rel.Var,
+	) rel.Clauses {
+		oldValue := rules.MkNodeVars("old-value")
+		return rel.Clauses{
+			value.Type((*scpb.EnumTypeValue)(nil)),
+			oldValue.Type((*scpb.EnumTypeValue)(nil)),
+			oldValue.JoinTarget(),
+			oldValue.TargetStatus(scpb.ToAbsent),
+			rules.JoinOnDescIDUntyped(oldValue.El, value, "type-id"),
+			rel.Filter("isSamePhysicalRepresentation", oldValue.El, value)(
+				isSamePhysicalRepresentation,
+			),
+		}
+	},
+)
+
+// isSamePhysicalRepresentation returns true if both enum type values are
+// encoded the same way on disk.
+func isSamePhysicalRepresentation(a, b *scpb.EnumTypeValue) bool {
+	return bytes.Equal(a.PhysicalRepresentation, b.PhysicalRepresentation)
+}
+
 // isDescriptor returns true for a descriptor-element, i.e. an element which
 // owns its corresponding descriptor.
 func isDescriptor(e scpb.Element) bool {
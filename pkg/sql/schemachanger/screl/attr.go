@@ -454,6 +454,7 @@ var elementSchemaOptions = []rel.SchemaOption{
 	rel.EntityMapping(t((*scpb.DatabaseRegionConfig)(nil)),
 		rel.EntityAttr(DescID, "DatabaseID"),
 		rel.EntityAttr(ReferencedDescID, "RegionEnumTypeID"),
+		rel.EntityAttr(SeqNum, "SeqNum"),
 	),
 	rel.EntityMapping(t((*scpb.DatabaseRoleSetting)(nil)),
 		rel.EntityAttr(DescID, "DatabaseID"),
@@ -15,6 +15,7 @@ import (
 
 	"github.com/cockroachdb/apd/v3"
 	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/cockroachdb/cockroach/pkg/geo/geomfn"
 	"github.com/cockroachdb/cockroach/pkg/geo/geopb"
 	"github.com/cockroachdb/cockroach/pkg/geo/geos"
 	"github.com/cockroachdb/cockroach/pkg/sql/appstatspb"
@@ -525,6 +526,11 @@ var aggregates = map[string]builtinDefinition{
 			"Calculates the bitwise XOR of the selected values."),
 	),
 
+	"xmlagg": makeBuiltin(tree.FunctionProperties{},
+		makeImmutableAggOverload([]*types.T{types.XML}, types.XML, newXMLConcatAggregate,
+			"Concatenates all selected XML values."),
+	),
+
 	"json_agg": makeBuiltin(tree.FunctionProperties{},
 		makeAggOverload([]*types.T{types.AnyElement}, types.Jsonb, newJSONAggregate,
 			"Aggregates values as a JSON or JSONB array.", volatility.Stable, true /* calledOnNullInput */),
@@ -589,6 +595,56 @@ var aggregates = map[string]builtinDefinition{
 	"st_collect":    makeSTCollectBuiltin(),
 	"st_memcollect": makeSTCollectBuiltin(),
 
+	"st_clusterintersecting": makeBuiltin(
+		tree.FunctionProperties{
+			AvailableOnPublicSchema: true,
+		},
+		makeAggOverload(
+			[]*types.T{types.Geometry},
+			types.MakeArray(types.Geometry),
+			newSTClusterIntersectingAgg,
+			infoBuilder{
+				info: "Returns an array of GeometryCollections, each holding a set of geometries " +
+					"which are connected through intersections.",
+				libraryUsage: usesGEOS,
+			}.String(),
+			volatility.Immutable,
+			true, /* calledOnNullInput */
+		),
+	),
+	"st_clusterwithin": makeBuiltin(
+		tree.FunctionProperties{
+			AvailableOnPublicSchema: true,
+		},
+		makeAggOverload(
+			[]*types.T{types.Geometry, types.Float},
+			types.MakeArray(types.Geometry),
+			newSTClusterWithinAgg,
+			infoBuilder{
+				info: "Returns an array of GeometryCollections, each holding a set of geometries " +
+					"which are connected through chains of geometries at most distance apart.",
+			}.String(),
+			volatility.Immutable,
+			true, /* calledOnNullInput */
+		),
+	),
+	"st_polygonize": makeBuiltin(
+		tree.FunctionProperties{
+			AvailableOnPublicSchema: true,
+		},
+		makeAggOverload(
+			[]*types.T{types.Geometry},
+			types.Geometry,
+			newSTPolygonizeAgg,
+			infoBuilder{
+				info:         "Returns a GeometryCollection of the polygons formed by the linework of the geometries provided.",
+				libraryUsage: usesGEOS,
+			}.String(),
+			volatility.Immutable,
+			true, /* calledOnNullInput */
+		),
+	),
+
 	AnyNotNull: makePrivate(makeBuiltin(tree.FunctionProperties{},
 		makeImmutableAggOverloadWithReturnType(
 			[]*types.T{types.AnyElement},
@@ -1035,6 +1091,201 @@ func (agg *stUnionAgg) Size() int64 {
 	return sizeOfSTUnionAggregate
 }
 
+// stGeometryBufferAgg buffers the non-NULL geometries passed to an aggregate
+// which can only be computed once all of its inputs are known.
+type stGeometryBufferAgg struct {
+	geoms []geo.Geometry
+	acc   mon.BoundAccount
+}
+
+func makeSTGeometryBufferAgg(evalCtx *eval.Context) stGeometryBufferAgg {
+	return stGeometryBufferAgg{
+		acc: evalCtx.SingleDatumAggMemAccount.Monitor().MakeBoundAccount(),
+	}
+}
+
+// add buffers the given geometry, returning an error if its SRID does not
+// match the SRID of the geometries buffered so far.
+func (agg *stGeometryBufferAgg) add(ctx context.Context, geomArg *tree.DGeometry) error {
+	if len(agg.geoms) > 0 && agg.geoms[0].SRID() != geomArg.SRID() {
+		return geo.NewMismatchingSRIDsError(geomArg.Geometry.SpatialObject(), agg.geoms[0].SpatialObject())
+	}
+	if err := agg.acc.Grow(ctx, int64(geomArg.Size())); err != nil {
+		return err
+	}
+	agg.geoms = append(agg.geoms, geomArg.Geometry)
+	return nil
+}
+
+func (agg *stGeometryBufferAgg) reset(ctx context.Context) {
+	agg.geoms = nil
+	agg.acc.Empty(ctx)
+}
+
+func (agg *stGeometryBufferAgg) close(ctx context.Context) {
+	agg.acc.Close(ctx)
+}
+
+// makeGeometryArray returns a geometry[] datum holding the given geometries.
+func makeGeometryArray(geoms []geo.Geometry) (tree.Datum, error) {
+	arr := tree.NewDArray(types.Geometry)
+	for _, g := range geoms {
+		if err := arr.Append(tree.NewDGeometry(g)); err != nil {
+			return nil, err
+		}
+	}
+	return arr, nil
+}
+
+type stClusterIntersectingAgg struct {
+	stGeometryBufferAgg
+}
+
+func newSTClusterIntersectingAgg(
+	_ []*types.T, evalCtx *eval.Context, _ tree.Datums,
+) eval.AggregateFunc {
+	return &stClusterIntersectingAgg{
+		stGeometryBufferAgg: makeSTGeometryBufferAgg(evalCtx),
+	}
+}
+
+// Add implements the AggregateFunc interface.
+func (agg *stClusterIntersectingAgg) Add(
+	ctx context.Context, firstArg tree.Datum, otherArgs ...tree.Datum,
+) error {
+	if firstArg == tree.DNull {
+		return nil
+	}
+	return agg.add(ctx, tree.MustBeDGeometry(firstArg))
+}
+
+// Result implements the AggregateFunc interface.
+func (agg *stClusterIntersectingAgg) Result() (tree.Datum, error) {
+	if len(agg.geoms) == 0 {
+		return tree.DNull, nil
+	}
+	clusters, err := geomfn.ClusterIntersecting(agg.geoms)
+	if err != nil {
+		return nil, err
+	}
+	return makeGeometryArray(clusters)
+}
+
+// Reset implements the AggregateFunc interface.
+func (agg *stClusterIntersectingAgg) Reset(ctx context.Context) {
+	agg.reset(ctx)
+}
+
+// Close implements the AggregateFunc interface.
+func (agg *stClusterIntersectingAgg) Close(ctx context.Context) {
+	agg.close(ctx)
+}
+
+// Size implements the AggregateFunc interface.
+func (agg *stClusterIntersectingAgg) Size() int64 {
+	return sizeOfSTClusterIntersectingAggregate
+}
+
+type stClusterWithinAgg struct {
+	stGeometryBufferAgg
+	// distance is the distance passed alongside the first non-NULL geometry.
+	distance float64
+}
+
+func newSTClusterWithinAgg(_ []*types.T, evalCtx *eval.Context, _ tree.Datums) eval.AggregateFunc {
+	return &stClusterWithinAgg{
+		stGeometryBufferAgg: makeSTGeometryBufferAgg(evalCtx),
+	}
+}
+
+// Add implements the AggregateFunc interface.
+func (agg *stClusterWithinAgg) Add(
+	ctx context.Context, firstArg tree.Datum, otherArgs ...tree.Datum,
+) error {
+	if firstArg == tree.DNull || otherArgs[0] == tree.DNull {
+		return nil
+	}
+	if len(agg.geoms) == 0 {
+		agg.distance = float64(tree.MustBeDFloat(otherArgs[0]))
+	}
+	return agg.add(ctx, tree.MustBeDGeometry(firstArg))
+}
+
+// Result implements the AggregateFunc interface.
+func (agg *stClusterWithinAgg) Result() (tree.Datum, error) {
+	if len(agg.geoms) == 0 {
+		return tree.DNull, nil
+	}
+	clusters, err := geomfn.ClusterWithin(agg.geoms, agg.distance)
+	if err != nil {
+		return nil, err
+	}
+	return makeGeometryArray(clusters)
+}
+
+// Reset implements the AggregateFunc interface.
+func (agg *stClusterWithinAgg) Reset(ctx context.Context) {
+	agg.reset(ctx)
+	agg.distance = 0
+}
+
+// Close implements the AggregateFunc interface.
+func (agg *stClusterWithinAgg) Close(ctx context.Context) {
+	agg.close(ctx)
+}
+
+// Size implements the AggregateFunc interface.
+func (agg *stClusterWithinAgg) Size() int64 {
+	return sizeOfSTClusterWithinAggregate
+}
+
+type stPolygonizeAgg struct {
+	stGeometryBufferAgg
+}
+
+func newSTPolygonizeAgg(_ []*types.T, evalCtx *eval.Context, _ tree.Datums) eval.AggregateFunc {
+	return &stPolygonizeAgg{
+		stGeometryBufferAgg: makeSTGeometryBufferAgg(evalCtx),
+	}
+}
+
+// Add implements the AggregateFunc interface.
+func (agg *stPolygonizeAgg) Add(
+	ctx context.Context, firstArg tree.Datum, otherArgs ...tree.Datum,
+) error {
+	if firstArg == tree.DNull {
+		return nil
+	}
+	return agg.add(ctx, tree.MustBeDGeometry(firstArg))
+}
+
+// Result implements the AggregateFunc interface.
+func (agg *stPolygonizeAgg) Result() (tree.Datum, error) {
+	if len(agg.geoms) == 0 {
+		return tree.DNull, nil
+	}
+	g, err := geomfn.Polygonize(agg.geoms)
+	if err != nil {
+		return nil, err
+	}
+	return tree.NewDGeometry(g), nil
+}
+
+// Reset implements the AggregateFunc interface.
+func (agg *stPolygonizeAgg) Reset(ctx context.Context) {
+	agg.reset(ctx)
+}
+
+// Close implements the AggregateFunc interface.
+func (agg *stPolygonizeAgg) Close(ctx context.Context) {
+	agg.close(ctx)
+}
+
+// Size implements the AggregateFunc interface.
+func (agg *stPolygonizeAgg) Size() int64 {
+	return sizeOfSTPolygonizeAggregate
+}
+
 type stCollectAgg struct {
 	acc  mon.BoundAccount
 	coll geom.T
@@ -1383,6 +1634,9 @@ const sizeOfSTMakeLineAggregate = int64(unsafe.Sizeof(stMakeLineAgg{}))
 const sizeOfSTUnionAggregate = int64(unsafe.Sizeof(stUnionAgg{}))
 const sizeOfSTCollectAggregate = int64(unsafe.Sizeof(stCollectAgg{}))
 const sizeOfSTExtentAggregate = int64(unsafe.Sizeof(stExtentAgg{}))
+const sizeOfSTClusterIntersectingAggregate = int64(unsafe.Sizeof(stClusterIntersectingAgg{}))
+const sizeOfSTClusterWithinAggregate = int64(unsafe.Sizeof(stClusterWithinAgg{}))
+const sizeOfSTPolygonizeAggregate = int64(unsafe.Sizeof(stPolygonizeAgg{}))
 const sizeOfStatementStatistics = int64(unsafe.Sizeof(aggStatementStatistics{}))
 const sizeOfAggStatementMetadata = int64(unsafe.Sizeof(aggStatementMetadata{}))
 const sizeOfTransactionStatistics = int64(unsafe.Sizeof(aggTransactionStatistics{}))
@@ -2089,6 +2343,7 @@ type concatAggregate struct {
 	singleDatumAggregateBase
 
 	forBytes   bool
+	forXML     bool
 	sawNonNull bool
 	delimiter  string // used for non window functions
 	result     bytes.Buffer
@@ -2123,6 +2378,15 @@ func newStringConcatAggregate(
 	return concatAgg
 }
 
+func newXMLConcatAggregate(
+	_ []*types.T, evalCtx *eval.Context, _ tree.Datums,
+) eval.AggregateFunc {
+	return &concatAggregate{
+		singleDatumAggregateBase: singleDatumAggregateBase{acc: evalCtx.SingleDatumAggMemAccount},
+		forXML:                   true,
+	}
+}
+
 func (a *concatAggregate) Add(ctx context.Context, datum tree.Datum, others ...tree.Datum) error {
 	if datum == tree.DNull {
 		return nil
@@ -2147,9 +2411,12 @@ func (a *concatAggregate) Add(ctx context.Context, datum tree.Datum, others ...t
 		}
 	}
 	var arg string
-	if a.forBytes {
+	switch {
+	case a.forBytes:
 		arg = string(tree.MustBeDBytes(datum))
-	} else {
+	case a.forXML:
+		arg = tree.MustBeDXML(datum).Contents
+	default:
 		arg = string(tree.MustBeDString(datum))
 	}
 	a.result.WriteString(arg)
@@ -2167,6 +2434,9 @@ func (a *concatAggregate) Result() (tree.Datum, error) {
 		res := tree.DBytes(a.result.String())
 		return &res, nil
 	}
+	if a.forXML {
+		return tree.NewDXML(a.result.String()), nil
+	}
 	res := tree.DString(a.result.String())
 	return &res, nil
 }
@@ -45,6 +45,7 @@ const (
 	CategoryIDGeneration        = "ID generation"
 	CategoryJSON                = "JSONB"
 	CategoryJsonpath            = "Jsonpath"
+	CategoryLargeObject         = "Large object"
 	CategoryLTree               = "LTree"
 	CategoryMultiRegion         = "Multi-region"
 	CategoryMultiTenancy        = "Multi-tenancy"
@@ -56,6 +57,7 @@ const (
 	CategorySystemRepair        = "System repair"
 	CategoryClusterReplication  = "Cluster Replication and Migration"
 	CategoryTesting             = "Testing"
+	CategoryXML                 = "XML"
 )
 
 const (
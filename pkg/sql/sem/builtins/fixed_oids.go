@@ -2867,6 +2867,95 @@ var builtinOidsArray = []string{
 	2912: `information_schema.crdb_rewrite_inline_hints(statement_fingerprint: string, donor_sql: string) -> int`,
 	2913: `crdb_internal.decode_key(key: bytes) -> jsonb`,
 	2914: `pg_trigger_depth() -> int`,
+	2915: `lo_create(loid: oid) -> oid`,
+	2916: `lo_creat(mode: int4) -> oid`,
+	2917: `lo_open(loid: oid, mode: int4) -> int4`,
+	2918: `lo_close(fd: int4) -> int4`,
+	2919: `loread(fd: int4, len: int4) -> bytes`,
+	2920: `lowrite(fd: int4, data: bytes) -> int4`,
+	2921: `lo_lseek(fd: int4, offset: int4, whence: int4) -> int4`,
+	2922: `lo_lseek64(fd: int4, offset: int, whence: int4) -> int`,
+	2923: `lo_tell(fd: int4) -> int4`,
+	2924: `lo_tell64(fd: int4) -> int`,
+	2925: `lo_truncate(fd: int4, len: int4) -> int4`,
+	2926: `lo_truncate64(fd: int4, len: int) -> int4`,
+	2927: `lo_unlink(loid: oid) -> int4`,
+	2928: `lo_get(loid: oid) -> bytes`,
+	2929: `lo_get(loid: oid, offset: int, length: int4) -> bytes`,
+	2930: `lo_put(loid: oid, offset: int, data: bytes) -> void`,
+	2931: `lo_from_bytea(loid: oid, data: bytes) -> oid`,
+	2932: `lo_import(uri: string) -> oid`,
+	2933: `lo_import(uri: string, loid: oid) -> oid`,
+	2934: `lo_export(loid: oid, uri: string) -> int4`,
+	2935: `xml_send(xml: xml) -> bytes`,
+	2936: `xml_in(input: anyelement) -> xml`,
+	2937: `xml_out(xml: xml) -> bytes`,
+	2938: `xml_recv(input: anyelement) -> xml`,
+	2939: `bpchar(xml: xml) -> bpchar`,
+	2940: `char(xml: xml) -> "char"`,
+	2941: `citext(xml: xml) -> citext`,
+	2942: `name(xml: xml) -> name`,
+	2943: `text(xml: xml) -> string`,
+	2944: `varchar(xml: xml) -> varchar`,
+	2945: `xml(bpchar: bpchar) -> xml`,
+	2946: `xml(char: "char") -> xml`,
+	2947: `xml(citext: citext) -> xml`,
+	2948: `xml(name: name) -> xml`,
+	2949: `xml(text: text) -> xml`,
+	2950: `xml(string: string) -> xml`,
+	2951: `xml(varchar: varchar) -> xml`,
+	2952: `xml(xml: xml) -> xml`,
+	2953: `xmlagg(arg1: xml) -> xml`,
+	2954: `xpath(xpath: string, xml: xml) -> xml[]`,
+	2955: `xpath(xpath: string, xml: xml, nsarray: string[]) -> xml[]`,
+	2956: `xpath_exists(xpath: string, xml: xml) -> bool`,
+	2957: `xpath_exists(xpath: string, xml: xml, nsarray: string[]) -> bool`,
+	2958: `xmlexists(xpath: string, xml: xml) -> bool`,
+	2959: `xml_is_well_formed(text: string) -> bool`,
+	2960: `xml_is_well_formed_content(text: string) -> bool`,
+	2961: `xml_is_well_formed_document(text: string) -> bool`,
+	2962: `xmlcomment(text: string) -> xml`,
+	2963: `xmltext(text: string) -> xml`,
+	2964: `crdb_internal.xmlparse(kind: string, text: string) -> xml`,
+	2965: `crdb_internal.xmlserialize(kind: string, xml: xml) -> string`,
+	2966: `crdb_internal.xmlelement(string, string[], string[], any...) -> xml`,
+	2967: `ts_rank_cd(weights: float[], vector: tsvector, query: tsquery, normalization: int) -> float4`,
+	2968: `ts_rank_cd(weights: float[], vector: tsvector, query: tsquery) -> float4`,
+	2969: `ts_rank_cd(vector: tsvector, query: tsquery, normalization: int) -> float4`,
+	2970: `ts_rank_cd(vector: tsvector, query: tsquery) -> float4`,
+	2971: `websearch_to_tsquery(config: string, text: string) -> tsquery`,
+	2972: `websearch_to_tsquery(text: string) -> tsquery`,
+	2973: `ts_headline(config: string, document: string, query: tsquery, options: string) -> string`,
+	2974: `ts_headline(config: string, document: string, query: tsquery) -> string`,
+	2975: `ts_headline(document: string, query: tsquery, options: string) -> string`,
+	2976: `ts_headline(document: string, query: tsquery) -> string`,
+	2977: `ts_rewrite(query: tsquery, target: tsquery, substitute: tsquery) -> tsquery`,
+	2978: `setweight(vector: tsvector, weight: "char") -> tsvector`,
+	2979: `setweight(vector: tsvector, weight: "char", lexemes: string[]) -> tsvector`,
+	2980: `strip(vector: tsvector) -> tsvector`,
+	2981: `ts_delete(vector: tsvector, lexeme: string) -> tsvector`,
+	2982: `ts_delete(vector: tsvector, lexemes: string[]) -> tsvector`,
+	2983: `ts_filter(vector: tsvector, weights: string[]) -> tsvector`,
+	2984: `tsvector_concat(vector1: tsvector, vector2: tsvector) -> tsvector`,
+	2985: `jsonb_to_tsvector(config: string, document: jsonb, filter: jsonb) -> tsvector`,
+	2986: `jsonb_to_tsvector(document: jsonb, filter: jsonb) -> tsvector`,
+	2987: `json_to_tsvector(config: string, document: jsonb, filter: jsonb) -> tsvector`,
+	2988: `json_to_tsvector(document: jsonb, filter: jsonb) -> tsvector`,
+	2989: `st_clusterintersecting(arg1: geometry) -> geometry[]`,
+	2990: `st_clusterwithin(arg1: geometry, arg2: float) -> geometry[]`,
+	2991: `st_polygonize(arg1: geometry) -> geometry`,
+	2992: `st_clusterdbscan(geometry: geometry, eps: float, minpoints: int) -> int`,
+	2993: `st_clusterkmeans(geometry: geometry, number_of_clusters: int) -> int`,
+	2994: `st_clusterkmeans(geometry: geometry, number_of_clusters: int, max_radius: float) -> int`,
+	2995: `st_dump(geometry: geometry) -> tuple{int[] AS path, geometry AS geom}`,
+	2996: `st_dumppoints(geometry: geometry) -> tuple{int[] AS path, geometry AS geom}`,
+	2997: `st_split(input: geometry, blade: geometry) -> geometry`,
+	2998: `st_concavehull(geometry: geometry, target_percent: float) -> geometry`,
+	2999: `st_concavehull(geometry: geometry, target_percent: float, allow_holes: bool) -> geometry`,
+	3000: `crdb_internal.brin_summarize(table: regclass, index: string) -> int`,
+	3001: `crdb_internal.brin_summarize(table: regclass, index: string, rows_per_block: int) -> int`,
+	3002: `tsvector_update_trigger() -> trigger`,
+	3003: `tsvector_update_trigger_column() -> trigger`,
 }
 
 var builtinOidsBySignature map[string]oid.Oid
@@ -487,6 +487,60 @@ func (s *subdividedGeometriesGen) Next(_ context.Context) (bool, error) {
 	return s.curr < len(s.geometries), nil
 }
 
+var geometryDumpReturnType = types.MakeLabeledTuple(
+	[]*types.T{types.IntArray, types.Geometry},
+	[]string{"path", "geom"},
+)
+
+func makeGeometryDumpGeneratorFactory(
+	dump func(geo.Geometry) ([]geomfn.DumpItem, error),
+) eval.GeneratorOverload {
+	return func(
+		_ context.Context, _ *eval.Context, args tree.Datums,
+	) (eval.ValueGenerator, error) {
+		geometry := tree.MustBeDGeometry(args[0])
+		items, err := dump(geometry.Geometry)
+		if err != nil {
+			return nil, err
+		}
+		return &geometryDumpGen{
+			items: items,
+			curr:  -1,
+		}, nil
+	}
+}
+
+// geometryDumpGen implements the eval.ValueGenerator interface
+type geometryDumpGen struct {
+	items []geomfn.DumpItem
+	curr  int
+}
+
+func (s *geometryDumpGen) ResolvedType() *types.T { return geometryDumpReturnType }
+
+func (s *geometryDumpGen) Close(_ context.Context) {}
+
+func (s *geometryDumpGen) Start(_ context.Context, _ *kv.Txn) error {
+	s.curr = -1
+	return nil
+}
+
+func (s *geometryDumpGen) Values() (tree.Datums, error) {
+	item := s.items[s.curr]
+	path := tree.NewDArray(types.Int)
+	for _, idx := range item.Path {
+		if err := path.Append(tree.NewDInt(tree.DInt(idx))); err != nil {
+			return nil, err
+		}
+	}
+	return tree.Datums{path, tree.NewDGeometry(item.Geometry)}, nil
+}
+
+func (s *geometryDumpGen) Next(_ context.Context) (bool, error) {
+	s.curr++
+	return s.curr < len(s.items), nil
+}
+
 var geoBuiltins = map[string]builtinDefinition{
 	//
 	// Meta builtins.
@@ -5615,6 +5669,82 @@ If no snapping occurs then the input geometry is returned unchanged.`,
 			Volatility: volatility.Immutable,
 		},
 	),
+	"st_split": makeBuiltin(
+		defProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "input", Typ: types.Geometry},
+				{Name: "blade", Typ: types.Geometry},
+			},
+			ReturnType: tree.FixedReturnType(types.Geometry),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				input := tree.MustBeDGeometry(args[0])
+				blade := tree.MustBeDGeometry(args[1])
+				ret, err := geomfn.Split(input.Geometry, blade.Geometry)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDGeometry(ret), nil
+			},
+			Info: infoBuilder{
+				info: "Returns a GeometryCollection of the parts obtained by splitting the input geometry by the blade geometry. " +
+					"LineStrings may be split by (Multi)Points, (Multi)LineStrings or (Multi)Polygon boundaries, and " +
+					"Polygons may be split by (Multi)LineStrings.",
+				libraryUsage: usesGEOS,
+			}.String(),
+			Volatility: volatility.Immutable,
+		},
+	),
+	"st_concavehull": makeBuiltin(
+		defProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "geometry", Typ: types.Geometry},
+				{Name: "target_percent", Typ: types.Float},
+			},
+			ReturnType: tree.FixedReturnType(types.Geometry),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				g := tree.MustBeDGeometry(args[0])
+				targetPercent := tree.MustBeDFloat(args[1])
+				ret, err := geomfn.ConcaveHull(g.Geometry, float64(targetPercent), false /* allowHoles */)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDGeometry(ret), nil
+			},
+			Info: infoBuilder{
+				info: "Returns a possibly concave geometry that encloses the given geometry. target_percent, " +
+					"between 0 and 1, controls how concave the result is; 1 returns the convex hull.",
+				libraryUsage: usesGEOS,
+			}.String(),
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "geometry", Typ: types.Geometry},
+				{Name: "target_percent", Typ: types.Float},
+				{Name: "allow_holes", Typ: types.Bool},
+			},
+			ReturnType: tree.FixedReturnType(types.Geometry),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				g := tree.MustBeDGeometry(args[0])
+				targetPercent := tree.MustBeDFloat(args[1])
+				allowHoles := tree.MustBeDBool(args[2])
+				ret, err := geomfn.ConcaveHull(g.Geometry, float64(targetPercent), bool(allowHoles))
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDGeometry(ret), nil
+			},
+			Info: infoBuilder{
+				info: "Returns a possibly concave geometry that encloses the given geometry. target_percent, " +
+					"between 0 and 1, controls how concave the result is; 1 returns the convex hull. " +
+					"If allow_holes is true, the result may contain holes.",
+				libraryUsage: usesGEOS,
+			}.String(),
+			Volatility: volatility.Immutable,
+		},
+	),
 	"st_buffer": makeBuiltin(
 		defProps(),
 		tree.Overload{
@@ -6192,6 +6322,34 @@ See http://developers.google.com/maps/documentation/utilities/polylinealgorithm`
 			volatility.Immutable,
 		),
 	),
+	"st_dump": makeBuiltin(
+		genProps(),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "geometry", Typ: types.Geometry},
+			},
+			geometryDumpReturnType,
+			makeGeometryDumpGeneratorFactory(geomfn.Dump),
+			"Returns a set of (path, geom) records for the non-collection components of the given geometry. "+
+				"The path holds the 1-indexed position of the component inside the geometry, and is empty "+
+				"if the given geometry is not a collection.",
+			volatility.Immutable,
+		),
+	),
+	"st_dumppoints": makeBuiltin(
+		genProps(),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "geometry", Typ: types.Geometry},
+			},
+			geometryDumpReturnType,
+			makeGeometryDumpGeneratorFactory(geomfn.DumpPoints),
+			"Returns a set of (path, geom) records for every vertex of the given geometry. "+
+				"The path holds the 1-indexed position of the vertex, preceded by the ring number for "+
+				"polygons and the component number for collections.",
+			volatility.Immutable,
+		),
+	),
 
 	//
 	// BoundingBox
@@ -7297,25 +7455,16 @@ Can be used to define the tile bounds required by ST_AsMVTGeom to convert geomet
 	"st_buildarea":           makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48892}),
 	"st_chaikinsmoothing":    makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48894}),
 	"st_cleangeometry":       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48895}),
-	"st_clusterdbscan":       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48898}),
-	"st_clusterintersecting": makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48899}),
-	"st_clusterkmeans":       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48900}),
-	"st_clusterwithin":       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48901}),
-	"st_concavehull":         makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48906}),
 	"st_delaunaytriangles":   makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48915}),
-	"st_dump":                makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49785}),
-	"st_dumppoints":          makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49786}),
 	"st_dumprings":           makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49787}),
 	"st_geometricmedian":     makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48944}),
 	"st_interpolatepoint":    makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48950}),
 	"st_isvaliddetail":       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48962}),
 	"st_length2dspheroid":    makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48967}),
 	"st_lengthspheroid":      makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48968}),
-	"st_polygonize":          makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49011}),
 	"st_quantizecoordinates": makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49012}),
 	"st_seteffectivearea":    makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49030}),
 	"st_simplifyvw":          makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49039}),
-	"st_split":               makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49045}),
 	"st_wrapx":               makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 49068}),
 	"st_geomfromgml":         makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48807}),
 	"st_geomfromtwkb":        makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 48809}),
@@ -7606,10 +7755,6 @@ func init() {
 		"st_astext",
 		"st_buffer",
 		"st_centroid",
-		// TODO(#48899): uncomment
-		// "st_clusterintersecting",
-		// TODO(#48901): uncomment
-		// "st_clusterwithin",
 		"st_coveredby",
 		"st_covers",
 		"st_distance",
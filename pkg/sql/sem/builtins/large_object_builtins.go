@@ -0,0 +1,499 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package builtins
+
+import (
+	"context"
+	"io"
+	"math"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/builtins/builtinconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/lib/pq/oid"
+)
+
+func init() {
+	for k, v := range largeObjectBuiltins {
+		const enforceClass = true
+		registerBuiltin(k, v, tree.NormalClass, enforceClass)
+	}
+}
+
+// largeObjectProps returns the properties shared by all large object
+// builtins. They all read or write the session's transaction state, so they
+// can only be evaluated on the gateway.
+func largeObjectProps() tree.FunctionProperties {
+	return tree.FunctionProperties{
+		Category:         builtinconstants.CategoryLargeObject,
+		DistsqlBlocklist: true,
+	}
+}
+
+// See https://www.postgresql.org/docs/current/lo-funcs.html and
+// https://www.postgresql.org/docs/current/lo-interfaces.html.
+var largeObjectBuiltins = map[string]builtinDefinition{
+	"lo_create": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "loid", Typ: types.Oid}},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				loid, err := m.Create(ctx, tree.MustBeDOid(args[0]).Oid)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDOid(loid), nil
+			},
+			Info: "Creates a new empty large object with the given OID, or with a newly " +
+				"assigned OID if 0 is passed, and returns its OID.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_creat": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "mode", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				// The mode is ignored, like in Postgres.
+				loid, err := m.Create(ctx, 0 /* loid */)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDOid(loid), nil
+			},
+			Info:       "Creates a new empty large object with a newly assigned OID and returns it.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_open": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "loid", Typ: types.Oid},
+				{Name: "mode", Typ: types.Int4},
+			},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				fd, err := m.Open(ctx, tree.MustBeDOid(args[0]).Oid, int32(tree.MustBeDInt(args[1])))
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDInt(tree.DInt(fd)), nil
+			},
+			Info: "Opens the large object for reading (mode 262144, INV_READ) and/or " +
+				"writing (mode 131072, INV_WRITE) and returns a descriptor that is valid " +
+				"until the end of the current transaction.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_close": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				if err := m.Close(int32(tree.MustBeDInt(args[0]))); err != nil {
+					return nil, err
+				}
+				return tree.NewDInt(0), nil
+			},
+			Info:       "Closes the large object descriptor.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"loread": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "fd", Typ: types.Int4},
+				{Name: "len", Typ: types.Int4},
+			},
+			ReturnType: tree.FixedReturnType(types.Bytes),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				data, err := m.Read(ctx, int32(tree.MustBeDInt(args[0])), int32(tree.MustBeDInt(args[1])))
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDBytes(tree.DBytes(data)), nil
+			},
+			Info:       "Reads up to `len` bytes from the large object descriptor's current position.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lowrite": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "fd", Typ: types.Int4},
+				{Name: "data", Typ: types.Bytes},
+			},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				n, err := m.Write(ctx, int32(tree.MustBeDInt(args[0])), []byte(tree.MustBeDBytes(args[1])))
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDInt(tree.DInt(n)), nil
+			},
+			Info: "Writes the data at the large object descriptor's current position and " +
+				"returns the number of bytes written.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_lseek": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "fd", Typ: types.Int4},
+				{Name: "offset", Typ: types.Int4},
+				{Name: "whence", Typ: types.Int4},
+			},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loSeek(ctx, evalCtx, args, math.MaxInt32)
+			},
+			Info: "Moves the large object descriptor's position relative to the start (0), " +
+				"the current position (1) or the end (2) of the object and returns the new position.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_lseek64": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "fd", Typ: types.Int4},
+				{Name: "offset", Typ: types.Int},
+				{Name: "whence", Typ: types.Int4},
+			},
+			ReturnType: tree.FixedReturnType(types.Int),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loSeek(ctx, evalCtx, args, math.MaxInt64)
+			},
+			Info: "Moves the large object descriptor's position relative to the start (0), " +
+				"the current position (1) or the end (2) of the object and returns the new position.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_tell": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loTell(evalCtx, args, math.MaxInt32)
+			},
+			Info:       "Returns the large object descriptor's current position.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_tell64": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Int),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loTell(evalCtx, args, math.MaxInt64)
+			},
+			Info:       "Returns the large object descriptor's current position.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_truncate": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "fd", Typ: types.Int4},
+				{Name: "len", Typ: types.Int4},
+			},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn:         loTruncate,
+			Info:       "Truncates or zero-extends the large object to `len` bytes.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_truncate64": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "fd", Typ: types.Int4},
+				{Name: "len", Typ: types.Int},
+			},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn:         loTruncate,
+			Info:       "Truncates or zero-extends the large object to `len` bytes.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_unlink": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "loid", Typ: types.Oid}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				if err := m.Unlink(ctx, tree.MustBeDOid(args[0]).Oid); err != nil {
+					return nil, err
+				}
+				return tree.NewDInt(1), nil
+			},
+			Info:       "Removes the large object.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_get": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "loid", Typ: types.Oid}},
+			ReturnType: tree.FixedReturnType(types.Bytes),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loGet(ctx, evalCtx, tree.MustBeDOid(args[0]), 0 /* offset */, -1 /* length */)
+			},
+			Info:       "Returns the contents of the large object.",
+			Volatility: volatility.Volatile,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "loid", Typ: types.Oid},
+				{Name: "offset", Typ: types.Int},
+				{Name: "length", Typ: types.Int4},
+			},
+			ReturnType: tree.FixedReturnType(types.Bytes),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				length := int64(tree.MustBeDInt(args[2]))
+				if length < 0 {
+					return nil, pgerror.Newf(pgcode.InvalidParameterValue, "requested length cannot be negative")
+				}
+				return loGet(ctx, evalCtx, tree.MustBeDOid(args[0]), int64(tree.MustBeDInt(args[1])), length)
+			},
+			Info:       "Returns `length` bytes of the large object starting at `offset`.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_put": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "loid", Typ: types.Oid},
+				{Name: "offset", Typ: types.Int},
+				{Name: "data", Typ: types.Bytes},
+			},
+			ReturnType: tree.FixedReturnType(types.Void),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				if err := m.Put(
+					ctx, tree.MustBeDOid(args[0]).Oid, int64(tree.MustBeDInt(args[1])), []byte(tree.MustBeDBytes(args[2])),
+				); err != nil {
+					return nil, err
+				}
+				return tree.DVoidDatum, nil
+			},
+			Info:       "Writes the data into the large object starting at `offset`.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_from_bytea": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "loid", Typ: types.Oid},
+				{Name: "data", Typ: types.Bytes},
+			},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loFromBytes(ctx, evalCtx, tree.MustBeDOid(args[0]).Oid, []byte(tree.MustBeDBytes(args[1])))
+			},
+			Info: "Creates a large object holding the data, with the given OID or with a " +
+				"newly assigned OID if 0 is passed, and returns its OID.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_import": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "uri", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loImport(ctx, evalCtx, string(tree.MustBeDString(args[0])), 0 /* loid */)
+			},
+			Info: "Creates a large object holding the contents of the file at the supplied " +
+				"external storage URI and returns its newly assigned OID.",
+			Volatility: volatility.Volatile,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "uri", Typ: types.String},
+				{Name: "loid", Typ: types.Oid},
+			},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return loImport(ctx, evalCtx, string(tree.MustBeDString(args[0])), tree.MustBeDOid(args[1]).Oid)
+			},
+			Info: "Creates a large object with the given OID holding the contents of the " +
+				"file at the supplied external storage URI.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_export": makeBuiltin(largeObjectProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "loid", Typ: types.Oid},
+				{Name: "uri", Typ: types.String},
+			},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				m, err := evalCtx.Planner.LargeObjects()
+				if err != nil {
+					return nil, err
+				}
+				uri := string(tree.MustBeDString(args[1]))
+				if err := m.Export(ctx, tree.MustBeDOid(args[0]).Oid, func(r io.Reader) error {
+					return evalCtx.Planner.ExternalWriteFile(ctx, uri, r)
+				}); err != nil {
+					return nil, err
+				}
+				return tree.NewDInt(1), nil
+			},
+			Info:       "Writes the contents of the large object to the supplied external storage URI.",
+			Volatility: volatility.Volatile,
+		},
+	),
+}
+
+// loSeek implements lo_lseek and lo_lseek64. maxResult is the largest
+// position that can be returned by the overload.
+func loSeek(
+	ctx context.Context, evalCtx *eval.Context, args tree.Datums, maxResult int64,
+) (tree.Datum, error) {
+	m, err := evalCtx.Planner.LargeObjects()
+	if err != nil {
+		return nil, err
+	}
+	fd := int32(tree.MustBeDInt(args[0]))
+	pos, err := m.Seek(ctx, fd, int64(tree.MustBeDInt(args[1])), int32(tree.MustBeDInt(args[2])))
+	if err != nil {
+		return nil, err
+	}
+	if pos > maxResult {
+		return nil, pgerror.Newf(pgcode.NumericValueOutOfRange,
+			"lo_lseek result out of range for large-object descriptor %d", fd)
+	}
+	return tree.NewDInt(tree.DInt(pos)), nil
+}
+
+// loTell implements lo_tell and lo_tell64. maxResult is the largest position
+// that can be returned by the overload.
+func loTell(evalCtx *eval.Context, args tree.Datums, maxResult int64) (tree.Datum, error) {
+	m, err := evalCtx.Planner.LargeObjects()
+	if err != nil {
+		return nil, err
+	}
+	fd := int32(tree.MustBeDInt(args[0]))
+	pos, err := m.Tell(fd)
+	if err != nil {
+		return nil, err
+	}
+	if pos > maxResult {
+		return nil, pgerror.Newf(pgcode.NumericValueOutOfRange,
+			"lo_tell result out of range for large-object descriptor %d", fd)
+	}
+	return tree.NewDInt(tree.DInt(pos)), nil
+}
+
+// loTruncate implements lo_truncate and lo_truncate64.
+func loTruncate(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+	m, err := evalCtx.Planner.LargeObjects()
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Truncate(ctx, int32(tree.MustBeDInt(args[0])), int64(tree.MustBeDInt(args[1]))); err != nil {
+		return nil, err
+	}
+	return tree.NewDInt(0), nil
+}
+
+func loGet(
+	ctx context.Context, evalCtx *eval.Context, loid *tree.DOid, offset, length int64,
+) (tree.Datum, error) {
+	m, err := evalCtx.Planner.LargeObjects()
+	if err != nil {
+		return nil, err
+	}
+	data, err := m.Get(ctx, loid.Oid, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	return tree.NewDBytes(tree.DBytes(data)), nil
+}
+
+func loFromBytes(
+	ctx context.Context, evalCtx *eval.Context, loid oid.Oid, data []byte,
+) (tree.Datum, error) {
+	m, err := evalCtx.Planner.LargeObjects()
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > largeobject.MaxSize {
+		return nil, pgerror.Newf(pgcode.ProgramLimitExceeded, "large object is too large")
+	}
+	loid, err = m.FromBytes(ctx, loid, data)
+	if err != nil {
+		return nil, err
+	}
+	return tree.NewDOid(loid), nil
+}
+
+// loImport creates a large object from the file at uri. The file is streamed
+// into the large object rather than read into memory first.
+func loImport(ctx context.Context, evalCtx *eval.Context, uri string, loid oid.Oid) (tree.Datum, error) {
+	m, err := evalCtx.Planner.LargeObjects()
+	if err != nil {
+		return nil, err
+	}
+	if err := evalCtx.Planner.ExternalStreamFile(ctx, uri, func(r io.Reader) error {
+		loid, err = m.Import(ctx, loid, r)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return tree.NewDOid(loid), nil
+}
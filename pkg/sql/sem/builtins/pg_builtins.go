@@ -85,6 +85,7 @@ var typeBuiltinsHaveUnderscore = map[oid.Oid]struct{}{
 	types.TimestampTZ.Oid(): {},
 	types.Trigger.Oid():     {},
 	types.AnyTuple.Oid():    {},
+	types.XML.Oid():         {},
 }
 
 // PGIOBuiltinPrefix returns the string prefix to a type's IO functions. This
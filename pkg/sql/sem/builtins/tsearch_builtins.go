@@ -7,8 +7,11 @@ package builtins
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/pkg/kv"
+	"github.com/cockroachdb/cockroach/pkg/sql/lexbase"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/builtins/builtinconstants"
@@ -16,7 +19,9 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
 	"github.com/cockroachdb/cockroach/pkg/util/tsearch"
+	"github.com/cockroachdb/errors"
 )
 
 func init() {
@@ -91,11 +96,14 @@ var tsearchBuiltins = map[string]builtinDefinition{
 		tree.Overload{
 			Types:      tree.ParamTypes{{Name: "config", Typ: types.String}, {Name: "text", Typ: types.String}},
 			ReturnType: tree.FixedReturnType(types.TSVector),
-			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
 				// Parse, stem, and stopword the input.
-				config := string(tree.MustBeDString(args[0]))
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
 				document := string(tree.MustBeDString(args[1]))
-				vector, err := tsearch.DocumentToTSVector(config, document)
+				vector, err := config.DocumentToTSVector(document)
 				if err != nil {
 					return nil, err
 				}
@@ -127,10 +135,13 @@ var tsearchBuiltins = map[string]builtinDefinition{
 		tree.Overload{
 			Types:      tree.ParamTypes{{Name: "config", Typ: types.String}, {Name: "text", Typ: types.String}},
 			ReturnType: tree.FixedReturnType(types.TSQuery),
-			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
-				config := string(tree.MustBeDString(args[0]))
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
 				input := string(tree.MustBeDString(args[1]))
-				query, err := tsearch.ToTSQuery(config, input)
+				query, err := config.ToTSQuery(input)
 				if err != nil {
 					return nil, err
 				}
@@ -164,10 +175,13 @@ var tsearchBuiltins = map[string]builtinDefinition{
 		tree.Overload{
 			Types:      tree.ParamTypes{{Name: "config", Typ: types.String}, {Name: "text", Typ: types.String}},
 			ReturnType: tree.FixedReturnType(types.TSQuery),
-			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
-				config := string(tree.MustBeDString(args[0]))
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
 				input := string(tree.MustBeDString(args[1]))
-				query, err := tsearch.PlainToTSQuery(config, input)
+				query, err := config.PlainToTSQuery(input)
 				if err != nil {
 					return nil, err
 				}
@@ -199,10 +213,13 @@ var tsearchBuiltins = map[string]builtinDefinition{
 		tree.Overload{
 			Types:      tree.ParamTypes{{Name: "config", Typ: types.String}, {Name: "text", Typ: types.String}},
 			ReturnType: tree.FixedReturnType(types.TSQuery),
-			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
-				config := string(tree.MustBeDString(args[0]))
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
 				input := string(tree.MustBeDString(args[1]))
-				query, err := tsearch.PhraseToTSQuery(config, input)
+				query, err := config.PhraseToTSQuery(input)
 				if err != nil {
 					return nil, err
 				}
@@ -328,6 +345,408 @@ var tsearchBuiltins = map[string]builtinDefinition{
 			Volatility: volatility.Immutable,
 		},
 	),
+	"ts_rank_cd": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "weights", Typ: types.FloatArray},
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "query", Typ: types.TSQuery},
+				{Name: "normalization", Typ: types.Int},
+			},
+			ReturnType: tree.FixedReturnType(types.Float4),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				weights, err := getWeights(tree.MustBeDArray(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				rank, err := tsearch.RankCD(
+					weights,
+					tree.MustBeDTSVector(args[1]).TSVector,
+					tree.MustBeDTSQuery(args[2]).TSQuery,
+					int(tree.MustBeDInt(args[3])),
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDFloat(tree.DFloat(rank)), nil
+			},
+			Info:       "Ranks vectors using the cover density method, which takes the proximity of matching lexemes into account.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "weights", Typ: types.FloatArray},
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "query", Typ: types.TSQuery},
+			},
+			ReturnType: tree.FixedReturnType(types.Float4),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				weights, err := getWeights(tree.MustBeDArray(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				rank, err := tsearch.RankCD(
+					weights,
+					tree.MustBeDTSVector(args[1]).TSVector,
+					tree.MustBeDTSQuery(args[2]).TSQuery,
+					0,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDFloat(tree.DFloat(rank)), nil
+			},
+			Info:       "Ranks vectors using the cover density method, which takes the proximity of matching lexemes into account.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "query", Typ: types.TSQuery},
+				{Name: "normalization", Typ: types.Int},
+			},
+			ReturnType: tree.FixedReturnType(types.Float4),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				rank, err := tsearch.RankCD(
+					nil, /* weights */
+					tree.MustBeDTSVector(args[0]).TSVector,
+					tree.MustBeDTSQuery(args[1]).TSQuery,
+					int(tree.MustBeDInt(args[2])),
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDFloat(tree.DFloat(rank)), nil
+			},
+			Info:       "Ranks vectors using the cover density method, which takes the proximity of matching lexemes into account.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "query", Typ: types.TSQuery},
+			},
+			ReturnType: tree.FixedReturnType(types.Float4),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				rank, err := tsearch.RankCD(
+					nil, /* weights */
+					tree.MustBeDTSVector(args[0]).TSVector,
+					tree.MustBeDTSQuery(args[1]).TSQuery,
+					0, /* method */
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDFloat(tree.DFloat(rank)), nil
+			},
+			Info:       "Ranks vectors using the cover density method, which takes the proximity of matching lexemes into account.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"websearch_to_tsquery": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "config", Typ: types.String}, {Name: "text", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.TSQuery),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
+				input := string(tree.MustBeDString(args[1]))
+				query, err := config.WebSearchToTSQuery(input)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSQuery{TSQuery: query}, nil
+			},
+			Info: "Converts text written in a web search syntax to a tsquery, normalizing words according to " +
+				"the specified configuration. Unquoted words are combined with &, quoted phrases with <->, " +
+				"the word \"or\" produces | and a leading - produces !.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "text", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.TSQuery),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config := tsearch.GetConfigKey(evalCtx.SessionData().DefaultTextSearchConfig)
+				input := string(tree.MustBeDString(args[0]))
+				query, err := tsearch.WebSearchToTSQuery(config, input)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSQuery{TSQuery: query}, nil
+			},
+			Info: "Converts text written in a web search syntax to a tsquery, normalizing words according to " +
+				"the default configuration. Unquoted words are combined with &, quoted phrases with <->, " +
+				"the word \"or\" produces | and a leading - produces !.",
+			Volatility: volatility.Stable,
+		},
+	),
+	"ts_headline": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "config", Typ: types.String},
+				{Name: "document", Typ: types.String},
+				{Name: "query", Typ: types.TSQuery},
+				{Name: "options", Typ: types.String},
+			},
+			ReturnType: tree.FixedReturnType(types.String),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
+				return tsHeadline(config, args[1], args[2], args[3])
+			},
+			Info:       headlineInfo + " The document is normalized according to the specified configuration.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "config", Typ: types.String},
+				{Name: "document", Typ: types.String},
+				{Name: "query", Typ: types.TSQuery},
+			},
+			ReturnType: tree.FixedReturnType(types.String),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
+				return tsHeadline(config, args[1], args[2], nil /* options */)
+			},
+			Info:       headlineInfo + " The document is normalized according to the specified configuration.",
+			Volatility: volatility.Immutable,
+			// Postgres takes a regconfig as the configuration, so this overload
+			// would otherwise be compared to ts_headline(text, tsquery, text),
+			// which is Stable.
+			IgnoreVolatilityCheck: true,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "document", Typ: types.String},
+				{Name: "query", Typ: types.TSQuery},
+				{Name: "options", Typ: types.String},
+			},
+			ReturnType: tree.FixedReturnType(types.String),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := tsearch.GetBuiltinConfig(tsearch.GetConfigKey(evalCtx.SessionData().DefaultTextSearchConfig))
+				if err != nil {
+					return nil, err
+				}
+				return tsHeadline(config, args[0], args[1], args[2])
+			},
+			Info:       headlineInfo + " The document is normalized according to the default configuration.",
+			Volatility: volatility.Stable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "document", Typ: types.String},
+				{Name: "query", Typ: types.TSQuery},
+			},
+			ReturnType: tree.FixedReturnType(types.String),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := tsearch.GetBuiltinConfig(tsearch.GetConfigKey(evalCtx.SessionData().DefaultTextSearchConfig))
+				if err != nil {
+					return nil, err
+				}
+				return tsHeadline(config, args[0], args[1], nil /* options */)
+			},
+			Info:       headlineInfo + " The document is normalized according to the default configuration.",
+			Volatility: volatility.Stable,
+		},
+	),
+	"ts_rewrite": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "query", Typ: types.TSQuery},
+				{Name: "target", Typ: types.TSQuery},
+				{Name: "substitute", Typ: types.TSQuery},
+			},
+			ReturnType: tree.FixedReturnType(types.TSQuery),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				query := tsearch.Rewrite(
+					tree.MustBeDTSQuery(args[0]).TSQuery,
+					tree.MustBeDTSQuery(args[1]).TSQuery,
+					tree.MustBeDTSQuery(args[2]).TSQuery,
+				)
+				return &tree.DTSQuery{TSQuery: query}, nil
+			},
+			Info:       "Replaces occurrences of target with substitute within the query.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"setweight": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "weight", Typ: types.QChar},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				vector, err := tsearch.SetWeight(
+					tree.MustBeDTSVector(args[0]).TSVector,
+					string(tree.MustBeDString(args[1])),
+					nil, /* lexemes */
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDTSVector(vector), nil
+			},
+			Info:       "Assigns the given weight (A, B, C or D) to each position of the vector.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "weight", Typ: types.QChar},
+				{Name: "lexemes", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				lexemes, err := getLexemes(tree.MustBeDArray(args[2]))
+				if err != nil {
+					return nil, err
+				}
+				vector, err := tsearch.SetWeight(
+					tree.MustBeDTSVector(args[0]).TSVector,
+					string(tree.MustBeDString(args[1])),
+					lexemes,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDTSVector(vector), nil
+			},
+			Info:       "Assigns the given weight (A, B, C or D) to the positions of the listed lexemes of the vector.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"strip": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "vector", Typ: types.TSVector}},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return tree.NewDTSVector(tsearch.Strip(tree.MustBeDTSVector(args[0]).TSVector)), nil
+			},
+			Info:       "Removes the positions and weights from the vector.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"ts_delete": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "lexeme", Typ: types.String},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				vector := tsearch.Delete(
+					tree.MustBeDTSVector(args[0]).TSVector,
+					string(tree.MustBeDString(args[1])),
+				)
+				return tree.NewDTSVector(vector), nil
+			},
+			Info:       "Removes any occurrence of the given lexeme from the vector.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "lexemes", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				lexemes, err := getLexemes(tree.MustBeDArray(args[1]))
+				if err != nil {
+					return nil, err
+				}
+				vector := tsearch.Delete(tree.MustBeDTSVector(args[0]).TSVector, lexemes...)
+				return tree.NewDTSVector(vector), nil
+			},
+			Info:       "Removes any occurrence of the given lexemes from the vector.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"ts_filter": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "weights", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				arr := tree.MustBeDArray(args[1])
+				weights := make([]string, arr.Len())
+				for i, d := range arr.Array {
+					if d == tree.DNull {
+						return nil, pgerror.New(pgcode.NullValueNotAllowed, "weight array may not contain nulls")
+					}
+					weights[i] = string(tree.MustBeDString(d))
+				}
+				vector, err := tsearch.Filter(tree.MustBeDTSVector(args[0]).TSVector, weights)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDTSVector(vector), nil
+			},
+			Info:       "Keeps only the positions of the vector that have one of the given weights.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"tsvector_concat": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector1", Typ: types.TSVector},
+				{Name: "vector2", Typ: types.TSVector},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				vector, err := tsearch.Concat(
+					tree.MustBeDTSVector(args[0]).TSVector,
+					tree.MustBeDTSVector(args[1]).TSVector,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDTSVector(vector), nil
+			},
+			Info: "Concatenates two vectors, shifting the positions of the second one after the positions " +
+				"of the first one. This is equivalent to the || operator.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"jsonb_to_tsvector": makeJSONToTSVectorBuiltin(),
+	"json_to_tsvector":  makeJSONToTSVectorBuiltin(),
+
+	"tsvector_update_trigger": makeBuiltin(
+		tree.FunctionProperties{},
+		makeTSVectorUpdateTriggerOverload(
+			"tsvector_update_trigger", false, /* configColumn */
+			"Trigger function that sets the tsvector column named by the first trigger argument to "+
+				"the concatenation of the text columns named by the remaining arguments, parsed "+
+				"using the text search configuration given as the second argument.",
+		),
+	),
+	"tsvector_update_trigger_column": makeBuiltin(
+		tree.FunctionProperties{},
+		makeTSVectorUpdateTriggerOverload(
+			"tsvector_update_trigger_column", true, /* configColumn */
+			"Trigger function that sets the tsvector column named by the first trigger argument to "+
+				"the concatenation of the text columns named by the remaining arguments, parsed "+
+				"using the text search configuration stored in the column named by the second argument.",
+		),
+	),
 }
 
 func getWeights(arr *tree.DArray) ([]float32, error) {
@@ -343,3 +762,319 @@ func getWeights(arr *tree.DArray) ([]float32, error) {
 	}
 	return ret, nil
 }
+
+// resolveTextSearchConfig returns the text search configuration with the given
+// name. Built-in configurations take precedence over user-defined ones, just
+// as pg_catalog is searched before the other schemas in the search path.
+func resolveTextSearchConfig(
+	ctx context.Context, evalCtx *eval.Context, name string,
+) (*tsearch.Config, error) {
+	if tsearch.IsBuiltinConfig(name) || evalCtx.Planner == nil {
+		return tsearch.GetBuiltinConfig(name)
+	}
+	return evalCtx.Planner.ResolveTextSearchConfig(ctx, name)
+}
+
+// getLexemes returns the strings in the given array, which must not contain
+// NULLs.
+func getLexemes(arr *tree.DArray) ([]string, error) {
+	ret := make([]string, arr.Len())
+	for i, d := range arr.Array {
+		if d == tree.DNull {
+			return nil, pgerror.New(pgcode.NullValueNotAllowed, "lexeme array may not contain nulls")
+		}
+		ret[i] = string(tree.MustBeDString(d))
+	}
+	return ret, nil
+}
+
+const headlineInfo = "Returns an excerpt of the document in which the words that match the query are " +
+	"highlighted. The options are a comma-separated list of option=value pairs among StartSel, StopSel, " +
+	"MaxWords, MinWords, ShortWord, HighlightAll, MaxFragments and FragmentDelimiter."
+
+// tsHeadline implements ts_headline. options may be nil to use the default
+// options.
+func tsHeadline(
+	config *tsearch.Config, document, query, options tree.Datum,
+) (tree.Datum, error) {
+	opts := tsearch.DefaultHeadlineOptions()
+	if options != nil {
+		var err error
+		opts, err = tsearch.ParseHeadlineOptions(string(tree.MustBeDString(options)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	headline, err := config.Headline(
+		string(tree.MustBeDString(document)), tree.MustBeDTSQuery(query).TSQuery, opts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tree.NewDString(headline), nil
+}
+
+// jsonToTSVectorFilter is a bitmask of the kinds of JSON values that are
+// included in the result of json_to_tsvector.
+type jsonToTSVectorFilter int
+
+const (
+	jsonToTSVectorString jsonToTSVectorFilter = 1 << iota
+	jsonToTSVectorNumeric
+	jsonToTSVectorBoolean
+	jsonToTSVectorKey
+
+	jsonToTSVectorAll = jsonToTSVectorString | jsonToTSVectorNumeric | jsonToTSVectorBoolean | jsonToTSVectorKey
+)
+
+const jsonToTSVectorFilterHint = `Possible values are: "string", "numeric", "boolean", "key", and "all".`
+
+// parseJSONToTSVectorFilter parses the filter argument of json_to_tsvector,
+// which is either a string or an array of strings.
+func parseJSONToTSVectorFilter(filter json.JSON) (jsonToTSVectorFilter, error) {
+	var elems []json.JSON
+	switch filter.Type() {
+	case json.ArrayJSONType:
+		elems, _ = filter.AsArray()
+	case json.ObjectJSONType:
+		return 0, pgerror.New(pgcode.InvalidParameterValue, "wrong flag type, only arrays and scalars are allowed")
+	default:
+		elems = []json.JSON{filter}
+	}
+	var ret jsonToTSVectorFilter
+	for _, elem := range elems {
+		if elem.Type() != json.StringJSONType {
+			return 0, errors.WithHint(
+				pgerror.New(pgcode.InvalidParameterValue, "flag array element is not a string"),
+				jsonToTSVectorFilterHint,
+			)
+		}
+		text, err := elem.AsText()
+		if err != nil {
+			return 0, err
+		}
+		switch strings.ToLower(*text) {
+		case "string":
+			ret |= jsonToTSVectorString
+		case "numeric":
+			ret |= jsonToTSVectorNumeric
+		case "boolean":
+			ret |= jsonToTSVectorBoolean
+		case "key":
+			ret |= jsonToTSVectorKey
+		case "all":
+			ret |= jsonToTSVectorAll
+		default:
+			return 0, errors.WithHint(
+				pgerror.Newf(pgcode.InvalidParameterValue, "wrong flag in flag array: %q", *text),
+				jsonToTSVectorFilterHint,
+			)
+		}
+	}
+	return ret, nil
+}
+
+// appendJSONDocuments appends the keys and scalar values of the JSON document
+// that are selected by the filter to docs, in document order.
+func appendJSONDocuments(
+	docs []string, j json.JSON, filter jsonToTSVectorFilter,
+) ([]string, error) {
+	switch j.Type() {
+	case json.ObjectJSONType:
+		it, err := j.ObjectIter()
+		if err != nil {
+			return nil, err
+		}
+		for it.Next() {
+			if filter&jsonToTSVectorKey != 0 {
+				docs = append(docs, it.Key())
+			}
+			if docs, err = appendJSONDocuments(docs, it.Value(), filter); err != nil {
+				return nil, err
+			}
+		}
+	case json.ArrayJSONType:
+		elems, _ := j.AsArray()
+		for _, elem := range elems {
+			var err error
+			if docs, err = appendJSONDocuments(docs, elem, filter); err != nil {
+				return nil, err
+			}
+		}
+	case json.StringJSONType, json.NumberJSONType, json.TrueJSONType, json.FalseJSONType:
+		var kind jsonToTSVectorFilter
+		switch j.Type() {
+		case json.StringJSONType:
+			kind = jsonToTSVectorString
+		case json.NumberJSONType:
+			kind = jsonToTSVectorNumeric
+		default:
+			kind = jsonToTSVectorBoolean
+		}
+		if filter&kind == 0 {
+			return docs, nil
+		}
+		text, err := j.AsText()
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *text)
+	}
+	return docs, nil
+}
+
+// jsonToTSVector implements json_to_tsvector.
+func jsonToTSVector(config *tsearch.Config, document, filter tree.Datum) (tree.Datum, error) {
+	f, err := parseJSONToTSVectorFilter(tree.MustBeDJSON(filter).JSON)
+	if err != nil {
+		return nil, err
+	}
+	docs, err := appendJSONDocuments(nil /* docs */, tree.MustBeDJSON(document).JSON, f)
+	if err != nil {
+		return nil, err
+	}
+	vector, err := config.DocumentsToTSVector(docs)
+	if err != nil {
+		return nil, err
+	}
+	return tree.NewDTSVector(vector), nil
+}
+
+func makeJSONToTSVectorBuiltin() builtinDefinition {
+	const info = "Converts the values of a JSON document selected by the filter to a tsvector. " +
+		"The filter is a JSON array containing any of \"string\", \"numeric\", \"boolean\", \"key\" " +
+		"and \"all\". The values are processed in document order, each being converted as by to_tsvector."
+	return makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "config", Typ: types.String},
+				{Name: "document", Typ: types.Jsonb},
+				{Name: "filter", Typ: types.Jsonb},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := resolveTextSearchConfig(ctx, evalCtx, string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
+				return jsonToTSVector(config, args[1], args[2])
+			},
+			Info:       info + " Words are normalized according to the specified configuration.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "document", Typ: types.Jsonb},
+				{Name: "filter", Typ: types.Jsonb},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config, err := tsearch.GetBuiltinConfig(tsearch.GetConfigKey(evalCtx.SessionData().DefaultTextSearchConfig))
+				if err != nil {
+					return nil, err
+				}
+				return jsonToTSVector(config, args[0], args[1])
+			},
+			Info:       info + " Words are normalized according to the default configuration.",
+			Volatility: volatility.Stable,
+		},
+	)
+}
+
+// makeTSVectorUpdateTriggerOverload returns the overload of the builtin
+// trigger function with the given name. If configColumn is true, the second
+// trigger argument names a column that holds the configuration, rather than
+// being the configuration itself.
+func makeTSVectorUpdateTriggerOverload(name string, configColumn bool, info string) tree.Overload {
+	return tree.Overload{
+		Types:      tree.ParamTypes{},
+		ReturnType: tree.FixedReturnType(types.Trigger),
+		Fn: func(context.Context, *eval.Context, tree.Datums) (tree.Datum, error) {
+			return nil, pgerror.New(pgcode.FeatureNotSupported,
+				"trigger functions can only be called as triggers")
+		},
+		TriggerBody: func(call *tree.BuiltinTriggerCall) (string, error) {
+			return tsvectorUpdateTriggerBody(name, configColumn, call)
+		},
+		Language:   tree.RoutineLangPLpgSQL,
+		Info:       info,
+		Volatility: volatility.Volatile,
+	}
+}
+
+// tsvectorUpdateTriggerBody validates a trigger that calls the builtin trigger
+// function with the given name, and returns the PL/pgSQL body that implements
+// the function for it. Like Postgres, which looks the columns up by name each
+// time the trigger fires, the body is regenerated each time the trigger is
+// built, so that the trigger keeps working after unrelated columns are altered
+// and fails if a column it names is renamed or dropped.
+//
+// Like in Postgres, NULL text columns are skipped, and the positions of the
+// lexemes of each column continue from those of the previous one.
+func tsvectorUpdateTriggerBody(
+	name string, configColumn bool, call *tree.BuiltinTriggerCall,
+) (string, error) {
+	if !call.ForEachRow {
+		return "", pgerror.Newf(pgcode.TriggeredActionException, "%s: must be fired for row", name)
+	}
+	if call.ActionTime != tree.TriggerActionTimeBefore {
+		return "", pgerror.Newf(pgcode.TriggeredActionException, "%s: must be fired BEFORE event", name)
+	}
+	if call.Events&^tree.MakeTriggerEventTypeSet(tree.TriggerEventInsert, tree.TriggerEventUpdate) != 0 {
+		return "", pgerror.Newf(pgcode.TriggeredActionException,
+			"%s: must be fired for INSERT or UPDATE", name)
+	}
+	if len(call.Args) < 3 {
+		return "", pgerror.Newf(pgcode.InvalidParameterValue,
+			"%s: arguments must be tsvector_field, ts_config, text_field1, ...)", name)
+	}
+	columnType := func(col string) *types.T {
+		for i, label := range call.TableTyp.TupleLabels() {
+			if label == col {
+				return call.TableTyp.TupleContents()[i]
+			}
+		}
+		return nil
+	}
+
+	vectorCol := call.Args[0]
+	if typ := columnType(vectorCol); typ == nil {
+		return "", pgerror.Newf(pgcode.UndefinedColumn, "tsvector column %q does not exist", vectorCol)
+	} else if typ.Family() != types.TSVectorFamily {
+		return "", pgerror.Newf(pgcode.DatatypeMismatch, "column %q is not of tsvector type", vectorCol)
+	}
+
+	var body strings.Builder
+	body.WriteString("BEGIN\n")
+	config := lexbase.EscapeSQLString(call.Args[1])
+	if configColumn {
+		col := call.Args[1]
+		if typ := columnType(col); typ == nil {
+			return "", pgerror.Newf(pgcode.UndefinedColumn, "config column %q does not exist", col)
+		} else if typ.Family() != types.StringFamily {
+			return "", pgerror.Newf(pgcode.DatatypeMismatch, "column %q is not of a character type", col)
+		}
+		config = "(NEW)." + tree.NameString(col)
+		fmt.Fprintf(&body, "  IF %s IS NULL THEN\n", config)
+		fmt.Fprintf(&body, "    RAISE EXCEPTION USING ERRCODE = '%s', MESSAGE = %s;\n",
+			pgcode.NullValueNotAllowed.String(),
+			lexbase.EscapeSQLString(fmt.Sprintf("config column %q must not be null", col)))
+		body.WriteString("  END IF;\n")
+	}
+
+	fmt.Fprintf(&body, "  NEW.%s := ", tree.NameString(vectorCol))
+	for i, col := range call.Args[2:] {
+		if typ := columnType(col); typ == nil {
+			return "", pgerror.Newf(pgcode.UndefinedColumn, "column %q does not exist", col)
+		} else if typ.Family() != types.StringFamily {
+			return "", pgerror.Newf(pgcode.DatatypeMismatch, "column %q is not of a character type", col)
+		}
+		if i > 0 {
+			body.WriteString(" || ")
+		}
+		fmt.Fprintf(&body, "to_tsvector(%s, COALESCE((NEW).%s, ''))", config, tree.NameString(col))
+	}
+	body.WriteString(";\n  RETURN NEW;\nEND\n")
+	return body.String(), nil
+}
@@ -8,6 +8,8 @@ package builtins
 import (
 	"context"
 
+	"github.com/cockroachdb/cockroach/pkg/geo"
+	"github.com/cockroachdb/cockroach/pkg/geo/geomfn"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
@@ -193,6 +195,47 @@ var windows = map[string]builtinDefinition{
 				volatility.Immutable,
 			)
 		}),
+	"st_clusterdbscan": makeBuiltin(tree.FunctionProperties{AvailableOnPublicSchema: true},
+		makeWindowOverload(
+			tree.ParamTypes{
+				{Name: "geometry", Typ: types.Geometry},
+				{Name: "eps", Typ: types.Float},
+				{Name: "minpoints", Typ: types.Int},
+			},
+			types.Int,
+			makeGeometryClusterWindowConstructor(clusterDBSCAN),
+			"Returns the 0-indexed cluster number of each geometry in the partition according to the "+
+				"DBSCAN algorithm. Geometries with at least `minpoints` geometries, including themselves, "+
+				"within `eps` form the cores of the clusters; geometries in no cluster return null.",
+			volatility.Immutable,
+		),
+	),
+	"st_clusterkmeans": makeBuiltin(tree.FunctionProperties{AvailableOnPublicSchema: true},
+		makeWindowOverload(
+			tree.ParamTypes{
+				{Name: "geometry", Typ: types.Geometry},
+				{Name: "number_of_clusters", Typ: types.Int},
+			},
+			types.Int,
+			makeGeometryClusterWindowConstructor(clusterKMeans),
+			"Returns the 0-indexed cluster number of each geometry in the partition, using the k-means "+
+				"algorithm on the centroids of the geometries to form `number_of_clusters` clusters.",
+			volatility.Immutable,
+		),
+		makeWindowOverload(
+			tree.ParamTypes{
+				{Name: "geometry", Typ: types.Geometry},
+				{Name: "number_of_clusters", Typ: types.Int},
+				{Name: "max_radius", Typ: types.Float},
+			},
+			types.Int,
+			makeGeometryClusterWindowConstructor(clusterKMeans),
+			"Returns the 0-indexed cluster number of each geometry in the partition, using the k-means "+
+				"algorithm on the centroids of the geometries to form at least `number_of_clusters` clusters. "+
+				"More clusters are formed until no geometry is further than `max_radius` from the center of its cluster.",
+			volatility.Immutable,
+		),
+	),
 }
 
 func makeWindowOverload(
@@ -220,6 +263,7 @@ var _ eval.WindowFunc = &leadLagWindow{}
 var _ eval.WindowFunc = &firstValueWindow{}
 var _ eval.WindowFunc = &lastValueWindow{}
 var _ eval.WindowFunc = &nthValueWindow{}
+var _ eval.WindowFunc = &geometryClusterWindow{}
 
 // aggregateWindowFunc aggregates over the current row's window frame, using
 // the internal eval.AggregateFunc to perform the aggregation.
@@ -855,3 +899,90 @@ func (nthValueWindow) Compute(
 func (nthValueWindow) Reset(context.Context) {}
 
 func (nthValueWindow) Close(context.Context, *eval.Context) {}
+
+// geometryClusterWindow assigns each row of the partition a cluster number
+// computed over the geometries of the whole partition. The clusters are
+// computed on the first call to Compute for a partition.
+type geometryClusterWindow struct {
+	// cluster computes the cluster number of each of the given geometries,
+	// using the arguments of the first row of the partition as parameters.
+	cluster  func(geoms []*geo.Geometry, firstArgs tree.Datums) ([]int, error)
+	clusters []int
+}
+
+func makeGeometryClusterWindowConstructor(
+	cluster func(geoms []*geo.Geometry, firstArgs tree.Datums) ([]int, error),
+) func([]*types.T, *eval.Context) eval.WindowFunc {
+	return func([]*types.T, *eval.Context) eval.WindowFunc {
+		return &geometryClusterWindow{cluster: cluster}
+	}
+}
+
+func (w *geometryClusterWindow) Compute(
+	ctx context.Context, _ *eval.Context, wfr *eval.WindowFrameRun,
+) (tree.Datum, error) {
+	if w.clusters == nil {
+		geoms := make([]*geo.Geometry, wfr.PartitionSize())
+		var firstArgs tree.Datums
+		for i := range geoms {
+			args, err := wfr.ArgsByRowIdx(ctx, i)
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 {
+				firstArgs = args
+			}
+			if args[0] != tree.DNull {
+				geoms[i] = &tree.MustBeDGeometry(args[0]).Geometry
+			}
+		}
+		clusters, err := w.cluster(geoms, firstArgs)
+		if err != nil {
+			return nil, err
+		}
+		w.clusters = clusters
+	}
+	if c := w.clusters[wfr.RowIdx]; c != geomfn.NoCluster {
+		return tree.NewDInt(tree.DInt(c)), nil
+	}
+	return tree.DNull, nil
+}
+
+// Reset implements eval.WindowFunc interface.
+func (w *geometryClusterWindow) Reset(context.Context) {
+	w.clusters = nil
+}
+
+func (w *geometryClusterWindow) Close(context.Context, *eval.Context) {}
+
+// noClusters returns the cluster numbers of n geometries which belong to no
+// cluster, which is the result of clustering with NULL parameters.
+func noClusters(n int) []int {
+	clusters := make([]int, n)
+	for i := range clusters {
+		clusters[i] = geomfn.NoCluster
+	}
+	return clusters
+}
+
+func clusterDBSCAN(geoms []*geo.Geometry, firstArgs tree.Datums) ([]int, error) {
+	if firstArgs[1] == tree.DNull || firstArgs[2] == tree.DNull {
+		return noClusters(len(geoms)), nil
+	}
+	eps := float64(tree.MustBeDFloat(firstArgs[1]))
+	minPoints := int(tree.MustBeDInt(firstArgs[2]))
+	return geomfn.ClusterDBSCAN(geoms, eps, minPoints)
+}
+
+func clusterKMeans(geoms []*geo.Geometry, firstArgs tree.Datums) ([]int, error) {
+	if firstArgs[1] == tree.DNull {
+		return noClusters(len(geoms)), nil
+	}
+	k := int(tree.MustBeDInt(firstArgs[1]))
+	// A NULL max_radius places no limit on the size of the clusters.
+	var maxRadius float64
+	if firstArgs[2] != tree.DNull {
+		maxRadius = float64(tree.MustBeDFloat(firstArgs[2]))
+	}
+	return geomfn.ClusterKMeans(geoms, k, maxRadius)
+}
@@ -0,0 +1,344 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package builtins
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/builtins/builtinconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/xml"
+)
+
+func init() {
+	for k, v := range xmlBuiltins {
+		v.props.Category = builtinconstants.CategoryXML
+		const enforceClass = true
+		registerBuiltin(k, v, tree.NormalClass, enforceClass)
+	}
+}
+
+// See https://www.postgresql.org/docs/current/functions-xml.html.
+//
+// XMLPARSE, XMLSERIALIZE and XMLELEMENT have special syntax in Postgres and
+// no function equivalent. The parser lowers them to the crdb_internal
+// builtins below.
+var xmlBuiltins = map[string]builtinDefinition{
+	"xpath": makeBuiltin(tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "xpath", Typ: types.String}, {Name: "xml", Typ: types.XML}},
+			ReturnType: tree.FixedReturnType(types.XMLArray),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return xpath(string(tree.MustBeDString(args[0])), tree.MustBeDXML(args[1]), nil /* nsArray */)
+			},
+			Info: "Evaluates the XPath 1.0 expression `xpath` against the XML document `xml` " +
+				"and returns the resulting nodes. Results that are not node-sets are returned " +
+				"as a single-element array.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "xpath", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+				{Name: "nsarray", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.XMLArray),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return xpath(string(tree.MustBeDString(args[0])), tree.MustBeDXML(args[1]), tree.MustBeDArray(args[2]))
+			},
+			Info: "Evaluates the XPath 1.0 expression `xpath` against the XML document `xml` " +
+				"and returns the resulting nodes. `nsarray` lists the namespace mappings as " +
+				"alternating prefixes and URIs, e.g. ARRAY['n', 'http://example.com'].",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"xpath_exists": makeBuiltin(tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "xpath", Typ: types.String}, {Name: "xml", Typ: types.XML}},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return xpathExists(string(tree.MustBeDString(args[0])), tree.MustBeDXML(args[1]), nil /* nsArray */)
+			},
+			Info:       "Returns whether evaluating the XPath 1.0 expression `xpath` against `xml` yields any result.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "xpath", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+				{Name: "nsarray", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return xpathExists(string(tree.MustBeDString(args[0])), tree.MustBeDXML(args[1]), tree.MustBeDArray(args[2]))
+			},
+			Info: "Returns whether evaluating the XPath 1.0 expression `xpath` against `xml` " +
+				"yields any result, using the namespace mappings in `nsarray`.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"xmlexists": makeBuiltin(tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "xpath", Typ: types.String}, {Name: "xml", Typ: types.XML}},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return xpathExists(string(tree.MustBeDString(args[0])), tree.MustBeDXML(args[1]), nil /* nsArray */)
+			},
+			Info:       "Returns whether evaluating the XPath 1.0 expression `xpath` against `xml` yields any result.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"xml_is_well_formed": makeBuiltin(tree.FunctionProperties{},
+		xmlIsWellFormedOverload(xml.Content, "an XML content fragment"),
+	),
+
+	"xml_is_well_formed_content": makeBuiltin(tree.FunctionProperties{},
+		xmlIsWellFormedOverload(xml.Content, "an XML content fragment"),
+	),
+
+	"xml_is_well_formed_document": makeBuiltin(tree.FunctionProperties{},
+		xmlIsWellFormedOverload(xml.Document, "an XML document"),
+	),
+
+	"xmlcomment": makeBuiltin(tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "text", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.XML),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				c, err := xml.Comment(string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDXML(c), nil
+			},
+			Info:       "Returns an XML comment containing `text`.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"xmltext": makeBuiltin(tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "text", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.XML),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return tree.NewDXML(xml.Escape(string(tree.MustBeDString(args[0])))), nil
+			},
+			Info:       "Returns an XML text node containing `text`, with the XML special characters escaped.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"crdb_internal.xmlparse": makeBuiltin(tree.FunctionProperties{Undocumented: true},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "kind", Typ: types.String}, {Name: "text", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.XML),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				opt, err := xml.ParseOption(string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
+				s := string(tree.MustBeDString(args[1]))
+				if err := xml.Validate(s, opt); err != nil {
+					return nil, err
+				}
+				return tree.NewDXML(s), nil
+			},
+			Info:       "Implements XMLPARSE(DOCUMENT | CONTENT text).",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"crdb_internal.xmlserialize": makeBuiltin(tree.FunctionProperties{Undocumented: true},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "kind", Typ: types.String}, {Name: "xml", Typ: types.XML}},
+			ReturnType: tree.FixedReturnType(types.String),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				opt, err := xml.ParseOption(string(tree.MustBeDString(args[0])))
+				if err != nil {
+					return nil, err
+				}
+				s := tree.MustBeDXML(args[1]).Contents
+				if opt == xml.Document {
+					doc, err := xml.Parse(s, xml.Content)
+					if err != nil {
+						return nil, err
+					}
+					if !xml.IsDocument(doc) {
+						return nil, pgerror.New(pgcode.NotAnXMLDocument, "not an XML document")
+					}
+				}
+				return tree.NewDString(s), nil
+			},
+			Info:       "Implements XMLSERIALIZE(DOCUMENT | CONTENT xml AS text).",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"crdb_internal.xmlelement": makeBuiltin(tree.FunctionProperties{Undocumented: true},
+		tree.Overload{
+			Types: tree.VariadicType{
+				FixedTypes: []*types.T{types.String, types.StringArray, types.StringArray},
+				VarType:    types.Any,
+			},
+			ReturnType: tree.FixedReturnType(types.XML),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				if args[0] == tree.DNull || args[1] == tree.DNull || args[2] == tree.DNull {
+					return nil, pgerror.New(pgcode.InvalidParameterValue, "XML element name and attribute lists must not be NULL")
+				}
+				return xmlElement(
+					string(tree.MustBeDString(args[0])),
+					tree.MustBeDArray(args[1]),
+					tree.MustBeDArray(args[2]),
+					args[3:],
+				)
+			},
+			Info:              "Implements XMLELEMENT(NAME name [, XMLATTRIBUTES(...)] [, content, ...]).",
+			Volatility:        volatility.Immutable,
+			CalledOnNullInput: true,
+		},
+	),
+}
+
+func xmlIsWellFormedOverload(opt xml.Option, what string) tree.Overload {
+	return tree.Overload{
+		Types:      tree.ParamTypes{{Name: "text", Typ: types.String}},
+		ReturnType: tree.FixedReturnType(types.Bool),
+		Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+			return tree.MakeDBool(tree.DBool(xml.IsWellFormed(string(tree.MustBeDString(args[0])), opt))), nil
+		},
+		Info:       "Returns whether `text` is well-formed as " + what + ".",
+		Volatility: volatility.Immutable,
+	}
+}
+
+// evalXPath parses the document and evaluates the XPath expression against
+// it. nsArray may be nil.
+func evalXPath(expr string, doc *tree.DXML, nsArray *tree.DArray) (xml.Value, error) {
+	var namespaces map[string]string
+	if nsArray != nil {
+		if nsArray.Len()%2 != 0 {
+			return xml.Value{}, pgerror.New(pgcode.InvalidParameterValue,
+				"namespace array must contain alternating prefixes and URIs")
+		}
+		namespaces = make(map[string]string, nsArray.Len()/2)
+		for i := 0; i < nsArray.Len(); i += 2 {
+			prefix, uri := nsArray.Array[i], nsArray.Array[i+1]
+			if prefix == tree.DNull || uri == tree.DNull {
+				return xml.Value{}, pgerror.New(pgcode.NullValueNotAllowed,
+					"neither namespace name nor URI may be null")
+			}
+			namespaces[string(tree.MustBeDString(prefix))] = string(tree.MustBeDString(uri))
+		}
+	}
+	compiled, err := xml.Compile(expr, namespaces)
+	if err != nil {
+		return xml.Value{}, err
+	}
+	root, err := xml.Parse(doc.Contents, xml.Document)
+	if err != nil {
+		return xml.Value{}, err
+	}
+	return compiled.Eval(root)
+}
+
+func xpath(expr string, doc *tree.DXML, nsArray *tree.DArray) (tree.Datum, error) {
+	v, err := evalXPath(expr, doc, nsArray)
+	if err != nil {
+		return nil, err
+	}
+	res := tree.NewDArray(types.XML)
+	if v.Kind != xml.NodeSetValue {
+		if err := res.Append(tree.NewDXML(xml.Escape(v.String()))); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	for _, n := range v.Nodes {
+		if err := res.Append(tree.NewDXML(n.String())); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// xpathExists returns whether the expression yields a non-empty node-set.
+// Like in Postgres, results that are not node-sets always count as existing.
+func xpathExists(expr string, doc *tree.DXML, nsArray *tree.DArray) (tree.Datum, error) {
+	v, err := evalXPath(expr, doc, nsArray)
+	if err != nil {
+		return nil, err
+	}
+	return tree.MakeDBool(tree.DBool(v.Kind != xml.NodeSetValue || len(v.Nodes) > 0)), nil
+}
+
+// xmlElement builds an element with the given attributes and content. NULL
+// attribute values and NULL content are omitted. XML content is inserted
+// verbatim, and other values are escaped.
+func xmlElement(
+	name string, attrNames, attrValues *tree.DArray, content tree.Datums,
+) (tree.Datum, error) {
+	if !xml.IsValidName(name) {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "invalid XML element name %q", name)
+	}
+	if attrNames.Len() != attrValues.Len() {
+		return nil, pgerror.New(pgcode.InvalidParameterValue,
+			"XML attribute names and values must have the same length")
+	}
+	var sb strings.Builder
+	sb.WriteByte('<')
+	sb.WriteString(name)
+	seen := make(map[string]struct{}, attrNames.Len())
+	for i, n := range attrNames.Array {
+		attr := string(tree.MustBeDString(n))
+		if !xml.IsValidName(attr) {
+			return nil, pgerror.Newf(pgcode.InvalidParameterValue, "invalid XML attribute name %q", attr)
+		}
+		if _, ok := seen[attr]; ok {
+			return nil, pgerror.Newf(pgcode.InvalidXMLContent,
+				"XML attribute name %q appears more than once", attr)
+		}
+		seen[attr] = struct{}{}
+		if attrValues.Array[i] == tree.DNull {
+			continue
+		}
+		sb.WriteByte(' ')
+		sb.WriteString(attr)
+		sb.WriteString(`="`)
+		sb.WriteString(xml.EscapeAttr(string(tree.MustBeDString(attrValues.Array[i]))))
+		sb.WriteByte('"')
+	}
+	var body strings.Builder
+	for _, d := range content {
+		switch t := d.(type) {
+		case *tree.DXML:
+			body.WriteString(t.Contents)
+		default:
+			if d == tree.DNull {
+				continue
+			}
+			body.WriteString(xml.Escape(tree.AsStringWithFlags(d, tree.FmtPgwireText)))
+		}
+	}
+	if body.Len() == 0 {
+		sb.WriteString("/>")
+	} else {
+		sb.WriteByte('>')
+		sb.WriteString(body.String())
+		sb.WriteString("</")
+		sb.WriteString(name)
+		sb.WriteByte('>')
+	}
+	return tree.NewDXML(sb.String()), nil
+}
@@ -113,6 +113,12 @@ const (
 	InspectErrorsTableName                  SystemTableName = "inspect_errors"
 	StatementHintsTableName                 SystemTableName = "statement_hints"
 	TableStatisticsLocksTableName           SystemTableName = "table_statistics_locks"
+	LargeObjectsTableName                   SystemTableName = "large_objects"
+	LargeObjectPagesTableName               SystemTableName = "large_object_pages"
+	LargeObjectOIDSequenceName              SystemTableName = "large_object_oid_seq"
+	UserLoginFailuresTableName              SystemTableName = "user_login_failures"
+	PasswordHistoryTableName                SystemTableName = "password_history"
+	AuditLogCheckpointsTableName            SystemTableName = "audit_log_checkpoints"
 )
 
 // Oid for virtual database and table.
@@ -274,6 +274,19 @@ func (e *evaluator) EvalConcatStringOp(
 
 }
 
+func (e *evaluator) EvalConcatTSVectorOp(
+	ctx context.Context, _ *tree.ConcatTSVectorOp, left, right tree.Datum,
+) (tree.Datum, error) {
+	concat, err := tsearch.Concat(
+		tree.MustBeDTSVector(left).TSVector,
+		tree.MustBeDTSVector(right).TSVector,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tree.NewDTSVector(concat), nil
+}
+
 func (e *evaluator) EvalConcatVarBitOp(
 	ctx context.Context, _ *tree.ConcatVarBitOp, left, right tree.Datum,
 ) (tree.Datum, error) {
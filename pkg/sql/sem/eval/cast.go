@@ -505,6 +505,8 @@ func performCastWithoutPrecisionTruncation(
 			s = t.T.String()
 		case *tree.DLTree:
 			s = t.LTree.String()
+		case *tree.DXML:
+			s = t.Contents
 		case *tree.DEnum:
 			s = t.LogicalRep
 		case *tree.DVoid:
@@ -976,6 +978,15 @@ func performCastWithoutPrecisionTruncation(
 			}
 			return ltree, nil
 		}
+	case types.XMLFamily:
+		switch v := d.(type) {
+		case *tree.DXML:
+			return d, nil
+		case *tree.DString:
+			return tree.ParseDXML(string(*v))
+		case *tree.DCollatedString:
+			return tree.ParseDXML(v.Contents)
+		}
 	case types.ArrayFamily:
 		switch v := d.(type) {
 		case *tree.DString:
@@ -7,6 +7,7 @@ package eval
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/clusterversion"
@@ -15,6 +16,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/hintpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
@@ -28,6 +30,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/json"
 	"github.com/cockroachdb/cockroach/pkg/util/mon"
 	"github.com/cockroachdb/cockroach/pkg/util/rangedesc"
+	"github.com/cockroachdb/cockroach/pkg/util/tsearch"
 	"github.com/cockroachdb/redact"
 	"github.com/lib/pq/oid"
 )
@@ -329,8 +332,13 @@ type Planner interface {
 	// ExternalReadFile reads the content from an external file URI.
 	ExternalReadFile(ctx context.Context, uri string) ([]byte, error)
 
-	// ExternalWriteFile writes the content to an external file URI.
-	ExternalWriteFile(ctx context.Context, uri string, content []byte) error
+	// ExternalStreamFile calls fn with a reader over the content of an external
+	// file URI, so that the content is not held in memory all at once.
+	ExternalStreamFile(ctx context.Context, uri string, fn func(io.Reader) error) error
+
+	// ExternalWriteFile writes the content read from the reader to an external
+	// file URI.
+	ExternalWriteFile(ctx context.Context, uri string, content io.Reader) error
 
 	// DecodeGist exposes gist functionality to the builtin functions.
 	DecodeGist(ctx context.Context, gist string, external bool) ([]string, error)
@@ -382,6 +390,11 @@ type Planner interface {
 	// it is invalid.
 	RepairTTLScheduledJobForTable(ctx context.Context, tableID int64) error
 
+	// BrinSummarize recomputes the summaries of the given BRIN index, using
+	// blocks of rowsPerBlock rows, or a default size if rowsPerBlock is zero.
+	// It returns the number of blocks summarized.
+	BrinSummarize(ctx context.Context, tableID int64, indexName string, rowsPerBlock int64) (int64, error)
+
 	// FingerprintSpan calculates a fingerprint for the given span. If a
 	// startTime is passed and allRevisions is true, then the fingerprint
 	// includes the MVCC history between startTime and the read timestamp of
@@ -450,6 +463,17 @@ type Planner interface {
 	// CLOSE statement.
 	PLpgSQLCloseCursor(cursorName tree.Name) error
 
+	// LargeObjects returns the manager used to implement the large object
+	// functions (lo_create, lo_open, loread, lowrite, etc.) in the current
+	// transaction.
+	LargeObjects() (*largeobject.Manager, error)
+
+	// ResolveTextSearchConfig returns the user-defined text search
+	// configuration with the given, possibly schema-qualified, name. Built-in
+	// configurations are resolved without the Planner, using the tsearch
+	// package.
+	ResolveTextSearchConfig(ctx context.Context, name string) (*tsearch.Config, error)
+
 	// PLpgSQLFetchCursor returns the next row from the cursor with the given
 	// name, if any. It returns nil if no such row exists. Used to implement the
 	// PLpgSQL FETCH statement.
@@ -15,6 +15,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/buildutil"
+	"github.com/lib/pq/oid"
 )
 
 type unsupportedTypeChecker struct {
@@ -65,8 +66,14 @@ func (tc *unsupportedTypeChecker) CheckType(ctx context.Context, typ *types.T) e
 			"%s not supported until version 25.4", typ.String(),
 		)
 	}
+	if (typ.Oid() == oid.T_xml || typ.Oid() == oid.T__xml) &&
+		!tc.version.IsActive(ctx, clusterversion.V26_2_XMLType) {
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"%s not supported until version 26.2", typ.String(),
+		)
+	}
 	if buildutil.CrdbTestBuild {
-		latestTypeFamily := types.LTreeFamily
+		latestTypeFamily := types.XMLFamily
 		if typ.Family() > latestTypeFamily && typ.Family() != types.AnyFamily {
 			panic("mark the new type as unsupported above for previous versions and advance the latest type family")
 		}
@@ -29,11 +29,19 @@ func (t T) HasLinearOrdering() bool {
 	return t == FORWARD
 }
 
+// IsSummary is true if this index type does not contain entries for the rows
+// of the table, but summaries of blocks of rows of the primary index. Such
+// indexes cannot be scanned to produce rows; they can only be used to narrow
+// down scans of the primary index.
+func (t T) IsSummary() bool {
+	return t == BRIN
+}
+
 // HasScannablePrefix is true if compound indexes of this type can be used with
 // inequality constraints. For example, a VECTOR index's prefix can only be used
 // with equality constraints and so returns false.
 func (t T) HasScannablePrefix() bool {
-	return t != VECTOR
+	return t != VECTOR && t != BRIN
 }
 
 // AllowsPrefixColumns is true if this index type allows other columns from the
@@ -76,6 +84,8 @@ func ErrorText(t T) redact.SafeString {
 		return "an inverted index"
 	case VECTOR:
 		return "a vector index"
+	case BRIN:
+		return "a BRIN index"
 	default:
 		return "an index"
 	}
@@ -53,33 +53,35 @@ type AlterTableCmd interface {
 	alterTableCmd()
 }
 
-func (*AlterTableAddColumn) alterTableCmd()          {}
-func (*AlterTableAddConstraint) alterTableCmd()      {}
-func (*AlterTableAlterColumnType) alterTableCmd()    {}
-func (*AlterTableAlterPrimaryKey) alterTableCmd()    {}
-func (*AlterTableDropColumn) alterTableCmd()         {}
-func (*AlterTableDropConstraint) alterTableCmd()     {}
-func (*AlterTableDropNotNull) alterTableCmd()        {}
-func (*AlterTableDropStored) alterTableCmd()         {}
-func (*AlterTableSetNotNull) alterTableCmd()         {}
-func (*AlterTableRenameColumn) alterTableCmd()       {}
-func (*AlterTableRenameConstraint) alterTableCmd()   {}
-func (*AlterTableSetAudit) alterTableCmd()           {}
-func (*AlterTableSetDefault) alterTableCmd()         {}
-func (*AlterTableSetOnUpdate) alterTableCmd()        {}
-func (*AlterTableSetVisible) alterTableCmd()         {}
-func (*AlterTableValidateConstraint) alterTableCmd() {}
-func (*AlterTablePartitionByTable) alterTableCmd()   {}
-func (*AlterTableInjectStats) alterTableCmd()        {}
-func (*AlterTablePushStats) alterTableCmd()          {}
-func (*AlterTableSetStorageParams) alterTableCmd()   {}
-func (*AlterTableResetStorageParams) alterTableCmd() {}
-func (*AlterTableAddIdentity) alterTableCmd()        {}
-func (*AlterTableSetIdentity) alterTableCmd()        {}
-func (*AlterTableIdentity) alterTableCmd()           {}
-func (*AlterTableDropIdentity) alterTableCmd()       {}
-func (*AlterTableSetRLSMode) alterTableCmd()         {}
-func (*AlterTableSetTrigger) alterTableCmd()         {}
+func (*AlterTableAddColumn) alterTableCmd()           {}
+func (*AlterTableAddConstraint) alterTableCmd()       {}
+func (*AlterTableAlterColumnType) alterTableCmd()     {}
+func (*AlterTableAlterPrimaryKey) alterTableCmd()     {}
+func (*AlterTableDropColumn) alterTableCmd()          {}
+func (*AlterTableDropConstraint) alterTableCmd()      {}
+func (*AlterTableDropNotNull) alterTableCmd()         {}
+func (*AlterTableDropStored) alterTableCmd()          {}
+func (*AlterTableSetNotNull) alterTableCmd()          {}
+func (*AlterTableRenameColumn) alterTableCmd()        {}
+func (*AlterTableRenameConstraint) alterTableCmd()    {}
+func (*AlterTableSetAudit) alterTableCmd()            {}
+func (*AlterTableSetDefault) alterTableCmd()          {}
+func (*AlterTableSetOnUpdate) alterTableCmd()         {}
+func (*AlterTableSetVisible) alterTableCmd()          {}
+func (*AlterTableSetMaskingPolicy) alterTableCmd()    {}
+func (*AlterTableRotateEncryptionKey) alterTableCmd() {}
+func (*AlterTableValidateConstraint) alterTableCmd()  {}
+func (*AlterTablePartitionByTable) alterTableCmd()    {}
+func (*AlterTableInjectStats) alterTableCmd()         {}
+func (*AlterTablePushStats) alterTableCmd()           {}
+func (*AlterTableSetStorageParams) alterTableCmd()    {}
+func (*AlterTableResetStorageParams) alterTableCmd()  {}
+func (*AlterTableAddIdentity) alterTableCmd()         {}
+func (*AlterTableSetIdentity) alterTableCmd()         {}
+func (*AlterTableIdentity) alterTableCmd()            {}
+func (*AlterTableDropIdentity) alterTableCmd()        {}
+func (*AlterTableSetRLSMode) alterTableCmd()          {}
+func (*AlterTableSetTrigger) alterTableCmd()          {}
 
 var _ AlterTableCmd = &AlterTableAddColumn{}
 var _ AlterTableCmd = &AlterTableAddConstraint{}
@@ -95,6 +97,8 @@ var _ AlterTableCmd = &AlterTableSetAudit{}
 var _ AlterTableCmd = &AlterTableSetDefault{}
 var _ AlterTableCmd = &AlterTableSetOnUpdate{}
 var _ AlterTableCmd = &AlterTableSetVisible{}
+var _ AlterTableCmd = &AlterTableSetMaskingPolicy{}
+var _ AlterTableCmd = &AlterTableRotateEncryptionKey{}
 var _ AlterTableCmd = &AlterTableValidateConstraint{}
 var _ AlterTableCmd = &AlterTablePartitionByTable{}
 var _ AlterTableCmd = &AlterTableInjectStats{}
@@ -479,6 +483,70 @@ func (node *AlterTableSetVisible) Format(ctx *FmtCtx) {
 	ctx.WriteString("VISIBLE")
 }
 
+// AlterTableSetMaskingPolicy represents an ALTER COLUMN SET MASKING POLICY or
+// DROP MASKING POLICY command.
+type AlterTableSetMaskingPolicy struct {
+	Column Name
+	// Func is the masking function. It is nil for DROP MASKING POLICY.
+	Func  *UnresolvedName
+	Roles RoleSpecList
+}
+
+// GetColumn implements the ColumnMutationCmd interface.
+func (node *AlterTableSetMaskingPolicy) GetColumn() Name {
+	return node.Column
+}
+
+// TelemetryName implements the AlterTableCmd interface.
+func (node *AlterTableSetMaskingPolicy) TelemetryName() string {
+	return "set_masking_policy"
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTableSetMaskingPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString(" ALTER COLUMN ")
+	ctx.FormatNode(&node.Column)
+	if node.Func == nil {
+		ctx.WriteString(" DROP MASKING POLICY")
+		return
+	}
+	ctx.WriteString(" SET MASKING POLICY ")
+	ctx.FormatNode(node.Func)
+	ctx.WriteString(" FOR ROLES (")
+	ctx.FormatNode(&node.Roles)
+	ctx.WriteByte(')')
+}
+
+// AlterTableRotateEncryptionKey represents an ALTER COLUMN ROTATE ENCRYPTION
+// KEY command.
+type AlterTableRotateEncryptionKey struct {
+	Column Name
+	// KMSURI is the URI of the KMS master key that wraps the new data key. If
+	// nil, the column's current KMS URI is used.
+	KMSURI Expr
+}
+
+// GetColumn implements the ColumnMutationCmd interface.
+func (node *AlterTableRotateEncryptionKey) GetColumn() Name {
+	return node.Column
+}
+
+// TelemetryName implements the AlterTableCmd interface.
+func (node *AlterTableRotateEncryptionKey) TelemetryName() string {
+	return "rotate_encryption_key"
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTableRotateEncryptionKey) Format(ctx *FmtCtx) {
+	ctx.WriteString(" ALTER COLUMN ")
+	ctx.FormatNode(&node.Column)
+	ctx.WriteString(" ROTATE ENCRYPTION KEY")
+	if node.KMSURI != nil {
+		ctx.WriteString(" TO ")
+		ctx.FormatURI(node.KMSURI)
+	}
+}
+
 // AlterTableSetNotNull represents an ALTER COLUMN SET NOT NULL
 // command.
 type AlterTableSetNotNull struct {
@@ -0,0 +1,157 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/cast"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treebin"
+)
+
+// CreateCast represents a CREATE CAST statement.
+type CreateCast struct {
+	SourceType ResolvableTypeReference
+	TargetType ResolvableTypeReference
+	// Function is the function that performs the cast.
+	Function RoutineObj
+	// Context is the context in which the cast may be applied; it is
+	// cast.ContextExplicit unless AS ASSIGNMENT or AS IMPLICIT is specified.
+	Context cast.Context
+}
+
+var _ Statement = &CreateCast{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateCast) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE CAST (")
+	ctx.FormatTypeReference(node.SourceType)
+	ctx.WriteString(" AS ")
+	ctx.FormatTypeReference(node.TargetType)
+	ctx.WriteString(") WITH FUNCTION ")
+	ctx.FormatNode(&node.Function)
+	switch node.Context {
+	case cast.ContextAssignment:
+		ctx.WriteString(" AS ASSIGNMENT")
+	case cast.ContextImplicit:
+		ctx.WriteString(" AS IMPLICIT")
+	}
+}
+
+// DropCast represents a DROP CAST statement.
+type DropCast struct {
+	SourceType   ResolvableTypeReference
+	TargetType   ResolvableTypeReference
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropCast{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropCast) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP CAST ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.WriteByte('(')
+	ctx.FormatTypeReference(node.SourceType)
+	ctx.WriteString(" AS ")
+	ctx.FormatTypeReference(node.TargetType)
+	ctx.WriteByte(')')
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
+
+// OperatorOption is an option of a CREATE OPERATOR statement, such as
+// LEFTARG = INT8 or FUNCTION = f. Value is nil for options that are flags.
+type OperatorOption struct {
+	Name  Name
+	Value ResolvableTypeReference
+}
+
+// OperatorOptions is a list of CREATE OPERATOR options.
+type OperatorOptions []OperatorOption
+
+// Format implements the NodeFormatter interface.
+func (node OperatorOptions) Format(ctx *FmtCtx) {
+	for i := range node {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		// Option names are keywords, so they are not anonymized.
+		ctx.WriteString(strings.ToUpper(string(node[i].Name)))
+		if node[i].Value != nil {
+			ctx.WriteString(" = ")
+			ctx.FormatTypeReference(node[i].Value)
+		}
+	}
+}
+
+// CreateOperator represents a CREATE OPERATOR statement. Only binary
+// operators are supported.
+type CreateOperator struct {
+	Operator treebin.BinaryOperator
+	Options  OperatorOptions
+}
+
+var _ Statement = &CreateOperator{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateOperator) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE OPERATOR ")
+	ctx.WriteString(node.Operator.String())
+	ctx.WriteString(" (")
+	ctx.FormatNode(node.Options)
+	ctx.WriteByte(')')
+}
+
+// OperatorWithArgs identifies a binary operator by its name and the types of
+// its operands.
+type OperatorWithArgs struct {
+	Operator  treebin.BinaryOperator
+	LeftType  ResolvableTypeReference
+	RightType ResolvableTypeReference
+}
+
+// Format implements the NodeFormatter interface.
+func (node *OperatorWithArgs) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Operator.String())
+	ctx.WriteString(" (")
+	ctx.FormatTypeReference(node.LeftType)
+	ctx.WriteString(", ")
+	ctx.FormatTypeReference(node.RightType)
+	ctx.WriteByte(')')
+}
+
+// DropOperator represents a DROP OPERATOR statement.
+type DropOperator struct {
+	Operators    []OperatorWithArgs
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropOperator{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropOperator) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP OPERATOR ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	for i := range node.Operators {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(&node.Operators[i])
+	}
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// CreateCollation represents a CREATE COLLATION statement.
+type CreateCollation struct {
+	IfNotExists bool
+	Name        *UnresolvedObjectName
+	// Params contains the PROVIDER, LOCALE and DETERMINISTIC options of the
+	// collation. It is empty if the collation is copied with FROM.
+	Params StorageParams
+	// From is the name of the collation to copy, if any.
+	From Name
+}
+
+var _ Statement = &CreateCollation{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateCollation) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE COLLATION ")
+	if node.IfNotExists {
+		ctx.WriteString("IF NOT EXISTS ")
+	}
+	ctx.FormatNode(node.Name)
+	if node.From != "" {
+		ctx.WriteString(" FROM ")
+		ctx.FormatNode(&node.From)
+		return
+	}
+	ctx.WriteString(" (")
+	ctx.FormatNode(&node.Params)
+	ctx.WriteByte(')')
+}
+
+// DropCollation represents a DROP COLLATION statement.
+type DropCollation struct {
+	Names        []*UnresolvedObjectName
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropCollation{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropCollation) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP COLLATION ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	for i := range node.Names {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(node.Names[i])
+	}
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
@@ -548,6 +548,7 @@ var (
 		types.TSVector,
 		types.VarBit,
 		types.LTree,
+		types.XML,
 		types.AnyEnum,
 		types.AnyEnumArray,
 		types.INetArray,
@@ -393,6 +393,13 @@ func mustParseDLTree(t *testing.T, s string) tree.Datum {
 	}
 	return d
 }
+func mustParseDXML(t *testing.T, s string) tree.Datum {
+	d, err := tree.ParseDXML(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
 func mustParseDArrayOfType(typ *types.T) func(t *testing.T, s string) tree.Datum {
 	return func(t *testing.T, s string) tree.Datum {
 		evalContext := eval.MakeTestingEvalContext(cluster.MakeTestingClusterSettings())
@@ -430,6 +437,7 @@ var parseFuncs = map[*types.T]func(*testing.T, string) tree.Datum{
 	types.TSQuery:          mustParseDTSQuery,
 	types.TSVector:         mustParseDTSVector,
 	types.LTree:            mustParseDLTree,
+	types.XML:              mustParseDXML,
 	types.BytesArray:       mustParseDArrayOfType(types.Bytes),
 	types.DecimalArray:     mustParseDArrayOfType(types.Decimal),
 	types.FloatArray:       mustParseDArrayOfType(types.Float),
@@ -472,37 +480,37 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 		{
 			c: tree.NewStrVal("abc 世界"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.TSVector,
-				types.RefCursor),
+				types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal("abc 世界   "),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.TSVector,
-				types.RefCursor),
+				types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal("true"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Bool, types.Jsonb,
-				types.TSVector, types.TSQuery, types.RefCursor, types.LTree),
+				types.TSVector, types.TSQuery, types.RefCursor, types.LTree, types.XML),
 		},
 		{
 			c: tree.NewStrVal("2010-09-28"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Date,
-				types.Timestamp, types.TimestampTZ, types.TSVector, types.TSQuery, types.RefCursor, types.LTree),
+				types.Timestamp, types.TimestampTZ, types.TSVector, types.TSQuery, types.RefCursor, types.LTree, types.XML),
 		},
 		{
 			c: tree.NewStrVal("2010-09-28 12:00:00.1"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Time, types.TimeTZ,
-				types.Timestamp, types.TimestampTZ, types.Date, types.RefCursor),
+				types.Timestamp, types.TimestampTZ, types.Date, types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal("2006-07-08T00:00:00.000000123Z"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Time, types.TimeTZ,
-				types.Timestamp, types.TimestampTZ, types.Date, types.RefCursor),
+				types.Timestamp, types.TimestampTZ, types.Date, types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal("PT12H2M"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Interval,
-				types.TSVector, types.TSQuery, types.RefCursor, types.LTree),
+				types.TSVector, types.TSQuery, types.RefCursor, types.LTree, types.XML),
 		},
 		{
 			c:            tree.NewBytesStrVal("abc 世界"),
@@ -527,17 +535,17 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 		{
 			c: tree.NewStrVal("box(0 0, 1 1)"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Box2D,
-				types.TSVector, types.RefCursor),
+				types.TSVector, types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal("POINT(-100.59 42.94)"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Geography,
-				types.Geometry, types.TSVector, types.RefCursor),
+				types.Geometry, types.TSVector, types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal("192.168.100.128/25"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.INet,
-				types.TSVector, types.TSQuery, types.RefCursor),
+				types.TSVector, types.TSQuery, types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal("111000110101"),
@@ -555,17 +563,18 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 				types.TSQuery,
 				types.RefCursor,
 				types.LTree,
+				types.XML,
 			),
 		},
 		{
 			c: tree.NewStrVal("A/1"),
 			parseOptions: typeSet(types.String, types.BPChar, types.PGLSN, types.Bytes,
-				types.TSQuery, types.TSVector, types.RefCursor),
+				types.TSQuery, types.TSVector, types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal(`{"a": 1}`),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Jsonb,
-				types.RefCursor),
+				types.RefCursor, types.XML),
 		},
 		{
 			c: tree.NewStrVal(`{1,2}`),
@@ -583,6 +592,7 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 				types.TSQuery,
 				types.RefCursor,
 				types.RefCursorArray,
+				types.XML,
 			),
 		},
 		{
@@ -600,6 +610,7 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 				types.TSQuery,
 				types.RefCursor,
 				types.RefCursorArray,
+				types.XML,
 			),
 		},
 		{
@@ -614,6 +625,7 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 				types.TSQuery,
 				types.RefCursor,
 				types.RefCursorArray,
+				types.XML,
 			),
 		},
 		{
@@ -623,31 +635,31 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 		{
 			c: tree.NewStrVal(`18e7b17e-4ead-4e27-bfd5-bb6d11261bb6`),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.Uuid,
-				types.TSVector, types.TSQuery, types.RefCursor, types.LTree),
+				types.TSVector, types.TSQuery, types.RefCursor, types.LTree, types.XML),
 		},
 		{
 			c: tree.NewStrVal(`{18e7b17e-4ead-4e27-bfd5-bb6d11261bb6, 18e7b17e-4ead-4e27-bfd5-bb6d11261bb7}`),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.BytesArray,
 				types.StringArray, types.UUIDArray, types.TSVector, types.RefCursor,
-				types.RefCursorArray),
+				types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{true, false}"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.BytesArray,
 				types.StringArray, types.BoolArray, types.TSVector, types.RefCursor,
-				types.RefCursorArray),
+				types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{2010-09-28, 2010-09-29}"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.BytesArray,
 				types.StringArray, types.DateArray, types.TimestampArray, types.TimestampTZArray,
-				types.TSVector, types.RefCursor, types.RefCursorArray),
+				types.TSVector, types.RefCursor, types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{1A/1,2/2A}"),
 			parseOptions: typeSet(types.String, types.BPChar, types.PGLSNArray, types.Bytes,
 				types.BytesArray, types.StringArray, types.TSQuery, types.TSVector, types.RefCursor,
-				types.RefCursorArray),
+				types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{2010-09-28 12:00:00.1, 2010-09-29 12:00:00.1}"),
@@ -663,7 +675,7 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 				types.TimestampTZArray,
 				types.DateArray,
 				types.RefCursor,
-				types.RefCursorArray),
+				types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{2006-07-08T00:00:00.000000123Z, 2006-07-10T00:00:00.000000123Z}"),
@@ -679,17 +691,17 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 				types.TimestampTZArray,
 				types.DateArray,
 				types.RefCursor,
-				types.RefCursorArray),
+				types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{PT12H2M, -23:00:00}"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.BytesArray,
-				types.StringArray, types.IntervalArray, types.RefCursor, types.RefCursorArray),
+				types.StringArray, types.IntervalArray, types.RefCursor, types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{192.168.100.128, ::ffff:10.4.3.2}"),
 			parseOptions: typeSet(types.String, types.BPChar, types.Bytes, types.BytesArray,
-				types.StringArray, types.INetArray, types.RefCursor, types.RefCursorArray),
+				types.StringArray, types.INetArray, types.RefCursor, types.RefCursorArray, types.XML),
 		},
 		{
 			c: tree.NewStrVal("{0101, 11}"),
@@ -707,6 +719,7 @@ func TestStringConstantResolveAvailableTypes(t *testing.T) {
 				types.TSVector,
 				types.RefCursor,
 				types.RefCursorArray,
+				types.XML,
 			),
 		},
 	}
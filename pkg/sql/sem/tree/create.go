@@ -29,6 +29,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/pretty"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
+	"github.com/lib/pq/oid"
 	"golang.org/x/text/language"
 )
 
@@ -50,6 +51,27 @@ type CreateDatabase struct {
 	SecondaryRegion Name
 }
 
+// DefaultCollation returns the locale of the collation given to string
+// columns created in the database, as specified by LC_COLLATE. It returns the
+// empty string if the database uses the default collation.
+func (node *CreateDatabase) DefaultCollation() (string, error) {
+	locale := node.Collate
+	// Postgres locale names may carry an encoding suffix, as in de_DE.UTF-8.
+	// Strings are always UTF-8 encoded, so the suffix can be dropped.
+	if i := strings.IndexByte(locale, '.'); i >= 0 {
+		if enc := locale[i+1:]; strings.EqualFold(enc, "UTF-8") || strings.EqualFold(enc, "UTF8") {
+			locale = locale[:i]
+		}
+	}
+	if locale == "" || collatedstring.IsDefaultEquivalentCollation(locale) {
+		return "", nil
+	}
+	if _, err := language.Parse(locale); err != nil {
+		return "", pgerror.Wrapf(err, pgcode.InvalidParameterValue, "invalid locale %s", locale)
+	}
+	return locale, nil
+}
+
 // Format implements the NodeFormatter interface.
 func (node *CreateDatabase) Format(ctx *FmtCtx) {
 	ctx.WriteString("CREATE DATABASE ")
@@ -74,10 +96,8 @@ func (node *CreateDatabase) Format(ctx *FmtCtx) {
 		lexbase.EncodeSQLStringWithFlags(&ctx.Buffer, node.Encoding, ctx.flags.EncodeFlags())
 	}
 	if node.Collate != "" {
-		// NB: the collation is not currently edited out under FmtAnonymize,
-		// because we don't support custom collations. If/when custom
-		// collations are supported, this should call ctx.FormatNode
-		// on the collation expr.
+		// NB: the collation is not edited out under FmtAnonymize, like the
+		// locale of a COLLATE clause.
 		ctx.WriteString(" LC_COLLATE = ")
 		lexbase.EncodeSQLStringWithFlags(&ctx.Buffer, node.Collate, ctx.flags.EncodeFlags())
 	}
@@ -276,6 +296,10 @@ func (node *CreateIndex) Format(ctx *FmtCtx) {
 	}
 	ctx.WriteString("ON ")
 	ctx.FormatNode(&node.Table)
+	if node.Type == idxtype.BRIN {
+		// BRIN indexes have no dedicated keyword.
+		ctx.WriteString(" USING brin")
+	}
 
 	ctx.WriteString(" (")
 	ctx.FormatNode(&node.Columns)
@@ -480,7 +504,11 @@ type ColumnTableDef struct {
 	IsSerial bool
 	// IsCreateAs is set to true if the Type is resolved after parsing.
 	// CREATE AS statements must not display column types during formatting.
-	IsCreateAs        bool
+	IsCreateAs bool
+	// HasCollation is set to true if the column definition has a COLLATE
+	// clause, in which case the default collation of the database does not
+	// apply to it.
+	HasCollation      bool
 	GeneratedIdentity struct {
 		IsGeneratedAsIdentity   bool
 		GeneratedAsIdentityType GeneratedIdentityType
@@ -528,6 +556,12 @@ type ColumnTableDef struct {
 		Create      bool
 		IfNotExists bool
 	}
+	Encryption struct {
+		// KMSURI is the URI of the KMS master key that wraps the column's data
+		// key. It is nil if the column is not encrypted.
+		KMSURI Expr
+		Roles  RoleSpecList
+	}
 }
 
 // ColumnTableDefCheckExpr represents a check constraint on a column definition
@@ -581,16 +615,15 @@ func NewColumnTableDef(
 	for _, c := range qualifications {
 		switch t := c.Qualification.(type) {
 		case ColumnCollation:
+			d.HasCollation = true
 			locale := string(t)
 			// In postgres, all strings have collations defaulting to "default".
 			// In CRDB, collated strings are treated separately to string family types.
 			// To most behave like postgres, set the CollatedString type if a non-"default"
 			// collation is used.
+			// The locale may also name a user-defined collation, so it is only
+			// validated once the column type is resolved.
 			if locale != collatedstring.DefaultCollationTag {
-				_, err := language.Parse(locale)
-				if err != nil {
-					return nil, pgerror.Wrapf(err, pgcode.Syntax, "invalid locale %s", locale)
-				}
 				collatedTyp, err := processCollationOnType(name, d.Type, t)
 				if err != nil {
 					return nil, err
@@ -716,6 +749,13 @@ func NewColumnTableDef(
 			d.Family.Name = t.Family
 			d.Family.Create = t.Create
 			d.Family.IfNotExists = t.IfNotExists
+		case *ColumnEncryptionDef:
+			if d.IsEncrypted() {
+				return nil, pgerror.Newf(pgcode.Syntax,
+					"multiple encryption keys specified for column %q", name)
+			}
+			d.Encryption.KMSURI = t.KMSURI
+			d.Encryption.Roles = t.Roles
 		default:
 			return nil, errors.AssertionFailedf("unexpected column qualification: %T", c)
 		}
@@ -754,6 +794,51 @@ func (node *ColumnTableDef) HasColumnFamily() bool {
 	return node.Family.Name != "" || node.Family.Create
 }
 
+// IsEncrypted returns if the ColumnTableDef is an encrypted column.
+func (node *ColumnTableDef) IsEncrypted() bool {
+	return node.Encryption.KMSURI != nil
+}
+
+// WithDatabaseCollation returns the column definition with its string type
+// collated with the given locale, which is the default collation of the
+// database the column is created in. The definition is returned unchanged if
+// the locale is empty, if it has its own COLLATE clause, if it is computed or
+// if its type is not a string type.
+func (node *ColumnTableDef) WithDatabaseCollation(locale string) *ColumnTableDef {
+	if locale == "" || node.HasCollation || node.IsCreateAs || node.IsComputed() {
+		return node
+	}
+	typ, ok := GetStaticallyKnownType(node.Type)
+	if !ok {
+		return node
+	}
+	collatedTyp := collateDefaultStringType(typ, locale)
+	if collatedTyp == nil {
+		return node
+	}
+	d := *node
+	d.Type = collatedTyp
+	return &d
+}
+
+// collateDefaultStringType returns the given type collated with the locale,
+// or nil if the type does not use the default collation of the database.
+// Like in Postgres, the "char" and NAME types always use the C collation.
+func collateDefaultStringType(typ *types.T, locale string) *types.T {
+	switch typ.Family() {
+	case types.StringFamily:
+		switch typ.Oid() {
+		case oid.T_text, oid.T_varchar, oid.T_bpchar:
+			return types.MakeCollatedString(typ, locale)
+		}
+	case types.ArrayFamily:
+		if elemTyp := collateDefaultStringType(typ.ArrayContents(), locale); elemTyp != nil {
+			return types.MakeArray(elemTyp)
+		}
+	}
+	return nil
+}
+
 // Format implements the NodeFormatter interface.
 func (node *ColumnTableDef) Format(ctx *FmtCtx) {
 	ctx.FormatNode(&node.Name)
@@ -893,6 +978,15 @@ func (node *ColumnTableDef) Format(ctx *FmtCtx) {
 			ctx.FormatNode(&node.Family.Name)
 		}
 	}
+	if node.IsEncrypted() {
+		ctx.WriteString(" ENCRYPTED WITH KEY ")
+		ctx.FormatURI(node.Encryption.KMSURI)
+		if len(node.Encryption.Roles) > 0 {
+			ctx.WriteString(" FOR ROLES (")
+			ctx.FormatNode(&node.Encryption.Roles)
+			ctx.WriteByte(')')
+		}
+	}
 }
 
 func (node *ColumnTableDef) formatColumnType(ctx *FmtCtx) {
@@ -950,6 +1044,7 @@ func (*ColumnFKConstraint) columnQualification()         {}
 func (*ColumnFamilyConstraint) columnQualification()     {}
 func (*GeneratedAlwaysAsIdentity) columnQualification()  {}
 func (*GeneratedByDefAsIdentity) columnQualification()   {}
+func (*ColumnEncryptionDef) columnQualification()        {}
 
 // ColumnCollation represents a COLLATE clause for a column.
 type ColumnCollation string
@@ -1027,6 +1122,12 @@ type ColumnFamilyConstraint struct {
 	IfNotExists bool
 }
 
+// ColumnEncryptionDef represents ENCRYPTED WITH KEY on a column.
+type ColumnEncryptionDef struct {
+	KMSURI Expr
+	Roles  RoleSpecList
+}
+
 // IndexTableDef represents an index definition within a CREATE TABLE
 // statement.
 type IndexTableDef struct {
@@ -1312,6 +1413,8 @@ const (
 	PartitionByList PartitionByType = "LIST"
 	// PartitionByRange indicates a PARTITION BY LIST clause.
 	PartitionByRange PartitionByType = "RANGE"
+	// PartitionByHash indicates a PARTITION BY HASH clause.
+	PartitionByHash PartitionByType = "HASH"
 )
 
 // PartitionByIndex represents a PARTITION BY definition within
@@ -1373,9 +1476,18 @@ func (node *PartitionByTable) ContainsPartitioningClause() bool {
 // structs for table and index definitions respectively.
 type PartitionBy struct {
 	Fields NameList
-	// Exactly one of List or Range is required to be non-empty.
+	// Exactly one of List or Range is required to be non-empty, unless
+	// HashPartitions is set.
 	List  []ListPartition
 	Range []RangePartition
+	// HashPartitions is the number of partitions of a PARTITION BY HASH, which
+	// spreads rows evenly across partitions according to the hash of Fields.
+	HashPartitions int32
+}
+
+// IsHash returns whether this is a PARTITION BY HASH clause.
+func (node *PartitionBy) IsHash() bool {
+	return node != nil && node.HashPartitions > 0
 }
 
 // Format implements the NodeFormatter interface.
@@ -1389,6 +1501,13 @@ func (node *PartitionBy) formatListOrRange(ctx *FmtCtx) {
 		ctx.WriteString(`NOTHING`)
 		return
 	}
+	if node.IsHash() {
+		ctx.WriteString(`HASH (`)
+		ctx.FormatNode(&node.Fields)
+		ctx.WriteString(`) PARTITIONS `)
+		ctx.WriteString(strconv.Itoa(int(node.HashPartitions)))
+		return
+	}
 	if len(node.List) > 0 {
 		ctx.WriteString(`LIST (`)
 	} else if len(node.Range) > 0 {
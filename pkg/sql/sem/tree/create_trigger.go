@@ -23,6 +23,10 @@ type CreateTrigger struct {
 	// TODO(#128536): Pass this information through `memo.CreateTriggerExpr`
 	// instead.
 	FuncBody string
+
+	// FuncBuiltin is the name of the builtin trigger function called by the
+	// trigger, if any. Like FuncBody, it is set when the statement is built.
+	FuncBuiltin string
 }
 
 var _ Statement = &CreateTrigger{}
@@ -50,6 +50,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/uint128"
 	"github.com/cockroachdb/cockroach/pkg/util/uuid"
 	"github.com/cockroachdb/cockroach/pkg/util/vector"
+	"github.com/cockroachdb/cockroach/pkg/util/xml"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
 	"github.com/lib/pq/oid"
@@ -3877,7 +3878,7 @@ func AsJSON(
 		// This is RFC3339Nano, but without the TZ fields.
 		return json.FromString(formatTime(t.UTC(), "2006-01-02T15:04:05.999999999")), nil
 	case *DDate, *DUuid, *DOid, *DInterval, *DBytes, *DIPAddr, *DTime, *DTimeTZ, *DBitArray, *DBox2D,
-		*DTSVector, *DTSQuery, *DPGLSN, *DPGVector, *DJsonpath, *DLTree:
+		*DTSVector, *DTSQuery, *DPGLSN, *DPGVector, *DJsonpath, *DLTree, *DXML:
 		return json.FromString(
 			AsStringWithFlags(t, FmtBareStrings, FmtDataConversionConfig(dcc), FmtLocation(loc)),
 		), nil
@@ -4302,6 +4303,105 @@ func MustBeDLTree(e Expr) *DLTree {
 	return b
 }
 
+// DXML is the XML Datum. It holds the text of a well-formed XML document or
+// content fragment, which is parsed again whenever the value is inspected.
+type DXML struct {
+	Contents string
+}
+
+// NewDXML returns a DXML from a string that is known to be well-formed XML
+// content.
+func NewDXML(s string) *DXML {
+	return &DXML{Contents: s}
+}
+
+// ParseDXML parses a string representation of an XML value, which must be
+// well-formed XML content.
+func ParseDXML(s string) (Datum, error) {
+	if err := xml.Validate(s, xml.Content); err != nil {
+		return nil, err
+	}
+	return NewDXML(s), nil
+}
+
+// ResolvedType implements the TypedExpr interface.
+func (*DXML) ResolvedType() *types.T {
+	return types.XML
+}
+
+// Compare implements the Datum interface. Like Postgres, we don't support
+// comparisons between XML values in SQL, but Compare is needed internally,
+// for example for DISTINCT on arrays of XML.
+func (d *DXML) Compare(ctx context.Context, cmpCtx CompareContext, other Datum) (int, error) {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1, nil
+	}
+	v, ok := cmpCtx.UnwrapDatum(ctx, other).(*DXML)
+	if !ok {
+		return 0, makeUnsupportedComparisonMessage(d, other)
+	}
+	return strings.Compare(d.Contents, v.Contents), nil
+}
+
+// Prev implements the Datum interface.
+func (d *DXML) Prev(ctx context.Context, cmpCtx CompareContext) (Datum, bool) {
+	return nil, false
+}
+
+// Next implements the Datum interface.
+func (d *DXML) Next(ctx context.Context, cmpCtx CompareContext) (Datum, bool) {
+	return nil, false
+}
+
+// IsMax implements the Datum interface.
+func (*DXML) IsMax(ctx context.Context, cmpCtx CompareContext) bool {
+	return false
+}
+
+// IsMin implements the Datum interface.
+func (d *DXML) IsMin(ctx context.Context, cmpCtx CompareContext) bool {
+	return d.Contents == ""
+}
+
+// Min implements the Datum interface.
+func (d *DXML) Min(ctx context.Context, cmpCtx CompareContext) (Datum, bool) {
+	return NewDXML(""), true
+}
+
+// Max implements the Datum interface.
+func (d *DXML) Max(ctx context.Context, cmpCtx CompareContext) (Datum, bool) {
+	return nil, false
+}
+
+// AmbiguousFormat implements the Datum interface.
+func (*DXML) AmbiguousFormat() bool { return true }
+
+// Format implements the NodeFormatter interface.
+func (d *DXML) Format(ctx *FmtCtx) {
+	buf, f := &ctx.Buffer, ctx.flags
+	if f.HasFlags(fmtRawStrings) || f.HasFlags(fmtPgwireFormat) {
+		buf.WriteString(d.Contents)
+	} else {
+		lexbase.EncodeSQLStringWithFlags(buf, d.Contents, f.EncodeFlags())
+	}
+}
+
+// Size implements the Datum interface.
+func (d *DXML) Size() uintptr {
+	return unsafe.Sizeof(*d) + uintptr(len(d.Contents))
+}
+
+// MustBeDXML attempts to retrieve a DXML from an Expr, panicking if the
+// assertion fails.
+func MustBeDXML(e Expr) *DXML {
+	x, ok := e.(*DXML)
+	if !ok {
+		panic(errors.AssertionFailedf("expected *DXML, found %T", e))
+	}
+	return x
+}
+
 // DTuple is the tuple Datum.
 type DTuple struct {
 	D Datums
@@ -6075,6 +6175,7 @@ var baseDatumTypeSizes = map[types.Family]struct {
 	types.OidFamily:            {unsafe.Sizeof(DOid{}.Oid), fixedSize},
 	types.EnumFamily:           {unsafe.Sizeof(DEnum{}), variableSize},
 	types.LTreeFamily:          {unsafe.Sizeof(DLTree{}), variableSize},
+	types.XMLFamily:            {unsafe.Sizeof(DXML{}), variableSize},
 
 	types.VoidFamily: {sz: unsafe.Sizeof(DVoid{}), variable: fixedSize},
 	// TODO(jordan,justin): This seems suspicious.
@@ -1262,6 +1262,13 @@ var BinOps = map[treebin.BinaryOperatorSymbol]*BinOpOverloads{
 			EvalOp:     &ConcatLTreeOp{},
 			Volatility: volatility.Immutable,
 		},
+		{
+			LeftType:   types.TSVector,
+			RightType:  types.TSVector,
+			ReturnType: types.TSVector,
+			EvalOp:     &ConcatTSVectorOp{},
+			Volatility: volatility.Immutable,
+		},
 	}},
 
 	// TODO(pmattis): Check that the shift is valid.
@@ -2250,6 +2257,6 @@ func EqCmpAllowedForEquivalentTypes(leftType, rightType *types.T) bool {
 		}
 	}
 	err := runValidations(treecmp.EQ, leftType, rightType,
-		[]types.Family{types.RefCursorFamily, types.JsonpathFamily})
+		[]types.Family{types.RefCursorFamily, types.JsonpathFamily, types.XMLFamily})
 	return err == nil
 }
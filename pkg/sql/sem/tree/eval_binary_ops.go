@@ -327,6 +327,8 @@ type (
 	ConcatVarBitOp struct{}
 	// ConcatLTreeOp is a BinaryEvalOp.
 	ConcatLTreeOp struct{}
+	// ConcatTSVectorOp is a BinaryEvalOp.
+	ConcatTSVectorOp struct{}
 )
 
 type (
@@ -67,6 +67,7 @@ type BinaryOpEvaluator interface {
 	EvalConcatLTreeOp(context.Context, *ConcatLTreeOp, Datum, Datum) (Datum, error)
 	EvalConcatOp(context.Context, *ConcatOp, Datum, Datum) (Datum, error)
 	EvalConcatStringOp(context.Context, *ConcatStringOp, Datum, Datum) (Datum, error)
+	EvalConcatTSVectorOp(context.Context, *ConcatTSVectorOp, Datum, Datum) (Datum, error)
 	EvalConcatVarBitOp(context.Context, *ConcatVarBitOp, Datum, Datum) (Datum, error)
 	EvalContainedByArrayOp(context.Context, *ContainedByArrayOp, Datum, Datum) (Datum, error)
 	EvalContainedByJsonbOp(context.Context, *ContainedByJsonbOp, Datum, Datum) (Datum, error)
@@ -342,6 +343,11 @@ func (op *ConcatStringOp) Eval(ctx context.Context, e OpEvaluator, a, b Datum) (
 	return e.EvalConcatStringOp(ctx, op, a, b)
 }
 
+// Eval is part of the BinaryEvalOp interface.
+func (op *ConcatTSVectorOp) Eval(ctx context.Context, e OpEvaluator, a, b Datum) (Datum, error) {
+	return e.EvalConcatTSVectorOp(ctx, op, a, b)
+}
+
 // Eval is part of the BinaryEvalOp interface.
 func (op *ConcatVarBitOp) Eval(ctx context.Context, e OpEvaluator, a, b Datum) (Datum, error) {
 	return e.EvalConcatVarBitOp(ctx, op, a, b)
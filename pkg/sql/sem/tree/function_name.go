@@ -13,6 +13,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
 	"github.com/lib/pq/oid"
@@ -55,6 +56,28 @@ type FunctionReferenceResolver interface {
 	) (*RoutineName, *Overload, error)
 }
 
+// CastAndOperatorResolver is implemented by FunctionReferenceResolvers that
+// can look up the user-defined casts and operators created with CREATE CAST
+// and CREATE OPERATOR. Both are evaluated by calling a user-defined function.
+type CastAndOperatorResolver interface {
+	// ResolveCast returns the OID of the function that implements the
+	// user-defined cast between the given types, or false if there is no such
+	// cast.
+	ResolveCast(ctx context.Context, source, target *types.T) (funcOID oid.Oid, found bool, _ error)
+
+	// ResolveOperators returns the user-defined binary operators with the
+	// given name in the schemas of the search path, in search path order.
+	ResolveOperators(ctx context.Context, name string) ([]UserDefinedOperator, error)
+}
+
+// UserDefinedOperator is a binary operator created with CREATE OPERATOR.
+type UserDefinedOperator struct {
+	LeftType  *types.T
+	RightType *types.T
+	// FuncOID is the OID of the function that evaluates the operator.
+	FuncOID oid.Oid
+}
+
 // ResolvableFunctionReference implements the editable reference call of a
 // FuncExpr.
 type ResolvableFunctionReference struct {
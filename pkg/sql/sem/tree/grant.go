@@ -18,10 +18,60 @@ import "github.com/cockroachdb/cockroach/pkg/sql/privilege"
 
 // Grant represents a GRANT statement.
 type Grant struct {
-	Privileges      privilege.List
-	Targets         GrantTargetList
-	Grantees        RoleSpecList
-	WithGrantOption bool
+	Privileges privilege.List
+	// ColumnPrivileges are the privileges granted on specific columns of the
+	// target tables, e.g. SELECT (a, b).
+	ColumnPrivileges ColumnPrivilegeList
+	Targets          GrantTargetList
+	Grantees         RoleSpecList
+	WithGrantOption  bool
+}
+
+// ColumnPrivilege represents a privilege on a list of columns in a GRANT or
+// REVOKE statement, e.g. SELECT (a, b).
+type ColumnPrivilege struct {
+	Privilege privilege.Kind
+	Columns   NameList
+}
+
+// ColumnPrivilegeList is a list of column privileges.
+type ColumnPrivilegeList []ColumnPrivilege
+
+// Format implements the NodeFormatter interface.
+func (l *ColumnPrivilegeList) Format(ctx *FmtCtx) {
+	for i := range *l {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		p := &(*l)[i]
+		ctx.WriteString(string(p.Privilege.DisplayName()))
+		ctx.WriteString(" (")
+		ctx.FormatNode(&p.Columns)
+		ctx.WriteByte(')')
+	}
+}
+
+// Privileges returns the privileges of the list, ignoring the columns.
+func (l ColumnPrivilegeList) Privileges() privilege.List {
+	privs := make(privilege.List, len(l))
+	for i := range l {
+		privs[i] = l[i].Privilege
+	}
+	return privs
+}
+
+// formatPrivileges formats the privileges of a GRANT or REVOKE statement.
+func formatPrivileges(ctx *FmtCtx, privs privilege.List, colPrivs *ColumnPrivilegeList) {
+	// NB: we cannot use FormatNode() for privs because it is not an AST
+	// node. This is OK, because a privilege list cannot contain sensitive
+	// information.
+	privs.FormatNames(&ctx.Buffer)
+	if len(*colPrivs) > 0 {
+		if len(privs) > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(colPrivs)
+	}
 }
 
 // GrantTargetList represents a list of targets.
@@ -111,7 +161,7 @@ func (node *Grant) Format(ctx *FmtCtx) {
 	if node.Targets.System {
 		ctx.WriteString(" SYSTEM ")
 	}
-	node.Privileges.FormatNames(&ctx.Buffer)
+	formatPrivileges(ctx, node.Privileges, &node.ColumnPrivileges)
 	if !node.Targets.System {
 		ctx.WriteString(" ON ")
 		ctx.FormatNode(&node.Targets)
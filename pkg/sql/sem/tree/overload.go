@@ -165,6 +165,19 @@ const (
 	OverloadPreferencePreferred   = OverloadPreference(1)
 )
 
+// BuiltinTriggerCall describes a trigger that calls a builtin trigger
+// function. See Overload.TriggerBody.
+type BuiltinTriggerCall struct {
+	ActionTime TriggerActionTime
+	ForEachRow bool
+	Events     TriggerEventTypeSet
+	// Args are the constant arguments of the trigger function.
+	Args []string
+	// TableTyp is the row type of the trigger's table, labeled with the names
+	// of its columns.
+	TableTyp *types.T
+}
+
 // Overload is one of the overloads of a built-in function.
 // Each FunctionDefinition may contain one or more overloads.
 type Overload struct {
@@ -213,6 +226,13 @@ type Overload struct {
 	// OnTypeCheck, if set, is called every time this overload is type checked.
 	OnTypeCheck func()
 
+	// TriggerBody, if set, marks a builtin trigger function. It validates the
+	// given trigger, and returns the PL/pgSQL body that implements the function
+	// for that trigger. It is called when the trigger is created, and again each
+	// time it is built, so that the columns named by its arguments are resolved
+	// against the current definition of the table.
+	TriggerBody func(call *BuiltinTriggerCall) (string, error)
+
 	// SpecializedVecBuiltin is used to let the vectorized engine
 	// know when an Overload has a specialized vectorized operator.
 	SpecializedVecBuiltin SpecializedVectorizedBuiltin
@@ -117,6 +117,8 @@ func ParseAndRequireString(
 		d, err = ParseDTSVector(s)
 	case types.LTreeFamily:
 		d, err = ParseDLTree(s)
+	case types.XMLFamily:
+		d, err = ParseDXML(s)
 	case types.TupleFamily:
 		d, dependsOnContext, err = ParseDTupleFromString(ctx, s, t)
 	case types.VoidFamily:
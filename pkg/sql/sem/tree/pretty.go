@@ -1516,6 +1516,8 @@ func (node *PartitionBy) doc(p *PrettyCfg) pretty.Doc {
 	//
 	// PARTITION BY RANGE (...)
 	//    ( ..values.. )
+	//
+	// PARTITION BY HASH (...) PARTITIONS n
 	return node.docInner(p, `PARTITION BY `)
 }
 
@@ -1523,6 +1525,14 @@ func (node *PartitionBy) docInner(p *PrettyCfg, kw string) pretty.Doc {
 	if node == nil {
 		return pretty.Keyword(kw + `NOTHING`)
 	}
+	if node.IsHash() {
+		return pretty.Fold(pretty.ConcatSpace,
+			pretty.Keyword(kw+`HASH`),
+			p.bracket("(", p.Doc(&node.Fields), ")"),
+			pretty.Keyword(`PARTITIONS`),
+			pretty.Text(strconv.Itoa(int(node.HashPartitions))),
+		)
+	}
 	if len(node.List) > 0 {
 		kw += `LIST`
 	} else if len(node.Range) > 0 {
@@ -1645,7 +1655,7 @@ func (node *ShardedIndexDef) doc(p *PrettyCfg) pretty.Doc {
 func (node *CreateIndex) doc(p *PrettyCfg) pretty.Doc {
 	// Final layout:
 	// CREATE [UNIQUE] [INVERTED | VECTOR] INDEX [name]
-	//    ON tbl (cols...)
+	//    ON tbl [USING brin] (cols...)
 	//    [STORING ( ... )]
 	//    [INTERLEAVE ...]
 	//    [PARTITION BY ...]
@@ -1676,10 +1686,12 @@ func (node *CreateIndex) doc(p *PrettyCfg) pretty.Doc {
 	}
 
 	clauses := make([]pretty.Doc, 0, 7)
-	clauses = append(clauses, pretty.Fold(pretty.ConcatSpace,
-		pretty.Keyword("ON"),
-		p.Doc(&node.Table),
-		p.bracket("(", p.Doc(&node.Columns), ")")))
+	on := []pretty.Doc{pretty.Keyword("ON"), p.Doc(&node.Table)}
+	if node.Type == idxtype.BRIN {
+		on = append(on, pretty.Keyword("USING"), pretty.Text("brin"))
+	}
+	on = append(on, p.bracket("(", p.Doc(&node.Columns), ")"))
+	clauses = append(clauses, pretty.Fold(pretty.ConcatSpace, on...))
 
 	if node.Sharded != nil {
 		clauses = append(clauses, p.Doc(node.Sharded))
@@ -2014,6 +2026,16 @@ func (node *ColumnTableDef) docRow(p *PrettyCfg) pretty.TableRow {
 		clauses = append(clauses, d)
 	}
 
+	// Column encryption.
+	if node.IsEncrypted() {
+		d := pretty.ConcatSpace(pretty.Keyword("ENCRYPTED WITH KEY"), p.Doc(node.Encryption.KMSURI))
+		if len(node.Encryption.Roles) > 0 {
+			d = pretty.ConcatSpace(d, pretty.ConcatSpace(pretty.Keyword("FOR ROLES"),
+				p.bracket("(", p.Doc(&node.Encryption.Roles), ")")))
+		}
+		clauses = append(clauses, d)
+	}
+
 	// DEFAULT constraint.
 	if node.HasDefaultExpr() {
 		clauses = append(clauses, p.maybePrependConstraintName(&node.DefaultExpr.ConstraintName,
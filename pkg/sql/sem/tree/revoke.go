@@ -19,10 +19,13 @@ import "github.com/cockroachdb/cockroach/pkg/sql/privilege"
 // Revoke represents a REVOKE statement.
 // PrivilegeList and TargetList are defined in grant.go
 type Revoke struct {
-	Privileges     privilege.List
-	Targets        GrantTargetList
-	Grantees       RoleSpecList
-	GrantOptionFor bool
+	Privileges privilege.List
+	// ColumnPrivileges are the privileges revoked on specific columns of the
+	// target tables, e.g. SELECT (a, b).
+	ColumnPrivileges ColumnPrivilegeList
+	Targets          GrantTargetList
+	Grantees         RoleSpecList
+	GrantOptionFor   bool
 }
 
 // Format implements the NodeFormatter interface.
@@ -31,10 +34,7 @@ func (node *Revoke) Format(ctx *FmtCtx) {
 	if node.Targets.System {
 		ctx.WriteString(" SYSTEM ")
 	}
-	// NB: we cannot use FormatNode() here because node.Privileges is
-	// not an AST node. This is OK, because a privilege list cannot
-	// contain sensitive information.
-	node.Privileges.FormatNames(&ctx.Buffer)
+	formatPrivileges(ctx, node.Privileges, &node.ColumnPrivileges)
 	if !node.Targets.System {
 		ctx.WriteString(" ON ")
 		ctx.FormatNode(&node.Targets)
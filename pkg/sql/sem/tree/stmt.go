@@ -88,9 +88,12 @@ const (
 )
 
 const (
+	AlterDatabaseTag       = "ALTER DATABASE"
+	AlterIndexTag          = "ALTER INDEX"
 	AlterSequenceTag       = "ALTER SEQUENCE"
 	AlterTableTag          = "ALTER TABLE"
 	AlterPolicyTag         = "ALTER POLICY"
+	AlterTypeTag           = "ALTER TYPE"
 	BackupTag              = "BACKUP"
 	CreateIndexTag         = "CREATE INDEX"
 	CreateFunctionTag      = "CREATE FUNCTION"
@@ -98,6 +101,9 @@ const (
 	CreateTriggerTag       = "CREATE TRIGGER"
 	CreateSchemaTag        = "CREATE SCHEMA"
 	CreateSequenceTag      = "CREATE SEQUENCE"
+	CreateTableTag         = "CREATE TABLE"
+	CreateTypeTag          = "CREATE TYPE"
+	CreateViewTag          = "CREATE VIEW"
 	CreateDatabaseTag      = "CREATE DATABASE"
 	CreatePolicyTag        = "CREATE POLICY"
 	CommentOnColumnTag     = "COMMENT ON COLUMN"
@@ -362,7 +368,7 @@ func (*AlterDatabaseSurvivalGoal) StatementReturnType() StatementReturnType { re
 func (*AlterDatabaseSurvivalGoal) StatementType() StatementType { return TypeDDL }
 
 // StatementTag returns a short string identifying the type of statement.
-func (*AlterDatabaseSurvivalGoal) StatementTag() string { return "ALTER DATABASE" }
+func (*AlterDatabaseSurvivalGoal) StatementTag() string { return AlterDatabaseTag }
 
 func (*AlterDatabaseSurvivalGoal) hiddenFromShowQueries() {}
 
@@ -373,7 +379,7 @@ func (*AlterDatabasePlacement) StatementReturnType() StatementReturnType { retur
 func (*AlterDatabasePlacement) StatementType() StatementType { return TypeDDL }
 
 // StatementTag returns a short string identifying the type of statement.
-func (*AlterDatabasePlacement) StatementTag() string { return "ALTER DATABASE" }
+func (*AlterDatabasePlacement) StatementTag() string { return AlterDatabaseTag }
 
 func (*AlterDatabasePlacement) hiddenFromShowQueries() {}
 
@@ -569,6 +575,24 @@ func (*AlterSchema) StatementTag() string { return "ALTER SCHEMA" }
 
 func (*AlterSchema) hiddenFromShowQueries() {}
 
+// StatementReturnType implements the Statement interface.
+func (*AlterTextSearchConfig) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*AlterTextSearchConfig) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*AlterTextSearchConfig) StatementTag() string { return "ALTER TEXT SEARCH CONFIGURATION" }
+
+// StatementReturnType implements the Statement interface.
+func (*AlterTextSearchDictionary) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*AlterTextSearchDictionary) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*AlterTextSearchDictionary) StatementTag() string { return "ALTER TEXT SEARCH DICTIONARY" }
+
 // StatementReturnType implements the Statement interface.
 func (*AlterTenantCapability) StatementReturnType() StatementReturnType { return Rows }
 
@@ -640,7 +664,7 @@ func (*AlterType) StatementReturnType() StatementReturnType { return DDL }
 func (*AlterType) StatementType() StatementType { return TypeDDL }
 
 // StatementTag implements the Statement interface.
-func (*AlterType) StatementTag() string { return "ALTER TYPE" }
+func (*AlterType) StatementTag() string { return AlterTypeTag }
 
 func (*AlterType) hiddenFromShowQueries() {}
 
@@ -1114,7 +1138,7 @@ func (n *CreateTable) StatementTag() string {
 	if n.As() {
 		return "CREATE TABLE AS"
 	}
-	return "CREATE TABLE"
+	return CreateTableTag
 }
 
 // StatementReturnType implements the Statement interface.
@@ -1124,7 +1148,43 @@ func (*CreateType) StatementReturnType() StatementReturnType { return DDL }
 func (*CreateType) StatementType() StatementType { return TypeDDL }
 
 // StatementTag implements the Statement interface.
-func (*CreateType) StatementTag() string { return "CREATE TYPE" }
+func (*CreateType) StatementTag() string { return CreateTypeTag }
+
+// StatementReturnType implements the Statement interface.
+func (*CreateCast) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CreateCast) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*CreateCast) StatementTag() string { return "CREATE CAST" }
+
+// StatementReturnType implements the Statement interface.
+func (*CreateOperator) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CreateOperator) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*CreateOperator) StatementTag() string { return "CREATE OPERATOR" }
+
+// StatementReturnType implements the Statement interface.
+func (*CreateCollation) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CreateCollation) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*CreateCollation) StatementTag() string { return "CREATE COLLATION" }
+
+// StatementReturnType implements the Statement interface.
+func (*CreateTextSearch) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CreateTextSearch) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (n *CreateTextSearch) StatementTag() string { return "CREATE TEXT SEARCH " + n.Type.String() }
 
 // StatementReturnType implements the Statement interface.
 func (*CreateRole) StatementReturnType() StatementReturnType { return DDL }
@@ -1144,7 +1204,7 @@ func (*CreateView) StatementReturnType() StatementReturnType { return DDL }
 func (*CreateView) StatementType() StatementType { return TypeDDL }
 
 // StatementTag returns a short string identifying the type of statement.
-func (*CreateView) StatementTag() string { return "CREATE VIEW" }
+func (*CreateView) StatementTag() string { return CreateViewTag }
 
 // StatementReturnType implements the Statement interface.
 func (*CreateSequence) StatementReturnType() StatementReturnType { return DDL }
@@ -1288,6 +1348,42 @@ func (*DropType) StatementType() StatementType { return TypeDDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*DropType) StatementTag() string { return DropTypeTag }
 
+// StatementReturnType implements the Statement interface.
+func (*DropCast) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*DropCast) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*DropCast) StatementTag() string { return "DROP CAST" }
+
+// StatementReturnType implements the Statement interface.
+func (*DropOperator) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*DropOperator) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*DropOperator) StatementTag() string { return "DROP OPERATOR" }
+
+// StatementReturnType implements the Statement interface.
+func (*DropCollation) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*DropCollation) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropCollation) StatementTag() string { return "DROP COLLATION" }
+
+// StatementReturnType implements the Statement interface.
+func (*DropTextSearch) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*DropTextSearch) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (n *DropTextSearch) StatementTag() string { return "DROP TEXT SEARCH " + n.Type.String() }
+
 // StatementReturnType implements the Statement interface.
 func (*DropSchema) StatementReturnType() StatementReturnType { return DDL }
 
@@ -1512,7 +1608,7 @@ func (*RenameDatabase) StatementReturnType() StatementReturnType { return DDL }
 func (*RenameDatabase) StatementType() StatementType { return TypeDDL }
 
 // StatementTag returns a short string identifying the type of statement.
-func (*RenameDatabase) StatementTag() string { return "ALTER DATABASE" }
+func (*RenameDatabase) StatementTag() string { return AlterDatabaseTag }
 
 // StatementReturnType implements the Statement interface.
 func (*ReparentDatabase) StatementReturnType() StatementReturnType { return DDL }
@@ -1530,7 +1626,7 @@ func (*RenameIndex) StatementReturnType() StatementReturnType { return DDL }
 func (*RenameIndex) StatementType() StatementType { return TypeDDL }
 
 // StatementTag returns a short string identifying the type of statement.
-func (*RenameIndex) StatementTag() string { return "ALTER INDEX" }
+func (*RenameIndex) StatementTag() string { return AlterIndexTag }
 
 // StatementReturnType implements the Statement interface.
 func (*RenameTable) StatementReturnType() StatementReturnType { return DDL }
@@ -2582,6 +2678,8 @@ func (n *AlterRoutineSetSchema) String() string               { return AsString(
 func (n *AlterRoutineSetOwner) String() string                { return AsString(n) }
 func (n *AlterFunctionDepExtension) String() string           { return AsString(n) }
 func (n *AlterSchema) String() string                         { return AsString(n) }
+func (n *AlterTextSearchConfig) String() string               { return AsString(n) }
+func (n *AlterTextSearchDictionary) String() string           { return AsString(n) }
 func (n *AlterTable) String() string                          { return AsString(n) }
 func (n *AlterTableCmds) String() string                      { return AsString(n) }
 func (n *AlterTableAddColumn) String() string                 { return AsString(n) }
@@ -2596,6 +2694,8 @@ func (n *AlterTableIdentity) String() string                  { return AsString(
 func (n *AlterTableLocality) String() string                  { return AsString(n) }
 func (n *AlterTableSetDefault) String() string                { return AsString(n) }
 func (n *AlterTableSetVisible) String() string                { return AsString(n) }
+func (n *AlterTableSetMaskingPolicy) String() string          { return AsString(n) }
+func (n *AlterTableRotateEncryptionKey) String() string       { return AsString(n) }
 func (n *AlterTableSetNotNull) String() string                { return AsString(n) }
 func (n *AlterTableOwner) String() string                     { return AsString(n) }
 func (n *AlterTableSetLogged) String() string                 { return AsString(n) }
@@ -2634,18 +2734,22 @@ func (n *CommitTransaction) String() string                   { return AsString(
 func (n *CopyFrom) String() string                            { return AsString(n) }
 func (n *CopyTo) String() string                              { return AsString(n) }
 func (n *CreateChangefeed) String() string                    { return AsString(n) }
+func (n *CreateCast) String() string                          { return AsString(n) }
+func (n *CreateCollation) String() string                     { return AsString(n) }
 func (n *CreateDatabase) String() string                      { return AsString(n) }
 func (n *CreateExtension) String() string                     { return AsString(n) }
 func (n *CreateRoutine) String() string                       { return AsString(n) }
 func (n *CreateTrigger) String() string                       { return AsString(n) }
 func (n *CreateIndex) String() string                         { return AsString(n) }
 func (n *CreateLogicalReplicationStream) String() string      { return AsString(n) }
+func (n *CreateOperator) String() string                      { return AsString(n) }
 func (n *CreatePolicy) String() string                        { return AsString(n) }
 func (n *CreateRole) String() string                          { return AsString(n) }
 func (n *CreateTable) String() string                         { return AsString(n) }
 func (n *CreateTenant) String() string                        { return AsString(n) }
 func (n *CreateTenantFromReplication) String() string         { return AsString(n) }
 func (n *CreateSchema) String() string                        { return AsString(n) }
+func (n *CreateTextSearch) String() string                    { return AsString(n) }
 func (n *CreateSequence) String() string                      { return AsString(n) }
 func (n *CreateStats) String() string                         { return AsString(n) }
 func (n *CreateView) String() string                          { return AsString(n) }
@@ -2653,7 +2757,10 @@ func (n *Deallocate) String() string                          { return AsString(
 func (n *Delete) String() string                              { return AsString(n) }
 func (n *DeclareCursor) String() string                       { return AsString(n) }
 func (n *DoBlock) String() string                             { return AsString(n) }
+func (n *DropCast) String() string                            { return AsString(n) }
+func (n *DropCollation) String() string                       { return AsString(n) }
 func (n *DropDatabase) String() string                        { return AsString(n) }
+func (n *DropOperator) String() string                        { return AsString(n) }
 func (n *DropPolicy) String() string                          { return AsString(n) }
 func (n *DropRoutine) String() string                         { return AsString(n) }
 func (n *DropTrigger) String() string                         { return AsString(n) }
@@ -2663,6 +2770,7 @@ func (n *DropSchema) String() string                          { return AsString(
 func (n *DropSequence) String() string                        { return AsString(n) }
 func (n *DropTable) String() string                           { return AsString(n) }
 func (n *DropType) String() string                            { return AsString(n) }
+func (n *DropTextSearch) String() string                      { return AsString(n) }
 func (n *DropView) String() string                            { return AsString(n) }
 func (n *DropRole) String() string                            { return AsString(n) }
 func (n *DropTenant) String() string                          { return AsString(n) }
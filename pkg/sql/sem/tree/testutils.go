@@ -93,6 +93,8 @@ func SampleDatum(t *types.T) Datum {
 			panic(err)
 		}
 		return l
+	case types.XMLFamily:
+		return NewDXML("<a>b</a>")
 	default:
 		panic(errors.AssertionFailedf("SampleDatum not implemented for %s", t))
 	}
@@ -0,0 +1,215 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// TextSearchObjectType is the type of object created or dropped by a TEXT
+// SEARCH statement.
+type TextSearchObjectType int
+
+const (
+	// TextSearchConfiguration is a text search configuration.
+	TextSearchConfiguration TextSearchObjectType = iota
+	// TextSearchDictionary is a text search dictionary.
+	TextSearchDictionary
+)
+
+var textSearchObjectTypeName = [...]string{
+	TextSearchConfiguration: "CONFIGURATION",
+	TextSearchDictionary:    "DICTIONARY",
+}
+
+func (t TextSearchObjectType) String() string {
+	return textSearchObjectTypeName[t]
+}
+
+// CreateTextSearch represents a CREATE TEXT SEARCH CONFIGURATION or CREATE
+// TEXT SEARCH DICTIONARY statement.
+type CreateTextSearch struct {
+	Type TextSearchObjectType
+	Name *UnresolvedObjectName
+	// Params contains the PARSER or COPY parameter of a configuration, or the
+	// TEMPLATE and template options of a dictionary.
+	Params StorageParams
+}
+
+var _ Statement = &CreateTextSearch{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateTextSearch) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE TEXT SEARCH ")
+	ctx.WriteString(node.Type.String())
+	ctx.WriteByte(' ')
+	ctx.FormatNode(node.Name)
+	ctx.WriteString(" (")
+	ctx.FormatNode(&node.Params)
+	ctx.WriteByte(')')
+}
+
+// DropTextSearch represents a DROP TEXT SEARCH CONFIGURATION or DROP TEXT
+// SEARCH DICTIONARY statement.
+type DropTextSearch struct {
+	Type         TextSearchObjectType
+	Names        []*UnresolvedObjectName
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropTextSearch{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropTextSearch) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP TEXT SEARCH ")
+	ctx.WriteString(node.Type.String())
+	ctx.WriteByte(' ')
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	for i := range node.Names {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(node.Names[i])
+	}
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
+
+// AlterTextSearchDictionary represents an ALTER TEXT SEARCH DICTIONARY
+// statement, which changes the template options of a dictionary.
+type AlterTextSearchDictionary struct {
+	Name   *UnresolvedObjectName
+	Params StorageParams
+}
+
+var _ Statement = &AlterTextSearchDictionary{}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTextSearchDictionary) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER TEXT SEARCH DICTIONARY ")
+	ctx.FormatNode(node.Name)
+	ctx.WriteString(" (")
+	ctx.FormatNode(&node.Params)
+	ctx.WriteByte(')')
+}
+
+// AlterTextSearchConfig represents an ALTER TEXT SEARCH CONFIGURATION
+// statement.
+type AlterTextSearchConfig struct {
+	Name *UnresolvedObjectName
+	Cmd  AlterTextSearchConfigCmd
+}
+
+var _ Statement = &AlterTextSearchConfig{}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTextSearchConfig) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER TEXT SEARCH CONFIGURATION ")
+	ctx.FormatNode(node.Name)
+	ctx.FormatNode(node.Cmd)
+}
+
+// AlterTextSearchConfigCmd represents a text search configuration
+// modification operation.
+type AlterTextSearchConfigCmd interface {
+	NodeFormatter
+	alterTextSearchConfigCmd()
+}
+
+func (*AlterTextSearchConfigAddMapping) alterTextSearchConfigCmd()     {}
+func (*AlterTextSearchConfigAlterMapping) alterTextSearchConfigCmd()   {}
+func (*AlterTextSearchConfigReplaceMapping) alterTextSearchConfigCmd() {}
+func (*AlterTextSearchConfigDropMapping) alterTextSearchConfigCmd()    {}
+func (*AlterTextSearchConfigRename) alterTextSearchConfigCmd()         {}
+
+// AlterTextSearchConfigAddMapping represents an ADD MAPPING command, which
+// maps token types that don't have a mapping yet to a list of dictionaries.
+type AlterTextSearchConfigAddMapping struct {
+	TokenTypes   NameList
+	Dictionaries []*UnresolvedObjectName
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTextSearchConfigAddMapping) Format(ctx *FmtCtx) {
+	ctx.WriteString(" ADD MAPPING FOR ")
+	ctx.FormatNode(&node.TokenTypes)
+	ctx.WriteString(" WITH ")
+	formatTextSearchDictionaries(ctx, node.Dictionaries)
+}
+
+// AlterTextSearchConfigAlterMapping represents an ALTER MAPPING ... WITH
+// command, which replaces the dictionaries of existing token type mappings.
+type AlterTextSearchConfigAlterMapping struct {
+	TokenTypes   NameList
+	Dictionaries []*UnresolvedObjectName
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTextSearchConfigAlterMapping) Format(ctx *FmtCtx) {
+	ctx.WriteString(" ALTER MAPPING FOR ")
+	ctx.FormatNode(&node.TokenTypes)
+	ctx.WriteString(" WITH ")
+	formatTextSearchDictionaries(ctx, node.Dictionaries)
+}
+
+// AlterTextSearchConfigReplaceMapping represents an ALTER MAPPING ... REPLACE
+// command, which substitutes one dictionary for another in the mappings of
+// the given token types, or of all token types if none are given.
+type AlterTextSearchConfigReplaceMapping struct {
+	TokenTypes NameList
+	Old        *UnresolvedObjectName
+	New        *UnresolvedObjectName
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTextSearchConfigReplaceMapping) Format(ctx *FmtCtx) {
+	ctx.WriteString(" ALTER MAPPING")
+	if len(node.TokenTypes) > 0 {
+		ctx.WriteString(" FOR ")
+		ctx.FormatNode(&node.TokenTypes)
+	}
+	ctx.WriteString(" REPLACE ")
+	ctx.FormatNode(node.Old)
+	ctx.WriteString(" WITH ")
+	ctx.FormatNode(node.New)
+}
+
+// AlterTextSearchConfigDropMapping represents a DROP MAPPING command.
+type AlterTextSearchConfigDropMapping struct {
+	IfExists   bool
+	TokenTypes NameList
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTextSearchConfigDropMapping) Format(ctx *FmtCtx) {
+	ctx.WriteString(" DROP MAPPING ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.WriteString("FOR ")
+	ctx.FormatNode(&node.TokenTypes)
+}
+
+// AlterTextSearchConfigRename represents a RENAME TO command.
+type AlterTextSearchConfigRename struct {
+	NewName Name
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTextSearchConfigRename) Format(ctx *FmtCtx) {
+	ctx.WriteString(" RENAME TO ")
+	ctx.FormatNode(&node.NewName)
+}
+
+func formatTextSearchDictionaries(ctx *FmtCtx, dicts []*UnresolvedObjectName) {
+	for i := range dicts {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(dicts[i])
+	}
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil/pgdate"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
-	"golang.org/x/text/language"
 )
 
 // OnTypeCheck* functions are hooks which get called if not nil and the
@@ -402,6 +401,92 @@ func (expr *AndExpr) TypeCheck(
 	return expr, nil
 }
 
+// typeCheckUserDefinedBinaryOp type checks a binary expression for which no
+// built-in operator exists as a call to the function of a user-defined
+// operator with matching operand types, if there is one. Constant operands
+// match any operand type they can become, and string literals and NULL match
+// all operand types.
+func typeCheckUserDefinedBinaryOp(
+	ctx context.Context,
+	semaCtx *SemaContext,
+	expr *BinaryExpr,
+	leftTyped, rightTyped TypedExpr,
+	desired *types.T,
+) (_ TypedExpr, ok bool, _ error) {
+	if semaCtx == nil {
+		return nil, false, nil
+	}
+	resolver, ok := semaCtx.FunctionResolver.(CastAndOperatorResolver)
+	if !ok {
+		return nil, false, nil
+	}
+	ops, err := resolver.ResolveOperators(ctx, expr.Operator.Symbol.String())
+	if err != nil {
+		return nil, false, err
+	}
+	operand := func(orig Expr, typed TypedExpr, typ *types.T) (Expr, bool) {
+		switch c := orig.(type) {
+		case *StrVal:
+			return orig, true
+		case Constant:
+			return orig, canConstantBecome(c, typ)
+		}
+		if typed.ResolvedType().Family() == types.UnknownFamily {
+			return typed, true
+		}
+		return typed, typed.ResolvedType().Oid() == typ.Oid()
+	}
+	for _, op := range ops {
+		left, leftOK := operand(expr.Left, leftTyped, op.LeftType)
+		right, rightOK := operand(expr.Right, rightTyped, op.RightType)
+		if !leftOK || !rightOK {
+			continue
+		}
+		fn := &FuncExpr{
+			Func:  ResolvableFunctionReference{FunctionReference: &FunctionOID{OID: op.FuncOID}},
+			Exprs: Exprs{left, right},
+		}
+		typed, err := fn.TypeCheck(ctx, semaCtx, desired)
+		if err != nil {
+			// The constant operands could not be converted to the operand types
+			// of this operator, or its function no longer exists; try the next
+			// one.
+			continue
+		}
+		return typed, true, nil
+	}
+	return nil, false, nil
+}
+
+// typeCheckUserDefinedCast type checks a cast for which no built-in cast
+// exists as a call to the function of a user-defined cast, if there is one.
+func typeCheckUserDefinedCast(
+	ctx context.Context, semaCtx *SemaContext, typedSubExpr TypedExpr, castTo *types.T,
+) (_ TypedExpr, ok bool, _ error) {
+	if semaCtx == nil {
+		return nil, false, nil
+	}
+	resolver, ok := semaCtx.FunctionResolver.(CastAndOperatorResolver)
+	if !ok {
+		return nil, false, nil
+	}
+	funcOID, found, err := resolver.ResolveCast(ctx, typedSubExpr.ResolvedType(), castTo)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	fn := &FuncExpr{
+		Func:  ResolvableFunctionReference{FunctionReference: &FunctionOID{OID: funcOID}},
+		Exprs: Exprs{typedSubExpr},
+	}
+	typed, err := fn.TypeCheck(ctx, semaCtx, castTo)
+	if err != nil {
+		// The function of the cast may have been dropped with its schema or
+		// with a type it uses; report the cast as invalid.
+		return nil, false, nil //nolint:returnerrcheck
+	}
+	return typed, true, nil
+}
+
 // TypeCheck implements the Expr interface.
 func (expr *BinaryExpr) TypeCheck(
 	ctx context.Context, semaCtx *SemaContext, desired *types.T,
@@ -445,6 +530,15 @@ func (expr *BinaryExpr) TypeCheck(
 		}
 		sig := redact.Sprintf("<%s> %s <%s>%s", leftReturn, expr.Operator, rightReturn, desStr)
 		if len(s.overloadIdxs) == 0 {
+			typed, ok, err := typeCheckUserDefinedBinaryOp(
+				ctx, semaCtx, expr, leftTyped, rightTyped, desired,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				return typed, nil
+			}
 			return nil,
 				pgerror.Newf(pgcode.InvalidParameterValue, unsupportedBinaryOpErrFmt, sig)
 		}
@@ -706,6 +800,15 @@ func (expr *CastExpr) TypeCheck(
 	}
 	err = resolveCast(context, castFrom, exprType, allowStable)
 	if err != nil {
+		if pgerror.GetPGCode(err) == pgcode.CannotCoerce {
+			typed, ok, udErr := typeCheckUserDefinedCast(ctx, semaCtx, typedSubExpr, exprType)
+			if udErr != nil {
+				return nil, udErr
+			}
+			if ok {
+				return typed, nil
+			}
+		}
 		return nil, err
 	}
 	if exprType.Identical(types.Trigger) {
@@ -828,10 +931,13 @@ func (expr *CollateExpr) TypeCheck(
 	if collatedstring.IsDefaultEquivalentCollation(expr.Locale) {
 		return subExpr, nil
 	}
-	if _, err := language.Parse(expr.Locale); err != nil {
-		return nil, pgerror.Wrapf(err, pgcode.InvalidParameterValue,
-			"invalid locale %s", expr.Locale)
+	locale, err := ResolveCollationLocale(ctx, expr.Locale, semaCtx.GetTypeResolver())
+	if err != nil {
+		return nil, err
 	}
+	// Replace the name of a user-defined collation with its locale, so that
+	// the expression no longer depends on the collation.
+	expr.Locale = locale
 	// setType is a recursive helper function to handle type checking of COLLATE
 	// on arrays.
 	var setType func(t *types.T) (*CollateExpr, error)
@@ -1002,7 +1108,7 @@ func (expr *ComparisonExpr) TypeCheck(
 	}
 	if err == nil {
 		err = runValidations(cmpOpSym, leftTyped.ResolvedType(), rightTyped.ResolvedType(),
-			[]types.Family{types.RefCursorFamily, types.JsonpathFamily})
+			[]types.Family{types.RefCursorFamily, types.JsonpathFamily, types.XMLFamily})
 	}
 	if err != nil {
 		return nil, err
@@ -2206,6 +2312,12 @@ func (d *DLTree) TypeCheck(_ context.Context, _ *SemaContext, _ *types.T) (Typed
 	return d, nil
 }
 
+// TypeCheck implements the Expr interface. It is implemented as an idempotent
+// identity function for Datum.
+func (d *DXML) TypeCheck(_ context.Context, _ *SemaContext, _ *types.T) (TypedExpr, error) {
+	return d, nil
+}
+
 // TypeCheck implements the Expr interface. It is implemented as an idempotent
 // identity function for Datum.
 func (d *DTuple) TypeCheck(_ context.Context, _ *SemaContext, _ *types.T) (TypedExpr, error) {
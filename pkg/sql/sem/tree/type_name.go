@@ -14,6 +14,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq/oid"
+	"golang.org/x/text/language"
 )
 
 // TypeName corresponds to the name of a type in a CREATE TYPE statement,
@@ -98,6 +99,64 @@ type TypeReferenceResolver interface {
 	ResolveTypeByOID(ctx context.Context, oid oid.Oid) (*types.T, error)
 }
 
+// CollationResolver is implemented by TypeReferenceResolvers that can look up
+// user-defined collations created with CREATE COLLATION.
+type CollationResolver interface {
+	// ResolveCollation returns the locale of the collation with the given
+	// name, or false if no such collation exists.
+	ResolveCollation(ctx context.Context, name string) (locale string, found bool, _ error)
+}
+
+// ResolveCollationLocale returns the locale to use for the given collation
+// name. Valid locale tags are returned unchanged; any other name is looked up
+// as a user-defined collation if the resolver supports it.
+func ResolveCollationLocale(
+	ctx context.Context, name string, resolver TypeReferenceResolver,
+) (string, error) {
+	_, parseErr := language.Parse(name)
+	if parseErr == nil {
+		return name, nil
+	}
+	if cr, ok := resolver.(CollationResolver); ok {
+		locale, found, err := cr.ResolveCollation(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return locale, nil
+		}
+	}
+	return "", pgerror.Wrapf(parseErr, pgcode.InvalidParameterValue, "invalid locale %s", name)
+}
+
+// resolveCollatedType replaces the name of a user-defined collation in a
+// collated string type, or in an array of them, with the collation's locale.
+func resolveCollatedType(
+	ctx context.Context, t *types.T, resolver TypeReferenceResolver,
+) (*types.T, error) {
+	switch t.Family() {
+	case types.CollatedStringFamily:
+		locale, err := ResolveCollationLocale(ctx, t.Locale(), resolver)
+		if err != nil {
+			return nil, err
+		}
+		if locale != t.Locale() {
+			return types.MakeCollatedString(t, locale), nil
+		}
+	case types.ArrayFamily:
+		if t.ArrayContents().Family() == types.CollatedStringFamily {
+			contents, err := resolveCollatedType(ctx, t.ArrayContents(), resolver)
+			if err != nil {
+				return nil, err
+			}
+			if contents != t.ArrayContents() {
+				return types.MakeArray(contents), nil
+			}
+		}
+	}
+	return t, nil
+}
+
 // ResolvableTypeReference represents a type that is possibly unknown
 // until type-checking/type name resolution is performed.
 // N.B. ResolvableTypeReferences in expressions must be formatted with
@@ -119,7 +178,7 @@ func ResolveType(
 ) (*types.T, error) {
 	switch t := ref.(type) {
 	case *types.T:
-		return t, nil
+		return resolveCollatedType(ctx, t, resolver)
 	case *ArrayTypeReference:
 		typ, err := ResolveType(ctx, t.ElementType, resolver)
 		if err != nil {
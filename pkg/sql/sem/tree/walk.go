@@ -876,6 +876,9 @@ func (expr *DTimestampTZ) Walk(_ Visitor) Expr { return expr }
 // Walk implements the Expr interface.
 func (expr *DLTree) Walk(_ Visitor) Expr { return expr }
 
+// Walk implements the Expr interface.
+func (expr *DXML) Walk(_ Visitor) Expr { return expr }
+
 // Walk implements the Expr interface.
 func (expr *DTuple) Walk(v Visitor) Expr {
 	for _, d := range expr.D {
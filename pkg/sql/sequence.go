@@ -12,6 +12,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/keys"
 	"github.com/cockroachdb/cockroach/pkg/kv"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
@@ -165,15 +166,22 @@ func (p *planner) incrementSequenceUsingCache(
 		// txn here, since nextval does not respect transaction boundaries.
 		// This matches the specification at
 		// https://www.postgresql.org/docs/14/functions-sequence.html.
-		var endValue int64
-		if createdInCurrentTxn {
-			var res kv.KeyValue
-			res, err = p.txn.Inc(ctx, seqValueKey, seqOpts.Increment*cacheSize)
-			endValue = res.ValueInt()
-		} else {
-			endValue, err = kv.IncrementValRetryable(
+		increment := func() (int64, error) {
+			if createdInCurrentTxn {
+				res, err := p.txn.Inc(ctx, seqValueKey, seqOpts.Increment*cacheSize)
+				return res.ValueInt(), err
+			}
+			return kv.IncrementValRetryable(
 				ctx, p.ExecCfg().DB, seqValueKey, seqOpts.Increment*cacheSize)
 		}
+		endValue, err := increment()
+		if err != nil && seqOpts.Cycle && errors.HasType(err, (*kvpb.IntegerOverflowError)(nil)) {
+			// The counter of a cycling sequence whose bound is close to the
+			// bound of INT8 cannot go past its bound: restart it and try again.
+			if err = p.restartCyclingSequence(ctx, descriptor, seqValueKey, createdInCurrentTxn); err == nil {
+				endValue, err = increment()
+			}
+		}
 
 		if err != nil {
 			if errors.HasType(err, (*kvpb.IntegerOverflowError)(nil)) {
@@ -183,6 +191,20 @@ func (p *planner) incrementSequenceUsingCache(
 		}
 
 		// This sequence has exceeded its bounds after performing this increment.
+		if seqOpts.Cycle && (endValue > seqOpts.MaxValue || endValue < seqOpts.MinValue) {
+			var lastValue int64
+			currentValue, sizeOfCache, lastValue = cycleSequenceValues(seqOpts, endValue, cacheSize)
+			// Move the counter back within the bounds so that it doesn't grow
+			// indefinitely. This is best effort: if another increment happened
+			// in the meantime, the next one to exceed the bounds does it, and
+			// the values of the batch past the wraparound are skipped.
+			if err := p.cputSequenceValue(
+				ctx, seqValueKey, lastValue, endValue, createdInCurrentTxn,
+			); err != nil {
+				return 0, 0, 0, err
+			}
+			return currentValue, seqOpts.Increment, sizeOfCache, nil
+		}
 		if endValue > seqOpts.MaxValue || endValue < seqOpts.MinValue {
 			// If the sequence exceeded its bounds prior to the increment, then return an error.
 			if (seqOpts.Increment > 0 && endValue-seqOpts.Increment*(cacheSize-1) > seqOpts.MaxValue) ||
@@ -230,6 +252,128 @@ func (p *planner) incrementSequenceUsingCache(
 	return val, nil
 }
 
+// cycleSequenceValues returns the values of a cycling sequence for a batch of
+// cacheSize increments that moved its counter to endValue, past the bounds of
+// the sequence. Once a bound is exceeded, the values restart from MinValue
+// (MaxValue for descending sequences), and the counter values past the bound
+// map to the values of the sequence modulo the length of a cycle.
+//
+// The values of the batch must be consecutive, so the returned batch ends at
+// the next wraparound. lastValue is the last value of the returned batch: the
+// counter can be reset to it, so that the next batch continues from there.
+func cycleSequenceValues(
+	opts *descpb.TableDescriptor_SequenceOpts, endValue, cacheSize int64,
+) (currentValue, sizeOfCache, lastValue int64) {
+	// The distances are computed with unsigned integers, since they may not
+	// fit in an INT8 when the bounds have opposite signs.
+	step := uint64(opts.Increment)
+	if opts.Increment < 0 {
+		step = uint64(-opts.Increment)
+	}
+	// cycleLen is the number of values in a cycle. It overflows to 0 only for
+	// a sequence that spans the whole INT8 range with an increment of 1, in
+	// which case no modulo is needed.
+	cycleLen := (uint64(opts.MaxValue)-uint64(opts.MinValue))/step + 1
+	// pastBound returns how far past the bound of the sequence a counter
+	// value is, or false if the value is within the bounds.
+	pastBound := func(v int64) (uint64, bool) {
+		if opts.Increment > 0 && v > opts.MaxValue {
+			return uint64(v) - uint64(opts.MaxValue), true
+		} else if opts.Increment < 0 && v < opts.MinValue {
+			return uint64(opts.MinValue) - uint64(v), true
+		}
+		return 0, false
+	}
+	// position returns the position in the cycle of a counter value that is
+	// past the bound.
+	position := func(dist uint64) uint64 {
+		pos := (dist - 1) / step
+		if cycleLen != 0 {
+			pos %= cycleLen
+		}
+		return pos
+	}
+	valueAt := func(pos uint64) int64 {
+		if opts.Increment > 0 {
+			return int64(uint64(opts.MinValue) + pos*step)
+		}
+		return int64(uint64(opts.MaxValue) - pos*step)
+	}
+
+	firstValue := endValue - opts.Increment*(cacheSize-1)
+	// remaining is the number of values that can be returned before the next
+	// wraparound.
+	var remaining uint64
+	if dist, ok := pastBound(firstValue); ok {
+		pos := position(dist)
+		currentValue = valueAt(pos)
+		remaining = cycleLen - pos
+	} else {
+		currentValue = firstValue
+		if opts.Increment > 0 {
+			remaining = (uint64(opts.MaxValue)-uint64(firstValue))/step + 1
+		} else {
+			remaining = (uint64(firstValue)-uint64(opts.MinValue))/step + 1
+		}
+	}
+	sizeOfCache = cacheSize
+	if remaining != 0 && remaining < uint64(cacheSize) {
+		sizeOfCache = int64(remaining)
+	}
+	lastValue = currentValue + opts.Increment*(sizeOfCache-1)
+	return currentValue, sizeOfCache, lastValue
+}
+
+// restartCyclingSequence resets the counter of a cycling sequence so that its
+// next increment returns MinValue (MaxValue for descending sequences). It is
+// used when the counter cannot be incremented past the bound of the sequence
+// without overflowing.
+func (p *planner) restartCyclingSequence(
+	ctx context.Context, descriptor catalog.TableDescriptor, key roachpb.Key, inTxn bool,
+) error {
+	seqOpts := descriptor.GetSequenceOpts()
+	var res kv.KeyValue
+	var err error
+	if inTxn {
+		res, err = p.txn.Get(ctx, key)
+	} else {
+		res, err = p.ExecCfg().DB.Get(ctx, key)
+	}
+	if err != nil {
+		return err
+	}
+	restartValue := seqOpts.MinValue - seqOpts.Increment
+	if seqOpts.Increment < 0 {
+		restartValue = seqOpts.MaxValue - seqOpts.Increment
+	}
+	// The value preceding the first value of the cycle cannot be represented
+	// when the sequence spans the whole INT8 range.
+	if (seqOpts.Increment > 0 && restartValue > seqOpts.MinValue) ||
+		(seqOpts.Increment < 0 && restartValue < seqOpts.MaxValue) {
+		return boundsExceededError(descriptor)
+	}
+	return p.cputSequenceValue(ctx, key, restartValue, res.ValueInt(), inTxn)
+}
+
+// cputSequenceValue sets the counter of a sequence to value if it is still
+// equal to expValue. It is not an error for the counter to have changed.
+func (p *planner) cputSequenceValue(
+	ctx context.Context, key roachpb.Key, value, expValue int64, inTxn bool,
+) error {
+	var exp roachpb.Value
+	exp.SetInt(expValue)
+	var err error
+	if inTxn {
+		err = p.txn.CPut(ctx, key, value, exp.TagAndDataBytes())
+	} else {
+		err = p.ExecCfg().DB.CPut(ctx, key, value, exp.TagAndDataBytes())
+	}
+	if errors.HasType(err, (*kvpb.ConditionFailedError)(nil)) {
+		return nil
+	}
+	return err
+}
+
 func boundsExceededError(descriptor catalog.TableDescriptor) error {
 	seqOpts := descriptor.GetSequenceOpts()
 	isAscending := seqOpts.Increment > 0
@@ -527,6 +671,13 @@ func assignSequenceOptions(
 	if p != nil && p.SessionData() != nil {
 		defaultIntSize = p.SessionData().DefaultIntSize
 	}
+	if p != nil {
+		if err := schemaexpr.CheckSequenceCycleSupported(
+			ctx, p.ExecCfg().Settings.Version, optsNode,
+		); err != nil {
+			return err
+		}
+	}
 	if err := schemaexpr.AssignSequenceOptions(
 		opts,
 		optsNode,
@@ -1205,3 +1205,70 @@ func breakOwnershipMapping(
 	)
 	require.NoError(t, err)
 }
+
+// TestCycleSequenceConcurrent checks that concurrent calls to nextval on a
+// cycling sequence return every value of the cycle equally often, and that
+// cached values stay within the bounds of the sequence.
+func TestCycleSequenceConcurrent(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	srv, sqlConn, _ := serverutils.StartServer(t, base.TestServerArgs{})
+	defer srv.Stopper().Stop(ctx)
+	sqlDB := sqlutils.MakeSQLRunner(sqlConn)
+
+	const workers, callsPerWorker, maxValue = 4, 50, 10
+	for _, tc := range []struct {
+		name  string
+		cache string
+	}{
+		{name: "uncached"},
+		{name: "session_cache", cache: "PER SESSION CACHE 4"},
+		{name: "node_cache", cache: "PER NODE CACHE 4"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			seq := "seq_" + tc.name
+			sqlDB.Exec(t, fmt.Sprintf(
+				`CREATE SEQUENCE %s MINVALUE 1 MAXVALUE %d CYCLE %s`, seq, maxValue, tc.cache))
+
+			var mu syncutil.Mutex
+			counts := make(map[int]int)
+			g := ctxgroup.WithContext(ctx)
+			for i := 0; i < workers; i++ {
+				g.GoCtx(func(ctx context.Context) error {
+					conn, err := sqlConn.Conn(ctx)
+					if err != nil {
+						return err
+					}
+					defer conn.Close()
+					for j := 0; j < callsPerWorker; j++ {
+						var v int
+						if err := conn.QueryRowContext(
+							ctx, fmt.Sprintf(`SELECT nextval('%s')`, seq),
+						).Scan(&v); err != nil {
+							return err
+						}
+						mu.Lock()
+						counts[v]++
+						mu.Unlock()
+					}
+					return nil
+				})
+			}
+			require.NoError(t, g.Wait())
+
+			if tc.cache == "" {
+				require.Len(t, counts, maxValue)
+			}
+			for v, count := range counts {
+				require.True(t, v >= 1 && v <= maxValue, "value %d out of bounds", v)
+				// Without a cache, no value is skipped, so all the values of
+				// the cycle are returned equally often.
+				if tc.cache == "" {
+					require.Equal(t, workers*callsPerWorker/maxValue, count, "value %d", v)
+				}
+			}
+		})
+	}
+}
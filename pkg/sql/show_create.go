@@ -17,6 +17,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
 	"github.com/cockroachdb/cockroach/pkg/util/tracing"
@@ -105,6 +106,11 @@ func ShowCreateTable(
 			return "", err
 		}
 		f.WriteString(colstr)
+		if col.IsEncrypted() {
+			if err := formatColumnEncryption(&f.Buffer, col.ColumnDesc().Encryption); err != nil {
+				return "", err
+			}
+		}
 	}
 
 	if desc.IsPhysicalTable() {
@@ -158,9 +164,17 @@ func ShowCreateTable(
 		}
 	}
 
+	var brinIndexes []catalog.Index
 	for _, idx := range desc.PublicNonPrimaryIndexes() {
 		// Showing the primary index is handled above.
 
+		// BRIN indexes cannot be defined inline and are shown as separate
+		// CREATE INDEX statements below.
+		if idx.GetType() == idxtype.BRIN {
+			brinIndexes = append(brinIndexes, idx)
+			continue
+		}
+
 		// Build the PARTITION BY clause.
 		var partitionBuf bytes.Buffer
 		if err := ShowCreatePartitioning(
@@ -216,6 +230,26 @@ func ShowCreateTable(
 		return "", err
 	}
 
+	for _, idx := range brinIndexes {
+		idxStr, err := catformat.IndexForDisplay(
+			ctx,
+			desc,
+			tn,
+			idx,
+			"", /* partition */
+			fmtFlags,
+			p.EvalContext(),
+			p.SemaCtx(),
+			p.SessionData(),
+			catformat.IndexDisplayShowCreate,
+		)
+		if err != nil {
+			return "", err
+		}
+		f.WriteString(";\n")
+		f.WriteString(idxStr)
+	}
+
 	if !displayOptions.IgnoreComments {
 		if err := showComments(tn, desc, selectComment(ctx, p, desc.GetID()), &f.Buffer); err != nil {
 			return "", err
@@ -671,6 +671,9 @@ func ShowCreateSequence(
 	f.Printf(" MAXVALUE %d", opts.MaxValue)
 	f.Printf(" INCREMENT %d", opts.Increment)
 	f.Printf(" START %d", opts.Start)
+	if opts.Cycle {
+		f.Printf(" CYCLE")
+	}
 	if opts.Virtual {
 		f.Printf(" VIRTUAL")
 	}
@@ -765,6 +768,17 @@ func ShowCreatePartitioning(
 		buf.WriteString(`ALL `)
 	}
 	buf.WriteString(`BY `)
+	if hashColNames := part.HashColumnNames(); len(hashColNames) > 0 {
+		buf.WriteString(`HASH (`)
+		for i, colName := range hashColNames {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(tree.NameString(colName))
+		}
+		fmt.Fprintf(buf, `) PARTITIONS %d`, part.NumLists())
+		return nil
+	}
 	if part.NumLists() > 0 {
 		buf.WriteString(`LIST`)
 	} else if part.NumRanges() > 0 {
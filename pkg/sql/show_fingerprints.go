@@ -335,9 +335,11 @@ func (n *showFingerprintsNode) Next(params runParams) (bool, error) {
 	}
 	index := n.indexes[n.run.rowIdx]
 
-	// Skip inverted indexes. Experimental fingerprint uses a query that forces
-	// the use of an index and that is incompatible with inverted indexes.
-	if index.GetType() == idxtype.INVERTED {
+	// Skip inverted and BRIN indexes. Experimental fingerprint uses a query
+	// that forces the use of an index and that is incompatible with inverted
+	// indexes. BRIN indexes only hold summaries which depend on the order in
+	// which rows were written.
+	if index.GetType() == idxtype.INVERTED || index.GetType() == idxtype.BRIN {
 		n.run.rowIdx++
 		return n.Next(params)
 	}
@@ -78,9 +78,17 @@ func renderCreateTriggerStatement(
 	}
 
 	// Resolve the fully-qualified names of the trigger function and table.
-	funcName, err := p.GetQualifiedFunctionNameByID(ctx, int64(trigger.FuncID))
-	if err != nil {
-		return "", err
+	// Builtin trigger functions are shown unqualified.
+	var funcName *tree.UnresolvedName
+	if trigger.BuiltinFuncName != "" {
+		name := tree.MakeUnresolvedName(trigger.BuiltinFuncName)
+		funcName = &name
+	} else {
+		qualifiedName, err := p.GetQualifiedFunctionNameByID(ctx, int64(trigger.FuncID))
+		if err != nil {
+			return "", err
+		}
+		funcName = qualifiedName.ToUnresolvedObjectName().ToUnresolvedName()
 	}
 
 	tableName, err := p.getQualifiedTableName(ctx, tableDesc)
@@ -140,7 +148,7 @@ func renderCreateTriggerStatement(
 		Transitions: transitions,
 		ForEach:     forEach,
 		When:        whenExpr,
-		FuncName:    funcName.ToUnresolvedObjectName().ToUnresolvedName(),
+		FuncName:    funcName,
 		FuncArgs:    trigger.FuncArgs,
 	}
 
@@ -103,6 +103,19 @@ func NewNonNullViolationError(columnName string) error {
 	return pgerror.Newf(pgcode.NotNullViolation, "null value in column %q violates not-null constraint", columnName)
 }
 
+// NewDropNotNullOnColumnWithMissingValueError creates an error for dropping the
+// NOT NULL constraint of a column which was added without a backfill. Rows
+// without a stored value for such a column decode to its missing value, so it
+// cannot hold NULLs.
+func NewDropNotNullOnColumnWithMissingValueError(columnName string) error {
+	return errors.WithHint(
+		pgerror.Newf(pgcode.FeatureNotSupported,
+			"cannot drop NOT NULL from column %q because it was added without rewriting existing rows",
+			columnName),
+		"add a new nullable column and copy the values of this column into it",
+	)
+}
+
 func NewAlterColumnTypeColOwnsSequenceNotSupportedErr() error {
 	return errors.WithHint(
 		unimplemented.NewWithIssuef(
@@ -529,6 +542,28 @@ func NewInsufficientPrivilegeOnDescriptorError(
 		user, privsStr, descType, descName)
 }
 
+// NewInsufficientColumnPrivilegeError creates an InsufficientPrivilege error
+// saying the `user` does not have `priv` on the given column of a relation,
+// when the user only holds that privilege on other columns of the relation.
+func NewInsufficientColumnPrivilegeError(
+	user username.SQLUsername, priv privilege.Kind, colName string, tableName string,
+) error {
+	return pgerror.Newf(pgcode.InsufficientPrivilege,
+		"user %s does not have %s privilege on column %q of relation %s",
+		user, priv.DisplayName(), colName, tableName)
+}
+
+// NewMaskedColumnReferenceError creates an InsufficientPrivilege error saying
+// that the `user` cannot use the original values of a column of a relation in
+// a statement, because the column is masked for the user.
+func NewMaskedColumnReferenceError(
+	user username.SQLUsername, colName string, tableName string,
+) error {
+	return pgerror.Newf(pgcode.InsufficientPrivilege,
+		"column %q of relation %s is masked for user %s and cannot be read by this statement",
+		colName, tableName, user)
+}
+
 // NewColumnNotIndexableError returns an error for a column type that cannot be
 // indexed.
 func NewColumnNotIndexableError(colDesc string, colType string, detail string) error {
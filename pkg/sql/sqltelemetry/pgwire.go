@@ -64,6 +64,10 @@ var CloseRequestCounter = telemetry.GetCounterOnce("pgwire.command.close")
 // is made.
 var FlushRequestCounter = telemetry.GetCounterOnce("pgwire.command.flush")
 
+// FunctionCallRequestCounter is to be incremented every time a fastpath
+// function call request is made.
+var FunctionCallRequestCounter = telemetry.GetCounterOnce("pgwire.command.function_call")
+
 // StmtsTriedWithPausablePortals is to be incremented every time there's a
 // not-internal statement executed with a pgwire portal and the session variable
 // multiple_active_portals_enabled has been set to true.
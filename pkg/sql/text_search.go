@@ -0,0 +1,954 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemadesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/paramparse"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/util/iterutil"
+	"github.com/cockroachdb/cockroach/pkg/util/tsearch"
+	"github.com/cockroachdb/errors"
+)
+
+// Text search configurations and dictionaries are stored in the descriptor of
+// the schema that contains them. They have an owner, the role that created
+// them, but no privileges of their own: managing them requires the CREATE
+// privilege on their schema, altering or dropping them also requires owning
+// them, and they can be used by anyone who can resolve their schema. A
+// configuration may only map token types to built-in dictionaries and to
+// dictionaries in its own schema.
+//
+// As in Postgres, calls to builtins such as to_tsvector are immutable even
+// when they use a user-defined configuration, so configurations can be used in
+// computed columns and indexes. Such expressions refer to configurations by
+// name, so a configuration that is used by a table or view of its database
+// cannot be dropped or renamed; see checkTextSearchConfigNotUsed.
+
+type createTextSearchNode struct {
+	zeroInputPlanNode
+	n *tree.CreateTextSearch
+}
+
+// CreateTextSearch creates a text search configuration or dictionary.
+func (p *planner) CreateTextSearch(ctx context.Context, n *tree.CreateTextSearch) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"CREATE TEXT SEARCH",
+	); err != nil {
+		return nil, err
+	}
+	if err := checkTextSearchObjectsSupported(ctx, p); err != nil {
+		return nil, err
+	}
+	return &createTextSearchNode{n: n}, nil
+}
+
+func (n *createTextSearchNode) startExec(params runParams) error {
+	p := params.p
+	db, sc, _, err := p.ResolveTargetObject(params.ctx, n.n.Name)
+	if err != nil {
+		return err
+	}
+	mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "text search objects")
+	if err != nil {
+		return err
+	}
+	name := n.n.Name.Object()
+	if textSearchObjectExists(mutSc, n.n.Type, name) {
+		return pgerror.Newf(pgcode.DuplicateObject, "%s %q already exists", textSearchObjectKind(n.n.Type), name)
+	}
+
+	switch n.n.Type {
+	case tree.TextSearchDictionary:
+		var template string
+		options := make(map[string]string)
+		for _, param := range n.n.Params {
+			v, err := objectParamValue(param)
+			if err != nil {
+				return err
+			}
+			if key := strings.ToLower(param.Key); key == "template" {
+				template = strings.TrimPrefix(v, catconstants.PgCatalogName+".")
+			} else {
+				options[key] = v
+			}
+		}
+		if template == "" {
+			return pgerror.New(pgcode.InvalidObjectDefinition, "text search template is required")
+		}
+		// Validate the options by building the dictionary.
+		if _, err := tsearch.NewDictionary(template, options); err != nil {
+			return err
+		}
+		mutSc.SetTextSearchDictionary(descpb.SchemaDescriptor_TextSearchDictionary{
+			Name:     name,
+			Template: template,
+			Options:  options,
+			Owner:    p.User().Normalized(),
+		})
+
+	case tree.TextSearchConfiguration:
+		config := descpb.SchemaDescriptor_TextSearchConfig{Name: name, Owner: p.User().Normalized()}
+		var hasParser, hasCopy bool
+		for _, param := range n.n.Params {
+			v, err := objectParamValue(param)
+			if err != nil {
+				return err
+			}
+			switch key := strings.ToLower(param.Key); key {
+			case "parser":
+				if v = strings.TrimPrefix(v, catconstants.PgCatalogName+"."); v != "default" {
+					return pgerror.Newf(pgcode.UndefinedObject, "text search parser %q does not exist", v)
+				}
+				hasParser = true
+			case "copy":
+				if config.Mappings, err = p.copyTextSearchConfigMappings(params.ctx, mutSc, v); err != nil {
+					return err
+				}
+				hasCopy = true
+			default:
+				return pgerror.Newf(pgcode.SyntaxError, "text search configuration parameter %q not recognized", key)
+			}
+		}
+		if hasParser == hasCopy {
+			return pgerror.New(pgcode.SyntaxError, "exactly one of PARSER and COPY must be specified")
+		}
+		mutSc.SetTextSearchConfig(config)
+	}
+	return p.writeSchemaDescChange(params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()))
+}
+
+func (n *createTextSearchNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *createTextSearchNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *createTextSearchNode) Close(ctx context.Context)           {}
+func (n *createTextSearchNode) ReadingOwnWrites()                   {}
+
+// copyTextSearchConfigMappings returns the mappings of the named
+// configuration, for use by a new configuration in schema sc.
+func (p *planner) copyTextSearchConfigMappings(
+	ctx context.Context, sc *schemadesc.Mutable, source string,
+) ([]descpb.SchemaDescriptor_TextSearchConfigMapping, error) {
+	un, err := parser.ParseTableName(source)
+	if err != nil {
+		return nil, err
+	}
+	if isBuiltinTextSearchName(un) && tsearch.IsBuiltinConfig(un.Object()) {
+		dict, err := tsearch.GetBuiltinConfigDictionary(un.Object())
+		if err != nil {
+			return nil, err
+		}
+		var ret []descpb.SchemaDescriptor_TextSearchConfigMapping
+		for _, t := range tsearch.AllTokenTypes() {
+			ret = append(ret, descpb.SchemaDescriptor_TextSearchConfigMapping{
+				TokenType:    t.String(),
+				Dictionaries: []string{catconstants.PgCatalogName + "." + dict},
+			})
+		}
+		return ret, nil
+	}
+	_, sourceSc, err := p.lookupTextSearchObject(ctx, tree.TextSearchConfiguration, un, false /* withLeased */)
+	if err != nil {
+		return nil, err
+	}
+	if sourceSc == nil {
+		return nil, pgerror.Newf(pgcode.UndefinedObject, "text search configuration %q does not exist", source)
+	}
+	config, _ := sourceSc.GetTextSearchConfig(un.Object())
+	ret := make([]descpb.SchemaDescriptor_TextSearchConfigMapping, len(config.Mappings))
+	for i, m := range config.Mappings {
+		for _, dict := range m.Dictionaries {
+			if sourceSc.GetID() != sc.GetID() && !strings.HasPrefix(dict, catconstants.PgCatalogName+".") {
+				return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+					"cannot copy text search configuration %q to another schema because it uses dictionary %q",
+					source, dict)
+			}
+		}
+		ret[i] = descpb.SchemaDescriptor_TextSearchConfigMapping{
+			TokenType:    m.TokenType,
+			Dictionaries: append([]string(nil), m.Dictionaries...),
+		}
+	}
+	return ret, nil
+}
+
+type alterTextSearchDictionaryNode struct {
+	zeroInputPlanNode
+	n *tree.AlterTextSearchDictionary
+}
+
+// AlterTextSearchDictionary changes the options of a text search dictionary.
+func (p *planner) AlterTextSearchDictionary(
+	ctx context.Context, n *tree.AlterTextSearchDictionary,
+) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"ALTER TEXT SEARCH DICTIONARY",
+	); err != nil {
+		return nil, err
+	}
+	if err := checkTextSearchObjectsSupported(ctx, p); err != nil {
+		return nil, err
+	}
+	return &alterTextSearchDictionaryNode{n: n}, nil
+}
+
+func (n *alterTextSearchDictionaryNode) startExec(params runParams) error {
+	p := params.p
+	mutSc, err := p.resolveExistingTextSearchObject(params.ctx, tree.TextSearchDictionary, n.n.Name)
+	if err != nil {
+		return err
+	}
+	dict, _ := mutSc.GetTextSearchDictionary(n.n.Name.Object())
+	if err := p.checkTextSearchObjectOwner(params.ctx, tree.TextSearchDictionary, dict.Name, dict.Owner); err != nil {
+		return err
+	}
+	options := make(map[string]string, len(dict.Options))
+	for k, v := range dict.Options {
+		options[k] = v
+	}
+	for _, param := range n.n.Params {
+		key := strings.ToLower(param.Key)
+		if key == "template" {
+			return pgerror.New(pgcode.FeatureNotSupported, "cannot change the template of a text search dictionary")
+		}
+		// Setting an option to DEFAULT removes it.
+		if _, ok := param.Value.(tree.DefaultVal); ok {
+			delete(options, key)
+			continue
+		}
+		v, err := objectParamValue(param)
+		if err != nil {
+			return err
+		}
+		options[key] = v
+	}
+	if _, err := tsearch.NewDictionary(dict.Template, options); err != nil {
+		return err
+	}
+	dict.Options = options
+	mutSc.SetTextSearchDictionary(dict)
+	return p.writeSchemaDescChange(params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()))
+}
+
+func (n *alterTextSearchDictionaryNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *alterTextSearchDictionaryNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *alterTextSearchDictionaryNode) Close(ctx context.Context)           {}
+func (n *alterTextSearchDictionaryNode) ReadingOwnWrites()                   {}
+
+type alterTextSearchConfigNode struct {
+	zeroInputPlanNode
+	n *tree.AlterTextSearchConfig
+}
+
+// AlterTextSearchConfig changes the mappings or the name of a text search
+// configuration.
+func (p *planner) AlterTextSearchConfig(
+	ctx context.Context, n *tree.AlterTextSearchConfig,
+) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"ALTER TEXT SEARCH CONFIGURATION",
+	); err != nil {
+		return nil, err
+	}
+	if err := checkTextSearchObjectsSupported(ctx, p); err != nil {
+		return nil, err
+	}
+	return &alterTextSearchConfigNode{n: n}, nil
+}
+
+func (n *alterTextSearchConfigNode) startExec(params runParams) error {
+	p := params.p
+	mutSc, err := p.resolveExistingTextSearchObject(params.ctx, tree.TextSearchConfiguration, n.n.Name)
+	if err != nil {
+		return err
+	}
+	config, _ := mutSc.GetTextSearchConfig(n.n.Name.Object())
+	if err := p.checkTextSearchObjectOwner(params.ctx, tree.TextSearchConfiguration, config.Name, config.Owner); err != nil {
+		return err
+	}
+	mappings := make(map[tsearch.TokenType][]string, len(config.Mappings))
+	for _, m := range config.Mappings {
+		t, err := tsearch.TokenTypeFromName(m.TokenType)
+		if err != nil {
+			return err
+		}
+		mappings[t] = m.Dictionaries
+	}
+
+	switch t := n.n.Cmd.(type) {
+	case *tree.AlterTextSearchConfigAddMapping:
+		dicts, err := resolveTextSearchDictionaryRefs(mutSc, t.Dictionaries)
+		if err != nil {
+			return err
+		}
+		for _, name := range t.TokenTypes {
+			tt, err := tsearch.TokenTypeFromName(string(name))
+			if err != nil {
+				return err
+			}
+			if _, ok := mappings[tt]; ok {
+				return pgerror.Newf(pgcode.DuplicateObject, "mapping for token type %q already exists", name)
+			}
+			mappings[tt] = dicts
+		}
+
+	case *tree.AlterTextSearchConfigAlterMapping:
+		dicts, err := resolveTextSearchDictionaryRefs(mutSc, t.Dictionaries)
+		if err != nil {
+			return err
+		}
+		for _, name := range t.TokenTypes {
+			tt, err := tsearch.TokenTypeFromName(string(name))
+			if err != nil {
+				return err
+			}
+			mappings[tt] = dicts
+		}
+
+	case *tree.AlterTextSearchConfigReplaceMapping:
+		refs, err := resolveTextSearchDictionaryRefs(mutSc, []*tree.UnresolvedObjectName{t.Old, t.New})
+		if err != nil {
+			return err
+		}
+		oldDict, newDict := refs[0], refs[1]
+		types := tsearch.AllTokenTypes()
+		if len(t.TokenTypes) > 0 {
+			types = types[:0]
+			for _, name := range t.TokenTypes {
+				tt, err := tsearch.TokenTypeFromName(string(name))
+				if err != nil {
+					return err
+				}
+				types = append(types, tt)
+			}
+		}
+		for _, tt := range types {
+			dicts := append([]string(nil), mappings[tt]...)
+			for i := range dicts {
+				if dicts[i] == oldDict {
+					dicts[i] = newDict
+				}
+			}
+			if len(dicts) > 0 {
+				mappings[tt] = dicts
+			}
+		}
+
+	case *tree.AlterTextSearchConfigDropMapping:
+		for _, name := range t.TokenTypes {
+			tt, err := tsearch.TokenTypeFromName(string(name))
+			if err != nil {
+				return err
+			}
+			if _, ok := mappings[tt]; !ok {
+				if !t.IfExists {
+					return pgerror.Newf(pgcode.UndefinedObject, "mapping for token type %q does not exist", name)
+				}
+				p.BufferClientNotice(params.ctx, pgnotice.Newf(
+					"mapping for token type %q does not exist, skipping", name,
+				))
+				continue
+			}
+			delete(mappings, tt)
+		}
+
+	case *tree.AlterTextSearchConfigRename:
+		newName := string(t.NewName)
+		if textSearchObjectExists(mutSc, tree.TextSearchConfiguration, newName) {
+			return pgerror.Newf(pgcode.DuplicateObject,
+				"text search configuration %q already exists in schema %q", newName, mutSc.GetName())
+		}
+		if err := p.checkTextSearchConfigNotUsed(params.ctx, mutSc, config.Name, "rename"); err != nil {
+			return err
+		}
+		mutSc.RemoveTextSearchConfig(config.Name)
+		config.Name = newName
+
+	default:
+		return errors.AssertionFailedf("unknown ALTER TEXT SEARCH CONFIGURATION command %T", t)
+	}
+
+	config.Mappings = nil
+	for _, tt := range tsearch.AllTokenTypes() {
+		if dicts, ok := mappings[tt]; ok {
+			config.Mappings = append(config.Mappings, descpb.SchemaDescriptor_TextSearchConfigMapping{
+				TokenType:    tt.String(),
+				Dictionaries: dicts,
+			})
+		}
+	}
+	mutSc.SetTextSearchConfig(config)
+	return p.writeSchemaDescChange(params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()))
+}
+
+func (n *alterTextSearchConfigNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *alterTextSearchConfigNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *alterTextSearchConfigNode) Close(ctx context.Context)           {}
+func (n *alterTextSearchConfigNode) ReadingOwnWrites()                   {}
+
+type dropTextSearchNode struct {
+	zeroInputPlanNode
+	n *tree.DropTextSearch
+}
+
+// DropTextSearch drops text search configurations or dictionaries.
+func (p *planner) DropTextSearch(ctx context.Context, n *tree.DropTextSearch) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"DROP TEXT SEARCH",
+	); err != nil {
+		return nil, err
+	}
+	return &dropTextSearchNode{n: n}, nil
+}
+
+func (n *dropTextSearchNode) startExec(params runParams) error {
+	p := params.p
+	kind := textSearchObjectKind(n.n.Type)
+	for _, un := range n.n.Names {
+		db, sc, err := p.lookupTextSearchObject(params.ctx, n.n.Type, un, false /* withLeased */)
+		if err != nil {
+			return err
+		}
+		if sc == nil {
+			if !n.n.IfExists {
+				return pgerror.Newf(pgcode.UndefinedObject, "%s %q does not exist", kind, un.String())
+			}
+			p.BufferClientNotice(params.ctx, pgnotice.Newf("%s %q does not exist, skipping", kind, un.String()))
+			continue
+		}
+		mutSc, err := p.mutableSchemaForObjects(params.ctx, db, sc, "text search objects")
+		if err != nil {
+			return err
+		}
+		name := un.Object()
+		var owner string
+		if n.n.Type == tree.TextSearchConfiguration {
+			config, _ := mutSc.GetTextSearchConfig(name)
+			owner = config.Owner
+		} else {
+			dict, _ := mutSc.GetTextSearchDictionary(name)
+			owner = dict.Owner
+		}
+		if err := p.checkTextSearchObjectOwner(params.ctx, n.n.Type, name, owner); err != nil {
+			return err
+		}
+		if n.n.Type == tree.TextSearchConfiguration {
+			if err := p.checkTextSearchConfigNotUsed(params.ctx, mutSc, name, "drop"); err != nil {
+				return err
+			}
+			mutSc.RemoveTextSearchConfig(name)
+		} else {
+			// Configurations that use the dictionary depend on it.
+			var dependents []string
+			for configName, config := range mutSc.TextSearchConfigs {
+				if textSearchConfigUsesDictionary(config, name) {
+					dependents = append(dependents, configName)
+				}
+			}
+			sort.Strings(dependents)
+			if len(dependents) > 0 && n.n.DropBehavior != tree.DropCascade {
+				return errors.WithHint(
+					sqlerrors.NewDependentObjectErrorf(
+						"cannot drop %s %q because text search configuration %q depends on it",
+						kind, name, dependents[0],
+					),
+					"use CASCADE if you really want to drop it.",
+				)
+			}
+			for _, configName := range dependents {
+				if err := p.checkTextSearchConfigNotUsed(params.ctx, mutSc, configName, "drop"); err != nil {
+					return err
+				}
+				mutSc.RemoveTextSearchConfig(configName)
+			}
+			mutSc.RemoveTextSearchDictionary(name)
+		}
+		if err := p.writeSchemaDescChange(
+			params.ctx, mutSc, tree.AsStringWithFQNames(n.n, params.Ann()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *dropTextSearchNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *dropTextSearchNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *dropTextSearchNode) Close(ctx context.Context)           {}
+func (n *dropTextSearchNode) ReadingOwnWrites()                   {}
+
+// checkTextSearchObjectOwner returns an error unless the current user, or a
+// role it is a member of, is the given owner of a text search object. Admins
+// own all text search objects, including those created before owners were
+// recorded, which have no owner.
+func (p *planner) checkTextSearchObjectOwner(
+	ctx context.Context, typ tree.TextSearchObjectType, name, owner string,
+) error {
+	ok, err := p.checkRolePredicate(ctx, p.User(), func(role username.SQLUsername) (bool, error) {
+		if role.IsNodeUser() || role.IsRootUser() || role.IsAdminRole() {
+			return true, nil
+		}
+		return owner != "" && role.Normalized() == owner, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return pgerror.Newf(pgcode.InsufficientPrivilege,
+			"must be owner of %s %s", textSearchObjectKind(typ), tree.Name(name))
+	}
+	return nil
+}
+
+// checkTextSearchConfigNotUsed returns an error if a table or view in the
+// database of schema sc uses the given text search configuration of sc, so
+// that it cannot be
+// dropped or renamed as described by verb. Expressions refer to
+// configurations by name and are resolved when they are evaluated, so an
+// unqualified name is assumed to refer to a configuration with that name in
+// any schema.
+func (p *planner) checkTextSearchConfigNotUsed(
+	ctx context.Context, sc catalog.SchemaDescriptor, name, verb string,
+) error {
+	db, err := p.Descriptors().ByIDWithoutLeased(p.txn).Get().Database(ctx, sc.GetParentID())
+	if err != nil {
+		return err
+	}
+	tables, err := p.Descriptors().GetAllTablesInDatabase(ctx, p.txn, db)
+	if err != nil {
+		return err
+	}
+	refersToConfig := func(ref string) bool {
+		un, err := parser.ParseTableName(ref)
+		if err != nil || un.Object() != name {
+			return false
+		}
+		if isBuiltinTextSearchName(un) && tsearch.IsBuiltinConfig(un.Object()) {
+			return false
+		}
+		return (!un.HasExplicitSchema() || un.Schema() == sc.GetName()) &&
+			(!un.HasExplicitCatalog() || un.Catalog() == db.GetName())
+	}
+	var dependent catalog.TableDescriptor
+	if err := tables.ForEachDescriptor(func(desc catalog.Descriptor) error {
+		table, ok := desc.(catalog.TableDescriptor)
+		if !ok || table.Dropped() {
+			return nil
+		}
+		used, err := tableUsesTextSearchConfig(table, refersToConfig)
+		if err != nil || !used {
+			return err
+		}
+		dependent = table
+		return iterutil.StopIteration()
+	}); err != nil {
+		return err
+	}
+	if dependent == nil {
+		return nil
+	}
+	kind := "table"
+	if dependent.IsView() {
+		kind = "view"
+	}
+	return sqlerrors.NewDependentObjectErrorf(
+		"cannot %s text search configuration %q because %s %q depends on it",
+		verb, name, kind, dependent.GetName(),
+	)
+}
+
+// tableUsesTextSearchConfig returns whether an expression, view query or
+// trigger of the table passes a text search configuration for which
+// refersToConfig returns true to a builtin function.
+func tableUsesTextSearchConfig(
+	table catalog.TableDescriptor, refersToConfig func(ref string) bool,
+) (bool, error) {
+	var exprs []string
+	for _, col := range table.AllColumns() {
+		if col.IsComputed() {
+			exprs = append(exprs, col.GetComputeExpr())
+		}
+		if col.HasDefault() {
+			exprs = append(exprs, col.GetDefaultExpr())
+		}
+		if col.HasOnUpdate() {
+			exprs = append(exprs, col.GetOnUpdateExpr())
+		}
+	}
+	for _, idx := range table.AllIndexes() {
+		if idx.IsPartial() {
+			exprs = append(exprs, idx.GetPredicate())
+		}
+	}
+	for _, ck := range table.CheckConstraints() {
+		exprs = append(exprs, ck.GetExpr())
+	}
+	for i := range table.GetTriggers() {
+		trig := &table.GetTriggers()[i]
+		if trig.WhenExpr != "" {
+			exprs = append(exprs, trig.WhenExpr)
+		}
+		// The second argument of tsvector_update_trigger is a configuration.
+		if trig.BuiltinFuncName == "tsvector_update_trigger" && len(trig.FuncArgs) > 1 &&
+			refersToConfig(trig.FuncArgs[1]) {
+			return true, nil
+		}
+	}
+
+	var used bool
+	visit := func(expr tree.Expr) (recurse bool, newExpr tree.Expr, err error) {
+		if f, ok := expr.(*tree.FuncExpr); ok && len(f.Exprs) > 0 && takesTextSearchConfig(f) {
+			if ref, ok := constantString(f.Exprs[0]); ok && refersToConfig(ref) {
+				used = true
+				return false, expr, nil
+			}
+		}
+		return !used, expr, nil
+	}
+	for _, s := range exprs {
+		expr, err := parser.ParseExpr(s)
+		if err != nil {
+			return false, err
+		}
+		if _, err := tree.SimpleVisit(expr, visit); err != nil || used {
+			return used, err
+		}
+	}
+	if table.IsView() {
+		stmt, err := parser.ParseOne(table.GetViewQuery())
+		if err != nil {
+			return false, err
+		}
+		if _, err := tree.SimpleStmtVisit(stmt.AST, visit); err != nil {
+			return false, err
+		}
+	}
+	return used, nil
+}
+
+// takesTextSearchConfig returns whether the given call is to a builtin
+// function with an overload whose first parameter is a text search
+// configuration.
+func takesTextSearchConfig(f *tree.FuncExpr) bool {
+	un, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return false
+	}
+	fn, err := un.ToRoutineName()
+	if err != nil || (fn.ExplicitSchema && fn.Schema() != catconstants.PgCatalogName) {
+		return false
+	}
+	def, ok := tree.FunDefs[strings.ToLower(fn.Object())]
+	if !ok {
+		return false
+	}
+	for _, ov := range def.Definition {
+		if params, ok := ov.Types.(tree.ParamTypes); ok && len(params) > 0 && params[0].Name == "config" {
+			return true
+		}
+	}
+	return false
+}
+
+// constantString returns the value of the given expression if it is a string
+// constant, possibly with a type annotation or cast.
+func constantString(expr tree.Expr) (string, bool) {
+	for {
+		switch e := expr.(type) {
+		case *tree.AnnotateTypeExpr:
+			expr = e.Expr
+		case *tree.CastExpr:
+			expr = e.Expr
+		case *tree.ParenExpr:
+			expr = e.Expr
+		case *tree.StrVal:
+			return e.RawString(), true
+		case *tree.DString:
+			return string(*e), true
+		default:
+			return "", false
+		}
+	}
+}
+
+// ResolveTextSearchConfig is part of the eval.Planner interface.
+func (p *planner) ResolveTextSearchConfig(
+	ctx context.Context, name string,
+) (*tsearch.Config, error) {
+	if config, ok := p.textSearchConfigs[name]; ok {
+		return config, nil
+	}
+	un, err := parser.ParseTableName(name)
+	if err != nil {
+		return nil, err
+	}
+	if isBuiltinTextSearchName(un) && tsearch.IsBuiltinConfig(un.Object()) {
+		return tsearch.GetBuiltinConfig(un.Object())
+	}
+	_, sc, err := p.lookupTextSearchObject(ctx, tree.TextSearchConfiguration, un, true /* withLeased */)
+	if err != nil {
+		return nil, err
+	}
+	if sc == nil {
+		return nil, pgerror.Newf(pgcode.UndefinedObject, "text search configuration %q does not exist", name)
+	}
+	stored, _ := sc.GetTextSearchConfig(un.Object())
+	config, err := makeTextSearchConfig(sc, stored)
+	if err != nil {
+		return nil, err
+	}
+	if p.textSearchConfigs == nil {
+		p.textSearchConfigs = make(map[string]*tsearch.Config)
+	}
+	p.textSearchConfigs[name] = config
+	return config, nil
+}
+
+// makeTextSearchConfig builds the given configuration, which is stored in
+// schema sc.
+func makeTextSearchConfig(
+	sc catalog.SchemaDescriptor, stored descpb.SchemaDescriptor_TextSearchConfig,
+) (*tsearch.Config, error) {
+	config := tsearch.NewConfig(stored.Name)
+	built := make(map[string]tsearch.Dictionary)
+	for _, m := range stored.Mappings {
+		t, err := tsearch.TokenTypeFromName(m.TokenType)
+		if err != nil {
+			return nil, err
+		}
+		dicts := make([]tsearch.Dictionary, len(m.Dictionaries))
+		for i, name := range m.Dictionaries {
+			d, ok := built[name]
+			if !ok {
+				if builtin, isBuiltin := strings.CutPrefix(name, catconstants.PgCatalogName+"."); isBuiltin {
+					if d, ok = tsearch.GetBuiltinDictionary(builtin); !ok {
+						return nil, errors.AssertionFailedf("unknown built-in text search dictionary %q", name)
+					}
+				} else {
+					stored, ok := sc.GetTextSearchDictionary(name)
+					if !ok {
+						return nil, errors.AssertionFailedf("unknown text search dictionary %q", name)
+					}
+					if d, err = tsearch.NewDictionary(stored.Template, stored.Options); err != nil {
+						return nil, err
+					}
+				}
+				built[name] = d
+			}
+			dicts[i] = d
+		}
+		config.SetMapping(t, dicts)
+	}
+	return config, nil
+}
+
+// lookupTextSearchObject returns the database and the schema that contain the
+// text search configuration or dictionary with the given name. Unqualified
+// names are looked up in the schemas on the search path. The returned schema
+// is nil if there is no such object.
+func (p *planner) lookupTextSearchObject(
+	ctx context.Context, typ tree.TextSearchObjectType, un *tree.UnresolvedObjectName, withLeased bool,
+) (catalog.DatabaseDescriptor, catalog.SchemaDescriptor, error) {
+	return p.lookupObjectInSchema(ctx, un, withLeased, func(sc catalog.SchemaDescriptor) bool {
+		return textSearchObjectExists(sc, typ, un.Object())
+	})
+}
+
+// lookupObjectInSchema returns the database and the first schema on the search
+// path, or the explicitly named schema, for which exists returns true. It is
+// used for objects stored in schema descriptors. The schema is nil if no such
+// schema exists.
+func (p *planner) lookupObjectInSchema(
+	ctx context.Context,
+	un *tree.UnresolvedObjectName,
+	withLeased bool,
+	exists func(sc catalog.SchemaDescriptor) bool,
+) (catalog.DatabaseDescriptor, catalog.SchemaDescriptor, error) {
+	getter := p.Descriptors().ByName(p.txn)
+	if withLeased {
+		getter = p.Descriptors().ByNameWithLeased(p.txn)
+	}
+	dbName := p.CurrentDatabase()
+	if un.HasExplicitCatalog() {
+		dbName = un.Catalog()
+	}
+	db, err := getter.Get().Database(ctx, dbName)
+	if err != nil {
+		return nil, nil, err
+	}
+	var scNames []string
+	if un.HasExplicitSchema() {
+		scNames = []string{un.Schema()}
+	} else {
+		iter := p.CurrentSearchPath().Iter()
+		for scName, ok := iter.Next(); ok; scName, ok = iter.Next() {
+			scNames = append(scNames, scName)
+		}
+	}
+	for _, scName := range scNames {
+		sc, err := getter.MaybeGet().Schema(ctx, db, scName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if sc == nil || sc.SchemaKind() == catalog.SchemaVirtual {
+			continue
+		}
+		if exists(sc) {
+			return db, sc, nil
+		}
+	}
+	return db, nil, nil
+}
+
+// resolveExistingTextSearchObject returns the mutable descriptor of the schema
+// that contains the given text search configuration or dictionary, after
+// checking that the user may modify it.
+func (p *planner) resolveExistingTextSearchObject(
+	ctx context.Context, typ tree.TextSearchObjectType, un *tree.UnresolvedObjectName,
+) (*schemadesc.Mutable, error) {
+	db, sc, err := p.lookupTextSearchObject(ctx, typ, un, false /* withLeased */)
+	if err != nil {
+		return nil, err
+	}
+	if sc == nil {
+		return nil, pgerror.Newf(pgcode.UndefinedObject, "%s %q does not exist", textSearchObjectKind(typ), un.String())
+	}
+	return p.mutableSchemaForObjects(ctx, db, sc, "text search objects")
+}
+
+// mutableSchemaForObjects returns the mutable descriptor of a schema in which
+// objects stored in the schema descriptor, such as text search objects and
+// collations, are created, altered or dropped, after checking that the user
+// has the CREATE privilege on it. The kind of the objects is used in errors.
+func (p *planner) mutableSchemaForObjects(
+	ctx context.Context, db catalog.DatabaseDescriptor, sc catalog.SchemaDescriptor, kind string,
+) (*schemadesc.Mutable, error) {
+	switch sc.SchemaKind() {
+	case catalog.SchemaPublic, catalog.SchemaUserDefined:
+	case catalog.SchemaVirtual:
+		return nil, sqlerrors.NewCannotModifyVirtualSchemaError(sc.GetName())
+	default:
+		return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+			"cannot create %s in schema %q", kind, sc.GetName())
+	}
+	if err := p.canCreateOnSchema(ctx, sc.GetID(), db.GetID(), p.User(), checkPublicSchema); err != nil {
+		return nil, err
+	}
+	return p.Descriptors().MutableByID(p.txn).Schema(ctx, sc.GetID())
+}
+
+// resolveTextSearchDictionaryRefs returns the names under which a
+// configuration in schema sc refers to the given dictionaries: built-in
+// dictionaries are qualified with pg_catalog, and dictionaries in sc are
+// unqualified. As with other objects, built-in dictionaries take precedence
+// over user-defined dictionaries with the same unqualified name.
+func resolveTextSearchDictionaryRefs(
+	sc catalog.SchemaDescriptor, names []*tree.UnresolvedObjectName,
+) ([]string, error) {
+	ret := make([]string, len(names))
+	for i, un := range names {
+		name := un.Object()
+		if isBuiltinTextSearchName(un) {
+			if _, ok := tsearch.GetBuiltinDictionary(name); ok {
+				ret[i] = catconstants.PgCatalogName + "." + name
+				continue
+			}
+		}
+		if un.HasExplicitSchema() && un.Schema() != sc.GetName() && un.Schema() != catconstants.PgCatalogName {
+			return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+				"text search configurations can only use dictionaries in their own schema %q", sc.GetName())
+		}
+		if _, ok := sc.GetTextSearchDictionary(name); !ok || un.Schema() == catconstants.PgCatalogName {
+			return nil, pgerror.Newf(pgcode.UndefinedObject, "text search dictionary %q does not exist", un.String())
+		}
+		ret[i] = name
+	}
+	return ret, nil
+}
+
+// checkTextSearchObjectsSupported returns an error if the text search objects
+// stored in schema descriptors cannot be written at the active cluster
+// version, since nodes running older versions would drop them.
+func checkTextSearchObjectsSupported(ctx context.Context, p *planner) error {
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_2_TextSearchObjects) {
+		return pgerror.New(pgcode.FeatureNotSupported,
+			"text search configurations and dictionaries are not supported until the upgrade to v26.2 is finalized")
+	}
+	return nil
+}
+
+// isBuiltinTextSearchName returns whether the given name may refer to a
+// built-in text search object, which lives in pg_catalog.
+func isBuiltinTextSearchName(un *tree.UnresolvedObjectName) bool {
+	return !un.HasExplicitSchema() || un.Schema() == catconstants.PgCatalogName
+}
+
+func textSearchObjectExists(sc catalog.SchemaDescriptor, typ tree.TextSearchObjectType, name string) bool {
+	var ok bool
+	if typ == tree.TextSearchConfiguration {
+		_, ok = sc.GetTextSearchConfig(name)
+	} else {
+		_, ok = sc.GetTextSearchDictionary(name)
+	}
+	return ok
+}
+
+func textSearchConfigUsesDictionary(
+	config descpb.SchemaDescriptor_TextSearchConfig, dict string,
+) bool {
+	for _, m := range config.Mappings {
+		for _, d := range m.Dictionaries {
+			if d == dict {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func textSearchObjectKind(typ tree.TextSearchObjectType) string {
+	if typ == tree.TextSearchConfiguration {
+		return "text search configuration"
+	}
+	return "text search dictionary"
+}
+
+// objectParamValue returns the value of a parameter of a text search
+// configuration, text search dictionary or collation. Like in Postgres, values
+// may be identifiers, string literals, numbers or Booleans.
+func objectParamValue(param tree.StorageParam) (string, error) {
+	switch v := paramparse.UnresolvedNameToStrVal(param.Value).(type) {
+	case *tree.StrVal:
+		return v.RawString(), nil
+	case tree.DefaultVal:
+		return "default", nil
+	case *tree.NumVal, *tree.DBool:
+		return tree.AsString(v), nil
+	}
+	return "", pgerror.Newf(pgcode.InvalidParameterValue,
+		"invalid value for parameter %q: %s", param.Key, tree.AsString(param.Value))
+}
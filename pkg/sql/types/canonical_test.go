@@ -448,6 +448,13 @@ func TestCanonical(t *testing.T) {
 			expected:  LTree,
 		},
 
+		// XMLFamily
+		{
+			name:      "XML",
+			inputType: XML,
+			expected:  XML,
+		},
+
 		// Composite Type
 		{
 			name:      "CompositeType",
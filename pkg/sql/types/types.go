@@ -286,6 +286,8 @@ func (t *T) Canonical() *T {
 		return Jsonpath
 	case LTreeFamily:
 		return LTree
+	case XMLFamily:
+		return XML
 	case AnyFamily:
 		return Any
 	case CollatedStringFamily:
@@ -710,6 +712,16 @@ var (
 		},
 	}
 
+	// XML is the type of a well-formed XML document or content fragment. The
+	// underlying value is the text of the XML.
+	XML = &T{
+		InternalType: InternalType{
+			Family: XMLFamily,
+			Oid:    oid.T_xml,
+			Locale: &emptyLocale,
+		},
+	}
+
 	// Scalar contains all types that meet this criteria:
 	//
 	//   1. Scalar type (no ArrayFamily or TupleFamily types).
@@ -892,6 +904,10 @@ var (
 			Family: ArrayFamily, ArrayContents: Jsonpath, Oid: oidext.T__jsonpath, Locale: &emptyLocale,
 		},
 	}
+
+	// XMLArray is the type of an array value having XML-typed elements.
+	XMLArray = &T{InternalType: InternalType{
+		Family: ArrayFamily, ArrayContents: XML, Oid: oid.T__xml, Locale: &emptyLocale}}
 )
 
 // Unexported wrapper types.
@@ -1767,6 +1783,7 @@ var familyNames = map[Family]redact.SafeString{
 	EncodedKeyFamily:     "encodedkey",
 	JsonpathFamily:       "jsonpath",
 	LTreeFamily:          "ltree",
+	XMLFamily:            "xml",
 }
 
 // Name returns a user-friendly word indicating the family type.
@@ -1876,6 +1893,9 @@ func (t *T) Name() string {
 	case LTreeFamily:
 		return "ltree"
 
+	case XMLFamily:
+		return "xml"
+
 	default:
 		return string(fam.Name())
 	}
@@ -2135,6 +2155,8 @@ func (t *T) SQLStandardNameWithTypmod(haveTypmod bool, typmod int) string {
 		return t.TypeMeta.Name.Basename()
 	case LTreeFamily:
 		return t.Name()
+	case XMLFamily:
+		return "xml"
 	default:
 		panic(errors.AssertionFailedf("unexpected Family: %v", errors.Safe(t.Family())))
 	}
@@ -3376,7 +3398,6 @@ var postgresPredefinedTypeIssues = map[string]int{
 	"money":         41578,
 	"path":          21286,
 	"txid_snapshot": -1,
-	"xml":           43355,
 }
 
 // SQLString outputs the GeoMetadata in a SQL-compatible string.
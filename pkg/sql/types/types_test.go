@@ -347,6 +347,11 @@ func TestTypes(t *testing.T) {
 			Family: JsonpathFamily, Oid: oidext.T_jsonpath, Locale: &emptyLocale}}},
 		{Jsonpath, MakeScalar(JsonpathFamily, oidext.T_jsonpath, 0, 0, emptyLocale)},
 
+		// XML
+		{XML, &T{InternalType: InternalType{
+			Family: XMLFamily, Oid: oid.T_xml, Locale: &emptyLocale}}},
+		{XML, MakeScalar(XMLFamily, oid.T_xml, 0, 0, emptyLocale)},
+
 		// OID
 		{Oid, &T{InternalType: InternalType{
 			Family: OidFamily, Oid: oid.T_oid, Locale: &emptyLocale}}},
@@ -1080,6 +1085,7 @@ func TestWithoutTypeModifiers(t *testing.T) {
 		{RefCursor, RefCursor},
 		{CIText, CIText},
 		{LTree, LTree},
+		{XML, XML},
 	}
 
 	for _, tc := range testCases {
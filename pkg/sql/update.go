@@ -168,8 +168,10 @@ func (u *updateNode) processBatch(params runParams) (lastBatch bool, err error)
 		if err = u.run.tu.finalize(params.ctx); err != nil {
 			return false, err
 		}
-		// Possibly initiate a run of CREATE STATISTICS.
+		// Possibly initiate a run of CREATE STATISTICS and a summarization of
+		// the BRIN indexes of the table.
 		params.ExecCfg().StatsRefresher.NotifyMutation(params.ctx, u.run.tu.tableDesc(), int(u.run.rowsAffected()))
+		params.ExecCfg().BrinSummarizer.NotifyMutation(params.ctx, u.run.tu.tableDesc(), int(u.run.rowsAffected()))
 	}
 	return lastBatch, nil
 }
@@ -113,8 +113,10 @@ func (u *updateSwapNode) processBatch(params runParams) error {
 		return err
 	}
 
-	// Possibly initiate a run of CREATE STATISTICS.
+	// Possibly initiate a run of CREATE STATISTICS and a summarization of
+	// the BRIN indexes of the table.
 	params.ExecCfg().StatsRefresher.NotifyMutation(params.ctx, u.run.tu.tableDesc(), int(u.run.rowsAffected()))
+	params.ExecCfg().BrinSummarizer.NotifyMutation(params.ctx, u.run.tu.tableDesc(), int(u.run.rowsAffected()))
 
 	return nil
 }
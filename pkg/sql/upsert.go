@@ -128,8 +128,10 @@ func (n *upsertNode) processBatch(params runParams) (lastBatch bool, err error)
 		if err = n.run.tw.finalize(params.ctx); err != nil {
 			return false, err
 		}
-		// Possibly initiate a run of CREATE STATISTICS.
+		// Possibly initiate a run of CREATE STATISTICS and a summarization of
+		// the BRIN indexes of the table.
 		params.ExecCfg().StatsRefresher.NotifyMutation(params.ctx, n.run.tw.tableDesc(), int(n.run.tw.rowsAffected()))
+		params.ExecCfg().BrinSummarizer.NotifyMutation(params.ctx, n.run.tw.tableDesc(), int(n.run.tw.rowsAffected()))
 	}
 	return lastBatch, nil
 }
@@ -0,0 +1,277 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/security/password"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/cockroach/pkg/util/log/severity"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/errors"
+)
+
+// This file implements the account lockout and password history
+// policies. Both are keyed by user ID rather than username, so that
+// they follow a user across renames and are not inherited by a new
+// user created with the name of a dropped one.
+//
+// Lockout state lives in system.user_login_failures and is maintained
+// by the pgwire and DB Console login paths via GetUserLockout,
+// RecordFailedLogin and ResetFailedLogins. Password history lives in
+// system.password_history and is maintained by CREATE/ALTER ROLE.
+
+// lockoutEnabled returns whether failed logins must be tracked for the
+// given user.
+func lockoutEnabled(ctx context.Context, execCfg *ExecutorConfig, user username.SQLUsername) bool {
+	if user.IsRootUser() || user.IsNodeUser() {
+		// The root and node users are never locked out, so that an
+		// attacker cannot deny access to the cluster's administrator.
+		return false
+	}
+	return security.LockoutThreshold.Get(&execCfg.Settings.SV) > 0 &&
+		execCfg.Settings.Version.IsActive(ctx, clusterversion.V26_2_AddSystemLoginPolicyTables)
+}
+
+// GetUserLockout returns the number of consecutive failed logins
+// recorded for the given user, and the time until which the user is
+// locked out. The returned time is zero if the user is not currently
+// locked out.
+func GetUserLockout(
+	ctx context.Context, execCfg *ExecutorConfig, user username.SQLUsername,
+) (failedAttempts int64, lockedUntil time.Time, err error) {
+	if !lockoutEnabled(ctx, execCfg, user) {
+		return 0, time.Time{}, nil
+	}
+	row, err := execCfg.InternalDB.Executor().QueryRowEx(
+		ctx, "get-user-lockout", nil, /* txn */
+		sessiondata.NodeUserSessionDataOverride,
+		`SELECT f.failed_attempts, f.locked_until
+FROM system.public.user_login_failures AS f
+JOIN system.public.users AS u ON f.user_id = u.user_id
+WHERE u.username = $1`,
+		user.Normalized(),
+	)
+	if err != nil || row == nil {
+		return 0, time.Time{}, err
+	}
+	failedAttempts = int64(tree.MustBeDInt(row[0]))
+	if ts, ok := row[1].(*tree.DTimestampTZ); ok && ts.Time.After(timeutil.Now()) {
+		lockedUntil = ts.Time
+	}
+	return failedAttempts, lockedUntil, nil
+}
+
+// RecordFailedLogin records a failed login for the given user. If the
+// number of consecutive failures reaches
+// server.user_login.lockout.failed_attempts_threshold, the user is
+// locked out for server.user_login.lockout.duration and a UserLockedOut
+// event is logged. The returned time is the end of the lockout, or zero
+// if this failure did not lock the user out.
+func RecordFailedLogin(
+	ctx context.Context, execCfg *ExecutorConfig, user username.SQLUsername,
+) (lockedUntil time.Time, err error) {
+	if execCfg.TenantReadOnly || !lockoutEnabled(ctx, execCfg, user) {
+		return time.Time{}, nil
+	}
+	threshold := security.LockoutThreshold.Get(&execCfg.Settings.SV)
+	var failedAttempts int64
+	if err := execCfg.InternalDB.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+		lockedUntil, failedAttempts = time.Time{}, 0
+		row, err := txn.QueryRowEx(
+			ctx, "record-failed-login", txn.KV(),
+			sessiondata.NodeUserSessionDataOverride,
+			`INSERT INTO system.public.user_login_failures (user_id, failed_attempts, last_failure)
+SELECT user_id, 1, now() FROM system.public.users WHERE username = $1
+ON CONFLICT (user_id) DO UPDATE
+SET failed_attempts = user_login_failures.failed_attempts + 1, last_failure = now()
+RETURNING user_id, failed_attempts`,
+			user.Normalized(),
+		)
+		if err != nil || row == nil {
+			// The user does not exist; there is nothing to record.
+			return err
+		}
+		failedAttempts = int64(tree.MustBeDInt(row[1]))
+		if failedAttempts < threshold {
+			return nil
+		}
+		// The counter restarts from zero, so that the user gets a new
+		// series of attempts once the lockout expires.
+		lockedUntil = timeutil.Now().Add(security.LockoutDuration.Get(&execCfg.Settings.SV))
+		_, err = txn.ExecEx(
+			ctx, "lock-out-user", txn.KV(),
+			sessiondata.NodeUserSessionDataOverride,
+			`UPDATE system.public.user_login_failures SET failed_attempts = 0, locked_until = $2 WHERE user_id = $1`,
+			row[0], lockedUntil,
+		)
+		return err
+	}); err != nil {
+		return time.Time{}, err
+	}
+	if !lockedUntil.IsZero() {
+		log.StructuredEvent(ctx, severity.WARNING, &eventpb.UserLockedOut{
+			RoleName:       user.Normalized(),
+			FailedAttempts: failedAttempts,
+			LockedUntil:    lockedUntil.UnixNano(),
+		})
+	}
+	return lockedUntil, nil
+}
+
+// ResetFailedLogins clears the failed login counter of the given user.
+// It is called after a successful login, and only needs to be called
+// if GetUserLockout reported failed attempts.
+func ResetFailedLogins(ctx context.Context, execCfg *ExecutorConfig, user username.SQLUsername) error {
+	if execCfg.TenantReadOnly || !lockoutEnabled(ctx, execCfg, user) {
+		return nil
+	}
+	_, err := execCfg.InternalDB.Executor().ExecEx(
+		ctx, "reset-failed-logins", nil, /* txn */
+		sessiondata.NodeUserSessionDataOverride,
+		`DELETE FROM system.public.user_login_failures
+WHERE user_id = (SELECT user_id FROM system.public.users WHERE username = $1)`,
+		user.Normalized(),
+	)
+	return err
+}
+
+// passwordHistoryCount returns the number of previous passwords that
+// cannot be reused, or 0 if the password history is not maintained.
+func (p *planner) passwordHistoryCount(ctx context.Context) int64 {
+	st := p.ExecCfg().Settings
+	if !st.Version.IsActive(ctx, clusterversion.V26_2_AddSystemLoginPolicyTables) {
+		return 0
+	}
+	return security.PasswordHistoryCount.Get(&st.SV)
+}
+
+// checkPasswordHistory returns an error if the new password of the
+// given user matches their current password or one of the last
+// server.user_login.password_history.count passwords. newHash is the
+// hash computed for the new password; passwordStr is the value
+// provided by the client, which may be a pre-computed hash.
+func (p *planner) checkPasswordHistory(
+	ctx context.Context, user username.SQLUsername, passwordStr string, newHash []byte,
+) error {
+	count := p.passwordHistoryCount(ctx)
+	if count == 0 {
+		return nil
+	}
+	rows, err := p.InternalSQLTxn().QueryBufferedEx(
+		ctx, "get-password-history", p.txn,
+		sessiondata.NodeUserSessionDataOverride,
+		`SELECT "hashedPassword" FROM system.public.users
+WHERE username = $1 AND "hashedPassword" IS NOT NULL
+UNION
+(SELECT h.hashed_password
+FROM system.public.password_history AS h
+JOIN system.public.users AS u ON h.user_id = u.user_id
+WHERE u.username = $1
+ORDER BY h.changed_at DESC
+LIMIT $2)`,
+		user.Normalized(), count,
+	)
+	if err != nil {
+		return err
+	}
+	sem := security.GetExpensiveHashComputeSem(ctx)
+	for _, row := range rows {
+		prevHash := []byte(tree.MustBeDBytes(row[0]))
+		if bytes.Equal(prevHash, newHash) {
+			return passwordReusedError(count)
+		}
+		ok, err := password.CompareHashAndCleartextPassword(
+			ctx, password.LoadPasswordHash(ctx, prevHash), passwordStr, sem)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return passwordReusedError(count)
+		}
+	}
+	return nil
+}
+
+func passwordReusedError(count int64) error {
+	return errors.WithHintf(
+		pgerror.WithCandidateCode(security.ErrPasswordReused, pgcode.InvalidPassword),
+		"The new password must differ from the last %d passwords.", count)
+}
+
+// recordPasswordHistory adds the new password hash of the given user to
+// system.password_history, and removes the entries that are no longer
+// needed to enforce server.user_login.password_history.count.
+func (p *planner) recordPasswordHistory(
+	ctx context.Context, user username.SQLUsername, hashedPassword []byte,
+) error {
+	count := p.passwordHistoryCount(ctx)
+	if count == 0 {
+		return nil
+	}
+	if _, err := p.InternalSQLTxn().ExecEx(
+		ctx, "record-password-history", p.txn,
+		sessiondata.NodeUserSessionDataOverride,
+		`UPSERT INTO system.public.password_history (user_id, changed_at, hashed_password)
+SELECT user_id, now(), $2 FROM system.public.users WHERE username = $1`,
+		user.Normalized(), hashedPassword,
+	); err != nil {
+		return err
+	}
+	_, err := p.InternalSQLTxn().ExecEx(
+		ctx, "prune-password-history", p.txn,
+		sessiondata.NodeUserSessionDataOverride,
+		`DELETE FROM system.public.password_history
+WHERE user_id = (SELECT user_id FROM system.public.users WHERE username = $1)
+AND changed_at < (
+  SELECT min(changed_at) FROM (
+    SELECT h.changed_at FROM system.public.password_history AS h
+    JOIN system.public.users AS u ON h.user_id = u.user_id
+    WHERE u.username = $1
+    ORDER BY h.changed_at DESC
+    LIMIT $2
+  )
+)`,
+		user.Normalized(), count,
+	)
+	return err
+}
+
+// deleteLoginPolicyRows removes the lockout and password history state
+// of the given user. It must be called before the user is deleted from
+// system.users.
+func (p *planner) deleteLoginPolicyRows(ctx context.Context, user username.SQLUsername) error {
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_2_AddSystemLoginPolicyTables) {
+		return nil
+	}
+	for _, stmt := range []string{
+		`DELETE FROM system.public.user_login_failures
+WHERE user_id = (SELECT user_id FROM system.public.users WHERE username = $1)`,
+		`DELETE FROM system.public.password_history
+WHERE user_id = (SELECT user_id FROM system.public.users WHERE username = $1)`,
+	} {
+		if _, err := p.InternalSQLTxn().ExecEx(
+			ctx, "drop-role-login-policy", p.txn,
+			sessiondata.NodeUserSessionDataOverride,
+			stmt, user.Normalized(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
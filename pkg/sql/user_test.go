@@ -19,6 +19,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sqltestutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils/serverutils"
+	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
@@ -275,3 +276,57 @@ GRANT admin TO foo`); err != nil {
 		}()
 	}()
 }
+
+// TestUserLockout verifies that a user is prevented from logging in after
+// server.user_login.lockout.failed_attempts_threshold consecutive failed
+// logins, and that a successful login resets the count of failures.
+func TestUserLockout(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	srv, db, _ := serverutils.StartServer(t, base.TestServerArgs{})
+	defer srv.Stopper().Stop(ctx)
+	s := srv.ApplicationLayer()
+	sqlDB := sqlutils.MakeSQLRunner(db)
+
+	sqlDB.Exec(t, `CREATE USER foo WITH PASSWORD 'correct'`)
+	sqlDB.Exec(t, `SET CLUSTER SETTING server.user_login.lockout.failed_attempts_threshold = 2`)
+	sqlDB.Exec(t, `SET CLUSTER SETTING server.user_login.lockout.duration = '1h'`)
+
+	login := func(password string) error {
+		pgURL, cleanup := s.PGUrl(t, serverutils.UserPassword("foo", password), serverutils.ClientCerts(false))
+		defer cleanup()
+		conn, err := sqltestutils.PGXConn(t, pgURL)
+		if err != nil {
+			return err
+		}
+		return conn.Close(ctx)
+	}
+	const failuresQuery = `
+SELECT f.failed_attempts, f.locked_until IS NOT NULL
+FROM system.user_login_failures AS f JOIN system.users AS u ON f.user_id = u.user_id
+WHERE u.username = 'foo'`
+
+	require.ErrorContains(t, login("wrong"), "password authentication failed")
+	sqlDB.CheckQueryResults(t, failuresQuery, [][]string{{"1", "false"}})
+	require.ErrorContains(t, login("wrong"), "password authentication failed")
+	sqlDB.CheckQueryResults(t, failuresQuery, [][]string{{"0", "true"}})
+
+	// The correct password is refused while the user is locked out, with
+	// the same error as an invalid password.
+	require.ErrorContains(t, login("correct"), "password authentication failed")
+
+	// Once the lockout expires, the user can log in again, and a successful
+	// login clears the failures.
+	sqlDB.Exec(t, `UPDATE system.user_login_failures SET locked_until = now() - '1s'::INTERVAL`)
+	require.ErrorContains(t, login("wrong"), "password authentication failed")
+	sqlDB.CheckQueryResults(t, failuresQuery, [][]string{{"1", "true"}})
+	require.NoError(t, login("correct"))
+	sqlDB.CheckQueryResults(t, failuresQuery, [][]string{})
+
+	// Dropping the user removes its lockout state.
+	require.ErrorContains(t, login("wrong"), "password authentication failed")
+	sqlDB.Exec(t, `DROP USER foo`)
+	sqlDB.CheckQueryResults(t, `SELECT count(*) FROM system.user_login_failures`, [][]string{{"0"}})
+}
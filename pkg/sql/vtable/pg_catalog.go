@@ -1784,7 +1784,9 @@ CREATE TABLE pg_catalog.pg_statistic_ext_data (
 	stxdmcv BYTES
 )`
 
-// PgCatalogLargeobjectMetadata is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogLargeobjectMetadata describes the schema of the
+// pg_catalog.pg_largeobject_metadata table.
+// https://www.postgresql.org/docs/current/catalog-pg-largeobject-metadata.html
 const PgCatalogLargeobjectMetadata = `
 CREATE TABLE pg_catalog.pg_largeobject_metadata (
 	oid OID,
@@ -1931,7 +1933,9 @@ CREATE TABLE pg_catalog.pg_ts_dict (
 	dictinitoption STRING
 )`
 
-// PgCatalogLargeobject is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogLargeobject describes the schema of the pg_catalog.pg_largeobject
+// table.
+// https://www.postgresql.org/docs/current/catalog-pg-largeobject.html
 const PgCatalogLargeobject = `
 CREATE TABLE pg_catalog.pg_largeobject (
 	loid OID,
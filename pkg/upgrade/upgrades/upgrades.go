@@ -87,6 +87,30 @@ var upgrades = []upgradebase.Upgrade{
 		upgrade.RestoreActionNotRequired("cluster restore does not restore this table"),
 	),
 
+	upgrade.NewTenantUpgrade(
+		"create large_objects and large_object_pages tables",
+		clusterversion.V26_2_AddSystemLargeObjectTables.Version(),
+		upgrade.NoPrecondition,
+		createLargeObjectTables,
+		upgrade.RestoreActionNotRequired("cluster restore restores these tables"),
+	),
+
+	upgrade.NewTenantUpgrade(
+		"create user_login_failures and password_history tables",
+		clusterversion.V26_2_AddSystemLoginPolicyTables.Version(),
+		upgrade.NoPrecondition,
+		createLoginPolicyTables,
+		upgrade.RestoreActionNotRequired("cluster restore restores password_history and does not restore user_login_failures"),
+	),
+
+	upgrade.NewTenantUpgrade(
+		"create audit_log_checkpoints table",
+		clusterversion.V26_2_AddSystemAuditLogCheckpointsTable.Version(),
+		upgrade.NoPrecondition,
+		createAuditLogCheckpointsTable,
+		upgrade.RestoreActionNotRequired("cluster restore does not restore this table"),
+	),
+
 	// Note: when starting a new release version, the first upgrade (for
 	// Vxy_zStart) must be a newFirstUpgrade. Keep this comment at the bottom.
 }
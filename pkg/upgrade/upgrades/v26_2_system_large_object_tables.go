@@ -0,0 +1,35 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package upgrades
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/upgrade"
+)
+
+// createLargeObjectTables creates the system.large_objects and
+// system.large_object_pages tables, and the system.large_object_oid_seq
+// sequence.
+func createLargeObjectTables(
+	ctx context.Context, _ clusterversion.ClusterVersion, d upgrade.TenantDeps,
+) error {
+	for _, table := range []catalog.TableDescriptor{
+		systemschema.LargeObjectsTable, systemschema.LargeObjectPagesTable,
+		systemschema.LargeObjectOIDSequence,
+	} {
+		if err := createSystemTable(
+			ctx, d.DB, d.Settings, d.Codec, table, tree.LocalityLevelTable,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
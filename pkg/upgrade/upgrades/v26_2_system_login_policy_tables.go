@@ -0,0 +1,33 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package upgrades
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/upgrade"
+)
+
+// createLoginPolicyTables creates the system.user_login_failures and
+// system.password_history tables.
+func createLoginPolicyTables(
+	ctx context.Context, _ clusterversion.ClusterVersion, d upgrade.TenantDeps,
+) error {
+	for _, table := range []catalog.TableDescriptor{
+		systemschema.UserLoginFailuresTable, systemschema.PasswordHistoryTable,
+	} {
+		if err := createSystemTable(
+			ctx, d.DB, d.Settings, d.Codec, table, tree.LocalityLevelTable,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,388 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package auditchain implements the hash chain that makes the events logged
+// on the SENSITIVE_ACCESS channel tamper-evident.
+//
+// When a chain is configured, every event logged on the channel is stamped
+// with the ID of the chain, its position in the chain and the HMAC of the
+// previous event. The HMAC of an event covers all its fields, including the
+// stamp, so that an event cannot be modified, removed or inserted without
+// breaking the chain. Checkpoints of the head of the chain, signed with a key
+// derived from the same key and stored outside of the log files, additionally
+// allow detecting a truncated chain.
+//
+// The key is read by each node from a local file, so that it cannot be
+// obtained, nor the events and checkpoints forged, through SQL.
+//
+// The HMAC is computed over a canonical form of the JSON fields of the event,
+// which does not depend on redaction markers or on the order of the fields, so
+// that it can be recomputed from the log files written by a sink in the
+// crdb-v2 format. Sinks that redact sensitive information make the events
+// impossible to verify.
+package auditchain
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/redact"
+)
+
+// Chain is the state of an audit log hash chain. It is safe for concurrent
+// use.
+type Chain struct {
+	id  string
+	key []byte
+	// checkpointKey is the key used to sign the checkpoints of the chain.
+	checkpointKey []byte
+
+	mu struct {
+		syncutil.Mutex
+		// seq is the sequence number of the last event of the chain.
+		seq uint64
+		// head is the HMAC of the last event of the chain.
+		head []byte
+	}
+}
+
+// NewChain creates a new, empty chain with the given ID, whose events are
+// authenticated with the given key.
+func NewChain(id string, key []byte) *Chain {
+	return &Chain{id: id, key: key, checkpointKey: deriveCheckpointKey(key)}
+}
+
+// deriveCheckpointKey returns the key used to sign the checkpoints of the
+// chains authenticated with the given key, so that a checkpoint signature
+// cannot be passed off as the HMAC of an event.
+func deriveCheckpointKey(key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte("audit log checkpoint"))
+	return h.Sum(nil)
+}
+
+// ReadKeyFile reads the key of the chains from the given node-local file.
+// Surrounding whitespace is ignored.
+func ReadKeyFile(path string) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading the audit log chain key")
+	}
+	key = bytes.TrimSpace(key)
+	if len(key) == 0 {
+		return nil, errors.Newf("audit log chain key file %s is empty", path)
+	}
+	return key, nil
+}
+
+// ID returns the ID of the chain.
+func (c *Chain) ID() string {
+	return c.id
+}
+
+// Stamp appends the event to the chain, setting the chain fields of its
+// common details.
+func (c *Chain) Stamp(event logpb.EventPayload) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	common := event.CommonDetails()
+	common.AuditChainID = c.id
+	common.AuditSequence = c.mu.seq + 1
+	common.AuditPrevHMAC = hex.EncodeToString(c.mu.head)
+	_, b := event.AppendJSONFields(false /* printComma */, nil /* b */)
+	mac, err := EventHMAC(c.key, []byte(b))
+	if err != nil {
+		common.AuditChainID, common.AuditSequence, common.AuditPrevHMAC = "", 0, ""
+		return err
+	}
+	c.mu.seq++
+	c.mu.head = mac
+	return nil
+}
+
+// Head returns the sequence number and the HMAC of the last event of the
+// chain. The sequence number is 0 if no event was added to the chain yet.
+func (c *Chain) Head() (seq uint64, mac []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.mu.seq, c.mu.head
+}
+
+// Checkpoint returns a signed checkpoint of the head of the chain.
+func (c *Chain) Checkpoint() Checkpoint {
+	seq, mac := c.Head()
+	cp := Checkpoint{ChainID: c.id, Sequence: seq, HMAC: mac}
+	cp.Signature = cp.sign(c.checkpointKey)
+	return cp
+}
+
+// Canonicalize returns the canonical form of the JSON fields of a structured
+// event, as produced by its AppendJSONFields method or found in a log file.
+// The surrounding braces are optional. In the canonical form, the fields are
+// sorted by name and the redaction markers are removed.
+func Canonicalize(payload []byte) ([]byte, error) {
+	payload = bytes.TrimSpace(payload)
+	if len(payload) == 0 || payload[0] != '{' {
+		payload = append(append([]byte{'{'}, payload...), '}')
+	}
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	// Numbers are kept as they were written, to avoid losing the precision of
+	// the 64-bit integers.
+	dec.UseNumber()
+	var fields map[string]interface{}
+	if err := dec.Decode(&fields); err != nil {
+		return nil, errors.Wrap(err, "decoding structured event")
+	}
+	return json.Marshal(stripMarkers(fields))
+}
+
+func stripMarkers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		return redact.RedactableString(t).StripMarkers()
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = stripMarkers(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = stripMarkers(e)
+		}
+	}
+	return v
+}
+
+// EventHMAC returns the HMAC of the canonical form of the given structured
+// event.
+func EventHMAC(key, payload []byte) ([]byte, error) {
+	canonical, err := Canonicalize(payload)
+	if err != nil {
+		return nil, err
+	}
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write(canonical)
+	return h.Sum(nil), nil
+}
+
+// Checkpoint records the head of a chain at some point in time. A checkpoint
+// with sequence number 0 records the creation of a chain.
+type Checkpoint struct {
+	ChainID  string
+	Sequence uint64
+	// HMAC is the HMAC of the event at Sequence.
+	HMAC []byte
+	// Signature authenticates the other fields of the checkpoint with a key
+	// derived from the key of the chain.
+	Signature []byte
+}
+
+func (cp Checkpoint) sign(key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	fmt.Fprintf(h, "%s/%d/", cp.ChainID, cp.Sequence)
+	_, _ = h.Write(cp.HMAC)
+	return h.Sum(nil)
+}
+
+// Problem describes an inconsistency found in a chain.
+type Problem struct {
+	ChainID     string
+	Description string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("chain %s: %s", p.ChainID, p.Description)
+}
+
+type chainEvent struct {
+	prev string
+	mac  []byte
+	// conflict is set if different events with the same sequence number were
+	// found.
+	conflict bool
+}
+
+// Verifier checks the integrity of the chains of a set of events and
+// checkpoints.
+type Verifier struct {
+	key           []byte
+	checkpointKey []byte
+	chains        map[string]map[uint64]*chainEvent
+	checkpoints   map[string][]Checkpoint
+}
+
+// NewVerifier creates a verifier for the chains authenticated with the given
+// key.
+func NewVerifier(key []byte) *Verifier {
+	return &Verifier{
+		key:           key,
+		checkpointKey: deriveCheckpointKey(key),
+		chains:        make(map[string]map[uint64]*chainEvent),
+		checkpoints:   make(map[string][]Checkpoint),
+	}
+}
+
+// AddLogEntry adds the event of the given log entry, if it is a structured
+// entry on the SENSITIVE_ACCESS channel that is part of a chain. It returns
+// whether the event was added.
+func (v *Verifier) AddLogEntry(entry logpb.Entry) (bool, error) {
+	if entry.Channel != logpb.Channel_SENSITIVE_ACCESS || entry.StructuredEnd == 0 {
+		return false, nil
+	}
+	return v.AddEvent([]byte(entry.Message))
+}
+
+// AddEvent adds the given structured event, if it is part of a chain. It
+// returns whether the event was added. The same event may be added multiple
+// times, for example when it was logged to several sinks.
+func (v *Verifier) AddEvent(payload []byte) (bool, error) {
+	canonical, err := Canonicalize(payload)
+	if err != nil {
+		return false, err
+	}
+	var stamp struct {
+		AuditChainID  string
+		AuditSequence json.Number
+		AuditPrevHMAC string
+	}
+	if err := json.Unmarshal(canonical, &stamp); err != nil {
+		return false, errors.Wrap(err, "decoding audit chain fields")
+	}
+	if stamp.AuditChainID == "" {
+		return false, nil
+	}
+	seq, err := strconv.ParseUint(string(stamp.AuditSequence), 10, 64)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid sequence number in chain %s", stamp.AuditChainID)
+	}
+	h := hmac.New(sha256.New, v.key)
+	_, _ = h.Write(canonical)
+	ev := &chainEvent{prev: stamp.AuditPrevHMAC, mac: h.Sum(nil)}
+
+	events, ok := v.chains[stamp.AuditChainID]
+	if !ok {
+		events = make(map[uint64]*chainEvent)
+		v.chains[stamp.AuditChainID] = events
+	}
+	if existing, ok := events[seq]; ok {
+		if !hmac.Equal(existing.mac, ev.mac) {
+			existing.conflict = true
+		}
+		return true, nil
+	}
+	events[seq] = ev
+	return true, nil
+}
+
+// AddCheckpoint adds a checkpoint of a chain.
+func (v *Verifier) AddCheckpoint(cp Checkpoint) {
+	v.checkpoints[cp.ChainID] = append(v.checkpoints[cp.ChainID], cp)
+}
+
+// NumChains returns the number of chains with events or checkpoints.
+func (v *Verifier) NumChains() int {
+	return len(v.chainIDs())
+}
+
+// NumEvents returns the number of distinct events added to the verifier.
+func (v *Verifier) NumEvents() int {
+	n := 0
+	for _, events := range v.chains {
+		n += len(events)
+	}
+	return n
+}
+
+func (v *Verifier) chainIDs() []string {
+	ids := make(map[string]struct{})
+	for id := range v.chains {
+		ids[id] = struct{}{}
+	}
+	for id := range v.checkpoints {
+		ids[id] = struct{}{}
+	}
+	res := make([]string, 0, len(ids))
+	for id := range ids {
+		res = append(res, id)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Verify returns the inconsistencies found in the chains: missing, modified
+// or conflicting events, and checkpoints that are not matched by the events.
+func (v *Verifier) Verify() []Problem {
+	var problems []Problem
+	for _, id := range v.chainIDs() {
+		report := func(format string, args ...interface{}) {
+			problems = append(problems, Problem{ChainID: id, Description: fmt.Sprintf(format, args...)})
+		}
+		events := v.chains[id]
+		seqs := make([]uint64, 0, len(events))
+		for seq := range events {
+			seqs = append(seqs, seq)
+		}
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+		var prev *chainEvent
+		next := uint64(1)
+		for _, seq := range seqs {
+			ev := events[seq]
+			if seq > next {
+				reportMissing(report, next, seq-1)
+				prev = nil
+			}
+			if ev.conflict {
+				report("different events were logged with sequence number %d", seq)
+			}
+			switch {
+			case seq == 1 && ev.prev != "":
+				report("event 1 does not start the chain")
+			case prev != nil && ev.prev != hex.EncodeToString(prev.mac):
+				report("event %d was modified", seq-1)
+			}
+			prev = ev
+			next = seq + 1
+		}
+
+		cps := v.checkpoints[id]
+		sort.Slice(cps, func(i, j int) bool { return cps[i].Sequence < cps[j].Sequence })
+		for _, cp := range cps {
+			if !hmac.Equal(cp.Signature, cp.sign(v.checkpointKey)) {
+				report("checkpoint at sequence number %d has an invalid signature", cp.Sequence)
+				continue
+			}
+			if cp.Sequence == 0 {
+				continue
+			}
+			if cp.Sequence >= next {
+				reportMissing(report, next, cp.Sequence)
+				next = cp.Sequence + 1
+				continue
+			}
+			if ev, ok := events[cp.Sequence]; ok && !hmac.Equal(ev.mac, cp.HMAC) {
+				report("event %d does not match its checkpoint", cp.Sequence)
+			}
+		}
+	}
+	return problems
+}
+
+func reportMissing(report func(string, ...interface{}), from, to uint64) {
+	if from == to {
+		report("event %d is missing", from)
+	} else {
+		report("events %d to %d are missing", from, to)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package auditchain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/util/jsonbytes"
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/redact"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	logpb.CommonEventDetails
+	Statement string
+}
+
+func (e *testEvent) LoggingChannel() logpb.Channel {
+	return logpb.Channel_SENSITIVE_ACCESS
+}
+
+func (e *testEvent) AppendJSONFields(
+	printComma bool, b redact.RedactableBytes,
+) (bool, redact.RedactableBytes) {
+	printComma, b = e.CommonEventDetails.AppendJSONFields(printComma, b)
+	if printComma {
+		b = append(b, ',')
+	}
+	b = append(b, "\"Statement\":\""...)
+	b = append(b, redact.StartMarker()...)
+	b = redact.RedactableBytes(jsonbytes.EncodeString([]byte(b), string(redact.EscapeMarkers([]byte(e.Statement)))))
+	b = append(b, redact.EndMarker()...)
+	b = append(b, '"')
+	return true, b
+}
+
+func TestCanonicalize(t *testing.T) {
+	a, err := Canonicalize([]byte(`"Timestamp":1700000000123456789,"Statement":"‹SELECT 1›"`))
+	require.NoError(t, err)
+	b, err := Canonicalize([]byte(`{"Statement":"SELECT 1","Timestamp":1700000000123456789}`))
+	require.NoError(t, err)
+	require.Equal(t, string(a), string(b))
+	require.Equal(t, `{"Statement":"SELECT 1","Timestamp":1700000000123456789}`, string(a))
+
+	_, err = Canonicalize([]byte(`"Statement":`))
+	require.Error(t, err)
+}
+
+func TestChain(t *testing.T) {
+	key := []byte("audit chain test key")
+	const numEvents = 5
+
+	// makeChain returns the payloads of the events of a new chain, as found in
+	// log files, and a checkpoint of its head.
+	makeChain := func() ([]string, Checkpoint) {
+		c := NewChain("c1", key)
+		start := c.Checkpoint()
+		require.Equal(t, uint64(0), start.Sequence)
+		var payloads []string
+		for i := 1; i <= numEvents; i++ {
+			ev := &testEvent{Statement: fmt.Sprintf("SELECT %d", i)}
+			ev.Timestamp = int64(1700000000123456789 + i)
+			require.NoError(t, c.Stamp(ev))
+			require.Equal(t, uint64(i), ev.AuditSequence)
+			_, b := ev.AppendJSONFields(false, nil)
+			payload := "{" + string(b) + "}"
+			if i%2 == 0 {
+				// Simulate a sink that is not redactable.
+				payload = string(redact.RedactableString(payload).StripMarkers())
+			}
+			payloads = append(payloads, payload)
+		}
+		return payloads, c.Checkpoint()
+	}
+
+	testCases := []struct {
+		name     string
+		mutate   func(payloads []string, cp *Checkpoint) []string
+		expected []string
+	}{
+		{
+			name:   "intact",
+			mutate: func(p []string, _ *Checkpoint) []string { return p },
+		},
+		{
+			name: "duplicate",
+			mutate: func(p []string, _ *Checkpoint) []string {
+				return append(p, p[2])
+			},
+		},
+		{
+			name: "removed",
+			mutate: func(p []string, _ *Checkpoint) []string {
+				return append(p[:2:2], p[3:]...)
+			},
+			expected: []string{"chain c1: event 3 is missing"},
+		},
+		{
+			name: "modified",
+			mutate: func(p []string, _ *Checkpoint) []string {
+				p[1] = strings.Replace(p[1], "SELECT 2", "SELECT 42", 1)
+				return p
+			},
+			expected: []string{"chain c1: event 2 was modified"},
+		},
+		{
+			name: "truncated",
+			mutate: func(p []string, _ *Checkpoint) []string {
+				return p[:3]
+			},
+			expected: []string{"chain c1: events 4 to 5 are missing"},
+		},
+		{
+			name: "last modified",
+			mutate: func(p []string, _ *Checkpoint) []string {
+				p[4] = strings.Replace(p[4], "SELECT 5", "SELECT 6", 1)
+				return p
+			},
+			expected: []string{"chain c1: event 5 does not match its checkpoint"},
+		},
+		{
+			name: "forged checkpoint",
+			mutate: func(p []string, cp *Checkpoint) []string {
+				cp.Sequence = 3
+				return p[:3]
+			},
+			expected: []string{"chain c1: checkpoint at sequence number 3 has an invalid signature"},
+		},
+		{
+			name: "checkpoint signed with the event key",
+			mutate: func(p []string, cp *Checkpoint) []string {
+				cp.Signature = cp.sign(key)
+				return p
+			},
+			expected: []string{"chain c1: checkpoint at sequence number 5 has an invalid signature"},
+		},
+		{
+			name: "forged event",
+			mutate: func(p []string, _ *Checkpoint) []string {
+				forged := NewChain("c1", []byte("another key"))
+				ev := &testEvent{Statement: "SELECT 1"}
+				require.NoError(t, forged.Stamp(ev))
+				_, b := ev.AppendJSONFields(false, nil)
+				p[0] = string(b)
+				return p
+			},
+			expected: []string{"chain c1: event 1 was modified"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payloads, cp := makeChain()
+			payloads = tc.mutate(payloads, &cp)
+			v := NewVerifier(key)
+			for _, p := range payloads {
+				added, err := v.AddEvent([]byte(p))
+				require.NoError(t, err)
+				require.True(t, added)
+			}
+			v.AddCheckpoint(cp)
+			var problems []string
+			for _, p := range v.Verify() {
+				problems = append(problems, p.String())
+			}
+			require.Equal(t, tc.expected, problems)
+		})
+	}
+
+	// Events that are not part of a chain are ignored.
+	v := NewVerifier(key)
+	added, err := v.AddEvent([]byte(`{"Timestamp":1,"Statement":"SELECT 1"}`))
+	require.NoError(t, err)
+	require.False(t, added)
+	require.Empty(t, v.Verify())
+}
@@ -8,9 +8,11 @@ package log
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/cockroachdb/cockroach/pkg/base/serverident"
 	"github.com/cockroachdb/cockroach/pkg/util/buildutil"
+	"github.com/cockroachdb/cockroach/pkg/util/log/auditchain"
 	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
 	"github.com/cockroachdb/cockroach/pkg/util/log/severity"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
@@ -74,6 +76,18 @@ func RemoveEventLogWriter(serverId serverident.ServerIdentifier) {
 	eventLogWriterRegistry.RemoveWriter(serverId)
 }
 
+// auditChain, if set, is the hash chain that stamps the events logged on the
+// SENSITIVE_ACCESS channel.
+var auditChain atomic.Pointer[auditchain.Chain]
+
+// CompareAndSwapAuditChain replaces the hash chain that stamps the events
+// logged on the SENSITIVE_ACCESS channel, if it is still old. A nil chain
+// disables the stamping. The chain is shared by all the servers of the
+// process, so only the server that installed it should replace it.
+func CompareAndSwapAuditChain(old, new *auditchain.Chain) bool {
+	return auditChain.CompareAndSwap(old, new)
+}
+
 type EventLogSettings struct {
 	sev        logpb.Severity
 	writeAsync bool
@@ -177,6 +191,13 @@ func structuredEventDepth(
 	if len(common.EventType) == 0 {
 		common.EventType = logpb.GetEventTypeName(event)
 	}
+	if ch == logpb.Channel_SENSITIVE_ACCESS {
+		if c := auditChain.Load(); c != nil {
+			if err := c.Stamp(event); err != nil {
+				Dev.Warningf(ctx, "unable to add event to the audit log chain: %v", err)
+			}
+		}
+	}
 
 	entry := makeStructuredEntry(ctx,
 		sev,
@@ -297,6 +297,12 @@ func (ltreeDecoder) decode(v parquet.ByteArray) (tree.Datum, error) {
 	return d, err
 }
 
+type xmlDecoder struct{}
+
+func (xmlDecoder) decode(v parquet.ByteArray) (tree.Datum, error) {
+	return tree.NewDXML(string(v)), nil
+}
+
 // decoderFromFamilyAndType returns the decoder to use based on the type oid and
 // family. Note the logical similarity to makeColumn in schema.go. This is
 // intentional as each decoder returned by this function corresponds to a
@@ -388,6 +394,8 @@ func decoderFromFamilyAndType(typOid oid.Oid, family types.Family) (decoder, err
 		return collatedStringDecoder{}, nil
 	case types.LTreeFamily:
 		return ltreeDecoder{}, nil
+	case types.XMLFamily:
+		return xmlDecoder{}, nil
 	default:
 		return nil, errors.AssertionFailedf("could not find decoder for type oid %d and family %d", typOid, family)
 	}
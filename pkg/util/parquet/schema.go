@@ -377,6 +377,15 @@ func makeColumn(colName string, typ *types.T, repetitions parquet.Repetition) (d
 		}
 		result.colWriter = scalarWriter(writeLTree)
 		return result, nil
+	case types.XMLFamily:
+		result.node, err = schema.NewPrimitiveNodeLogical(colName,
+			repetitions, schema.StringLogicalType{}, parquet.Types.ByteArray,
+			defaultTypeLength, defaultSchemaFieldID)
+		if err != nil {
+			return datumColumn{}, err
+		}
+		result.colWriter = scalarWriter(writeXML)
+		return result, nil
 	case types.ArrayFamily:
 		// Arrays for type T are represented by the following:
 		// message schema {                 -- toplevel schema
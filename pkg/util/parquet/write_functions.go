@@ -726,6 +726,24 @@ func writeLTree(
 	return writeBatch[parquet.ByteArray](w, a.byteArrayBatch[:], defLevels, repLevels)
 }
 
+func writeXML(
+	d tree.Datum, w file.ColumnChunkWriter, a *batchAlloc, defLevels, repLevels []int16,
+) error {
+	if d == tree.DNull {
+		return writeBatch[parquet.ByteArray](w, a.byteArrayBatch[:], defLevels, repLevels)
+	}
+	di, ok := d.(*tree.DXML)
+	if !ok {
+		return pgerror.Newf(pgcode.DatatypeMismatch, "expected DXML, found %T", d)
+	}
+	b, err := unsafeGetBytes(di.Contents)
+	if err != nil {
+		return err
+	}
+	a.byteArrayBatch[0] = b
+	return writeBatch[parquet.ByteArray](w, a.byteArrayBatch[:], defLevels, repLevels)
+}
+
 // parquetDatatypes are the physical types used in the parquet library.
 type parquetDatatypes interface {
 	bool | int32 | int64 | float32 | float64 | parquet.ByteArray | parquet.FixedLenByteArray
@@ -5,22 +5,218 @@
 
 package tsearch
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+)
 
 // ValidConfig returns an error if the input string is not a supported and valid
 // text search config.
 func ValidConfig(input string) error {
-	input = GetConfigKey(input)
-	_, err := getStemmer(input)
+	_, err := GetBuiltinConfig(input)
 	return err
 }
 
 // GetConfigKey returns a config that can be used as a key to look up stemmers
-// and stopwords from an input config value. This is simulating the more
-// advanced customizable dictionaries and configs that Postgres has, which
-// allows user-defined text search configurations: because of this, configs can
-// have schema prefixes. Because we don't (yet?) allow this, we just have to
-// trim off any `pg_catalog.` prefix if it exists.
+// and stopwords from an input config value. Built-in configurations live in
+// pg_catalog, so any `pg_catalog.` prefix is trimmed off. Names of
+// user-defined configurations, which may be qualified with other schemas, are
+// returned unchanged.
 func GetConfigKey(config string) string {
 	return strings.TrimPrefix(config, "pg_catalog.")
 }
+
+// IsBuiltinConfig returns whether the given config name, after trimming any
+// `pg_catalog.` prefix, refers to one of the built-in text search
+// configurations.
+func IsBuiltinConfig(config string) bool {
+	_, ok := builtinConfigs[GetConfigKey(config)]
+	return ok
+}
+
+// TokenType is the type of a token produced by the text search parser. A text
+// search configuration maps each token type to the list of dictionaries that
+// are consulted to normalize tokens of that type.
+type TokenType int
+
+// The token types use the names and numbering of the Postgres default parser,
+// so that mappings written for Postgres can be used unchanged. Our parser only
+// produces the asciiword, word, numword and uint types, but mappings may be
+// defined for all of them.
+const (
+	_ TokenType = iota
+	AsciiWord
+	Word
+	NumWord
+	AsciiHWord
+	HWord
+	NumHWord
+	HWordAsciiPart
+	HWordNumPart
+	HWordPart
+	Email
+	Protocol
+	URL
+	Host
+	URLPath
+	File
+	SFloat
+	Float
+	Int
+	UInt
+	Version
+	Tag
+	Entity
+	Blank
+	numTokenTypes
+)
+
+var tokenTypeNames = [numTokenTypes]string{
+	AsciiWord:      "asciiword",
+	Word:           "word",
+	NumWord:        "numword",
+	AsciiHWord:     "asciihword",
+	HWord:          "hword",
+	NumHWord:       "numhword",
+	HWordAsciiPart: "hword_asciipart",
+	HWordNumPart:   "hword_numpart",
+	HWordPart:      "hword_part",
+	Email:          "email",
+	Protocol:       "protocol",
+	URL:            "url",
+	Host:           "host",
+	URLPath:        "url_path",
+	File:           "file",
+	SFloat:         "sfloat",
+	Float:          "float",
+	Int:            "int",
+	UInt:           "uint",
+	Version:        "version",
+	Tag:            "tag",
+	Entity:         "entity",
+	Blank:          "blank",
+}
+
+// String implements the fmt.Stringer interface.
+func (t TokenType) String() string {
+	return tokenTypeNames[t]
+}
+
+// TokenTypeFromName returns the token type with the given name.
+func TokenTypeFromName(name string) (TokenType, error) {
+	for t := AsciiWord; t < numTokenTypes; t++ {
+		if tokenTypeNames[t] == name {
+			return t, nil
+		}
+	}
+	return 0, pgerror.Newf(pgcode.InvalidParameterValue, "token type %q does not exist", name)
+}
+
+// AllTokenTypes returns every token type, in order.
+func AllTokenTypes() []TokenType {
+	ret := make([]TokenType, 0, numTokenTypes-1)
+	for t := AsciiWord; t < numTokenTypes; t++ {
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+// tokenTypeOf classifies a token produced by TSParse.
+func tokenTypeOf(token string) TokenType {
+	var letters, digits, nonASCII bool
+	for _, r := range token {
+		if unicode.IsNumber(r) {
+			digits = true
+			continue
+		}
+		letters = true
+		if r > unicode.MaxASCII {
+			nonASCII = true
+		}
+	}
+	switch {
+	case !letters:
+		return UInt
+	case digits:
+		return NumWord
+	case nonASCII:
+		return Word
+	default:
+		return AsciiWord
+	}
+}
+
+// Config is a text search configuration. It determines which dictionaries
+// normalize each type of token produced by the parser.
+type Config struct {
+	// Name is the name of the configuration, used in error messages.
+	Name     string
+	mappings [numTokenTypes][]Dictionary
+}
+
+// NewConfig returns an empty text search configuration with the given name.
+// Tokens of types without a mapping are ignored.
+func NewConfig(name string) *Config {
+	return &Config{Name: name}
+}
+
+// SetMapping sets the dictionaries that are consulted, in order, for tokens of
+// the given type.
+func (c *Config) SetMapping(t TokenType, dicts []Dictionary) {
+	c.mappings[t] = dicts
+}
+
+// lexize normalizes a token into lexemes using the dictionaries mapped to its
+// type. The first dictionary that recognizes the token determines the result.
+// An empty result means the token should be ignored, either because it is a
+// stop word or because no dictionary recognized it.
+func (c *Config) lexize(token string) []string {
+	for _, d := range c.mappings[tokenTypeOf(token)] {
+		if lexemes, ok := d.Lexize(token); ok {
+			return lexemes
+		}
+	}
+	return nil
+}
+
+// builtinConfigs contains the built-in text search configurations, keyed by
+// name. Each maps every token type to the dictionary of the same language.
+var builtinConfigs = func() map[string]*Config {
+	ret := make(map[string]*Config, len(builtinDictionaries))
+	for name, d := range builtinDictionaries {
+		name = strings.TrimSuffix(name, "_stem")
+		c := NewConfig(name)
+		for t := AsciiWord; t < numTokenTypes; t++ {
+			c.SetMapping(t, []Dictionary{d})
+		}
+		ret[name] = c
+	}
+	return ret
+}()
+
+// GetBuiltinConfig returns the built-in text search configuration with the
+// given name, which may be qualified with `pg_catalog.`.
+func GetBuiltinConfig(config string) (*Config, error) {
+	key := GetConfigKey(config)
+	c, ok := builtinConfigs[key]
+	if !ok {
+		return nil, pgerror.Newf(pgcode.UndefinedObject, "text search configuration %q does not exist", key)
+	}
+	return c, nil
+}
+
+// GetBuiltinConfigDictionary returns the name of the built-in dictionary that
+// the given built-in configuration maps every token type to.
+func GetBuiltinConfigDictionary(config string) (string, error) {
+	key := GetConfigKey(config)
+	if _, err := GetBuiltinConfig(key); err != nil {
+		return "", err
+	}
+	if _, ok := builtinDictionaries[key]; ok {
+		return key, nil
+	}
+	return key + "_stem", nil
+}
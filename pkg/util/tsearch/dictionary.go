@@ -0,0 +1,308 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tsearch
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/blevesearch/snowballstem"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+)
+
+// Dictionary is a text search dictionary, which normalizes a single token
+// produced by the parser into lexemes.
+type Dictionary interface {
+	// Lexize normalizes the given token. It returns false if the dictionary
+	// does not recognize the token, in which case the next dictionary mapped to
+	// the token's type is consulted. A recognized token without lexemes is a
+	// stop word.
+	Lexize(token string) (lexemes []string, ok bool)
+}
+
+// The dictionary templates that can be used to create dictionaries. Postgres
+// also has a thesaurus template, which we don't support.
+const (
+	SimpleTemplate   = "simple"
+	SnowballTemplate = "snowball"
+	SynonymTemplate  = "synonym"
+	IspellTemplate   = "ispell"
+)
+
+// NewDictionary creates a dictionary from the given template and options.
+// Option names are case-insensitive.
+//
+// Postgres dictionaries read their stop word, synonym and ispell data from
+// files in the server's installation directory. We have no such directory,
+// so the options that name files in Postgres instead contain the data itself:
+//
+//   - StopWords is either the name of a built-in stop word list, such as
+//     english, or a list of words separated by whitespace or commas.
+//   - Synonyms is a list of entries separated by newlines or commas, each of
+//     which contains a word and its synonym separated by whitespace.
+//   - DictFile is a list of words in the Hunspell .dic format, separated by
+//     newlines or commas. Each word may be followed by a slash and the affix
+//     flags that apply to it.
+//   - AffFile is a list of affix rules in the Hunspell .aff format, separated
+//     by newlines. Only the PFX and SFX directives are used.
+func NewDictionary(template string, options map[string]string) (Dictionary, error) {
+	names := make([]string, 0, len(options))
+	lowered := make(map[string]string, len(options))
+	for k, v := range options {
+		k = strings.ToLower(k)
+		names = append(names, k)
+		lowered[k] = v
+	}
+	sort.Strings(names)
+
+	switch template {
+	case SimpleTemplate:
+		d := &simpleDictionary{accept: true}
+		for _, name := range names {
+			var err error
+			switch v := lowered[name]; name {
+			case "stopwords":
+				d.stopwords, err = resolveStopwords(v)
+			case "accept":
+				d.accept, err = parseDictionaryBool(name, v)
+			default:
+				err = unrecognizedDictionaryParameter(template, name)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		return d, nil
+
+	case SnowballTemplate:
+		d := &snowballDictionary{}
+		for _, name := range names {
+			var err error
+			switch v := lowered[name]; name {
+			case "stopwords":
+				d.stopwords, err = resolveStopwords(v)
+			case "language":
+				d.stemmer, err = getStemmer(strings.ToLower(v))
+				if err != nil {
+					err = pgerror.Newf(pgcode.InvalidParameterValue, "no Snowball stemmer available for language %q", v)
+				}
+			default:
+				err = unrecognizedDictionaryParameter(template, name)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if d.stemmer == nil {
+			return nil, missingDictionaryParameter("Language")
+		}
+		return d, nil
+
+	case SynonymTemplate:
+		var synonyms string
+		var hasSynonyms, caseSensitive bool
+		for _, name := range names {
+			var err error
+			switch v := lowered[name]; name {
+			case "synonyms":
+				synonyms, hasSynonyms = v, true
+			case "casesensitive":
+				caseSensitive, err = parseDictionaryBool(name, v)
+			default:
+				err = unrecognizedDictionaryParameter(template, name)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !hasSynonyms {
+			return nil, missingDictionaryParameter("Synonyms")
+		}
+		return newSynonymDictionary(synonyms, caseSensitive)
+
+	case IspellTemplate:
+		var dictFile, affFile string
+		var hasDictFile, hasAffFile bool
+		var stopwords map[string]struct{}
+		for _, name := range names {
+			var err error
+			switch v := lowered[name]; name {
+			case "dictfile":
+				dictFile, hasDictFile = v, true
+			case "afffile":
+				affFile, hasAffFile = v, true
+			case "stopwords":
+				stopwords, err = resolveStopwords(v)
+			default:
+				err = unrecognizedDictionaryParameter(template, name)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !hasDictFile {
+			return nil, missingDictionaryParameter("DictFile")
+		}
+		if !hasAffFile {
+			return nil, missingDictionaryParameter("AffFile")
+		}
+		return newIspellDictionary(dictFile, affFile, stopwords)
+	}
+	return nil, pgerror.Newf(pgcode.UndefinedObject, "text search template %q does not exist", template)
+}
+
+func unrecognizedDictionaryParameter(template, name string) error {
+	return pgerror.Newf(pgcode.InvalidParameterValue, "unrecognized %s dictionary parameter: %q", template, name)
+}
+
+func missingDictionaryParameter(name string) error {
+	return pgerror.Newf(pgcode.InvalidParameterValue, "missing %s parameter", name)
+}
+
+func parseDictionaryBool(name, value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "true", "on", "yes", "1":
+		return true, nil
+	case "false", "off", "no", "0":
+		return false, nil
+	}
+	return false, pgerror.Newf(pgcode.InvalidParameterValue, "%s requires a Boolean value", name)
+}
+
+// splitDictionaryEntries splits dictionary data into non-empty entries
+// separated by newlines or commas.
+func splitDictionaryEntries(data string) []string {
+	entries := strings.FieldsFunc(data, func(r rune) bool {
+		return r == '\n' || r == '\r' || r == ','
+	})
+	ret := entries[:0]
+	for _, e := range entries {
+		if e = strings.TrimSpace(e); e != "" {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+// resolveStopwords returns the built-in stop word list with the given name, or
+// else the inline list of words contained in the value.
+func resolveStopwords(value string) (map[string]struct{}, error) {
+	if stopwords, ok := stopwordsMap[strings.ToLower(value)]; ok {
+		return stopwords, nil
+	}
+	words := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+	if len(words) == 0 {
+		return nil, pgerror.New(pgcode.InvalidParameterValue, "stop word list must not be empty")
+	}
+	ret := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		ret[strings.ToLower(w)] = struct{}{}
+	}
+	return ret, nil
+}
+
+// simpleDictionary lower-cases tokens and discards stop words. If accept is
+// false, tokens that aren't stop words are not recognized, so that they're
+// passed on to the next dictionary.
+type simpleDictionary struct {
+	stopwords map[string]struct{}
+	accept    bool
+}
+
+// Lexize implements the Dictionary interface.
+func (d *simpleDictionary) Lexize(token string) ([]string, bool) {
+	lower := strings.ToLower(token)
+	if _, ok := d.stopwords[lower]; ok {
+		return nil, true
+	}
+	if !d.accept {
+		return nil, false
+	}
+	return []string{lower}, true
+}
+
+// snowballDictionary discards stop words and stems all other tokens. It
+// recognizes every token.
+type snowballDictionary struct {
+	stemmer   func(env *snowballstem.Env) bool
+	stopwords map[string]struct{}
+}
+
+// Lexize implements the Dictionary interface.
+func (d *snowballDictionary) Lexize(token string) ([]string, bool) {
+	lower := strings.ToLower(token)
+	if _, ok := d.stopwords[lower]; ok {
+		return nil, true
+	}
+	env := snowballstem.NewEnv(lower)
+	d.stemmer(env)
+	return []string{env.Current()}, true
+}
+
+// synonymDictionary replaces words with their synonyms. It only recognizes
+// the words in its synonym list.
+type synonymDictionary struct {
+	synonyms      map[string]string
+	caseSensitive bool
+}
+
+func newSynonymDictionary(data string, caseSensitive bool) (*synonymDictionary, error) {
+	d := &synonymDictionary{synonyms: make(map[string]string), caseSensitive: caseSensitive}
+	for _, entry := range splitDictionaryEntries(data) {
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			return nil, pgerror.Newf(pgcode.ConfigFile, "invalid synonym entry %q", entry)
+		}
+		word, synonym := fields[0], fields[1]
+		if !caseSensitive {
+			word, synonym = strings.ToLower(word), strings.ToLower(synonym)
+		}
+		d.synonyms[word] = synonym
+	}
+	return d, nil
+}
+
+// Lexize implements the Dictionary interface.
+func (d *synonymDictionary) Lexize(token string) ([]string, bool) {
+	if !d.caseSensitive {
+		token = strings.ToLower(token)
+	}
+	synonym, ok := d.synonyms[token]
+	if !ok {
+		return nil, false
+	}
+	return []string{synonym}, true
+}
+
+// builtinDictionaries contains the dictionaries that exist in pg_catalog:
+// simple, and a Snowball dictionary named <language>_stem for each language
+// that has both a stemmer and a stop word list.
+var builtinDictionaries = func() map[string]Dictionary {
+	ret := map[string]Dictionary{
+		"simple": &simpleDictionary{accept: true},
+	}
+	for lang, stopwords := range stopwordsMap {
+		if lang == "simple" {
+			continue
+		}
+		stemmer, err := getStemmer(lang)
+		if err != nil {
+			continue
+		}
+		ret[lang+"_stem"] = &snowballDictionary{stemmer: stemmer, stopwords: stopwords}
+	}
+	return ret
+}()
+
+// GetBuiltinDictionary returns the built-in text search dictionary with the
+// given name, which may be qualified with `pg_catalog.`.
+func GetBuiltinDictionary(name string) (Dictionary, bool) {
+	d, ok := builtinDictionaries[strings.TrimPrefix(name, "pg_catalog.")]
+	return d, ok
+}
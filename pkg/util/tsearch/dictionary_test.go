@@ -0,0 +1,154 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDictionaries(t *testing.T) {
+	const affFile = `
+SFX S Y 3
+SFX S 0 s [^sxy]
+SFX S y ies [^aeiou]y
+SFX S 0 es [sx]
+PFX U Y 1
+PFX U 0 un .
+`
+	tcs := []struct {
+		template string
+		options  map[string]string
+		token    string
+		expected []string
+		ok       bool
+	}{
+		{SimpleTemplate, nil, "Foo", []string{"foo"}, true},
+		{SimpleTemplate, map[string]string{"StopWords": "english"}, "The", nil, true},
+		{SimpleTemplate, map[string]string{"stopwords": "foo, bar"}, "BAR", nil, true},
+		{SimpleTemplate, map[string]string{"stopwords": "foo", "accept": "false"}, "baz", nil, false},
+		{SnowballTemplate, map[string]string{"language": "english"}, "Running", []string{"run"}, true},
+		{SnowballTemplate, map[string]string{"language": "english", "stopwords": "english"}, "and", nil, true},
+		{SynonymTemplate, map[string]string{"synonyms": "postgres pgsql\npostgresql pgsql"}, "PostgreSQL", []string{"pgsql"}, true},
+		{SynonymTemplate, map[string]string{"synonyms": "postgres pgsql, crdb cockroachdb"}, "crdb", []string{"cockroachdb"}, true},
+		{SynonymTemplate, map[string]string{"synonyms": "postgres pgsql"}, "mysql", nil, false},
+		{SynonymTemplate, map[string]string{"synonyms": "Postgres pgsql", "casesensitive": "on"}, "postgres", nil, false},
+		{SynonymTemplate, map[string]string{"synonyms": "Postgres pgsql", "casesensitive": "on"}, "Postgres", []string{"pgsql"}, true},
+		{IspellTemplate, map[string]string{"dictfile": "3\nbox/S\nberry/S\ndo/U", "afffile": affFile}, "Boxes", []string{"box"}, true},
+		{IspellTemplate, map[string]string{"dictfile": "box/S,berry/S", "afffile": affFile}, "berries", []string{"berry"}, true},
+		{IspellTemplate, map[string]string{"dictfile": "box/S,berry/S", "afffile": affFile}, "box", []string{"box"}, true},
+		{IspellTemplate, map[string]string{"dictfile": "box/S,berry/S", "afffile": affFile}, "boxs", nil, false},
+		{IspellTemplate, map[string]string{"dictfile": "do/U,undo", "afffile": affFile}, "undo", []string{"undo", "do"}, true},
+		{IspellTemplate, map[string]string{"dictfile": "lock/US", "afffile": affFile}, "unlocks", []string{"lock"}, true},
+		{IspellTemplate, map[string]string{"dictfile": "lock/S", "afffile": affFile, "stopwords": "a"}, "A", nil, true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.template+"/"+tc.token, func(t *testing.T) {
+			d, err := NewDictionary(tc.template, tc.options)
+			require.NoError(t, err)
+			lexemes, ok := d.Lexize(tc.token)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, lexemes)
+		})
+	}
+}
+
+func TestDictionaryErrors(t *testing.T) {
+	tcs := []struct {
+		template string
+		options  map[string]string
+		expected string
+	}{
+		{"thesaurus", nil, `text search template "thesaurus" does not exist`},
+		{SimpleTemplate, map[string]string{"language": "english"}, `unrecognized simple dictionary parameter: "language"`},
+		{SimpleTemplate, map[string]string{"accept": "maybe"}, `accept requires a Boolean value`},
+		{SnowballTemplate, nil, `missing Language parameter`},
+		{SnowballTemplate, map[string]string{"language": "klingon"}, `no Snowball stemmer available for language "klingon"`},
+		{SynonymTemplate, nil, `missing Synonyms parameter`},
+		{SynonymTemplate, map[string]string{"synonyms": "a b c"}, `invalid synonym entry "a b c"`},
+		{IspellTemplate, map[string]string{"dictfile": "a"}, `missing AffFile parameter`},
+		{IspellTemplate, map[string]string{"dictfile": "a", "afffile": "SFX S Y 1\nSFX S 0 s [a"}, `invalid affix rule "SFX S 0 s [a"`},
+	}
+	for _, tc := range tcs {
+		_, err := NewDictionary(tc.template, tc.options)
+		require.EqualError(t, err, tc.expected)
+	}
+}
+
+func TestConfigMappings(t *testing.T) {
+	synonyms, err := NewDictionary(SynonymTemplate, map[string]string{"synonyms": "crdb cockroachdb"})
+	require.NoError(t, err)
+	english, ok := GetBuiltinDictionary("pg_catalog.english_stem")
+	require.True(t, ok)
+	simple, ok := GetBuiltinDictionary("simple")
+	require.True(t, ok)
+
+	c := NewConfig("catalog")
+	c.SetMapping(AsciiWord, []Dictionary{synonyms, english})
+	c.SetMapping(NumWord, []Dictionary{simple})
+
+	vector, err := c.DocumentToTSVector("The CRDB nodes run v25 on 3 servers")
+	require.NoError(t, err)
+	// "3" is a uint, which has no mapping, so it's dropped.
+	assert.Equal(t, `'cockroachdb':2 'node':3 'run':4 'server':8 'v25':5`, vector.String())
+
+	query, err := c.PlainToTSQuery("crdb servers")
+	require.NoError(t, err)
+	assert.Equal(t, `'cockroachdb' & 'server'`, query.String())
+
+	query, err = c.PhraseToTSQuery("the running servers")
+	require.NoError(t, err)
+	assert.Equal(t, `'run' <-> 'server'`, query.String())
+
+	_, err = c.ToTSQuery("the")
+	require.EqualError(t, err, "text-search query doesn't contain lexemes: the")
+
+	// Tokens that a dictionary expands into several lexemes are matched by any
+	// of them.
+	ispell, err := NewDictionary(IspellTemplate, map[string]string{
+		"dictfile": "do/U,undo", "afffile": "PFX U Y 1\nPFX U 0 un .",
+	})
+	require.NoError(t, err)
+	c.SetMapping(AsciiWord, []Dictionary{ispell})
+	vector, err = c.DocumentToTSVector("undo")
+	require.NoError(t, err)
+	assert.Equal(t, `'do':1 'undo':1`, vector.String())
+	query, err = c.ToTSQuery("undo & crdb")
+	require.NoError(t, err)
+	assert.Equal(t, `'undo' | 'do'`, query.String())
+}
+
+func TestBuiltinConfigDictionary(t *testing.T) {
+	for config, expected := range map[string]string{
+		"english": "english_stem", "pg_catalog.german": "german_stem", "simple": "simple",
+	} {
+		dict, err := GetBuiltinConfigDictionary(config)
+		require.NoError(t, err)
+		require.Equal(t, expected, dict)
+		_, ok := GetBuiltinDictionary(dict)
+		require.True(t, ok)
+	}
+	_, err := GetBuiltinConfigDictionary("klingon")
+	require.EqualError(t, err, `text search configuration "klingon" does not exist`)
+}
+
+func TestTokenTypes(t *testing.T) {
+	for _, tt := range AllTokenTypes() {
+		fromName, err := TokenTypeFromName(tt.String())
+		require.NoError(t, err)
+		require.Equal(t, tt, fromName)
+	}
+	_, err := TokenTypeFromName("emoji")
+	require.EqualError(t, err, `token type "emoji" does not exist`)
+
+	for token, expected := range map[string]TokenType{
+		"foo": AsciiWord, "café": Word, "v25": NumWord, "42": UInt,
+	} {
+		assert.Equal(t, expected, tokenTypeOf(token), token)
+	}
+}
@@ -26,6 +26,10 @@ func EvalTSQuery(q TSQuery, v TSVector) (bool, error) {
 type tsEvaluator struct {
 	v TSVector
 	q TSQuery
+
+	// skipNot, if true, causes not operators that aren't nested within a
+	// followed by operator to evaluate to true, regardless of their operand.
+	skipNot bool
 }
 
 func (e *tsEvaluator) eval() (bool, error) {
@@ -88,6 +92,9 @@ func (e *tsEvaluator) evalNode(node *tsNode) (bool, error) {
 		}
 		return e.evalNode(node.r)
 	case not:
+		if e.skipNot {
+			return true, nil
+		}
 		// Match if the operand is false.
 		ret, err := e.evalNode(node.l)
 		return !ret, err
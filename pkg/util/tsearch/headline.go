@@ -0,0 +1,451 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tsearch
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+)
+
+// HeadlineOptions controls the output of Headline. See
+// https://www.postgresql.org/docs/current/textsearch-controls.html#TEXTSEARCH-HEADLINE
+// for the meaning of each option.
+type HeadlineOptions struct {
+	// StartSel and StopSel delimit the query words that appear in the
+	// document.
+	StartSel, StopSel string
+	// MaxWords and MinWords bound the number of words in the headline.
+	MaxWords, MinWords int
+	// ShortWord is the length of the words that are dropped at the start and
+	// end of a headline, unless they are query words.
+	ShortWord int
+	// HighlightAll, if true, uses the whole document as the headline, ignoring
+	// the previous options.
+	HighlightAll bool
+	// MaxFragments is the maximum number of fragments to display. If it is 0,
+	// the headline is made of a single fragment that is not based on covers.
+	MaxFragments int
+	// FragmentDelimiter separates the fragments of the headline.
+	FragmentDelimiter string
+}
+
+// DefaultHeadlineOptions returns the options used by Headline when none are
+// specified.
+func DefaultHeadlineOptions() HeadlineOptions {
+	return HeadlineOptions{
+		StartSel:          "<b>",
+		StopSel:           "</b>",
+		MaxWords:          35,
+		MinWords:          15,
+		ShortWord:         3,
+		MaxFragments:      0,
+		FragmentDelimiter: " ... ",
+	}
+}
+
+// ParseHeadlineOptions parses the options argument of ts_headline, which is a
+// comma-separated list of option=value pairs, and validates the result. Values
+// may be wrapped in double quotes.
+func ParseHeadlineOptions(input string) (HeadlineOptions, error) {
+	opts := DefaultHeadlineOptions()
+	syntaxErr := func() error {
+		return pgerror.Newf(pgcode.Syntax, "invalid parameter list format: %q", input)
+	}
+	parseInt := func(val string) (int, error) {
+		i, err := strconv.Atoi(val)
+		if err != nil {
+			return 0, pgerror.Newf(pgcode.InvalidTextRepresentation,
+				"invalid input syntax for type integer: %q", val)
+		}
+		return i, nil
+	}
+	isSeparator := func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	}
+	rest := strings.TrimLeftFunc(input, isSeparator)
+	for rest != "" {
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return opts, syntaxErr()
+		}
+		key := strings.TrimRightFunc(rest[:eq], unicode.IsSpace)
+		if key == "" || strings.IndexFunc(key, isSeparator) >= 0 {
+			return opts, syntaxErr()
+		}
+		rest = strings.TrimLeftFunc(rest[eq+1:], unicode.IsSpace)
+		var val string
+		if strings.HasPrefix(rest, `"`) {
+			// A quoted value ends at the first quote that isn't doubled.
+			var buf strings.Builder
+			i := 1
+			for {
+				if i >= len(rest) {
+					return opts, syntaxErr()
+				}
+				if rest[i] == '"' {
+					if i+1 < len(rest) && rest[i+1] == '"' {
+						buf.WriteByte('"')
+						i += 2
+						continue
+					}
+					break
+				}
+				buf.WriteByte(rest[i])
+				i++
+			}
+			val, rest = buf.String(), rest[i+1:]
+		} else {
+			end := strings.IndexFunc(rest, isSeparator)
+			if end < 0 {
+				end = len(rest)
+			}
+			val, rest = rest[:end], rest[end:]
+		}
+		rest = strings.TrimLeftFunc(rest, isSeparator)
+
+		var err error
+		switch strings.ToLower(key) {
+		case "startsel":
+			opts.StartSel = val
+		case "stopsel":
+			opts.StopSel = val
+		case "maxwords":
+			opts.MaxWords, err = parseInt(val)
+		case "minwords":
+			opts.MinWords, err = parseInt(val)
+		case "shortword":
+			opts.ShortWord, err = parseInt(val)
+		case "maxfragments":
+			opts.MaxFragments, err = parseInt(val)
+		case "highlightall":
+			switch strings.ToLower(val) {
+			case "1", "on", "true", "t", "y", "yes":
+				opts.HighlightAll = true
+			default:
+				opts.HighlightAll = false
+			}
+		case "fragmentdelimiter":
+			opts.FragmentDelimiter = val
+		default:
+			return opts, pgerror.Newf(pgcode.InvalidParameterValue,
+				"unrecognized headline parameter: %q", key)
+		}
+		if err != nil {
+			return opts, err
+		}
+	}
+	if !opts.HighlightAll {
+		if opts.MinWords >= opts.MaxWords {
+			return opts, pgerror.New(pgcode.InvalidParameterValue, "MinWords must be less than MaxWords")
+		}
+		if opts.MinWords <= 0 {
+			return opts, pgerror.New(pgcode.InvalidParameterValue, "MinWords must be positive")
+		}
+		if opts.ShortWord < 0 {
+			return opts, pgerror.New(pgcode.InvalidParameterValue, "ShortWord must be >= 0")
+		}
+		if opts.MaxFragments < 0 {
+			return opts, pgerror.New(pgcode.InvalidParameterValue, "MaxFragments must be >= 0")
+		}
+	}
+	return opts, nil
+}
+
+// Headline implements the ts_headline builtin using a built-in text search
+// configuration passed by name. See Config.Headline for details.
+func Headline(config string, document string, q TSQuery, opts HeadlineOptions) (string, error) {
+	c, err := GetBuiltinConfig(config)
+	if err != nil {
+		return "", err
+	}
+	return c.Headline(document, q, opts)
+}
+
+// Headline returns an excerpt of the document in which the words that match
+// the query are highlighted. The words of the document are normalized with the
+// receiver's dictionaries before being compared to the query.
+//
+// The excerpt is built around the "covers" of the query: the shortest runs of
+// words that satisfy it. Without MaxFragments, the headline is made of the
+// cover that contains the most query words, stretched to between MinWords and
+// MaxWords words. With MaxFragments, up to that many covers are stretched to
+// MaxWords words each, and are joined with FragmentDelimiter. This is a
+// simplified version of the fragment selection implemented by Postgres in
+// wparser_def.c, so the exact bounds of the excerpts may differ.
+func (c *Config) Headline(document string, q TSQuery, opts HeadlineOptions) (string, error) {
+	h := headliner{opts: opts, document: document}
+	h.splitWords(c)
+	if len(h.words) == 0 {
+		return document, nil
+	}
+	h.markQueryWords(q)
+	if opts.HighlightAll {
+		return h.render([][2]int{{0, len(h.words) - 1}}), nil
+	}
+
+	covers, err := h.findCovers(q)
+	if err != nil {
+		return "", err
+	}
+	var fragments [][2]int
+	switch {
+	case len(covers) == 0:
+		fragments = [][2]int{{0, min(opts.MinWords, len(h.words)) - 1}}
+	case opts.MaxFragments == 0:
+		fragments = [][2]int{h.bestFragment(covers)}
+	default:
+		fragments = h.selectFragments(covers)
+	}
+	return h.render(fragments), nil
+}
+
+// headlineWord is a word of a document passed to Headline.
+type headlineWord struct {
+	// start and end are the byte offsets of the word within the document.
+	start, end int
+	// lexemes are the normalized forms of the word.
+	lexemes []string
+	// short is true if the word isn't longer than the ShortWord option.
+	short bool
+	// match is true if one of the lexemes matches a query term that isn't
+	// negated.
+	match bool
+}
+
+type headliner struct {
+	opts     HeadlineOptions
+	document string
+	words    []headlineWord
+}
+
+// splitWords splits the document into the same words as TSParse, keeping
+// track of their offsets.
+func (h *headliner) splitWords(c *Config) {
+	start := -1
+	for i, r := range h.document {
+		isWordChar := unicode.IsOneOf(validCharTables, r)
+		if isWordChar && start < 0 {
+			start = i
+		} else if !isWordChar && start >= 0 {
+			h.addWord(c, start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		h.addWord(c, start, len(h.document))
+	}
+}
+
+func (h *headliner) addWord(c *Config, start, end int) {
+	text := h.document[start:end]
+	h.words = append(h.words, headlineWord{
+		start:   start,
+		end:     end,
+		lexemes: c.lexize(text),
+		short:   utf8.RuneCountInString(text) <= h.opts.ShortWord,
+	})
+}
+
+// markQueryWords marks the words that match a term of the query that isn't
+// negated.
+func (h *headliner) markQueryWords(q TSQuery) {
+	var terms []tsTerm
+	var collect func(n *tsNode)
+	collect = func(n *tsNode) {
+		if n == nil || n.op == not {
+			return
+		}
+		if n.op == invalid {
+			terms = append(terms, n.term)
+			return
+		}
+		collect(n.l)
+		collect(n.r)
+	}
+	collect(q.root)
+	for i := range h.words {
+		for _, lexeme := range h.words[i].lexemes {
+			for _, term := range terms {
+				if lexeme == term.lexeme || (term.isPrefixMatch() && strings.HasPrefix(lexeme, term.lexeme)) {
+					h.words[i].match = true
+				}
+			}
+		}
+	}
+}
+
+// findCovers returns the non-overlapping covers of the query within the
+// document, as pairs of word indexes. A cover starts and ends with a query
+// word.
+func (h *headliner) findCovers(q TSQuery) ([][2]int, error) {
+	var matches []int
+	for i := range h.words {
+		if h.words[i].match {
+			matches = append(matches, i)
+		}
+	}
+	var covers [][2]int
+	for i := 0; i < len(matches); i++ {
+		p := matches[i]
+		if len(covers) > 0 && p <= covers[len(covers)-1][1] {
+			continue
+		}
+		for _, end := range matches[i:] {
+			ok, err := EvalTSQuery(q, h.vector(p, end))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				covers = append(covers, [2]int{p, end})
+				break
+			}
+		}
+	}
+	return covers, nil
+}
+
+// vector returns a TSVector made of the lexemes of the words between the
+// given indexes, inclusive.
+func (h *headliner) vector(from, to int) TSVector {
+	var v TSVector
+	for i := from; i <= to; i++ {
+		for _, lexeme := range h.words[i].lexemes {
+			v = append(v, tsTerm{lexeme: lexeme, positions: []tsPosition{{position: uint16(min(i+1, maxTSVectorPosition))}}})
+		}
+	}
+	v, _ = normalizeTSVector(v)
+	return v
+}
+
+// countMatches returns the number of query words between the given indexes,
+// inclusive.
+func (h *headliner) countMatches(from, to int) int {
+	var n int
+	for i := from; i <= to; i++ {
+		if h.words[i].match {
+			n++
+		}
+	}
+	return n
+}
+
+// bestFragment stretches each cover to between MinWords and MaxWords words
+// and returns the resulting fragment with the most query words.
+func (h *headliner) bestFragment(covers [][2]int) [2]int {
+	maxWords, minWords := h.opts.MaxWords, h.opts.MinWords
+	var best [2]int
+	bestMatches := -1
+	for _, cover := range covers {
+		p, q := cover[0], cover[1]
+		if q-p+1 > maxWords {
+			q = p + maxWords - 1
+			// Avoid ending the fragment with a short word.
+			for q > p && q-p+1 > minWords && h.words[q].short && !h.words[q].match {
+				q--
+			}
+		} else if q-p+1 < minWords || h.words[q].short {
+			// Look for a good end after the cover.
+			for q+1 < len(h.words) && q-p+1 < maxWords {
+				q++
+				if q-p+1 >= minWords && !h.words[q].short {
+					break
+				}
+			}
+			// If the end of the document was reached and the fragment is still too
+			// short, look for a good beginning before the cover.
+			if q == len(h.words)-1 {
+				for p > 0 && q-p+1 < minWords {
+					p--
+					if q-p+1 >= minWords && !h.words[p].short {
+						break
+					}
+				}
+			}
+		}
+		if n := h.countMatches(p, q); n > bestMatches {
+			best, bestMatches = [2]int{p, q}, n
+		}
+	}
+	return best
+}
+
+// selectFragments picks up to MaxFragments covers with the most query words,
+// and stretches each of them to MaxWords words without overlapping the
+// others. The fragments are returned in document order.
+func (h *headliner) selectFragments(covers [][2]int) [][2]int {
+	maxWords := h.opts.MaxWords
+	fragments := make([][2]int, len(covers))
+	for i, cover := range covers {
+		fragments[i] = [2]int{cover[0], min(cover[1], cover[0]+maxWords-1)}
+	}
+	sort.SliceStable(fragments, func(i, j int) bool {
+		return h.countMatches(fragments[i][0], fragments[i][1]) >
+			h.countMatches(fragments[j][0], fragments[j][1])
+	})
+	if len(fragments) > h.opts.MaxFragments {
+		fragments = fragments[:h.opts.MaxFragments]
+	}
+	sort.Slice(fragments, func(i, j int) bool {
+		return fragments[i][0] < fragments[j][0]
+	})
+	for i := range fragments {
+		lowerBound, upperBound := 0, len(h.words)-1
+		if i > 0 {
+			lowerBound = fragments[i-1][1] + 1
+		}
+		if i+1 < len(fragments) {
+			upperBound = fragments[i+1][0] - 1
+		}
+		p, q := fragments[i][0], fragments[i][1]
+		extra := maxWords - (q - p + 1)
+		left := min(extra/2, p-lowerBound)
+		right := min(extra-left, upperBound-q)
+		// Give any words that couldn't be used on the right to the left.
+		left = min(extra-right, p-lowerBound)
+		fragments[i] = [2]int{p - left, q + right}
+	}
+	return fragments
+}
+
+// render returns the text of the given fragments, with the query words
+// highlighted, joined by the fragment delimiter. The text preceding the first
+// word or following the last word of the document is included if a fragment
+// begins or ends there.
+func (h *headliner) render(fragments [][2]int) string {
+	var buf strings.Builder
+	for i, fragment := range fragments {
+		if i > 0 {
+			buf.WriteString(h.opts.FragmentDelimiter)
+		}
+		p, q := fragment[0], fragment[1]
+		if p == 0 {
+			buf.WriteString(h.document[:h.words[0].start])
+		}
+		for j := p; j <= q; j++ {
+			w := h.words[j]
+			if j > p {
+				buf.WriteString(h.document[h.words[j-1].end:w.start])
+			}
+			if w.match {
+				buf.WriteString(h.opts.StartSel)
+			}
+			buf.WriteString(h.document[w.start:w.end])
+			if w.match {
+				buf.WriteString(h.opts.StopSel)
+			}
+		}
+		if q == len(h.words)-1 {
+			buf.WriteString(h.document[h.words[q].end:])
+		}
+	}
+	return buf.String()
+}
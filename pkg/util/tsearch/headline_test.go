@@ -0,0 +1,138 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadline(t *testing.T) {
+	const doc1 = `The most common type of search
+is to find all documents containing given query terms
+and return them in order of their similarity to the
+query.`
+	const doc2 = `Search terms may occur
+many times in a document,
+requiring ranking of the search matches to decide which
+occurrences to display in the result.`
+	for _, tc := range []struct {
+		document string
+		query    string
+		options  string
+		expected string
+	}{
+		{
+			document: doc1,
+			query:    `queri & similar`,
+			expected: `containing given <b>query</b> terms
+and return them in order of their <b>similarity</b> to the
+<b>query</b>.`,
+		},
+		{
+			document: doc1,
+			query:    `queri & similar`,
+			options:  `StartSel = <, StopSel = >`,
+			expected: `containing given <query> terms
+and return them in order of their <similarity> to the
+<query>.`,
+		},
+		{
+			document: doc1,
+			query:    `search`,
+			options:  `MaxWords=5, MinWords=3`,
+			expected: `<b>search</b>
+is to find`,
+		},
+		{
+			document: doc1,
+			query:    `document`,
+			options:  `MaxWords=4, MinWords=2, ShortWord=0`,
+			expected: `<b>documents</b> containing`,
+		},
+		{
+			document: doc1,
+			query:    `nomatch`,
+			options:  `MaxWords=5, MinWords=3`,
+			expected: `The most common`,
+		},
+		{
+			document: `"Quoted" fat rats.`,
+			query:    `rat`,
+			options:  `HighlightAll=true, StartSel=*, StopSel=*`,
+			expected: `"Quoted" fat *rats*.`,
+		},
+		{
+			document: doc2,
+			query:    `search & term`,
+			options:  `MaxFragments=10, MaxWords=7, MinWords=3, StartSel=<<, StopSel=>>`,
+			expected: `<<Search>> <<terms>> may occur
+many times in`,
+		},
+		{
+			document: doc2,
+			query:    `search & term`,
+			options:  `MaxFragments=1, MaxWords=3, MinWords=1, FragmentDelimiter=" | "`,
+			expected: `<b>Search</b> <b>terms</b> may`,
+		},
+		{
+			document: `alpha one two three four five six seven eight nine ten eleven twelve alpha`,
+			query:    `alpha`,
+			options:  `MaxFragments=2, MaxWords=3, MinWords=1`,
+			expected: `<b>alpha</b> one two ... eleven twelve <b>alpha</b>`,
+		},
+		{
+			document: `  `,
+			query:    `foo`,
+			expected: `  `,
+		},
+	} {
+		q, err := ToTSQuery("english", tc.query)
+		require.NoError(t, err)
+		opts, err := ParseHeadlineOptions(tc.options)
+		require.NoError(t, err)
+		actual, err := Headline("english", tc.document, q, opts)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, actual, "%s %s", tc.query, tc.options)
+	}
+}
+
+func TestParseHeadlineOptions(t *testing.T) {
+	opts, err := ParseHeadlineOptions(
+		`startsel="<em class=""hl"">", StopSel = </em>,MaxWords=10 minwords=2, HighlightAll=off`,
+	)
+	require.NoError(t, err)
+	expected := DefaultHeadlineOptions()
+	expected.StartSel = `<em class="hl">`
+	expected.StopSel = `</em>`
+	expected.MaxWords = 10
+	expected.MinWords = 2
+	assert.Equal(t, expected, opts)
+
+	// The word limits aren't validated when highlighting the whole document.
+	_, err = ParseHeadlineOptions(`MinWords=10, MaxWords=5, HighlightAll=TRUE`)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{`MaxWords`, `invalid parameter list format: "MaxWords"`},
+		{`Max Words=3`, `invalid parameter list format: "Max Words=3"`},
+		{`StartSel="<b>`, `invalid parameter list format: "StartSel=\"<b>"`},
+		{`MaxWords=ten`, `invalid input syntax for type integer: "ten"`},
+		{`Foo=1`, `unrecognized headline parameter: "Foo"`},
+		{`MinWords=10, MaxWords=5`, `MinWords must be less than MaxWords`},
+		{`MinWords=0`, `MinWords must be positive`},
+		{`ShortWord=-1`, `ShortWord must be >= 0`},
+		{`MaxFragments=-1`, `MaxFragments must be >= 0`},
+	} {
+		_, err := ParseHeadlineOptions(tc.input)
+		assert.EqualError(t, err, tc.err, tc.input)
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tsearch
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+)
+
+// ispellDictionary is a morphological dictionary that reduces the inflected
+// forms of a word to the word's base form, using a word list and a set of
+// affix rules in the Hunspell format. It only recognizes tokens that reduce
+// to a word in its word list.
+//
+// This is a simplified version of the Postgres ispell template: affix flags
+// are single characters, continuation flags are ignored, and at most one
+// prefix and one suffix are removed from each token.
+type ispellDictionary struct {
+	// words maps each base form to the set of affix flags that apply to it.
+	words     map[string]string
+	prefixes  []affixRule
+	suffixes  []affixRule
+	stopwords map[string]struct{}
+}
+
+// affixRule is a single PFX or SFX rule. A word with the rule's flag can be
+// inflected by removing strip from the start (for prefixes) or end (for
+// suffixes) of the word and adding add in its place, as long as the word
+// matches cond.
+type affixRule struct {
+	flag         rune
+	crossProduct bool
+	strip        string
+	add          string
+	cond         []affixCondChar
+}
+
+// affixCondChar is a single character position of an affix rule condition: a
+// set of runes that the position may (or, if negated, may not) contain. A nil
+// set matches any rune.
+type affixCondChar struct {
+	runes   map[rune]struct{}
+	negated bool
+}
+
+func (c affixCondChar) matches(r rune) bool {
+	if c.runes == nil {
+		return true
+	}
+	_, ok := c.runes[r]
+	return ok != c.negated
+}
+
+func newIspellDictionary(
+	dictFile, affFile string, stopwords map[string]struct{},
+) (*ispellDictionary, error) {
+	d := &ispellDictionary{words: make(map[string]string), stopwords: stopwords}
+	for i, entry := range splitDictionaryEntries(dictFile) {
+		if i == 0 {
+			// Hunspell dictionaries start with an approximate word count.
+			if _, err := strconv.Atoi(entry); err == nil {
+				continue
+			}
+		}
+		word, flags, _ := strings.Cut(entry, "/")
+		word = strings.ToLower(strings.TrimSpace(word))
+		d.words[word] += strings.TrimSpace(flags)
+	}
+	if err := d.parseAffixes(affFile); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// parseAffixes parses the PFX and SFX directives of a Hunspell affix file.
+// Each group of rules starts with a header line of the form
+//
+//	SFX <flag> <cross product: Y or N> <number of rules>
+//
+// followed by that many rule lines of the form
+//
+//	SFX <flag> <strip or 0> <add or 0> <condition>
+func (d *ispellDictionary) parseAffixes(affFile string) error {
+	crossProduct := make(map[string]bool)
+	for _, line := range strings.Split(affFile, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		kind := fields[0]
+		if kind != "PFX" && kind != "SFX" {
+			continue
+		}
+		invalid := func() error {
+			return pgerror.Newf(pgcode.ConfigFile, "invalid affix rule %q", strings.TrimSpace(line))
+		}
+		if len(fields) < 4 {
+			return invalid()
+		}
+		flag, size := utf8.DecodeRuneInString(fields[1])
+		if size != len(fields[1]) {
+			return invalid()
+		}
+		key := kind + fields[1]
+		cross, isHeader := crossProduct[key]
+		if !isHeader {
+			if _, err := strconv.Atoi(fields[3]); err != nil {
+				return invalid()
+			}
+			crossProduct[key] = fields[2] == "Y"
+			continue
+		}
+		rule := affixRule{flag: flag, crossProduct: cross}
+		if fields[2] != "0" {
+			rule.strip = strings.ToLower(fields[2])
+		}
+		// The added affix may carry continuation flags, which we ignore.
+		if add, _, _ := strings.Cut(fields[3], "/"); add != "0" {
+			rule.add = strings.ToLower(add)
+		}
+		cond := "."
+		if len(fields) > 4 {
+			cond = fields[4]
+		}
+		var err error
+		if rule.cond, err = parseAffixCondition(cond); err != nil {
+			return invalid()
+		}
+		if kind == "PFX" {
+			d.prefixes = append(d.prefixes, rule)
+		} else {
+			d.suffixes = append(d.suffixes, rule)
+		}
+	}
+	return nil
+}
+
+// parseAffixCondition parses a condition such as "[^aeiou]y", in which each
+// position is either a literal rune, a bracketed (and possibly negated) set
+// of runes, or '.' to match any rune.
+func parseAffixCondition(cond string) ([]affixCondChar, error) {
+	var ret []affixCondChar
+	runes := []rune(strings.ToLower(cond))
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '.':
+			ret = append(ret, affixCondChar{})
+		case '[':
+			c := affixCondChar{runes: make(map[rune]struct{})}
+			i++
+			if i < len(runes) && runes[i] == '^' {
+				c.negated = true
+				i++
+			}
+			for ; i < len(runes) && runes[i] != ']'; i++ {
+				c.runes[runes[i]] = struct{}{}
+			}
+			if i == len(runes) {
+				return nil, pgerror.Newf(pgcode.ConfigFile, "unterminated affix condition %q", cond)
+			}
+			ret = append(ret, c)
+		default:
+			ret = append(ret, affixCondChar{runes: map[rune]struct{}{runes[i]: {}}})
+		}
+	}
+	return ret, nil
+}
+
+// matchesSuffixCondition returns whether the end of word matches the rule's
+// condition.
+func (r *affixRule) matchesSuffixCondition(word string) bool {
+	runes := []rune(word)
+	if len(runes) < len(r.cond) {
+		return false
+	}
+	runes = runes[len(runes)-len(r.cond):]
+	for i, c := range r.cond {
+		if !c.matches(runes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesPrefixCondition returns whether the start of word matches the rule's
+// condition.
+func (r *affixRule) matchesPrefixCondition(word string) bool {
+	runes := []rune(word)
+	if len(runes) < len(r.cond) {
+		return false
+	}
+	for i, c := range r.cond {
+		if !c.matches(runes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// removeSuffix undoes the suffix rule on the given inflected word, returning
+// false if the rule can't have produced it.
+func (r *affixRule) removeSuffix(word string) (string, bool) {
+	if !strings.HasSuffix(word, r.add) || len(word) == len(r.add) {
+		return "", false
+	}
+	stem := word[:len(word)-len(r.add)] + r.strip
+	return stem, r.matchesSuffixCondition(stem)
+}
+
+// removePrefix undoes the prefix rule on the given inflected word, returning
+// false if the rule can't have produced it.
+func (r *affixRule) removePrefix(word string) (string, bool) {
+	if !strings.HasPrefix(word, r.add) || len(word) == len(r.add) {
+		return "", false
+	}
+	stem := r.strip + word[len(r.add):]
+	return stem, r.matchesPrefixCondition(stem)
+}
+
+// hasFlags returns whether word is in the word list with all the given flags.
+func (d *ispellDictionary) hasFlags(word string, flags ...rune) bool {
+	wordFlags, ok := d.words[word]
+	if !ok {
+		return false
+	}
+	for _, f := range flags {
+		if !strings.ContainsRune(wordFlags, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Lexize implements the Dictionary interface.
+func (d *ispellDictionary) Lexize(token string) ([]string, bool) {
+	lower := strings.ToLower(token)
+	if _, ok := d.stopwords[lower]; ok {
+		return nil, true
+	}
+	var ret []string
+	add := func(stem string) {
+		for _, s := range ret {
+			if s == stem {
+				return
+			}
+		}
+		ret = append(ret, stem)
+	}
+	if _, ok := d.words[lower]; ok {
+		add(lower)
+	}
+	for i := range d.suffixes {
+		s := &d.suffixes[i]
+		if stem, ok := s.removeSuffix(lower); ok && d.hasFlags(stem, s.flag) {
+			add(stem)
+		}
+	}
+	for i := range d.prefixes {
+		p := &d.prefixes[i]
+		withoutPrefix, ok := p.removePrefix(lower)
+		if !ok {
+			continue
+		}
+		if d.hasFlags(withoutPrefix, p.flag) {
+			add(withoutPrefix)
+		}
+		if !p.crossProduct {
+			continue
+		}
+		for j := range d.suffixes {
+			s := &d.suffixes[j]
+			if !s.crossProduct {
+				continue
+			}
+			if stem, ok := s.removeSuffix(withoutPrefix); ok && d.hasFlags(stem, p.flag, s.flag) {
+				add(stem)
+			}
+		}
+	}
+	return ret, len(ret) > 0
+}
@@ -9,6 +9,9 @@ import (
 	"math"
 	"sort"
 	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 )
 
 // defaultWeights is the default list of weights corresponding to the tsvector
@@ -20,12 +23,8 @@ var defaultWeights = [4]float32{0.1, 0.2, 0.4, 1.0}
 // 0, the default, ignores the document length.
 // 1 devides the rank by 1 + the logarithm of the document length.
 // 2 divides the rank by the document length.
-// 4 divides the rank by the mean harmonic distance between extents.
-//
-//	NOTE: This is only implemented by ts_rank_cd, which is currently not
-//	implemented by CockroachDB. This constant is left for consistency with
-//	the original PostgreSQL source code.
-//
+// 4 divides the rank by the mean harmonic distance between extents. This is
+// only implemented by ts_rank_cd.
 // 8 divides the rank by the number of unique words in document.
 // 16 divides the rank by 1 + the logarithm of the number of unique words in document.
 // 32 divides the rank by itself + 1.
@@ -39,8 +38,7 @@ const (
 	// rankNormLength divides the rank by the document length.
 	rankNormLength = 0x02
 	// rankNormExtdist divides the rank by the mean harmonic distance between extents.
-	// Note, this is only implemented by ts_rank_cd, which is not currently implemented
-	// by CockroachDB. The constant is kept for consistency with Postgres.
+	// Note, this is only implemented by ts_rank_cd.
 	rankNormExtdist = 0x04
 	// rankNormUniq divides the rank by the number of unique words in document.
 	rankNormUniq = 0x08
@@ -52,7 +50,6 @@ const (
 
 // Defeat the unused linter.
 var _ = rankNoNorm
-var _ = rankNormExtdist
 
 // cntLen returns the count of represented lexemes in a tsvector, including
 // the number of repeated lexemes in the vector.
@@ -279,3 +276,252 @@ func wordDistance(dist int) float32 {
 	}
 	return float32(1.0 / (1.005 + 0.05*math.Exp(float64(float32(dist)/1.5-2))))
 }
+
+// RankCD implements the ts_rank_cd functionality, which ranks a tsvector
+// against a tsquery using the "cover density" method described in Clarke,
+// Cormack and Tudhope's "Relevance Ranking for One to Three Term Queries".
+// The parameters are the same as the ones of Rank. Unlike Rank, RankCD
+// requires positional information, so it returns 0 for stripped vectors.
+//
+// This function is translated from the calc_rank_cd function in tsrank.c.
+// https://github.com/postgres/postgres/blob/765f5df726918bcdcfd16bcc5418e48663d1dd59/src/backend/utils/adt/tsrank.c#L855
+func RankCD(weights []float32, v TSVector, q TSQuery, method int) (float32, error) {
+	w := defaultWeights
+	if weights != nil {
+		copy(w[:4], weights[:4])
+	}
+	var invWeights [4]float64
+	for i := range w {
+		if w[i] > 1.0 {
+			return 0, pgerror.New(pgcode.InvalidParameterValue, "weight out of range")
+		}
+		invWeights[i] = 1.0 / float64(w[i])
+	}
+	if len(v) == 0 || q.root == nil {
+		return 0, nil
+	}
+
+	doc := makeCoverDoc(v, q)
+	var res, sumDist, prevExtPos float64
+	var nExtent int
+	for start := 0; ; {
+		c, ok, err := nextCover(doc, v, q, start)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			break
+		}
+		start = c.begin + 1
+
+		var invSum float64
+		for i := c.begin; i <= c.end; i++ {
+			invSum += invWeights[doc[i].pos.weight.val()]
+		}
+		cPos := float64(c.end-c.begin+1) / invSum
+
+		// If the document is big enough, the cover's positions may be equal due
+		// to the limit of positional information. In this case, approximate the
+		// number of noise words as half of the cover's length.
+		nNoise := (c.q - c.p) - (c.end - c.begin)
+		if nNoise < 0 {
+			nNoise = (c.end - c.begin) / 2
+		}
+		res += cPos / float64(1+nNoise)
+
+		curExtPos := float64(c.q+c.p) / 2.0
+		// The position check prevents division by zero in case of multiple
+		// lexemes at the same position.
+		if nExtent > 0 && curExtPos > prevExtPos {
+			sumDist += 1.0 / (curExtPos - prevExtPos)
+		}
+		prevExtPos = curExtPos
+		nExtent++
+	}
+
+	if method&rankNormLoglength > 0 {
+		res /= math.Log(float64(cntLen(v) + 1))
+	}
+
+	if method&rankNormLength > 0 {
+		l := cntLen(v)
+		if l > 0 {
+			res /= float64(l)
+		}
+	}
+
+	if method&rankNormExtdist > 0 && nExtent > 0 && sumDist > 0 {
+		res /= float64(nExtent) / sumDist
+	}
+
+	if method&rankNormUniq > 0 {
+		res /= float64(len(v))
+	}
+
+	if method&rankNormLoguniq > 0 {
+		res /= math.Log(float64(len(v)+1)) / math.Log(2.0)
+	}
+
+	if method&rankNormRdivrplus1 > 0 {
+		res /= res + 1
+	}
+
+	return float32(res), nil
+}
+
+// coverDocEntry is a position within a document at which a lexeme that
+// matches a query term occurs.
+type coverDocEntry struct {
+	pos tsPosition
+	// termIdx is the index of the lexeme within the ranked tsvector.
+	termIdx int
+}
+
+// makeCoverDoc returns the positions of all the lexemes of v that match a term
+// of q, sorted by position. Stripped lexemes are given a position of 0.
+func makeCoverDoc(v TSVector, q TSQuery) []coverDocEntry {
+	var doc []coverDocEntry
+	seen := make(map[coverDocEntry]struct{})
+	var visit func(n *tsNode)
+	visit = func(n *tsNode) {
+		if n == nil {
+			return
+		}
+		if n.op != invalid {
+			visit(n.l)
+			visit(n.r)
+			return
+		}
+		targetWeight := weightAny
+		if len(n.term.positions) > 0 {
+			targetWeight = n.term.positions[0].weight &^ weightStar
+			if targetWeight == 0 {
+				targetWeight = weightAny
+			}
+		}
+		target := n.term.lexeme
+		prefix := n.term.isPrefixMatch()
+		for i := sort.Search(len(v), func(i int) bool {
+			return v[i].lexeme >= target
+		}); i < len(v); i++ {
+			if prefix {
+				if !strings.HasPrefix(v[i].lexeme, target) {
+					break
+				}
+			} else if v[i].lexeme != target {
+				break
+			}
+			positions := v[i].positions
+			if len(positions) == 0 {
+				positions = []tsPosition{{}}
+			}
+			for _, pos := range positions {
+				if !pos.weight.matches(targetWeight) {
+					continue
+				}
+				entry := coverDocEntry{pos: pos, termIdx: i}
+				if _, ok := seen[entry]; !ok {
+					seen[entry] = struct{}{}
+					doc = append(doc, entry)
+				}
+			}
+		}
+	}
+	visit(q.root)
+	sort.Slice(doc, func(i, j int) bool {
+		if doc[i].pos.position != doc[j].pos.position {
+			return doc[i].pos.position < doc[j].pos.position
+		}
+		return doc[i].termIdx < doc[j].termIdx
+	})
+	return doc
+}
+
+// docCover is a minimal extent of a document that satisfies a query. begin and
+// end are the indexes of its first and last entry within the cover document,
+// while p and q are its first and last positions.
+type docCover struct {
+	begin, end int
+	p, q       int
+}
+
+// nextCover finds the first cover in doc that begins at or after the start
+// index. It returns false if there are no more covers.
+func nextCover(doc []coverDocEntry, v TSVector, q TSQuery, start int) (docCover, bool, error) {
+	for ; start < len(doc); start++ {
+		// Find the upper bound of the cover, moving forward from the start.
+		var acc coverAccumulator
+		end := -1
+		for i := start; i < len(doc); i++ {
+			acc.add(doc[i])
+			ok, err := acc.eval(v, q, false /* skipNot */)
+			if err != nil {
+				return docCover{}, false, err
+			}
+			if ok {
+				if doc[i].pos.position > 0 {
+					end = i
+				}
+				break
+			}
+		}
+		if end < 0 {
+			return docCover{}, false, nil
+		}
+
+		// Find the lower bound of the cover, moving backward from the upper
+		// bound.
+		acc = coverAccumulator{}
+		for i := end; i >= start; i-- {
+			acc.add(doc[i])
+			ok, err := acc.eval(v, q, true /* skipNot */)
+			if err != nil {
+				return docCover{}, false, err
+			}
+			if ok {
+				return docCover{
+					begin: i,
+					end:   end,
+					p:     int(doc[i].pos.position),
+					q:     int(doc[end].pos.position),
+				}, true, nil
+			}
+		}
+	}
+	return docCover{}, false, nil
+}
+
+// coverAccumulator collects the cover document entries seen while searching
+// for a cover, so that the query can be evaluated against them.
+type coverAccumulator struct {
+	positions map[int][]tsPosition
+}
+
+func (a *coverAccumulator) add(entry coverDocEntry) {
+	if a.positions == nil {
+		a.positions = make(map[int][]tsPosition)
+	}
+	positions := a.positions[entry.termIdx]
+	if entry.pos.position > 0 {
+		positions = append(positions, entry.pos)
+	}
+	a.positions[entry.termIdx] = positions
+}
+
+// eval evaluates the query against a vector made up of the lexemes of v at the
+// positions collected so far.
+func (a *coverAccumulator) eval(v TSVector, q TSQuery, skipNot bool) (bool, error) {
+	idxs := make([]int, 0, len(a.positions))
+	for idx := range a.positions {
+		idxs = append(idxs, idx)
+	}
+	sort.Ints(idxs)
+	vec := make(TSVector, len(idxs))
+	for i, idx := range idxs {
+		positions := make([]tsPosition, len(a.positions[idx]))
+		copy(positions, a.positions[idx])
+		vec[i] = tsTerm{lexeme: v[idx].lexeme, positions: sortAndUniqTSPositions(positions)}
+	}
+	evaluator := tsEvaluator{v: vec, q: q, skipNot: skipNot}
+	return evaluator.eval()
+}
@@ -39,3 +39,41 @@ func TestRank(t *testing.T) {
 		assert.Equalf(t, tt.expected, actual, "Rank(%v, %v, %v, %v)", tt.weights, tt.v, tt.q, tt.method)
 	}
 }
+
+func TestRankCD(t *testing.T) {
+	tests := []struct {
+		weights  []float32
+		v        string
+		q        string
+		method   int
+		expected float32
+	}{
+		{v: "a:1 b:2", q: "a & b", expected: 0.1},
+		{v: "a:1 b:3", q: "a & b", expected: 0.05},
+		{v: "a:1A b:2A", q: "a & b", expected: 1},
+		{v: "a:1 s:2C d g", q: "a | s", expected: 0.3},
+		{v: "a:1 s:2C d g", q: "a | s", method: 4, expected: 0.15},
+		{v: "a:1 s:2C d g", q: "a | s", method: 32, expected: 0.23076923},
+		{v: "a:1 b:2", q: "a & b", weights: []float32{0.5, 0.2, 0.4, 1.0}, expected: 0.5},
+		{v: "a:1 b:2 a:3 b:4", q: "a <-> b", expected: 0.2},
+		{v: "a:1 b:2", q: "a & !b", expected: 0.1},
+		{v: "a b", q: "a & b", expected: 0},
+		{v: "a:1 b:2", q: "c", expected: 0},
+	}
+	for _, tt := range tests {
+		v, err := ParseTSVector(tt.v)
+		assert.NoError(t, err)
+		q, err := ParseTSQuery(tt.q)
+		assert.NoError(t, err)
+		actual, err := RankCD(tt.weights, v, q, tt.method)
+		assert.NoError(t, err)
+		assert.Equalf(t, tt.expected, actual, "RankCD(%v, %v, %v, %v)", tt.weights, tt.v, tt.q, tt.method)
+	}
+
+	v, err := ParseTSVector("a:1")
+	assert.NoError(t, err)
+	q, err := ParseTSQuery("a")
+	assert.NoError(t, err)
+	_, err = RankCD([]float32{0.1, 0.2, 0.4, 1.5}, v, q, 0)
+	assert.EqualError(t, err, "weight out of range")
+}
@@ -15,10 +15,11 @@ import (
 //go:embed stopwords/*
 var stopwordFS embed.FS
 
-var stopwordsMap map[string]map[string]struct{}
+// stopwordsMap contains the built-in stop word lists, keyed by language.
+var stopwordsMap = loadStopwords()
 
-func init() {
-	stopwordsMap = make(map[string]map[string]struct{})
+func loadStopwords() map[string]map[string]struct{} {
+	stopwordsMap := make(map[string]map[string]struct{})
 	dir, err := stopwordFS.ReadDir("stopwords")
 	if err != nil {
 		panic("error loading stopwords: " + err.Error())
@@ -40,4 +41,5 @@ func init() {
 	}
 	// The simple text search config has no stopwords.
 	stopwordsMap["simple"] = nil
+	return stopwordsMap
 }
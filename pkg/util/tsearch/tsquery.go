@@ -8,6 +8,8 @@ package tsearch
 import (
 	"fmt"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/cockroachdb/cockroach/pkg/keysbase"
 	"github.com/cockroachdb/cockroach/pkg/sql/inverted"
@@ -411,28 +413,198 @@ func (p *tsQueryParser) syntaxError() (*tsNode, error) {
 // ToTSQuery implements the to_tsquery builtin, which lexes an input, performs
 // stopwording and normalization on the tokens, and returns a parsed query.
 func ToTSQuery(config string, input string) (TSQuery, error) {
-	return toTSQuery(config, invalid, input)
+	c, err := GetBuiltinConfig(config)
+	if err != nil {
+		return TSQuery{}, err
+	}
+	return c.ToTSQuery(input)
 }
 
 // PlainToTSQuery implements the plainto_tsquery builtin, which lexes an input,
 // performs stopwording and normalization on the tokens, and returns a parsed
 // query, interposing the & operator between each token.
 func PlainToTSQuery(config string, input string) (TSQuery, error) {
-	return toTSQuery(config, and, input)
+	c, err := GetBuiltinConfig(config)
+	if err != nil {
+		return TSQuery{}, err
+	}
+	return c.PlainToTSQuery(input)
 }
 
 // PhraseToTSQuery implements the phraseto_tsquery builtin, which lexes an input,
 // performs stopwording and normalization on the tokens, and returns a parsed
 // query, interposing the <-> operator between each token.
 func PhraseToTSQuery(config string, input string) (TSQuery, error) {
-	return toTSQuery(config, followedby, input)
+	c, err := GetBuiltinConfig(config)
+	if err != nil {
+		return TSQuery{}, err
+	}
+	return c.PhraseToTSQuery(input)
+}
+
+// WebSearchToTSQuery implements the websearch_to_tsquery builtin, which
+// produces a query from an input written in the syntax commonly used by web
+// search engines. See Config.WebSearchToTSQuery for details.
+func WebSearchToTSQuery(config string, input string) (TSQuery, error) {
+	c, err := GetBuiltinConfig(config)
+	if err != nil {
+		return TSQuery{}, err
+	}
+	return c.WebSearchToTSQuery(input)
+}
+
+// ToTSQuery is like the ToTSQuery function, but normalizes the tokens with the
+// receiver's dictionaries.
+func (c *Config) ToTSQuery(input string) (TSQuery, error) {
+	return c.toTSQuery(invalid, input)
+}
+
+// PlainToTSQuery is like the PlainToTSQuery function, but normalizes the
+// tokens with the receiver's dictionaries.
+func (c *Config) PlainToTSQuery(input string) (TSQuery, error) {
+	return c.toTSQuery(and, input)
+}
+
+// PhraseToTSQuery is like the PhraseToTSQuery function, but normalizes the
+// tokens with the receiver's dictionaries.
+func (c *Config) PhraseToTSQuery(input string) (TSQuery, error) {
+	return c.toTSQuery(followedby, input)
+}
+
+// WebSearchToTSQuery is like the WebSearchToTSQuery function, but normalizes
+// the tokens with the receiver's dictionaries. The input syntax is the
+// following:
+//
+//   - Unquoted words are combined with the & operator.
+//   - Words within double quotes are combined with the <-> operator.
+//   - The word "or" combines the operands around it with the | operator.
+//   - A dash in front of a word or of a quoted phrase negates it.
+//
+// Unlike the other functions that produce a TSQuery, WebSearchToTSQuery never
+// returns a syntax error: any other punctuation is ignored.
+func (c *Config) WebSearchToTSQuery(input string) (TSQuery, error) {
+	type webSearchOperand struct {
+		words   []string
+		negated bool
+		or      bool
+	}
+	var operands []webSearchOperand
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		if unicode.IsSpace(r) {
+			i += size
+			continue
+		}
+		negated := false
+		if r == '-' {
+			negated = true
+			i += size
+			if i >= len(input) {
+				break
+			}
+			r, size = utf8.DecodeRuneInString(input[i:])
+			if unicode.IsSpace(r) {
+				continue
+			}
+		}
+		if r == '"' {
+			// A quoted phrase extends up to the closing quote or the end of the
+			// input.
+			i += size
+			end := strings.IndexByte(input[i:], '"')
+			if end < 0 {
+				end = len(input) - i
+			}
+			if words := TSParse(input[i : i+end]); len(words) > 0 {
+				operands = append(operands, webSearchOperand{words: words, negated: negated})
+			}
+			i += end + 1
+			continue
+		}
+		end := strings.IndexFunc(input[i:], func(r rune) bool {
+			return unicode.IsSpace(r) || r == '"'
+		})
+		if end < 0 {
+			end = len(input) - i
+		}
+		word := input[i : i+end]
+		i += end
+		if !negated && strings.EqualFold(word, "or") {
+			operands = append(operands, webSearchOperand{or: true})
+			continue
+		}
+		if words := TSParse(word); len(words) > 0 {
+			operands = append(operands, webSearchOperand{words: words, negated: negated})
+		}
+	}
+
+	var tokens TSVector
+	foundStopwords := false
+	haveOperand, pendingOr := false, false
+	for _, operand := range operands {
+		if operand.or {
+			// An "or" is only meaningful between two operands; it's ignored
+			// elsewhere.
+			pendingOr = haveOperand
+			continue
+		}
+		if haveOperand {
+			op := and
+			if pendingOr {
+				op = or
+			}
+			tokens = append(tokens, tsTerm{operator: op})
+		}
+		pendingOr = false
+		haveOperand = true
+		if operand.negated {
+			tokens = append(tokens, tsTerm{operator: not})
+		}
+		tokens = append(tokens, tsTerm{operator: lparen})
+		for j, word := range operand.words {
+			if j > 0 {
+				tokens = append(tokens, tsTerm{operator: followedby, followedN: 1})
+			}
+			lexemes := c.lexize(word)
+			switch len(lexemes) {
+			case 0:
+				foundStopwords = true
+				tokens = append(tokens, tsTerm{})
+			case 1:
+				tokens = append(tokens, tsTerm{lexeme: lexemes[0]})
+			default:
+				tokens = append(tokens, tsTerm{operator: lparen})
+				for k, lexeme := range lexemes {
+					if k > 0 {
+						tokens = append(tokens, tsTerm{operator: or})
+					}
+					tokens = append(tokens, tsTerm{lexeme: lexeme})
+				}
+				tokens = append(tokens, tsTerm{operator: rparen})
+			}
+		}
+		tokens = append(tokens, tsTerm{operator: rparen})
+	}
+
+	queryParser := tsQueryParser{terms: tokens, input: input}
+	query, err := queryParser.parse()
+	if err != nil {
+		return query, err
+	}
+	if foundStopwords {
+		query = cleanupStopwords(query)
+		if query.root == nil {
+			return query, pgerror.Newf(pgcode.Syntax, "text-search query doesn't contain lexemes: %s", input)
+		}
+	}
+	return query, nil
 }
 
 // toTSQuery implements the to_tsquery builtin, which lexes an input,
 // performs stopwording and normalization on the tokens, and returns a parsed
 // query. If the interpose operator is not invalid, it's interposed between each
 // token in the input.
-func toTSQuery(config string, interpose tsOperator, input string) (TSQuery, error) {
+func (c *Config) toTSQuery(interpose tsOperator, input string) (TSQuery, error) {
 	vector, err := lexTSQuery(input)
 	if err != nil {
 		return TSQuery{}, err
@@ -494,14 +666,25 @@ func toTSQuery(config string, interpose tsOperator, input string) (TSQuery, erro
 				}
 				tokens = append(tokens, term)
 			}
-			lexeme, stopWord, err := TSLexize(config, lexemeTokens[j])
-			if err != nil {
-				return TSQuery{}, err
-			}
-			if stopWord {
+			lexemes := c.lexize(lexemeTokens[j])
+			switch len(lexemes) {
+			case 0:
 				foundStopwords = true
+				tokens = append(tokens, tsTerm{positions: tok.positions})
+			case 1:
+				tokens = append(tokens, tsTerm{lexeme: lexemes[0], positions: tok.positions})
+			default:
+				// A dictionary produced several lexemes for the token, any of
+				// which may match.
+				tokens = append(tokens, tsTerm{operator: lparen})
+				for k, lexeme := range lexemes {
+					if k > 0 {
+						tokens = append(tokens, tsTerm{operator: or})
+					}
+					tokens = append(tokens, tsTerm{lexeme: lexeme, positions: tok.positions})
+				}
+				tokens = append(tokens, tsTerm{operator: rparen})
 			}
-			tokens = append(tokens, tsTerm{lexeme: lexeme, positions: tok.positions})
 		}
 	}
 
@@ -647,3 +830,63 @@ func cleanupStopword(node *tsNode) (ret *tsNode, lAdd int, rAdd int) {
 	// Otherwise we found a non-phrase operator; keep it as-is.
 	return node, 0, 0
 }
+
+// Rewrite implements the ts_rewrite builtin. It returns a copy of the query in
+// which every occurrence of the target query is replaced by the substitute.
+// Occurrences are matched structurally, except that the operands of the & and
+// | operators may appear in either order.
+func Rewrite(q TSQuery, target TSQuery, substitute TSQuery) TSQuery {
+	if q.root == nil || target.root == nil || substitute.root == nil {
+		return q
+	}
+	return TSQuery{root: rewriteTSNode(q.root, target.root, substitute.root)}
+}
+
+func rewriteTSNode(n, target, substitute *tsNode) *tsNode {
+	if n == nil {
+		return nil
+	}
+	if tsNodesEqual(n, target) {
+		return copyTSNode(substitute)
+	}
+	ret := *n
+	ret.l = rewriteTSNode(n.l, target, substitute)
+	ret.r = rewriteTSNode(n.r, target, substitute)
+	return &ret
+}
+
+func copyTSNode(n *tsNode) *tsNode {
+	if n == nil {
+		return nil
+	}
+	ret := *n
+	ret.l = copyTSNode(n.l)
+	ret.r = copyTSNode(n.r)
+	return &ret
+}
+
+// tsNodesEqual returns whether the two query trees are equivalent.
+func tsNodesEqual(a, b *tsNode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.op != b.op || a.followedN != b.followedN {
+		return false
+	}
+	switch a.op {
+	case invalid:
+		var aWeight, bWeight tsWeight
+		if len(a.term.positions) > 0 {
+			aWeight = a.term.positions[0].weight
+		}
+		if len(b.term.positions) > 0 {
+			bWeight = b.term.positions[0].weight
+		}
+		return a.term.lexeme == b.term.lexeme && aWeight == bWeight
+	case and, or:
+		if tsNodesEqual(a.l, b.r) && tsNodesEqual(a.r, b.l) {
+			return true
+		}
+	}
+	return tsNodesEqual(a.l, b.l) && tsNodesEqual(a.r, b.r)
+}
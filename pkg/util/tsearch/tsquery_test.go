@@ -290,3 +290,59 @@ func TestParseTSQueryError(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestWebSearchToTSQuery(t *testing.T) {
+	for _, tc := range []struct {
+		config   string
+		input    string
+		expected string
+	}{
+		{`english`, `The fat rats`, `'fat' & 'rat'`},
+		{`english`, `"supernovae stars" -crab`, `'supernova' <-> 'star' & !'crab'`},
+		{`english`, `"sad cat" or "fat rat"`, `'sad' <-> 'cat' | 'fat' <-> 'rat'`},
+		{`english`, `signal -"segmentation fault"`, `'signal' & !( 'segment' <-> 'fault' )`},
+		{`english`, `""" )( dummy \\ query <->`, `'dummi' <-> 'queri'`},
+		{`english`, `"the cat in the hat"`, `'cat' <3> 'hat'`},
+		{`simple`, `cat or dog`, `'cat' | 'dog'`},
+		{`simple`, `or cat or or dog or`, `'cat' | 'dog'`},
+		{`simple`, `cat -or`, `'cat' & !'or'`},
+		{`simple`, `cat - dog`, `'cat' & 'dog'`},
+		{`simple`, `wi-fi & (router | !modem)`, `'wi' <-> 'fi' & 'router' & 'modem'`},
+		{`simple`, `"unterminated phrase`, `'unterminated' <-> 'phrase'`},
+	} {
+		actual, err := WebSearchToTSQuery(tc.config, tc.input)
+		require.NoError(t, err, tc.input)
+		assert.Equal(t, tc.expected, actual.String(), tc.input)
+	}
+
+	for _, input := range []string{``, `  - " "`, `the`} {
+		_, err := WebSearchToTSQuery(`english`, input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	for _, tc := range []struct {
+		query, target, substitute string
+		expected                  string
+	}{
+		{`a & b`, `a`, `c`, `'c' & 'b'`},
+		{`a & b`, `a`, `foo | bar`, `( 'foo' | 'bar' ) & 'b'`},
+		{`(a & b) | c`, `b & a`, `d`, `'d' | 'c'`},
+		{`a <-> b | b <-> a`, `a <-> b`, `c`, `'c' | 'b' <-> 'a'`},
+		{`a:A & a`, `a`, `c`, `'a':A & 'c'`},
+		{`a & b`, `c`, `d`, `'a' & 'b'`},
+	} {
+		q, err := ParseTSQuery(tc.query)
+		require.NoError(t, err)
+		target, err := ParseTSQuery(tc.target)
+		require.NoError(t, err)
+		substitute, err := ParseTSQuery(tc.substitute)
+		require.NoError(t, err)
+		before := q.String()
+		actual := Rewrite(q, target, substitute)
+		assert.Equal(t, tc.expected, actual.String())
+		// The input query must not be modified.
+		assert.Equal(t, before, q.String())
+	}
+}
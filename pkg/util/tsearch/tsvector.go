@@ -13,7 +13,6 @@ import (
 	"unicode"
 	"unicode/utf8"
 
-	"github.com/blevesearch/snowballstem"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/errors"
@@ -401,47 +400,234 @@ func TSParse(input string) []string {
 // routines like to_tsvector and to_tsquery.
 // It can return true in the second parameter to indicate a stopword was found.
 func TSLexize(config string, token string) (lexeme string, stopWord bool, err error) {
-	stopwords, ok := stopwordsMap[config]
-	if !ok {
-		return "", false, pgerror.Newf(pgcode.UndefinedObject, "text search configuration %q does not exist", config)
-	}
-
-	lower := strings.ToLower(token)
-	if _, ok := stopwords[lower]; ok {
-		return "", true, nil
-	}
-	stemmer, err := getStemmer(config)
+	c, err := GetBuiltinConfig(config)
 	if err != nil {
 		return "", false, err
 	}
-	env := snowballstem.NewEnv(lower)
-	stemmer(env)
-	return env.Current(), false, nil
+	lexemes := c.lexize(token)
+	if len(lexemes) == 0 {
+		return "", true, nil
+	}
+	return lexemes[0], false, nil
 }
 
 // DocumentToTSVector parses an input document into lexemes, removes stop words,
 // stems and normalizes the lexemes, and returns a TSVector annotated with
-// lexeme positions according to a text search configuration passed by name.
+// lexeme positions according to a built-in text search configuration passed by
+// name.
 func DocumentToTSVector(config string, input string) (TSVector, error) {
+	c, err := GetBuiltinConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return c.DocumentToTSVector(input)
+}
+
+// DocumentToTSVector parses an input document into lexemes, normalizes them
+// with the receiver's dictionaries, and returns a TSVector annotated with
+// lexeme positions. Tokens that are stop words or that no dictionary
+// recognizes are left out, but still count towards the positions of the
+// following lexemes.
+func (c *Config) DocumentToTSVector(input string) (TSVector, error) {
 	tokens := TSParse(input)
 	vector := make(TSVector, 0, len(tokens))
 	for i := range tokens {
-		lexeme, stopWord, err := TSLexize(config, tokens[i])
-		if err != nil {
-			return nil, err
-		}
-		if stopWord {
-			continue
-		}
-
-		term := tsTerm{lexeme: lexeme}
 		pos := i + 1
 		if i > maxTSVectorPosition {
 			// Postgres silently truncates positions larger than 16383 to 16383.
 			pos = maxTSVectorPosition
 		}
-		term.positions = []tsPosition{{position: uint16(pos)}}
-		vector = append(vector, term)
+		// A dictionary may produce several lexemes for a token, all of which
+		// share the token's position.
+		for _, lexeme := range c.lexize(tokens[i]) {
+			term := tsTerm{lexeme: lexeme}
+			term.positions = []tsPosition{{position: uint16(pos)}}
+			vector = append(vector, term)
+		}
+	}
+	return normalizeTSVector(vector)
+}
+
+// DocumentsToTSVector is like DocumentToTSVector, but processes a list of
+// documents with a built-in text search configuration passed by name. See
+// Config.DocumentsToTSVector for details.
+func DocumentsToTSVector(config string, documents []string) (TSVector, error) {
+	c, err := GetBuiltinConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return c.DocumentsToTSVector(documents)
+}
+
+// DocumentsToTSVector is like DocumentToTSVector, but processes a list of
+// documents, such as the values of a JSON document. The positions of the
+// lexemes of each document follow the ones of the previous document, with a
+// gap of one position so that phrase queries don't match across documents.
+func (c *Config) DocumentsToTSVector(documents []string) (TSVector, error) {
+	var vector TSVector
+	pos := 0
+	for _, document := range documents {
+		foundLexemes := false
+		for _, token := range TSParse(document) {
+			pos++
+			for _, lexeme := range c.lexize(token) {
+				term := tsTerm{lexeme: lexeme}
+				term.positions = []tsPosition{{position: uint16(min(pos, maxTSVectorPosition))}}
+				vector = append(vector, term)
+				foundLexemes = true
+			}
+		}
+		if foundLexemes {
+			pos++
+		}
 	}
 	return normalizeTSVector(vector)
 }
+
+// parseTSWeightLabel returns the weight denoted by a weight label, which is
+// one of the letters A, B, C or D in either case. Weight D is returned as 0,
+// which is how it is stored in a TSVector.
+func parseTSWeightLabel(label string) (tsWeight, error) {
+	if len(label) == 1 {
+		switch label[0] {
+		case 'A', 'a':
+			return weightA, nil
+		case 'B', 'b':
+			return weightB, nil
+		case 'C', 'c':
+			return weightC, nil
+		case 'D', 'd':
+			return 0, nil
+		}
+	}
+	return 0, pgerror.Newf(pgcode.InvalidParameterValue, "unrecognized weight: %q", label)
+}
+
+// SetWeight implements the setweight builtin. It returns a copy of the input
+// vector in which every position is assigned the given weight label. If
+// lexemes is non-nil, only the positions of the listed lexemes are changed.
+// Stripped lexemes have no positions and are left unchanged.
+func SetWeight(v TSVector, weight string, lexemes []string) (TSVector, error) {
+	w, err := parseTSWeightLabel(weight)
+	if err != nil {
+		return nil, err
+	}
+	var only map[string]struct{}
+	if lexemes != nil {
+		only = make(map[string]struct{}, len(lexemes))
+		for _, lexeme := range lexemes {
+			only[lexeme] = struct{}{}
+		}
+	}
+	ret := make(TSVector, len(v))
+	for i, term := range v {
+		ret[i] = tsTerm{lexeme: term.lexeme}
+		if len(term.positions) == 0 {
+			continue
+		}
+		ret[i].positions = make([]tsPosition, len(term.positions))
+		copy(ret[i].positions, term.positions)
+		if only != nil {
+			if _, ok := only[term.lexeme]; !ok {
+				continue
+			}
+		}
+		for j := range ret[i].positions {
+			ret[i].positions[j].weight = w
+		}
+	}
+	return ret, nil
+}
+
+// Strip implements the strip builtin. It returns a copy of the input vector
+// with all position and weight information removed.
+func Strip(v TSVector) TSVector {
+	ret := make(TSVector, len(v))
+	for i, term := range v {
+		ret[i] = tsTerm{lexeme: term.lexeme}
+	}
+	return ret
+}
+
+// Delete implements the ts_delete builtin. It returns a copy of the input
+// vector without any of the given lexemes.
+func Delete(v TSVector, lexemes ...string) TSVector {
+	remove := make(map[string]struct{}, len(lexemes))
+	for _, lexeme := range lexemes {
+		remove[lexeme] = struct{}{}
+	}
+	ret := make(TSVector, 0, len(v))
+	for _, term := range v {
+		if _, ok := remove[term.lexeme]; !ok {
+			ret = append(ret, term)
+		}
+	}
+	return ret
+}
+
+// Filter implements the ts_filter builtin. It returns a copy of the input
+// vector that only retains the positions whose weight is one of the given
+// weight labels. Lexemes that are left without positions, including stripped
+// lexemes, are removed.
+func Filter(v TSVector, weights []string) (TSVector, error) {
+	var mask tsWeight
+	for _, label := range weights {
+		w, err := parseTSWeightLabel(label)
+		if err != nil {
+			return nil, err
+		}
+		if w == 0 {
+			w = weightD
+		}
+		mask |= w
+	}
+	ret := make(TSVector, 0, len(v))
+	for _, term := range v {
+		var positions []tsPosition
+		for _, pos := range term.positions {
+			w := pos.weight
+			if w == 0 {
+				w = weightD
+			}
+			if w&mask != 0 {
+				positions = append(positions, pos)
+			}
+		}
+		if len(positions) > 0 {
+			ret = append(ret, tsTerm{lexeme: term.lexeme, positions: positions})
+		}
+	}
+	return ret, nil
+}
+
+// Concat implements the || operator for tsvectors. The positions of the right
+// vector are shifted by the largest position of the left vector, so that the
+// result behaves as if the two underlying documents had been concatenated.
+func Concat(l, r TSVector) (TSVector, error) {
+	var maxPos uint16
+	for _, term := range l {
+		for _, pos := range term.positions {
+			if pos.position > maxPos {
+				maxPos = pos.position
+			}
+		}
+	}
+	ret := make(TSVector, 0, len(l)+len(r))
+	for _, term := range l {
+		positions := make([]tsPosition, len(term.positions))
+		copy(positions, term.positions)
+		ret = append(ret, tsTerm{lexeme: term.lexeme, positions: positions})
+	}
+	for _, term := range r {
+		positions := make([]tsPosition, len(term.positions))
+		for i, pos := range term.positions {
+			p := int(pos.position) + int(maxPos)
+			if p > maxTSVectorPosition {
+				p = maxTSVectorPosition
+			}
+			positions[i] = tsPosition{position: uint16(p), weight: pos.weight}
+		}
+		ret = append(ret, tsTerm{lexeme: term.lexeme, positions: positions})
+	}
+	return normalizeTSVector(ret)
+}
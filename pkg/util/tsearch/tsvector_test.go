@@ -213,3 +213,95 @@ func BenchmarkTSVector(b *testing.B) {
 		}
 	})
 }
+
+func TestDocumentsToTSVector(t *testing.T) {
+	for _, tc := range []struct {
+		documents []string
+		expected  string
+	}{
+		{nil, ``},
+		{[]string{`The Fat Rats`}, `'fat':2 'rat':3`},
+		{[]string{`The Fat Rats`, `dog`}, `'dog':5 'fat':2 'rat':3`},
+		{[]string{`fat`, `the`, `, `, `rats and dogs`}, `'dog':6 'fat':1 'rat':4`},
+	} {
+		actual, err := DocumentsToTSVector("english", tc.documents)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, actual.String())
+	}
+}
+
+func TestTSVectorFunctions(t *testing.T) {
+	mustParse := func(s string) TSVector {
+		v, err := ParseTSVector(s)
+		require.NoError(t, err)
+		return v
+	}
+
+	t.Run("setweight", func(t *testing.T) {
+		for _, tc := range []struct {
+			input    string
+			weight   string
+			lexemes  []string
+			expected string
+		}{
+			{`a:1 b:2,3 c`, `A`, nil, `'a':1A 'b':2A,3A 'c'`},
+			{`a:1 b:2,3 c`, `b`, []string{`a`, `c`, `x`}, `'a':1B 'b':2,3 'c'`},
+			{`a:1A b:2C`, `D`, nil, `'a':1 'b':2`},
+			{`a:1A b:2C`, `c`, []string{}, `'a':1A 'b':2C`},
+		} {
+			input := mustParse(tc.input)
+			actual, err := SetWeight(input, tc.weight, tc.lexemes)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual.String())
+			// The input must not be modified.
+			assert.Equal(t, mustParse(tc.input), input)
+		}
+		_, err := SetWeight(mustParse(`a:1`), `E`, nil)
+		assert.EqualError(t, err, `unrecognized weight: "E"`)
+	})
+
+	t.Run("strip", func(t *testing.T) {
+		assert.Equal(t, `'a' 'b' 'c'`, Strip(mustParse(`a:1A b:2,3 c`)).String())
+	})
+
+	t.Run("ts_delete", func(t *testing.T) {
+		assert.Equal(t, `'a':1 'c':3`, Delete(mustParse(`a:1 b:2 c:3`), `b`, `x`).String())
+		assert.Equal(t, `'a':1 'b':2`, Delete(mustParse(`a:1 b:2`)).String())
+	})
+
+	t.Run("ts_filter", func(t *testing.T) {
+		for _, tc := range []struct {
+			input    string
+			weights  []string
+			expected string
+		}{
+			{`a:1A b:2B,3 c:4C d`, []string{`a`, `b`}, `'a':1A 'b':2B`},
+			{`a:1A b:2B,3 c:4C d`, []string{`d`}, `'b':3`},
+			{`a:1A b:2B,3 c:4C d`, []string{`C`, `A`}, `'a':1A 'c':4C`},
+			{`a:1A b:2B`, nil, ``},
+		} {
+			actual, err := Filter(mustParse(tc.input), tc.weights)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual.String())
+		}
+		_, err := Filter(mustParse(`a:1`), []string{`ab`})
+		assert.EqualError(t, err, `unrecognized weight: "ab"`)
+	})
+
+	t.Run("concat", func(t *testing.T) {
+		for _, tc := range []struct {
+			l, r     string
+			expected string
+		}{
+			{`a:1 b:2`, `c:1 a:3`, `'a':1,5 'b':2 'c':3`},
+			{`a:1A b:2`, `b:1B c`, `'a':1A 'b':2,3B 'c'`},
+			{`a b`, `c:1 a:2`, `'a':2 'b' 'c':1`},
+			{``, `a:1`, `'a':1`},
+			{`a:16380`, `b:10`, `'a':16380 'b':16383`},
+		} {
+			actual, err := Concat(mustParse(tc.l), mustParse(tc.r))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual.String())
+		}
+	})
+}
@@ -0,0 +1,445 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package xml implements parsing, validation and XPath 1.0 evaluation for
+// the SQL XML type.
+//
+// Values of the XML type are stored as the text they were created from. This
+// package is used to check that the text is well-formed and to build the
+// read-only node tree that XPath expressions are evaluated against.
+package xml
+
+import (
+	stdxml "encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/errors"
+)
+
+// Option determines whether a value has to be a well-formed XML document, or
+// only a well-formed content fragment. It corresponds to the xmloption
+// session variable and to the DOCUMENT and CONTENT keywords of XMLPARSE and
+// XMLSERIALIZE.
+type Option int
+
+const (
+	// Content allows any sequence of elements, text, comments and processing
+	// instructions, optionally preceded by an XML declaration.
+	Content Option = iota
+	// Document requires a single root element.
+	Document
+)
+
+// String implements the fmt.Stringer interface.
+func (o Option) String() string {
+	if o == Document {
+		return "document"
+	}
+	return "content"
+}
+
+// ParseOption parses the name of an Option. The comparison is case
+// insensitive.
+func ParseOption(s string) (Option, error) {
+	switch strings.ToLower(s) {
+	case "content":
+		return Content, nil
+	case "document":
+		return Document, nil
+	}
+	return 0, pgerror.Newf(pgcode.InvalidParameterValue, "invalid XML option %q", s)
+}
+
+// NodeKind is the kind of a Node.
+type NodeKind int
+
+const (
+	// DocumentNode is the root of a parsed value. Its children are the
+	// top-level nodes of the document or content fragment.
+	DocumentNode NodeKind = iota
+	// ElementNode is an element.
+	ElementNode
+	// AttributeNode is an attribute of an element.
+	AttributeNode
+	// TextNode is character data, including CDATA sections.
+	TextNode
+	// CommentNode is a comment.
+	CommentNode
+	// ProcInstNode is a processing instruction.
+	ProcInstNode
+)
+
+// Node is a node of a parsed XML value.
+type Node struct {
+	Kind NodeKind
+	// Prefix and Local make up the qualified name of element and attribute
+	// nodes. Local is the target of processing instructions.
+	Prefix, Local string
+	// Namespace is the namespace URI of element and attribute nodes, resolved
+	// from the namespace declarations in scope.
+	Namespace string
+	// Data is the value of attribute, text, comment and processing instruction
+	// nodes.
+	Data string
+	// Attrs are the attributes of an element, in the order they appear.
+	// Namespace declarations are included so that elements serialize
+	// faithfully, but they are not visible to XPath.
+	Attrs []*Node
+	// Children are the child nodes of documents and elements.
+	Children []*Node
+	// Parent is the parent of the node; nil for the document node.
+	Parent *Node
+
+	// order is the position of the node in document order.
+	order int
+	// nsDecl is set for attributes that declare a namespace.
+	nsDecl bool
+}
+
+// Name returns the qualified name of an element or attribute node, or the
+// target of a processing instruction.
+func (n *Node) Name() string {
+	if n.Prefix != "" {
+		return n.Prefix + ":" + n.Local
+	}
+	return n.Local
+}
+
+// StringValue returns the XPath string-value of the node: the concatenation
+// of all descendant text for documents and elements, and the node's data
+// otherwise.
+func (n *Node) StringValue() string {
+	switch n.Kind {
+	case DocumentNode, ElementNode:
+		var sb strings.Builder
+		n.appendText(&sb)
+		return sb.String()
+	default:
+		return n.Data
+	}
+}
+
+func (n *Node) appendText(sb *strings.Builder) {
+	for _, c := range n.Children {
+		switch c.Kind {
+		case TextNode:
+			sb.WriteString(c.Data)
+		case ElementNode:
+			c.appendText(sb)
+		}
+	}
+}
+
+// String serializes the node as XML. Text and attribute nodes are escaped,
+// so the result is always valid XML content.
+func (n *Node) String() string {
+	var sb strings.Builder
+	n.serialize(&sb)
+	return sb.String()
+}
+
+func (n *Node) serialize(sb *strings.Builder) {
+	switch n.Kind {
+	case DocumentNode:
+		for _, c := range n.Children {
+			c.serialize(sb)
+		}
+	case ElementNode:
+		sb.WriteByte('<')
+		sb.WriteString(n.Name())
+		for _, a := range n.Attrs {
+			sb.WriteByte(' ')
+			sb.WriteString(a.Name())
+			sb.WriteString(`="`)
+			sb.WriteString(EscapeAttr(a.Data))
+			sb.WriteByte('"')
+		}
+		if len(n.Children) == 0 {
+			sb.WriteString("/>")
+			return
+		}
+		sb.WriteByte('>')
+		for _, c := range n.Children {
+			c.serialize(sb)
+		}
+		sb.WriteString("</")
+		sb.WriteString(n.Name())
+		sb.WriteByte('>')
+	case AttributeNode, TextNode:
+		sb.WriteString(Escape(n.Data))
+	case CommentNode:
+		sb.WriteString("<!--")
+		sb.WriteString(n.Data)
+		sb.WriteString("-->")
+	case ProcInstNode:
+		sb.WriteString("<?")
+		sb.WriteString(n.Local)
+		if n.Data != "" {
+			sb.WriteByte(' ')
+			sb.WriteString(n.Data)
+		}
+		sb.WriteString("?>")
+	}
+}
+
+// Escape escapes the characters that cannot appear literally in XML text.
+func Escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// EscapeAttr escapes the characters that cannot appear literally in a
+// double-quoted XML attribute value.
+func EscapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrEscaper = strings.NewReplacer(
+	"&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;",
+	"\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;",
+)
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// Parse parses s, which must be well-formed according to opt, and returns
+// the document node of the resulting tree.
+func Parse(s string, opt Option) (*Node, error) {
+	doc, err := parse(s, opt)
+	if err != nil {
+		if opt == Document {
+			return nil, pgerror.Wrap(err, pgcode.InvalidXMLDocument, "invalid XML document")
+		}
+		return nil, pgerror.Wrap(err, pgcode.InvalidXMLContent, "invalid XML content")
+	}
+	return doc, nil
+}
+
+// IsWellFormed returns whether s is well-formed according to opt.
+func IsWellFormed(s string, opt Option) bool {
+	_, err := parse(s, opt)
+	return err == nil
+}
+
+// IsDocument returns whether the parsed value is a well-formed document,
+// i.e. whether it has exactly one top-level element and no top-level text
+// other than whitespace.
+func IsDocument(doc *Node) bool {
+	elems := 0
+	for _, c := range doc.Children {
+		switch c.Kind {
+		case ElementNode:
+			elems++
+		case TextNode:
+			if strings.TrimSpace(c.Data) != "" {
+				return false
+			}
+		}
+	}
+	return elems == 1
+}
+
+// Validate checks that s is well-formed according to opt.
+func Validate(s string, opt Option) error {
+	_, err := Parse(s, opt)
+	return err
+}
+
+// parser holds the state of a single call to parse.
+type parser struct {
+	order int
+	// scopes is the stack of namespace declarations in scope, one map per
+	// open element.
+	scopes []map[string]string
+}
+
+func (p *parser) newNode(kind NodeKind, parent *Node) *Node {
+	n := &Node{Kind: kind, Parent: parent, order: p.order}
+	p.order++
+	return n
+}
+
+func (p *parser) resolve(prefix string) (string, bool) {
+	switch prefix {
+	case "xml":
+		return xmlNamespace, true
+	case "xmlns":
+		return "", true
+	}
+	for i := len(p.scopes) - 1; i >= 0; i-- {
+		if ns, ok := p.scopes[i][prefix]; ok {
+			return ns, true
+		}
+	}
+	// The default namespace is empty unless declared.
+	return "", prefix == ""
+}
+
+func parse(s string, opt Option) (*Node, error) {
+	d := stdxml.NewDecoder(strings.NewReader(s))
+	d.Strict = true
+	var p parser
+	doc := p.newNode(DocumentNode, nil)
+	cur := doc
+	first := true
+	rootSeen := false
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		atStart := first
+		first = false
+		switch t := tok.(type) {
+		case stdxml.StartElement:
+			if cur == doc {
+				if opt == Document && rootSeen {
+					return nil, errors.New("extra content at the end of the document")
+				}
+				rootSeen = true
+			}
+			if err := p.startElement(t, &cur); err != nil {
+				return nil, err
+			}
+		case stdxml.EndElement:
+			if cur == doc || t.Name.Space != cur.Prefix || t.Name.Local != cur.Local {
+				return nil, errors.Newf("unexpected end tag </%s>", qualifiedName(t.Name))
+			}
+			p.scopes = p.scopes[:len(p.scopes)-1]
+			cur = cur.Parent
+		case stdxml.CharData:
+			text := string(t)
+			if cur == doc && opt == Document {
+				if strings.TrimSpace(text) != "" {
+					return nil, errors.New("text outside of the root element")
+				}
+				continue
+			}
+			// Merge adjacent character data, e.g. text followed by a CDATA
+			// section, into a single text node like XPath expects.
+			if l := len(cur.Children); l > 0 && cur.Children[l-1].Kind == TextNode {
+				cur.Children[l-1].Data += text
+				continue
+			}
+			n := p.newNode(TextNode, cur)
+			n.Data = text
+			cur.Children = append(cur.Children, n)
+		case stdxml.Comment:
+			n := p.newNode(CommentNode, cur)
+			n.Data = string(t)
+			cur.Children = append(cur.Children, n)
+		case stdxml.ProcInst:
+			if strings.EqualFold(t.Target, "xml") {
+				// The XML declaration is only allowed at the very beginning.
+				if !atStart {
+					return nil, errors.New("XML declaration allowed only at the start of the document")
+				}
+				continue
+			}
+			n := p.newNode(ProcInstNode, cur)
+			n.Local = t.Target
+			n.Data = string(t.Inst)
+			cur.Children = append(cur.Children, n)
+		case stdxml.Directive:
+			// A DOCTYPE declaration is accepted before the root element of a
+			// document. Its contents are not interpreted.
+			if cur != doc || rootSeen || !strings.HasPrefix(string(t), "DOCTYPE") {
+				return nil, errors.Newf("unexpected directive <!%s>", string(t))
+			}
+		}
+	}
+	if cur != doc {
+		return nil, errors.Newf("premature end of data in tag %s", cur.Name())
+	}
+	if opt == Document && !rootSeen {
+		return nil, errors.New("document is empty")
+	}
+	return doc, nil
+}
+
+func (p *parser) startElement(t stdxml.StartElement, cur **Node) error {
+	parent := *cur
+	n := p.newNode(ElementNode, parent)
+	n.Prefix, n.Local = t.Name.Space, t.Name.Local
+	// Namespace declarations apply to the element itself, so collect them
+	// before resolving any names.
+	scope := make(map[string]string)
+	for _, a := range t.Attr {
+		switch {
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			scope[""] = a.Value
+		case a.Name.Space == "xmlns":
+			scope[a.Name.Local] = a.Value
+		}
+	}
+	p.scopes = append(p.scopes, scope)
+	ns, ok := p.resolve(n.Prefix)
+	if !ok {
+		return errors.Newf("namespace prefix %s on %s is not defined", n.Prefix, n.Local)
+	}
+	n.Namespace = ns
+	seen := make(map[string]struct{}, len(t.Attr))
+	for _, a := range t.Attr {
+		attr := p.newNode(AttributeNode, n)
+		attr.Prefix, attr.Local, attr.Data = a.Name.Space, a.Name.Local, a.Value
+		if _, dup := seen[attr.Name()]; dup {
+			return errors.Newf("attribute %s redefined", attr.Name())
+		}
+		seen[attr.Name()] = struct{}{}
+		attr.nsDecl = attr.Prefix == "xmlns" || (attr.Prefix == "" && attr.Local == "xmlns")
+		// Unprefixed attributes are in no namespace.
+		if attr.Prefix != "" && !attr.nsDecl {
+			ns, ok := p.resolve(attr.Prefix)
+			if !ok {
+				return errors.Newf("namespace prefix %s for %s on %s is not defined",
+					attr.Prefix, attr.Local, n.Local)
+			}
+			attr.Namespace = ns
+		}
+		n.Attrs = append(n.Attrs, attr)
+	}
+	parent.Children = append(parent.Children, n)
+	*cur = n
+	return nil
+}
+
+func qualifiedName(n stdxml.Name) string {
+	if n.Space != "" {
+		return n.Space + ":" + n.Local
+	}
+	return n.Local
+}
+
+// Comment returns an XML comment with the given text. It fails if the text
+// would terminate the comment early.
+func Comment(text string) (string, error) {
+	if strings.Contains(text, "--") || strings.HasSuffix(text, "-") {
+		return "", pgerror.New(pgcode.InvalidXMLComment, "invalid XML comment")
+	}
+	return "<!--" + text + "-->", nil
+}
+
+// IsValidName returns whether s is a valid XML name. Only the ASCII subset
+// of the name grammar is checked strictly; other characters are accepted.
+func IsValidName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || r == ':' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r > 0x7f:
+		case i > 0 && (r == '-' || r == '.' || (r >= '0' && r <= '9')):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package xml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		input     string
+		document  bool
+		content   bool
+		errSubstr string
+	}{
+		{input: "<a/>", document: true, content: true},
+		{input: `<?xml version="1.0"?><a b="c">x</a>`, document: true, content: true},
+		{input: "<!DOCTYPE a><a/>", document: true, content: true},
+		{input: "<a/><!-- c -->", document: true, content: true},
+		{input: "", content: true},
+		{input: "text only", content: true},
+		{input: "<a/><b/>", content: true},
+		{input: "x<a>&amp;</a>y", content: true},
+		{input: "<a>", errSubstr: "premature end of data"},
+		{input: "<a></b>", errSubstr: "unexpected end tag"},
+		{input: "<a>&foo;</a>", errSubstr: "invalid"},
+		{input: "<a b=c/>", errSubstr: "unquoted"},
+		{input: "<a/><?xml version=\"1.0\"?>", errSubstr: "XML declaration"},
+		{input: "<a/><!DOCTYPE a>", errSubstr: "DOCTYPE"},
+		{input: "<p:a/>", errSubstr: "namespace prefix p on a is not defined"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := IsWellFormed(tc.input, Document); got != tc.document {
+				t.Errorf("expected IsWellFormed(Document) = %t, got %t", tc.document, got)
+			}
+			err := Validate(tc.input, Content)
+			if tc.content != (err == nil) {
+				t.Fatalf("expected content validity %t, got error %v", tc.content, err)
+			}
+			if tc.errSubstr != "" && !strings.Contains(err.Error(), tc.errSubstr) {
+				t.Errorf("expected error containing %q, got %q", tc.errSubstr, err)
+			}
+		})
+	}
+}
+
+func TestSerialize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "<a></a>", expected: "<a/>"},
+		{input: `<a x='1 &lt; 2'>b &gt; c</a>`, expected: `<a x="1 &lt; 2">b &gt; c</a>`},
+		{input: `<a xmlns:p="u"><p:b p:c="d"/></a>`, expected: `<a xmlns:p="u"><p:b p:c="d"/></a>`},
+		{input: "<a><!--x--><?pi data?></a>", expected: "<a><!--x--><?pi data?></a>"},
+		{input: "<a><![CDATA[<x>]]></a>", expected: "<a>&lt;x&gt;</a>"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			doc, err := Parse(tc.input, Document)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := doc.String(); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestComment(t *testing.T) {
+	if got, err := Comment("hello"); err != nil || got != "<!--hello-->" {
+		t.Errorf("unexpected result %q, %v", got, err)
+	}
+	for _, bad := range []string{"a--b", "a-"} {
+		if _, err := Comment(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestXPath(t *testing.T) {
+	const doc = `<root xmlns:n="http://example.com/n">` +
+		`<item id="1" kind="a">one</item>` +
+		`<item id="2" kind="b">two</item>` +
+		`<item id="3" kind="a">three<sub>3</sub></item>` +
+		`<n:item id="4">four</n:item>` +
+		`<!--note-->` +
+		`</root>`
+	namespaces := map[string]string{"ns": "http://example.com/n"}
+
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		// Node-set results are rendered as the serialized nodes separated by
+		// '|', and other results as their string value. Attributes serialize
+		// to their value, as in Postgres.
+		{expr: "/root/item/@id", expected: "1|2|3"},
+		{expr: "/root/item[@kind='a']/text()", expected: "one|three"},
+		{expr: "//item[2]", expected: `<item id="2" kind="b">two</item>`},
+		{expr: "//item[last()]/@id", expected: "3"},
+		{expr: "//ns:item/text()", expected: "four"},
+		{expr: "/root/*/@id", expected: "1|2|3|4"},
+		{expr: "//sub/ancestor::*[1]/@id", expected: "3"},
+		{expr: "//item[@id='2']/following-sibling::item/@id", expected: "3"},
+		{expr: "//item[@id='2']/preceding-sibling::*[1]/@id", expected: "1"},
+		{expr: "//comment()", expected: "<!--note-->"},
+		{expr: "(//item | //sub)[last()]", expected: "<sub>3</sub>"},
+		{expr: "count(//item)", expected: "3"},
+		{expr: "sum(//item/@id) div 2", expected: "3"},
+		{expr: "string(//item[3])", expected: "three3"},
+		{expr: "//item = 'two'", expected: "true"},
+		{expr: "//item/@id > 2", expected: "true"},
+		{expr: "//item/@id > 3", expected: "false"},
+		{expr: "not(//missing)", expected: "true"},
+		{expr: "concat('a', 1 + 1, true())", expected: "a2true"},
+		{expr: "substring('12345', 1.5, 2.6)", expected: "234"},
+		{expr: "substring-before('1999/04/01', '/')", expected: "1999"},
+		{expr: "translate('--aaa--', 'abc-', 'ABC')", expected: "AAA"},
+		{expr: "normalize-space('  a   b ')", expected: "a b"},
+		{expr: "round(-0.5) + floor(2.7) + ceiling(1.1)", expected: "4"},
+		{expr: "7 mod 3 * 2", expected: "2"},
+		{expr: "1 div 0", expected: "Infinity"},
+		{expr: "number('abc')", expected: "NaN"},
+		{expr: "local-name(//ns:item)", expected: "item"},
+		{expr: "name(/*)", expected: "root"},
+	}
+	root, err := Parse(doc, Document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			e, err := Compile(tc.expr, namespaces)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v, err := e.Eval(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := v.String()
+			if v.Kind == NodeSetValue {
+				parts := make([]string, len(v.Nodes))
+				for i, n := range v.Nodes {
+					parts[i] = n.String()
+				}
+				got = strings.Join(parts, "|")
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestXPathErrors(t *testing.T) {
+	tests := []struct {
+		expr      string
+		errSubstr string
+	}{
+		{expr: "", errSubstr: "unexpected end of expression"},
+		{expr: "//a[", errSubstr: "unexpected end of expression"},
+		{expr: "foo()", errSubstr: "unknown function foo()"},
+		{expr: "count()", errSubstr: "wrong number of arguments"},
+		{expr: "//x:a", errSubstr: "undefined namespace prefix x"},
+		{expr: "$var", errSubstr: "variable references are not supported"},
+		{expr: "'abc", errSubstr: "unterminated string literal"},
+		{expr: "bogus::a", errSubstr: "unknown axis"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			_, err := Compile(tc.expr, nil)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tc.errSubstr) {
+				t.Errorf("expected error containing %q, got %q", tc.errSubstr, err)
+			}
+		})
+	}
+}
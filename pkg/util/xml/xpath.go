@@ -0,0 +1,1166 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package xml
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+)
+
+// ValueKind is the type of the result of an XPath expression.
+type ValueKind int
+
+const (
+	// NodeSetValue is an ordered set of nodes without duplicates.
+	NodeSetValue ValueKind = iota
+	// BooleanValue is a boolean.
+	BooleanValue
+	// NumberValue is an IEEE 754 double.
+	NumberValue
+	// StringValue is a string.
+	StringValue
+)
+
+// Value is the result of evaluating an XPath expression.
+type Value struct {
+	Kind ValueKind
+	// Nodes is set for NodeSetValue, in document order.
+	Nodes []*Node
+	Bool  bool
+	Num   float64
+	Str   string
+}
+
+// String converts the value to a string as the XPath string() function
+// does.
+func (v Value) String() string {
+	switch v.Kind {
+	case NodeSetValue:
+		if len(v.Nodes) == 0 {
+			return ""
+		}
+		return v.Nodes[0].StringValue()
+	case BooleanValue:
+		if v.Bool {
+			return "true"
+		}
+		return "false"
+	case NumberValue:
+		return formatNumber(v.Num)
+	default:
+		return v.Str
+	}
+}
+
+// Boolean converts the value to a boolean as the XPath boolean() function
+// does.
+func (v Value) Boolean() bool {
+	switch v.Kind {
+	case NodeSetValue:
+		return len(v.Nodes) > 0
+	case BooleanValue:
+		return v.Bool
+	case NumberValue:
+		return v.Num != 0 && !math.IsNaN(v.Num)
+	default:
+		return v.Str != ""
+	}
+}
+
+// Number converts the value to a number as the XPath number() function
+// does.
+func (v Value) Number() float64 {
+	switch v.Kind {
+	case BooleanValue:
+		if v.Bool {
+			return 1
+		}
+		return 0
+	case NumberValue:
+		return v.Num
+	default:
+		return parseNumber(v.String())
+	}
+}
+
+func formatNumber(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case f == 0:
+		// This also covers negative zero.
+		return "0"
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// parseNumber implements the XPath conversion from strings to numbers, which
+// only accepts an optional minus sign followed by digits with an optional
+// decimal point.
+func parseNumber(s string) float64 {
+	s = strings.TrimSpace(s)
+	body := strings.TrimPrefix(s, "-")
+	digits, dot := 0, 0
+	for _, r := range body {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '.':
+			dot++
+		default:
+			return math.NaN()
+		}
+	}
+	if digits == 0 || dot > 1 {
+		return math.NaN()
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return f
+}
+
+// Expr is a compiled XPath 1.0 expression.
+type Expr struct {
+	root exprNode
+}
+
+// Compile compiles an XPath 1.0 expression. Namespace prefixes that appear in
+// name tests are resolved using namespaces, which maps prefixes to URIs.
+// Variable references are not supported.
+func Compile(expr string, namespaces map[string]string) (*Expr, error) {
+	toks, err := lexXPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := xpathParser{toks: toks, namespaces: namespaces}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.at(tokEOF) {
+		return nil, p.errorf("unexpected %s", p.peek())
+	}
+	return &Expr{root: root}, nil
+}
+
+// Eval evaluates the expression with the document node of doc as the
+// context node.
+func (e *Expr) Eval(doc *Node) (Value, error) {
+	return e.root.eval(&evalCtx{node: doc, pos: 1, size: 1})
+}
+
+func newXPathError(format string, args ...interface{}) error {
+	return pgerror.Newf(pgcode.InvalidParameterValue, "invalid XPath expression: "+format, args...)
+}
+
+// Lexing.
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	// tokName is an NCName, a QName, or a "prefix:*" name test.
+	tokName
+	tokNumber
+	tokLiteral
+	// tokSym is any punctuation or operator, including "*".
+	tokSym
+)
+
+type token struct {
+	kind tokKind
+	val  string
+	// lparenNext is set for names immediately followed by '(', which makes
+	// them function names or node type tests.
+	lparenNext bool
+	// axisNext is set for names followed by "::".
+	axisNext bool
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.val)
+}
+
+func isNameStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isNameChar(r rune) bool {
+	return isNameStart(r) || r == '-' || r == '.' || unicode.IsDigit(r)
+}
+
+func lexXPath(s string) ([]token, error) {
+	var toks []token
+	i := 0
+	for i < len(s) {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += w
+		case r == '"' || r == '\'':
+			end := strings.IndexRune(s[i+1:], r)
+			if end < 0 {
+				return nil, newXPathError("unterminated string literal")
+			}
+			toks = append(toks, token{kind: tokLiteral, val: s[i+1 : i+1+end]})
+			i += end + 2
+		case r >= '0' && r <= '9' || (r == '.' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9'):
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{kind: tokNumber, val: s[i:j]})
+			i = j
+		case isNameStart(r):
+			j := scanNCName(s, i)
+			// A colon followed by a name or '*' makes this a QName; a double
+			// colon is an axis separator.
+			if j+1 < len(s) && s[j] == ':' && s[j+1] != ':' {
+				if s[j+1] == '*' {
+					j += 2
+				} else if r2, _ := utf8.DecodeRuneInString(s[j+1:]); isNameStart(r2) {
+					j = scanNCName(s, j+1)
+				}
+			}
+			tok := token{kind: tokName, val: s[i:j]}
+			rest := strings.TrimLeftFunc(s[j:], unicode.IsSpace)
+			tok.lparenNext = strings.HasPrefix(rest, "(")
+			tok.axisNext = strings.HasPrefix(rest, "::")
+			toks = append(toks, tok)
+			i = j
+		default:
+			sym := s[i : i+1]
+			if i+1 < len(s) {
+				switch two := s[i : i+2]; two {
+				case "//", "!=", "<=", ">=", "::", "..":
+					sym = two
+				}
+			}
+			if !strings.Contains("/|+-=<>*()[].@,$", sym[:1]) && len(sym) == 1 {
+				return nil, newXPathError("unexpected character %q", r)
+			}
+			toks = append(toks, token{kind: tokSym, val: sym})
+			i += len(sym)
+		}
+	}
+	return append(toks, token{kind: tokEOF}), nil
+}
+
+func scanNCName(s string, i int) int {
+	for i < len(s) {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		if !isNameChar(r) {
+			break
+		}
+		i += w
+	}
+	return i
+}
+
+// Parsing.
+
+type xpathParser struct {
+	toks       []token
+	pos        int
+	namespaces map[string]string
+}
+
+func (p *xpathParser) peek() token { return p.toks[p.pos] }
+
+func (p *xpathParser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *xpathParser) at(kind tokKind) bool { return p.peek().kind == kind }
+
+func (p *xpathParser) atSym(syms ...string) bool {
+	t := p.peek()
+	if t.kind != tokSym {
+		return false
+	}
+	for _, s := range syms {
+		if t.val == s {
+			return true
+		}
+	}
+	return false
+}
+
+// atOperatorName returns whether the next token is one of the operator names
+// "and", "or", "div" and "mod". It is only called where an operator is
+// expected, which is how XPath disambiguates them from element names.
+func (p *xpathParser) atOperatorName(name string) bool {
+	t := p.peek()
+	return t.kind == tokName && t.val == name
+}
+
+func (p *xpathParser) expectSym(sym string) error {
+	if !p.atSym(sym) {
+		return p.errorf("expected %q, found %s", sym, p.peek())
+	}
+	p.next()
+	return nil
+}
+
+func (p *xpathParser) errorf(format string, args ...interface{}) error {
+	return newXPathError(format, args...)
+}
+
+func (p *xpathParser) parseExpr() (exprNode, error) {
+	return p.parseOr()
+}
+
+func (p *xpathParser) parseOr() (exprNode, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.atOperatorName("or") {
+		p.next()
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = &logicalExpr{or: true, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *xpathParser) parseAnd() (exprNode, error) {
+	l, err := p.parseEquality()
+	if err != nil {
+		return nil, err
+	}
+	for p.atOperatorName("and") {
+		p.next()
+		r, err := p.parseEquality()
+		if err != nil {
+			return nil, err
+		}
+		l = &logicalExpr{l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *xpathParser) parseEquality() (exprNode, error) {
+	l, err := p.parseRelational()
+	if err != nil {
+		return nil, err
+	}
+	for p.atSym("=", "!=") {
+		op := p.next().val
+		r, err := p.parseRelational()
+		if err != nil {
+			return nil, err
+		}
+		l = &compareExpr{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *xpathParser) parseRelational() (exprNode, error) {
+	l, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	for p.atSym("<", "<=", ">", ">=") {
+		op := p.next().val
+		r, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		l = &compareExpr{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *xpathParser) parseAdditive() (exprNode, error) {
+	l, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.atSym("+", "-") {
+		op := p.next().val
+		r, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		l = &arithExpr{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *xpathParser) parseMultiplicative() (exprNode, error) {
+	l, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.atSym("*") || p.atOperatorName("div") || p.atOperatorName("mod") {
+		op := p.next().val
+		r, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l = &arithExpr{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *xpathParser) parseUnary() (exprNode, error) {
+	if p.atSym("-") {
+		p.next()
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &negateExpr{e: e}, nil
+	}
+	return p.parseUnion()
+}
+
+func (p *xpathParser) parseUnion() (exprNode, error) {
+	l, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	for p.atSym("|") {
+		p.next()
+		r, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		l = &unionExpr{l: l, r: r}
+	}
+	return l, nil
+}
+
+var nodeTypes = map[string]bool{
+	"comment": true, "text": true, "processing-instruction": true, "node": true,
+}
+
+// atStep returns whether the next token starts a location step.
+func (p *xpathParser) atStep() bool {
+	t := p.peek()
+	switch t.kind {
+	case tokName:
+		return !t.lparenNext || nodeTypes[t.val]
+	case tokSym:
+		return t.val == "*" || t.val == "." || t.val == ".." || t.val == "@"
+	}
+	return false
+}
+
+func (p *xpathParser) parsePath() (exprNode, error) {
+	path := &pathExpr{}
+	switch {
+	case p.atSym("/"):
+		p.next()
+		path.absolute = true
+		if !p.atStep() {
+			return path, nil
+		}
+	case p.atSym("//"):
+		p.next()
+		path.absolute = true
+		path.steps = append(path.steps, descendantOrSelfStep())
+	case p.atStep():
+	default:
+		filter, err := p.parseFilter()
+		if err != nil {
+			return nil, err
+		}
+		if !p.atSym("/", "//") {
+			return filter, nil
+		}
+		path.filter = filter
+		if p.next().val == "//" {
+			path.steps = append(path.steps, descendantOrSelfStep())
+		}
+	}
+	for {
+		s, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		path.steps = append(path.steps, s)
+		if !p.atSym("/", "//") {
+			return path, nil
+		}
+		if p.next().val == "//" {
+			path.steps = append(path.steps, descendantOrSelfStep())
+		}
+	}
+}
+
+func descendantOrSelfStep() *step {
+	return &step{axis: axisDescendantOrSelf, test: nodeTest{kind: testNode}}
+}
+
+func (p *xpathParser) parseFilter() (exprNode, error) {
+	var primary exprNode
+	t := p.next()
+	switch t.kind {
+	case tokLiteral:
+		primary = &literalExpr{v: Value{Kind: StringValue, Str: t.val}}
+	case tokNumber:
+		f, err := strconv.ParseFloat(t.val, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", t)
+		}
+		primary = &literalExpr{v: Value{Kind: NumberValue, Num: f}}
+	case tokName:
+		if !t.lparenNext {
+			return nil, p.errorf("unexpected %s", t)
+		}
+		fn, err := p.parseFunctionCall(t.val)
+		if err != nil {
+			return nil, err
+		}
+		primary = fn
+	case tokSym:
+		switch t.val {
+		case "(":
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectSym(")"); err != nil {
+				return nil, err
+			}
+			primary = e
+		case "$":
+			return nil, p.errorf("variable references are not supported")
+		default:
+			return nil, p.errorf("unexpected %s", t)
+		}
+	default:
+		return nil, p.errorf("unexpected %s", t)
+	}
+	preds, err := p.parsePredicates()
+	if err != nil {
+		return nil, err
+	}
+	if len(preds) == 0 {
+		return primary, nil
+	}
+	return &filterExpr{primary: primary, preds: preds}, nil
+}
+
+func (p *xpathParser) parseFunctionCall(name string) (exprNode, error) {
+	def, ok := xpathFuncs[name]
+	if !ok {
+		return nil, p.errorf("unknown function %s()", name)
+	}
+	if err := p.expectSym("("); err != nil {
+		return nil, err
+	}
+	var args []exprNode
+	if !p.atSym(")") {
+		for {
+			a, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, a)
+			if !p.atSym(",") {
+				break
+			}
+			p.next()
+		}
+	}
+	if err := p.expectSym(")"); err != nil {
+		return nil, err
+	}
+	if len(args) < def.minArgs || (def.maxArgs >= 0 && len(args) > def.maxArgs) {
+		return nil, p.errorf("wrong number of arguments to %s()", name)
+	}
+	return &funcExpr{name: name, fn: def.fn, args: args}, nil
+}
+
+func (p *xpathParser) parsePredicates() ([]exprNode, error) {
+	var preds []exprNode
+	for p.atSym("[") {
+		p.next()
+		e, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectSym("]"); err != nil {
+			return nil, err
+		}
+		preds = append(preds, e)
+	}
+	return preds, nil
+}
+
+var axisNames = map[string]axis{
+	"ancestor":           axisAncestor,
+	"ancestor-or-self":   axisAncestorOrSelf,
+	"attribute":          axisAttribute,
+	"child":              axisChild,
+	"descendant":         axisDescendant,
+	"descendant-or-self": axisDescendantOrSelf,
+	"following":          axisFollowing,
+	"following-sibling":  axisFollowingSibling,
+	"parent":             axisParent,
+	"preceding":          axisPreceding,
+	"preceding-sibling":  axisPrecedingSibling,
+	"self":               axisSelf,
+}
+
+func (p *xpathParser) parseStep() (*step, error) {
+	switch {
+	case p.atSym("."):
+		p.next()
+		return &step{axis: axisSelf, test: nodeTest{kind: testNode}}, nil
+	case p.atSym(".."):
+		p.next()
+		return &step{axis: axisParent, test: nodeTest{kind: testNode}}, nil
+	}
+	s := &step{axis: axisChild}
+	if p.atSym("@") {
+		p.next()
+		s.axis = axisAttribute
+	} else if t := p.peek(); t.kind == tokName && t.axisNext {
+		a, ok := axisNames[t.val]
+		if !ok {
+			return nil, p.errorf("unknown axis %s", t)
+		}
+		p.next()
+		p.next() // "::"
+		s.axis = a
+	}
+	test, err := p.parseNodeTest()
+	if err != nil {
+		return nil, err
+	}
+	s.test = test
+	if s.preds, err = p.parsePredicates(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (p *xpathParser) parseNodeTest() (nodeTest, error) {
+	t := p.next()
+	if t.kind == tokSym && t.val == "*" {
+		return nodeTest{kind: testAnyName}, nil
+	}
+	if t.kind != tokName {
+		return nodeTest{}, p.errorf("expected a node test, found %s", t)
+	}
+	if t.lparenNext && nodeTypes[t.val] {
+		p.next() // "("
+		test := nodeTest{kind: testNodeType, nodeType: t.val}
+		if t.val == "node" {
+			test.kind = testNode
+		}
+		if t.val == "processing-instruction" && p.at(tokLiteral) {
+			test.piTarget = p.next().val
+		}
+		return test, p.expectSym(")")
+	}
+	prefix, local, hasPrefix := strings.Cut(t.val, ":")
+	if !hasPrefix {
+		return nodeTest{kind: testName, local: t.val}, nil
+	}
+	ns, ok := p.namespaces[prefix]
+	if !ok {
+		return nodeTest{}, p.errorf("undefined namespace prefix %s", prefix)
+	}
+	if local == "*" {
+		return nodeTest{kind: testNamespace, ns: ns}, nil
+	}
+	return nodeTest{kind: testName, ns: ns, local: local}, nil
+}
+
+// Evaluation.
+
+type evalCtx struct {
+	node      *Node
+	pos, size int
+}
+
+type exprNode interface {
+	eval(ctx *evalCtx) (Value, error)
+}
+
+type literalExpr struct{ v Value }
+
+func (e *literalExpr) eval(*evalCtx) (Value, error) { return e.v, nil }
+
+type logicalExpr struct {
+	or   bool
+	l, r exprNode
+}
+
+func (e *logicalExpr) eval(ctx *evalCtx) (Value, error) {
+	l, err := e.l.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	// Both operators short-circuit.
+	if l.Boolean() == e.or {
+		return boolValue(e.or), nil
+	}
+	r, err := e.r.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	return boolValue(r.Boolean()), nil
+}
+
+type compareExpr struct {
+	op   string
+	l, r exprNode
+}
+
+func (e *compareExpr) eval(ctx *evalCtx) (Value, error) {
+	l, err := e.l.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	r, err := e.r.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	return boolValue(compareValues(e.op, l, r)), nil
+}
+
+// compareValues implements the comparison rules of section 3.4 of the XPath
+// 1.0 specification.
+func compareValues(op string, l, r Value) bool {
+	if l.Kind == NodeSetValue && r.Kind == NodeSetValue {
+		for _, a := range l.Nodes {
+			for _, b := range r.Nodes {
+				if compareAtoms(op, stringValue(a.StringValue()), stringValue(b.StringValue())) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if l.Kind == NodeSetValue || r.Kind == NodeSetValue {
+		ns, other, swapped := l, r, false
+		if r.Kind == NodeSetValue {
+			ns, other, swapped = r, l, true
+		}
+		if other.Kind == BooleanValue {
+			a, b := boolValue(ns.Boolean()), other
+			if swapped {
+				a, b = b, a
+			}
+			return compareAtoms(op, a, b)
+		}
+		for _, n := range ns.Nodes {
+			a := stringValue(n.StringValue())
+			if other.Kind == NumberValue {
+				a = numberValue(parseNumber(a.Str))
+			}
+			b := other
+			if swapped {
+				a, b = b, a
+			}
+			if compareAtoms(op, a, b) {
+				return true
+			}
+		}
+		return false
+	}
+	return compareAtoms(op, l, r)
+}
+
+// compareAtoms compares two values that are not node-sets.
+func compareAtoms(op string, l, r Value) bool {
+	if op == "=" || op == "!=" {
+		var eq bool
+		switch {
+		case l.Kind == BooleanValue || r.Kind == BooleanValue:
+			eq = l.Boolean() == r.Boolean()
+		case l.Kind == NumberValue || r.Kind == NumberValue:
+			eq = l.Number() == r.Number()
+		default:
+			eq = l.String() == r.String()
+		}
+		return eq == (op == "=")
+	}
+	a, b := l.Number(), r.Number()
+	switch op {
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	default:
+		return a >= b
+	}
+}
+
+type arithExpr struct {
+	op   string
+	l, r exprNode
+}
+
+func (e *arithExpr) eval(ctx *evalCtx) (Value, error) {
+	l, err := e.l.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	r, err := e.r.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	a, b := l.Number(), r.Number()
+	switch e.op {
+	case "+":
+		return numberValue(a + b), nil
+	case "-":
+		return numberValue(a - b), nil
+	case "*":
+		return numberValue(a * b), nil
+	case "div":
+		return numberValue(a / b), nil
+	default:
+		return numberValue(math.Mod(a, b)), nil
+	}
+}
+
+type negateExpr struct{ e exprNode }
+
+func (e *negateExpr) eval(ctx *evalCtx) (Value, error) {
+	v, err := e.e.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	return numberValue(-v.Number()), nil
+}
+
+type unionExpr struct{ l, r exprNode }
+
+func (e *unionExpr) eval(ctx *evalCtx) (Value, error) {
+	l, err := e.l.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	r, err := e.r.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	if l.Kind != NodeSetValue || r.Kind != NodeSetValue {
+		return Value{}, newXPathError("operands of | must be node-sets")
+	}
+	return nodeSetValue(append(append([]*Node(nil), l.Nodes...), r.Nodes...)), nil
+}
+
+type filterExpr struct {
+	primary exprNode
+	preds   []exprNode
+}
+
+func (e *filterExpr) eval(ctx *evalCtx) (Value, error) {
+	v, err := e.primary.eval(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	if v.Kind != NodeSetValue {
+		return Value{}, newXPathError("predicates can only be applied to node-sets")
+	}
+	nodes := v.Nodes
+	for _, pred := range e.preds {
+		if nodes, err = applyPredicate(nodes, pred); err != nil {
+			return Value{}, err
+		}
+	}
+	return Value{Kind: NodeSetValue, Nodes: nodes}, nil
+}
+
+type pathExpr struct {
+	// filter, if set, produces the initial node-set. Otherwise the path
+	// starts at the context node, or at the root if absolute is set.
+	filter   exprNode
+	absolute bool
+	steps    []*step
+}
+
+func (e *pathExpr) eval(ctx *evalCtx) (Value, error) {
+	var nodes []*Node
+	switch {
+	case e.filter != nil:
+		v, err := e.filter.eval(ctx)
+		if err != nil {
+			return Value{}, err
+		}
+		if v.Kind != NodeSetValue {
+			return Value{}, newXPathError("path steps can only be applied to node-sets")
+		}
+		nodes = v.Nodes
+	case e.absolute:
+		root := ctx.node
+		for root.Parent != nil {
+			root = root.Parent
+		}
+		nodes = []*Node{root}
+	default:
+		nodes = []*Node{ctx.node}
+	}
+	for _, s := range e.steps {
+		var next []*Node
+		for _, n := range nodes {
+			res, err := s.eval(n)
+			if err != nil {
+				return Value{}, err
+			}
+			next = append(next, res...)
+		}
+		nodes = sortNodes(next)
+	}
+	return Value{Kind: NodeSetValue, Nodes: nodes}, nil
+}
+
+type axis int
+
+const (
+	axisChild axis = iota
+	axisDescendant
+	axisDescendantOrSelf
+	axisSelf
+	axisParent
+	axisAncestor
+	axisAncestorOrSelf
+	axisFollowingSibling
+	axisPrecedingSibling
+	axisFollowing
+	axisPreceding
+	axisAttribute
+)
+
+// reverse returns whether the axis is a reverse axis, whose proximity
+// positions count backwards in document order.
+func (a axis) reverse() bool {
+	switch a {
+	case axisParent, axisAncestor, axisAncestorOrSelf, axisPrecedingSibling, axisPreceding:
+		return true
+	}
+	return false
+}
+
+type testKind int
+
+const (
+	// testName matches principal nodes with a specific expanded name.
+	testName testKind = iota
+	// testAnyName is "*".
+	testAnyName
+	// testNamespace is "prefix:*".
+	testNamespace
+	// testNode is node(), which matches any node.
+	testNode
+	// testNodeType is text(), comment() or processing-instruction().
+	testNodeType
+)
+
+type nodeTest struct {
+	kind      testKind
+	ns, local string
+	nodeType  string
+	piTarget  string
+}
+
+func (t nodeTest) matches(n *Node, a axis) bool {
+	principal := ElementNode
+	if a == axisAttribute {
+		principal = AttributeNode
+	}
+	switch t.kind {
+	case testNode:
+		return true
+	case testNodeType:
+		switch t.nodeType {
+		case "text":
+			return n.Kind == TextNode
+		case "comment":
+			return n.Kind == CommentNode
+		default:
+			return n.Kind == ProcInstNode && (t.piTarget == "" || t.piTarget == n.Local)
+		}
+	case testAnyName:
+		return n.Kind == principal
+	case testNamespace:
+		return n.Kind == principal && n.Namespace == t.ns
+	default:
+		return n.Kind == principal && n.Namespace == t.ns && n.Local == t.local
+	}
+}
+
+type step struct {
+	axis  axis
+	test  nodeTest
+	preds []exprNode
+}
+
+// eval returns the nodes selected by the step from the context node n, in
+// document order.
+func (s *step) eval(n *Node) ([]*Node, error) {
+	var nodes []*Node
+	add := func(c *Node) {
+		if s.test.matches(c, s.axis) {
+			nodes = append(nodes, c)
+		}
+	}
+	switch s.axis {
+	case axisChild:
+		for _, c := range n.Children {
+			add(c)
+		}
+	case axisDescendant, axisDescendantOrSelf:
+		if s.axis == axisDescendantOrSelf {
+			add(n)
+		}
+		walkDescendants(n, add)
+	case axisSelf:
+		add(n)
+	case axisParent:
+		if n.Parent != nil {
+			add(n.Parent)
+		}
+	case axisAncestor, axisAncestorOrSelf:
+		if s.axis == axisAncestorOrSelf {
+			add(n)
+		}
+		for p := n.Parent; p != nil; p = p.Parent {
+			add(p)
+		}
+	case axisFollowingSibling, axisPrecedingSibling:
+		if n.Parent != nil && n.Kind != AttributeNode {
+			for _, c := range n.Parent.Children {
+				if (s.axis == axisFollowingSibling && c.order > n.order) ||
+					(s.axis == axisPrecedingSibling && c.order < n.order) {
+					add(c)
+				}
+			}
+		}
+	case axisFollowing, axisPreceding:
+		root := n
+		for root.Parent != nil {
+			root = root.Parent
+		}
+		// The last descendant of n bounds the following axis, since
+		// descendants are not part of it.
+		last := n
+		for len(last.Children) > 0 {
+			last = last.Children[len(last.Children)-1]
+		}
+		walkDescendants(root, func(c *Node) {
+			if s.axis == axisFollowing {
+				if c.order > last.order {
+					add(c)
+				}
+			} else if c.order < n.order && !isAncestor(c, n) {
+				add(c)
+			}
+		})
+	case axisAttribute:
+		for _, a := range n.Attrs {
+			if !a.nsDecl {
+				add(a)
+			}
+		}
+	}
+	nodes = sortNodes(nodes)
+	if s.axis.reverse() {
+		reverseNodes(nodes)
+	}
+	for _, pred := range s.preds {
+		var err error
+		if nodes, err = applyPredicate(nodes, pred); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func walkDescendants(n *Node, fn func(*Node)) {
+	for _, c := range n.Children {
+		fn(c)
+		walkDescendants(c, fn)
+	}
+}
+
+func isAncestor(a, n *Node) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p == a {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPredicate filters nodes, which are in the proximity order of the axis
+// that produced them, by pred.
+func applyPredicate(nodes []*Node, pred exprNode) ([]*Node, error) {
+	var res []*Node
+	for i, n := range nodes {
+		v, err := pred.eval(&evalCtx{node: n, pos: i + 1, size: len(nodes)})
+		if err != nil {
+			return nil, err
+		}
+		keep := v.Boolean()
+		if v.Kind == NumberValue {
+			keep = v.Num == float64(i+1)
+		}
+		if keep {
+			res = append(res, n)
+		}
+	}
+	return res, nil
+}
+
+// sortNodes sorts nodes in document order and removes duplicates.
+func sortNodes(nodes []*Node) []*Node {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].order < nodes[j].order })
+	res := nodes[:0]
+	for i, n := range nodes {
+		if i == 0 || n != nodes[i-1] {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+func reverseNodes(nodes []*Node) {
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+}
+
+func boolValue(b bool) Value      { return Value{Kind: BooleanValue, Bool: b} }
+func numberValue(f float64) Value { return Value{Kind: NumberValue, Num: f} }
+func stringValue(s string) Value  { return Value{Kind: StringValue, Str: s} }
+
+func nodeSetValue(nodes []*Node) Value {
+	return Value{Kind: NodeSetValue, Nodes: sortNodes(nodes)}
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package xml
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+type funcExpr struct {
+	name string
+	fn   func(ctx *evalCtx, args []Value) (Value, error)
+	args []exprNode
+}
+
+func (e *funcExpr) eval(ctx *evalCtx) (Value, error) {
+	args := make([]Value, len(e.args))
+	for i, a := range e.args {
+		v, err := a.eval(ctx)
+		if err != nil {
+			return Value{}, err
+		}
+		args[i] = v
+	}
+	return e.fn(ctx, args)
+}
+
+type xpathFunc struct {
+	// maxArgs is -1 for functions with a variable number of arguments.
+	minArgs, maxArgs int
+	fn               func(ctx *evalCtx, args []Value) (Value, error)
+}
+
+// xpathFuncs is the XPath 1.0 core function library, except for lang() and
+// id(), which have no meaning without a DTD.
+var xpathFuncs map[string]xpathFunc
+
+func init() {
+	xpathFuncs = map[string]xpathFunc{
+		"last": {0, 0, func(ctx *evalCtx, _ []Value) (Value, error) {
+			return numberValue(float64(ctx.size)), nil
+		}},
+		"position": {0, 0, func(ctx *evalCtx, _ []Value) (Value, error) {
+			return numberValue(float64(ctx.pos)), nil
+		}},
+		"count": {1, 1, func(_ *evalCtx, args []Value) (Value, error) {
+			nodes, err := nodeSetArg("count", args[0])
+			if err != nil {
+				return Value{}, err
+			}
+			return numberValue(float64(len(nodes))), nil
+		}},
+		"local-name": {0, 1, nameFunc("local-name", func(n *Node) string {
+			return n.Local
+		})},
+		"name": {0, 1, nameFunc("name", (*Node).Name)},
+		"namespace-uri": {0, 1, nameFunc("namespace-uri", func(n *Node) string {
+			return n.Namespace
+		})},
+		"string": {0, 1, func(ctx *evalCtx, args []Value) (Value, error) {
+			return stringValue(contextArg(ctx, args).String()), nil
+		}},
+		"concat": {2, -1, func(_ *evalCtx, args []Value) (Value, error) {
+			var sb strings.Builder
+			for _, a := range args {
+				sb.WriteString(a.String())
+			}
+			return stringValue(sb.String()), nil
+		}},
+		"starts-with": {2, 2, func(_ *evalCtx, args []Value) (Value, error) {
+			return boolValue(strings.HasPrefix(args[0].String(), args[1].String())), nil
+		}},
+		"contains": {2, 2, func(_ *evalCtx, args []Value) (Value, error) {
+			return boolValue(strings.Contains(args[0].String(), args[1].String())), nil
+		}},
+		"substring-before": {2, 2, func(_ *evalCtx, args []Value) (Value, error) {
+			before, _, _ := strings.Cut(args[0].String(), args[1].String())
+			if !strings.Contains(args[0].String(), args[1].String()) {
+				before = ""
+			}
+			return stringValue(before), nil
+		}},
+		"substring-after": {2, 2, func(_ *evalCtx, args []Value) (Value, error) {
+			_, after, _ := strings.Cut(args[0].String(), args[1].String())
+			return stringValue(after), nil
+		}},
+		"substring": {2, 3, substring},
+		"string-length": {0, 1, func(ctx *evalCtx, args []Value) (Value, error) {
+			return numberValue(float64(utf8.RuneCountInString(contextArg(ctx, args).String()))), nil
+		}},
+		"normalize-space": {0, 1, func(ctx *evalCtx, args []Value) (Value, error) {
+			return stringValue(strings.Join(strings.Fields(contextArg(ctx, args).String()), " ")), nil
+		}},
+		"translate": {3, 3, translate},
+		"boolean": {1, 1, func(_ *evalCtx, args []Value) (Value, error) {
+			return boolValue(args[0].Boolean()), nil
+		}},
+		"not": {1, 1, func(_ *evalCtx, args []Value) (Value, error) {
+			return boolValue(!args[0].Boolean()), nil
+		}},
+		"true": {0, 0, func(*evalCtx, []Value) (Value, error) {
+			return boolValue(true), nil
+		}},
+		"false": {0, 0, func(*evalCtx, []Value) (Value, error) {
+			return boolValue(false), nil
+		}},
+		"number": {0, 1, func(ctx *evalCtx, args []Value) (Value, error) {
+			return numberValue(contextArg(ctx, args).Number()), nil
+		}},
+		"sum": {1, 1, func(_ *evalCtx, args []Value) (Value, error) {
+			nodes, err := nodeSetArg("sum", args[0])
+			if err != nil {
+				return Value{}, err
+			}
+			var sum float64
+			for _, n := range nodes {
+				sum += parseNumber(n.StringValue())
+			}
+			return numberValue(sum), nil
+		}},
+		"floor": {1, 1, func(_ *evalCtx, args []Value) (Value, error) {
+			return numberValue(math.Floor(args[0].Number())), nil
+		}},
+		"ceiling": {1, 1, func(_ *evalCtx, args []Value) (Value, error) {
+			return numberValue(math.Ceil(args[0].Number())), nil
+		}},
+		"round": {1, 1, func(_ *evalCtx, args []Value) (Value, error) {
+			return numberValue(round(args[0].Number())), nil
+		}},
+	}
+}
+
+// contextArg returns the single optional argument of a function, which
+// defaults to a node-set containing only the context node.
+func contextArg(ctx *evalCtx, args []Value) Value {
+	if len(args) == 0 {
+		return Value{Kind: NodeSetValue, Nodes: []*Node{ctx.node}}
+	}
+	return args[0]
+}
+
+func nodeSetArg(fn string, v Value) ([]*Node, error) {
+	if v.Kind != NodeSetValue {
+		return nil, newXPathError("argument of %s() must be a node-set", fn)
+	}
+	return v.Nodes, nil
+}
+
+// nameFunc returns the implementation of one of the name functions, which
+// apply to the first node of their argument.
+func nameFunc(fn string, get func(*Node) string) func(*evalCtx, []Value) (Value, error) {
+	return func(ctx *evalCtx, args []Value) (Value, error) {
+		nodes, err := nodeSetArg(fn, contextArg(ctx, args))
+		if err != nil || len(nodes) == 0 {
+			return stringValue(""), err
+		}
+		switch nodes[0].Kind {
+		case ElementNode, AttributeNode, ProcInstNode:
+			return stringValue(get(nodes[0])), nil
+		}
+		return stringValue(""), nil
+	}
+}
+
+// substring implements substring(), whose positions are 1-based and rounded,
+// and which may start before the beginning of the string.
+func substring(_ *evalCtx, args []Value) (Value, error) {
+	runes := []rune(args[0].String())
+	start := round(args[1].Number())
+	end := math.Inf(1)
+	if len(args) == 3 {
+		end = start + round(args[2].Number())
+	}
+	var sb strings.Builder
+	for i, r := range runes {
+		if pos := float64(i + 1); pos >= start && pos < end {
+			sb.WriteRune(r)
+		}
+	}
+	return stringValue(sb.String()), nil
+}
+
+func translate(_ *evalCtx, args []Value) (Value, error) {
+	from, to := []rune(args[1].String()), []rune(args[2].String())
+	mapping := make(map[rune]int, len(from))
+	for i, r := range from {
+		// Only the first occurrence of a character counts.
+		if _, ok := mapping[r]; !ok {
+			mapping[r] = i
+		}
+	}
+	var sb strings.Builder
+	for _, r := range args[0].String() {
+		i, ok := mapping[r]
+		switch {
+		case !ok:
+			sb.WriteRune(r)
+		case i < len(to):
+			sb.WriteRune(to[i])
+		}
+	}
+	return stringValue(sb.String()), nil
+}
+
+// round rounds to the closest integer, with halves rounded towards positive
+// infinity.
+func round(f float64) float64 {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return f
+	}
+	if f < 0 && f >= -0.5 {
+		return math.Copysign(0, -1)
+	}
+	return math.Floor(f + 0.5)
+}
@@ -311,6 +311,8 @@ func DatumToGoSQL(d tree.Datum) (interface{}, error) {
 		return d.String(), nil
 	case *tree.DLTree:
 		return d.LTree.String(), nil
+	case *tree.DXML:
+		return d.Contents, nil
 	}
 	return nil, errors.Errorf("unhandled datum type: %s", reflect.TypeOf(d))
 }